@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaxWebhookHealthCheckTimeout is the maximum timeout of a webhook health check. Custom health checks are evaluated
+// during the status reconciliation of an Etcd, which is therefore delayed by at most this duration.
+const MaxWebhookHealthCheckTimeout = 10 * time.Second
+
+// HealthCheck defines a custom health check which is configured for etcd-druid and evaluated for all etcd clusters.
+// Its result is published as a condition in the status of the Etcd resources. Exactly one of HTTP, Metric and Webhook
+// must be set.
+type HealthCheck struct {
+	// ConditionType is the type of the condition in which the result of the check is published. It must not be the
+	// type of a condition maintained by etcd-druid itself.
+	ConditionType string `json:"conditionType"`
+	// HTTP is a check which sends a GET request to the client service of the etcd cluster.
+	// +optional
+	HTTP *HTTPHealthCheck `json:"http,omitempty"`
+	// Metric is a check which compares a metric served by etcd with thresholds.
+	// +optional
+	Metric *MetricHealthCheck `json:"metric,omitempty"`
+	// Webhook is a check which is delegated to an external endpoint.
+	// +optional
+	Webhook *WebhookHealthCheck `json:"webhook,omitempty"`
+}
+
+// HTTPHealthCheck is a health check which sends a GET request to the client service of the etcd cluster. The client
+// certificate configured for the etcd cluster is presented if TLS is enabled for the client communication.
+type HTTPHealthCheck struct {
+	// Path is the path which is requested, e.g. `/health` or `/readyz`.
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path"`
+	// ExpectedStatusCodes are the HTTP status codes of a successful check. Defaults to 200.
+	// +optional
+	ExpectedStatusCodes []int32 `json:"expectedStatusCodes,omitempty"`
+}
+
+// MetricHealthCheck is a health check which compares the value of a metric, which is served by etcd on its client
+// port, with thresholds. The check fails if any of the selected samples is outside the thresholds.
+// +kubebuilder:validation:XValidation:message="at least one of min and max must be set",rule="has(self.min) || has(self.max)"
+type MetricHealthCheck struct {
+	// Name is the name of a gauge or counter metric, e.g. `etcd_mvcc_db_total_size_in_bytes`.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_:][a-zA-Z0-9_:]*$`
+	Name string `json:"name"`
+	// Labels selects the samples of the metric which are checked. All samples are checked if not set.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Min is the minimum value of the samples.
+	// +optional
+	Min *resource.Quantity `json:"min,omitempty"`
+	// Max is the maximum value of the samples.
+	// +optional
+	Max *resource.Quantity `json:"max,omitempty"`
+}
+
+// WebhookHealthCheck is a health check which is delegated to an external endpoint. etcd-druid sends a POST request
+// with the Etcd resource as JSON body to the URL. The endpoint has to respond with status code 200 and a JSON body of
+// the form `{"status": "True|False|Unknown", "reason": "...", "message": "..."}`. Webhook health checks can only be
+// configured for etcd-druid, so that the endpoints which etcd-druid sends requests to are controlled by its operator.
+type WebhookHealthCheck struct {
+	// URL is the URL of the endpoint. It must use the https scheme.
+	// +kubebuilder:validation:XValidation:message="url must be a valid https URL",rule="isURL(self) && url(self).getScheme() == 'https'"
+	URL string `json:"url"`
+	// CABundle is a PEM encoded CA bundle which is used to verify the certificate of the endpoint. The system trust
+	// store is used if not set.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// Timeout is the timeout of the request. It must be greater than 0s and at most 10s. Defaults to 5s.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="timeout must be greater than 0s and at most 10s",rule="duration(self) > duration('0s') && duration(self) <= duration('10s')"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
@@ -154,6 +155,34 @@ func SetDefaults_CompactionControllerConfiguration(compactionCtrlConfig *Compact
 	}
 }
 
+const (
+	// DefaultCompactionDatabaseSizeMemoryPercent is the default percentage of the etcd database size that is added to the memory requests of a compaction job.
+	DefaultCompactionDatabaseSizeMemoryPercent = 50
+	// DefaultCompactionMemoryLimitPercent is the default memory limit of a compaction job as a percentage of its memory requests.
+	DefaultCompactionMemoryLimitPercent = 150
+	// DefaultCompactionOOMRetryMemoryPercent is the default percentage of the memory requests of an OOMKilled compaction job that is requested by the next compaction job.
+	DefaultCompactionOOMRetryMemoryPercent = 150
+)
+
+// DefaultCompactionMemoryPerDeltaEvent is the default amount of memory that is added to the memory requests of a compaction job for every accumulated delta event.
+var DefaultCompactionMemoryPerDeltaEvent = resource.MustParse("1Ki")
+
+// SetDefaults_CompactionJobAdaptiveResourcesConfiguration sets defaults for the adaptive resources configuration of compaction jobs.
+func SetDefaults_CompactionJobAdaptiveResourcesConfiguration(adaptiveResourcesConfig *CompactionJobAdaptiveResourcesConfiguration) {
+	if adaptiveResourcesConfig.DatabaseSizeMemoryPercent == 0 {
+		adaptiveResourcesConfig.DatabaseSizeMemoryPercent = DefaultCompactionDatabaseSizeMemoryPercent
+	}
+	if adaptiveResourcesConfig.MemoryPerDeltaEvent.IsZero() {
+		adaptiveResourcesConfig.MemoryPerDeltaEvent = DefaultCompactionMemoryPerDeltaEvent.DeepCopy()
+	}
+	if adaptiveResourcesConfig.MemoryLimitPercent == 0 {
+		adaptiveResourcesConfig.MemoryLimitPercent = DefaultCompactionMemoryLimitPercent
+	}
+	if adaptiveResourcesConfig.OOMRetryMemoryPercent == 0 {
+		adaptiveResourcesConfig.OOMRetryMemoryPercent = DefaultCompactionOOMRetryMemoryPercent
+	}
+}
+
 // DefaultEtcdCopyBackupsTaskConcurrentSyncs is the default number of concurrent syncs for the etcd copy backups task controller.
 const DefaultEtcdCopyBackupsTaskConcurrentSyncs = 3
 
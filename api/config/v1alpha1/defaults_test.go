@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -281,6 +282,7 @@ func TestSetDefaults_CompactionControllerConfiguration(t *testing.T) {
 				ConcurrentSyncs:              ptr.To(5),
 				TriggerFullSnapshotThreshold: 2000000,
 				ActiveDeadlineDuration:       metav1.Duration{Duration: 1 * time.Hour},
+				HistoryLimit:                 ptr.To(10),
 			},
 			expected: &CompactionControllerConfiguration{
 				Enabled:                      true,
@@ -288,6 +290,7 @@ func TestSetDefaults_CompactionControllerConfiguration(t *testing.T) {
 				EventsThreshold:              1000000,
 				TriggerFullSnapshotThreshold: 2000000,
 				ActiveDeadlineDuration:       metav1.Duration{Duration: 1 * time.Hour},
+				HistoryLimit:                 ptr.To(10),
 			},
 		},
 	}
@@ -303,6 +306,52 @@ func TestSetDefaults_CompactionControllerConfiguration(t *testing.T) {
 	}
 }
 
+func TestSetDefaults_CompactionJobAdaptiveResourcesConfiguration(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *CompactionJobAdaptiveResourcesConfiguration
+		expected *CompactionJobAdaptiveResourcesConfiguration
+	}{
+		{
+			name:   "should correctly set default values",
+			config: &CompactionJobAdaptiveResourcesConfiguration{},
+			expected: &CompactionJobAdaptiveResourcesConfiguration{
+				DatabaseSizeMemoryPercent: 50,
+				MemoryPerDeltaEvent:       resource.MustParse("1Ki"),
+				MemoryLimitPercent:        150,
+				OOMRetryMemoryPercent:     150,
+			},
+		},
+		{
+			name: "should not overwrite already set values",
+			config: &CompactionJobAdaptiveResourcesConfiguration{
+				DatabaseSizeMemoryPercent: 100,
+				MemoryPerDeltaEvent:       resource.MustParse("2Ki"),
+				MemoryLimitPercent:        200,
+				OOMRetryMemoryPercent:     300,
+				MaxMemory:                 ptr.To(resource.MustParse("16Gi")),
+			},
+			expected: &CompactionJobAdaptiveResourcesConfiguration{
+				DatabaseSizeMemoryPercent: 100,
+				MemoryPerDeltaEvent:       resource.MustParse("2Ki"),
+				MemoryLimitPercent:        200,
+				OOMRetryMemoryPercent:     300,
+				MaxMemory:                 ptr.To(resource.MustParse("16Gi")),
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			SetDefaults_CompactionJobAdaptiveResourcesConfiguration(test.config)
+			g.Expect(test.config).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestSetDefaults_EtcdCopyBackupsTaskControllerConfiguration(t *testing.T) {
 	tests := []struct {
 		name     string
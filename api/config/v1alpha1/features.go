@@ -17,6 +17,10 @@ const (
 
 	// UpgradeEtcdVersion is the name of the feature which enables upgrade of etcd version to v3.5.
 	UpgradeEtcdVersion = "UpgradeEtcdVersion"
+
+	// RollOutReferencedSecretsCheckSum is the name of the feature which adds the checksum of the referenced secrets to the
+	// pod template of StatefulSets created by a previous version of etcd-druid right away, instead of with their next rollout.
+	RollOutReferencedSecretsCheckSum = "RollOutReferencedSecretsCheckSum"
 )
 
 // maturityLevelSpec is the specification of maturity level for a feature.
@@ -85,6 +89,7 @@ var DefaultFeatureGates = newFeatureGate()
 func init() {
 	DefaultFeatureGates.knownFeatures[UseEtcdWrapper] = maturityLevelSpecGA
 	DefaultFeatureGates.knownFeatures[UpgradeEtcdVersion] = maturityLevelSpecAlpha
+	DefaultFeatureGates.knownFeatures[RollOutReferencedSecretsCheckSum] = maturityLevelSpecAlpha
 }
 
 // IsEnabled checks if a feature is enabled.
@@ -5,6 +5,9 @@
 package v1alpha1
 
 import (
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,6 +31,9 @@ type OperatorConfiguration struct {
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
 	// Logging defines the configuration for logging.
 	Logging LogConfiguration `json:"logging"`
+	// Tracing defines the configuration for OpenTelemetry tracing.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 }
 
 // ClientConnectionConfiguration defines the configuration for constructing a client.Client to connect to k8s kube-apiserver.
@@ -142,6 +148,15 @@ type EtcdControllerConfiguration struct {
 	EtcdStatusSyncPeriod metav1.Duration `json:"etcdStatusSyncPeriod"`
 	// EtcdMember holds configuration related to etcd members.
 	EtcdMember EtcdMemberConfiguration `json:"etcdMember"`
+	// HealthChecks are custom health checks which are evaluated for all etcd clusters. Their results are published as
+	// additional conditions in the status of the Etcd resources. Unlike the health checks of an Etcd, they may contain
+	// webhook health checks.
+	// +optional
+	HealthChecks []druidapicommon.HealthCheck `json:"healthChecks,omitempty"`
+	// OperationHistoryLimit is the maximum number of operations which are recorded in the operation history in the
+	// status of an Etcd resource. If not set, no operation history is recorded.
+	// +optional
+	OperationHistoryLimit *int `json:"operationHistoryLimit,omitempty"`
 }
 
 // EtcdMemberConfiguration holds configuration related to etcd members.
@@ -174,6 +189,43 @@ type CompactionControllerConfiguration struct {
 	ActiveDeadlineDuration metav1.Duration `json:"activeDeadlineDuration"`
 	// MetricsScrapeWaitDuration is the duration to wait for after compaction job is completed, to allow Prometheus metrics to be scraped
 	MetricsScrapeWaitDuration metav1.Duration `json:"metricsScrapeWaitDuration"`
+	// MaxConcurrentJobs is the maximum number of compaction jobs that can run at the same time across all Etcd resources.
+	// If not set, the number of concurrently running compaction jobs is not limited.
+	// +optional
+	MaxConcurrentJobs *int `json:"maxConcurrentJobs,omitempty"`
+	// HistoryLimit is the maximum number of compaction job runs that are recorded in the status of an Etcd resource.
+	// If not set or 0, no compaction job runs are recorded.
+	// +optional
+	HistoryLimit *int `json:"historyLimit,omitempty"`
+	// FailedJobRetentionDuration is the duration for which the pod of a failed compaction job is retained before being deleted,
+	// allowing it to be inspected. The failed job itself is deleted right away, so that retained pods do not block subsequent compaction jobs.
+	// If not set, the pods of failed compaction jobs are deleted along with the job.
+	// +optional
+	FailedJobRetentionDuration *metav1.Duration `json:"failedJobRetentionDuration,omitempty"`
+	// AdaptiveResources configures the sizing of compaction job resources based on the size of the etcd database
+	// and the number of accumulated delta events. If not set, the resources configured in the Etcd resource or the
+	// default resources are used for compaction jobs.
+	// +optional
+	AdaptiveResources *CompactionJobAdaptiveResourcesConfiguration `json:"adaptiveResources,omitempty"`
+}
+
+// CompactionJobAdaptiveResourcesConfiguration defines how the resources of compaction jobs are computed.
+// The computed memory is never lower than the memory requests configured in the Etcd resource or the default memory requests.
+type CompactionJobAdaptiveResourcesConfiguration struct {
+	// DatabaseSizeMemoryPercent is the percentage of the etcd database size that is added to the memory requests of a compaction job.
+	// The largest database size reported by the etcd members is used, or the etcd quota if no member has reported it yet.
+	DatabaseSizeMemoryPercent int `json:"databaseSizeMemoryPercent"`
+	// MemoryPerDeltaEvent is the amount of memory that is added to the memory requests of a compaction job for every accumulated delta event.
+	MemoryPerDeltaEvent resource.Quantity `json:"memoryPerDeltaEvent"`
+	// MemoryLimitPercent is the memory limit of a compaction job as a percentage of its memory requests.
+	MemoryLimitPercent int `json:"memoryLimitPercent"`
+	// OOMRetryMemoryPercent is the percentage of the memory requests of the previous compaction job that is requested
+	// by the next compaction job if the previous one was OOMKilled.
+	OOMRetryMemoryPercent int `json:"oomRetryMemoryPercent"`
+	// MaxMemory is the upper bound for the memory requests of a compaction job. A CompactionJobMemoryCapped warning event
+	// is emitted on the Etcd resource whenever the computed memory requests are capped.
+	// +optional
+	MaxMemory *resource.Quantity `json:"maxMemory,omitempty"`
 }
 
 // EtcdCopyBackupsTaskControllerConfiguration defines the configuration for the EtcdCopyBackupsTask controller.
@@ -258,3 +310,36 @@ type LogConfiguration struct {
 	// LogFormat is the output format for the logs. Must be one of [text,json].
 	LogFormat LogFormat `json:"logFormat"`
 }
+
+// TracingConfiguration contains the configuration for OpenTelemetry tracing of the reconciliations. Each reconciliation
+// run of an Etcd resource is recorded as a trace with spans for its steps and the operations on its components.
+type TracingConfiguration struct {
+	// Enabled specifies whether tracing is enabled.
+	Enabled bool `json:"enabled"`
+	// SamplingPercentage is the percentage of reconciliation runs which are traced. Defaults to 100.
+	// +optional
+	SamplingPercentage *int32 `json:"samplingPercentage,omitempty"`
+	// OTLP configures the export of traces to an OpenTelemetry collector via OTLP over gRPC.
+	// Exactly one of OTLP and File must be set if tracing is enabled.
+	// +optional
+	OTLP *OTLPTraceExporterConfiguration `json:"otlp,omitempty"`
+	// File configures the export of traces as JSON lines to a file. It is intended for tests and local development.
+	// Exactly one of OTLP and File must be set if tracing is enabled.
+	// +optional
+	File *FileTraceExporterConfiguration `json:"file,omitempty"`
+}
+
+// OTLPTraceExporterConfiguration contains the configuration for exporting traces via OTLP over gRPC.
+type OTLPTraceExporterConfiguration struct {
+	// Endpoint is the host and port of the OTLP gRPC endpoint, e.g. `otel-collector.monitoring:4317`.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the endpoint.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// FileTraceExporterConfiguration contains the configuration for exporting traces to a file.
+type FileTraceExporterConfiguration struct {
+	// Path is the path of the file to which the traces are appended.
+	Path string `json:"path"`
+}
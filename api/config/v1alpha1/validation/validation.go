@@ -5,9 +5,14 @@
 package validation
 
 import (
+	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
 
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -15,6 +20,8 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+var healthCheckConditionTypeRegex = regexp.MustCompile(`^[A-Z][A-Za-z0-9]{0,62}$`)
+
 // ValidateOperatorConfiguration validates the operator configuration.
 func ValidateOperatorConfiguration(config *druidconfigv1alpha1.OperatorConfiguration) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -24,6 +31,7 @@ func ValidateOperatorConfiguration(config *druidconfigv1alpha1.OperatorConfigura
 	allErrs = append(allErrs, validateControllerConfiguration(config.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateLogConfiguration(config.Logging, field.NewPath("log"))...)
 	allErrs = append(allErrs, validateWebhookConfiguration(config.Webhooks, field.NewPath("webhooks"))...)
+	allErrs = append(allErrs, validateTracingConfiguration(config.Tracing, field.NewPath("tracing"))...)
 
 	return allErrs
 }
@@ -68,6 +76,27 @@ func validateLogConfiguration(logConfig druidconfigv1alpha1.LogConfiguration, fl
 	return allErrs
 }
 
+func validateTracingConfiguration(tracingConfig *druidconfigv1alpha1.TracingConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if tracingConfig == nil || !tracingConfig.Enabled {
+		return allErrs
+	}
+	if tracingConfig.SamplingPercentage != nil && (*tracingConfig.SamplingPercentage < 0 || *tracingConfig.SamplingPercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("samplingPercentage"), *tracingConfig.SamplingPercentage, "must be between 0 and 100"))
+	}
+	switch {
+	case tracingConfig.OTLP == nil && tracingConfig.File == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one of otlp and file must be set if tracing is enabled"))
+	case tracingConfig.OTLP != nil && tracingConfig.File != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of otlp and file may be set"))
+	case tracingConfig.OTLP != nil && tracingConfig.OTLP.Endpoint == "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("otlp", "endpoint"), "must be set"))
+	case tracingConfig.File != nil && tracingConfig.File.Path == "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("file", "path"), "must be set"))
+	}
+	return allErrs
+}
+
 func validateControllerConfiguration(controllerConfig druidconfigv1alpha1.ControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateEtcdControllerConfiguration(controllerConfig.Etcd, fldPath.Child("etcd"))...)
@@ -84,6 +113,58 @@ func validateEtcdControllerConfiguration(etcdControllerConfig druidconfigv1alpha
 	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(etcdControllerConfig.EtcdStatusSyncPeriod, fldPath.Child("etcdStatusSyncPeriod"))...)
 	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(etcdControllerConfig.EtcdMember.NotReadyThreshold, fldPath.Child("etcdMember", "notReadyThreshold"))...)
 	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(etcdControllerConfig.EtcdMember.UnknownThreshold, fldPath.Child("etcdMember", "unknownThreshold"))...)
+	allErrs = append(allErrs, validateHealthChecks(etcdControllerConfig.HealthChecks, fldPath.Child("healthChecks"))...)
+	if etcdControllerConfig.OperationHistoryLimit != nil && *etcdControllerConfig.OperationHistoryLimit <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("operationHistoryLimit"), *etcdControllerConfig.OperationHistoryLimit, "must be greater than 0"))
+	}
+	return allErrs
+}
+
+func validateHealthChecks(healthChecks []druidapicommon.HealthCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	conditionTypes := sets.New[string]()
+	for i, healthCheck := range healthChecks {
+		idxPath := fldPath.Index(i)
+		conditionTypePath := idxPath.Child("conditionType")
+		switch {
+		case !healthCheckConditionTypeRegex.MatchString(healthCheck.ConditionType):
+			allErrs = append(allErrs, field.Invalid(conditionTypePath, healthCheck.ConditionType, "must start with an upper case letter and consist of alphanumeric characters"))
+		case druidv1alpha1.IsDruidManagedConditionType(druidv1alpha1.ConditionType(healthCheck.ConditionType)):
+			allErrs = append(allErrs, field.Invalid(conditionTypePath, healthCheck.ConditionType, "must not be the type of a condition maintained by etcd-druid"))
+		case conditionTypes.Has(healthCheck.ConditionType):
+			allErrs = append(allErrs, field.Duplicate(conditionTypePath, healthCheck.ConditionType))
+		}
+		conditionTypes.Insert(healthCheck.ConditionType)
+
+		var kinds int
+		if healthCheck.HTTP != nil {
+			kinds++
+			if !strings.HasPrefix(healthCheck.HTTP.Path, "/") {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("http", "path"), healthCheck.HTTP.Path, "must start with /"))
+			}
+		}
+		if healthCheck.Metric != nil {
+			kinds++
+			if healthCheck.Metric.Name == "" {
+				allErrs = append(allErrs, field.Required(idxPath.Child("metric", "name"), "must be set"))
+			}
+			if healthCheck.Metric.Min == nil && healthCheck.Metric.Max == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("metric"), "at least one of min and max must be set"))
+			}
+		}
+		if healthCheck.Webhook != nil {
+			kinds++
+			if u, err := url.Parse(healthCheck.Webhook.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("webhook", "url"), healthCheck.Webhook.URL, "must be a valid https URL"))
+			}
+			if timeout := healthCheck.Webhook.Timeout; timeout != nil && (timeout.Duration <= 0 || timeout.Duration > druidapicommon.MaxWebhookHealthCheckTimeout) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("webhook", "timeout"), *timeout, fmt.Sprintf("must be greater than 0 and at most %s", druidapicommon.MaxWebhookHealthCheckTimeout)))
+			}
+		}
+		if kinds != 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath, healthCheck.ConditionType, "exactly one of http, metric and webhook must be set"))
+		}
+	}
 	return allErrs
 }
 
@@ -101,6 +182,38 @@ func validateCompactionControllerConfiguration(compactionControllerConfig druidc
 	if compactionControllerConfig.TriggerFullSnapshotThreshold <= 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("triggerFullSnapshotThreshold"), compactionControllerConfig.TriggerFullSnapshotThreshold, "must be greater than 0"))
 	}
+	if compactionControllerConfig.MaxConcurrentJobs != nil && *compactionControllerConfig.MaxConcurrentJobs <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentJobs"), *compactionControllerConfig.MaxConcurrentJobs, "must be greater than 0"))
+	}
+	if compactionControllerConfig.HistoryLimit != nil && *compactionControllerConfig.HistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("historyLimit"), *compactionControllerConfig.HistoryLimit, "must be greater than or equal to 0"))
+	}
+	if compactionControllerConfig.FailedJobRetentionDuration != nil {
+		allErrs = append(allErrs, mustBeGreaterThanZeroDurationPointer(compactionControllerConfig.FailedJobRetentionDuration, fldPath.Child("failedJobRetentionDuration"))...)
+	}
+	if compactionControllerConfig.AdaptiveResources != nil {
+		allErrs = append(allErrs, validateCompactionJobAdaptiveResourcesConfiguration(*compactionControllerConfig.AdaptiveResources, fldPath.Child("adaptiveResources"))...)
+	}
+	return allErrs
+}
+
+func validateCompactionJobAdaptiveResourcesConfiguration(adaptiveResourcesConfig druidconfigv1alpha1.CompactionJobAdaptiveResourcesConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if adaptiveResourcesConfig.DatabaseSizeMemoryPercent < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("databaseSizeMemoryPercent"), adaptiveResourcesConfig.DatabaseSizeMemoryPercent, "must be greater than or equal to 0"))
+	}
+	if adaptiveResourcesConfig.MemoryPerDeltaEvent.Sign() < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryPerDeltaEvent"), adaptiveResourcesConfig.MemoryPerDeltaEvent.String(), "must be greater than or equal to 0"))
+	}
+	if adaptiveResourcesConfig.MemoryLimitPercent < 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryLimitPercent"), adaptiveResourcesConfig.MemoryLimitPercent, "must be greater than or equal to 100"))
+	}
+	if adaptiveResourcesConfig.OOMRetryMemoryPercent <= 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("oomRetryMemoryPercent"), adaptiveResourcesConfig.OOMRetryMemoryPercent, "must be greater than 100"))
+	}
+	if adaptiveResourcesConfig.MaxMemory != nil && adaptiveResourcesConfig.MaxMemory.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxMemory"), adaptiveResourcesConfig.MaxMemory.String(), "must be greater than 0"))
+	}
 	return allErrs
 }
 
@@ -8,9 +8,12 @@ import (
 	"testing"
 	"time"
 
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 
 	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -232,13 +235,14 @@ func TestValidateLogConfiguration(t *testing.T) {
 
 func TestValidateEtcdControllerConfiguration(t *testing.T) {
 	tests := []struct {
-		name                 string
-		concurrentSync       *int
-		etcdStatusSyncPeriod *metav1.Duration
-		notReadyThreshold    *metav1.Duration
-		unknownThreshold     *metav1.Duration
-		expectedErrors       int
-		matcher              gomegatypes.GomegaMatcher
+		name                  string
+		concurrentSync        *int
+		etcdStatusSyncPeriod  *metav1.Duration
+		notReadyThreshold     *metav1.Duration
+		unknownThreshold      *metav1.Duration
+		operationHistoryLimit *int
+		expectedErrors        int
+		matcher               gomegatypes.GomegaMatcher
 	}{
 		{
 			name:           "should allow default etcd controller configuration",
@@ -280,6 +284,17 @@ func TestValidateEtcdControllerConfiguration(t *testing.T) {
 			expectedErrors:   1,
 			matcher:          ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.etcdMember.unknownThreshold")}))),
 		},
+		{
+			name:                  "should allow operationHistoryLimit greater than zero",
+			operationHistoryLimit: ptr.To(10),
+			expectedErrors:        0,
+		},
+		{
+			name:                  "should forbid operationHistoryLimit equal to zero",
+			operationHistoryLimit: ptr.To(0),
+			expectedErrors:        1,
+			matcher:               ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.operationHistoryLimit")}))),
+		},
 	}
 
 	fldPath := field.NewPath("controllers.etcd")
@@ -302,6 +317,7 @@ func TestValidateEtcdControllerConfiguration(t *testing.T) {
 			if test.unknownThreshold != nil {
 				etcdConfig.EtcdMember.UnknownThreshold = *test.unknownThreshold
 			}
+			etcdConfig.OperationHistoryLimit = test.operationHistoryLimit
 			actualErrList := validateEtcdControllerConfiguration(*etcdConfig, fldPath)
 			g.Expect(len(actualErrList)).To(Equal(test.expectedErrors))
 			if test.matcher != nil {
@@ -311,6 +327,146 @@ func TestValidateEtcdControllerConfiguration(t *testing.T) {
 	}
 }
 
+func TestValidateHealthChecks(t *testing.T) {
+	httpCheck := &druidapicommon.HTTPHealthCheck{Path: "/health"}
+	tests := []struct {
+		name              string
+		healthChecks      []druidapicommon.HealthCheck
+		numExpectedErrors int
+		matcher           gomegatypes.GomegaMatcher
+	}{
+		{
+			name: "should allow valid health checks",
+			healthChecks: []druidapicommon.HealthCheck{
+				{ConditionType: "Healthy", HTTP: httpCheck},
+				{ConditionType: "DBSizeBelowLimit", Metric: &druidapicommon.MetricHealthCheck{Name: "etcd_mvcc_db_total_size_in_bytes", Max: ptr.To(resource.MustParse("6Gi"))}},
+				{ConditionType: "ExternallyHealthy", Webhook: &druidapicommon.WebhookHealthCheck{URL: "https://checker.example.com/check"}},
+			},
+			numExpectedErrors: 0,
+		},
+		{
+			name:              "should forbid condition types maintained by etcd-druid",
+			healthChecks:      []druidapicommon.HealthCheck{{ConditionType: string(druidv1alpha1.ConditionTypeReady), HTTP: httpCheck}},
+			numExpectedErrors: 1,
+			matcher:           ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.healthChecks[0].conditionType")}))),
+		},
+		{
+			name:              "should forbid duplicate condition types",
+			healthChecks:      []druidapicommon.HealthCheck{{ConditionType: "Healthy", HTTP: httpCheck}, {ConditionType: "Healthy", HTTP: httpCheck}},
+			numExpectedErrors: 1,
+			matcher:           ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("controllers.etcd.healthChecks[1].conditionType")}))),
+		},
+		{
+			name:              "should forbid health checks without or with multiple kinds",
+			healthChecks:      []druidapicommon.HealthCheck{{ConditionType: "Healthy"}, {ConditionType: "Healthy2", HTTP: httpCheck, Webhook: &druidapicommon.WebhookHealthCheck{URL: "https://checker.example.com"}}},
+			numExpectedErrors: 2,
+			matcher: ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.healthChecks[0]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.healthChecks[1]")})),
+			),
+		},
+		{
+			name: "should forbid invalid checks",
+			healthChecks: []druidapicommon.HealthCheck{
+				{ConditionType: "Healthy", HTTP: &druidapicommon.HTTPHealthCheck{Path: "health"}},
+				{ConditionType: "DBSizeBelowLimit", Metric: &druidapicommon.MetricHealthCheck{Name: "etcd_mvcc_db_total_size_in_bytes"}},
+				{ConditionType: "ExternallyHealthy", Webhook: &druidapicommon.WebhookHealthCheck{URL: "http://checker.example.com/check"}},
+				{ConditionType: "ExternallyHealthy2", Webhook: &druidapicommon.WebhookHealthCheck{URL: "https://checker.example.com/check", Timeout: &metav1.Duration{Duration: time.Minute}}},
+			},
+			numExpectedErrors: 4,
+			matcher: ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.healthChecks[0].http.path")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("controllers.etcd.healthChecks[1].metric")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.healthChecks[2].webhook.url")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.etcd.healthChecks[3].webhook.timeout")})),
+			),
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			actualErrList := validateHealthChecks(test.healthChecks, field.NewPath("controllers.etcd.healthChecks"))
+			g.Expect(len(actualErrList)).To(Equal(test.numExpectedErrors))
+			if test.matcher != nil {
+				g.Expect(actualErrList).To(test.matcher)
+			}
+		})
+	}
+}
+
+func TestValidateTracingConfiguration(t *testing.T) {
+	tests := []struct {
+		name              string
+		tracingConfig     *druidconfigv1alpha1.TracingConfiguration
+		numExpectedErrors int
+		matcher           gomegatypes.GomegaMatcher
+	}{
+		{
+			name:              "should allow tracing configuration to be absent",
+			numExpectedErrors: 0,
+		},
+		{
+			name:              "should allow disabled tracing without exporter",
+			tracingConfig:     &druidconfigv1alpha1.TracingConfiguration{Enabled: false},
+			numExpectedErrors: 0,
+		},
+		{
+			name: "should allow enabled tracing with OTLP exporter",
+			tracingConfig: &druidconfigv1alpha1.TracingConfiguration{
+				Enabled:            true,
+				SamplingPercentage: ptr.To[int32](10),
+				OTLP:               &druidconfigv1alpha1.OTLPTraceExporterConfiguration{Endpoint: "otel-collector.monitoring:4317"},
+			},
+			numExpectedErrors: 0,
+		},
+		{
+			name:              "should forbid enabled tracing without exporter",
+			tracingConfig:     &druidconfigv1alpha1.TracingConfiguration{Enabled: true},
+			numExpectedErrors: 1,
+			matcher:           ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("tracing")}))),
+		},
+		{
+			name: "should forbid enabled tracing with multiple exporters",
+			tracingConfig: &druidconfigv1alpha1.TracingConfiguration{
+				Enabled: true,
+				OTLP:    &druidconfigv1alpha1.OTLPTraceExporterConfiguration{Endpoint: "otel-collector.monitoring:4317"},
+				File:    &druidconfigv1alpha1.FileTraceExporterConfiguration{Path: "/tmp/traces.json"},
+			},
+			numExpectedErrors: 1,
+			matcher:           ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("tracing")}))),
+		},
+		{
+			name: "should forbid invalid sampling percentage and empty file path",
+			tracingConfig: &druidconfigv1alpha1.TracingConfiguration{
+				Enabled:            true,
+				SamplingPercentage: ptr.To[int32](101),
+				File:               &druidconfigv1alpha1.FileTraceExporterConfiguration{},
+			},
+			numExpectedErrors: 2,
+			matcher: ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("tracing.samplingPercentage")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("tracing.file.path")})),
+			),
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			actualErrList := validateTracingConfiguration(test.tracingConfig, field.NewPath("tracing"))
+			g.Expect(len(actualErrList)).To(Equal(test.numExpectedErrors))
+			if test.matcher != nil {
+				g.Expect(actualErrList).To(test.matcher)
+			}
+		})
+	}
+}
+
 func TestValidateCompactionControllerConfiguration(t *testing.T) {
 	tests := []struct {
 		name                         string
@@ -320,6 +476,10 @@ func TestValidateCompactionControllerConfiguration(t *testing.T) {
 		triggerFullSnapshotThreshold *int64
 		activeDeadlineDuration       *metav1.Duration
 		metricsScrapeWaitDuration    *metav1.Duration
+		maxConcurrentJobs            *int
+		historyLimit                 *int
+		failedJobRetentionDuration   *metav1.Duration
+		adaptiveResources            *druidconfigv1alpha1.CompactionJobAdaptiveResourcesConfiguration
 		expectedErrors               int
 		matcher                      gomegatypes.GomegaMatcher
 	}{
@@ -397,6 +557,70 @@ func TestValidateCompactionControllerConfiguration(t *testing.T) {
 			expectedErrors:            1,
 			matcher:                   ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.metricsScrapeWaitDuration")}))),
 		},
+		{
+			name:              "should allow max concurrent jobs greater than zero",
+			enabled:           true,
+			maxConcurrentJobs: ptr.To(5),
+			expectedErrors:    0,
+		},
+		{
+			name:              "should forbid max concurrent jobs equal to zero",
+			enabled:           true,
+			maxConcurrentJobs: ptr.To(0),
+			expectedErrors:    1,
+			matcher:           ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.maxConcurrentJobs")}))),
+		},
+		{
+			name:           "should allow history limit equal to zero",
+			enabled:        true,
+			historyLimit:   ptr.To(0),
+			expectedErrors: 0,
+		},
+		{
+			name:           "should forbid history limit less than zero",
+			enabled:        true,
+			historyLimit:   ptr.To(-1),
+			expectedErrors: 1,
+			matcher:        ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.historyLimit")}))),
+		},
+		{
+			name:                       "should allow failed job retention duration greater than zero",
+			enabled:                    true,
+			failedJobRetentionDuration: &metav1.Duration{Duration: time.Hour},
+			expectedErrors:             0,
+		},
+		{
+			name:                       "should forbid failed job retention duration equal to zero",
+			enabled:                    true,
+			failedJobRetentionDuration: &metav1.Duration{},
+			expectedErrors:             1,
+			matcher:                    ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.failedJobRetentionDuration")}))),
+		},
+		{
+			name:              "should allow defaulted adaptive resources configuration",
+			enabled:           true,
+			adaptiveResources: &druidconfigv1alpha1.CompactionJobAdaptiveResourcesConfiguration{},
+			expectedErrors:    0,
+		},
+		{
+			name:    "should forbid invalid adaptive resources configuration",
+			enabled: true,
+			adaptiveResources: &druidconfigv1alpha1.CompactionJobAdaptiveResourcesConfiguration{
+				DatabaseSizeMemoryPercent: -1,
+				MemoryPerDeltaEvent:       resource.MustParse("-1Ki"),
+				MemoryLimitPercent:        50,
+				OOMRetryMemoryPercent:     100,
+				MaxMemory:                 ptr.To(resource.MustParse("0")),
+			},
+			expectedErrors: 5,
+			matcher: ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.adaptiveResources.databaseSizeMemoryPercent")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.adaptiveResources.memoryPerDeltaEvent")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.adaptiveResources.memoryLimitPercent")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.adaptiveResources.oomRetryMemoryPercent")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.compaction.adaptiveResources.maxMemory")})),
+			),
+		},
 	}
 
 	fldPath := field.NewPath("controllers.compaction")
@@ -423,6 +647,17 @@ func TestValidateCompactionControllerConfiguration(t *testing.T) {
 			if test.metricsScrapeWaitDuration != nil {
 				controllerConfig.MetricsScrapeWaitDuration = *test.metricsScrapeWaitDuration
 			}
+			if test.maxConcurrentJobs != nil {
+				controllerConfig.MaxConcurrentJobs = test.maxConcurrentJobs
+			}
+			if test.historyLimit != nil {
+				controllerConfig.HistoryLimit = test.historyLimit
+			}
+			controllerConfig.FailedJobRetentionDuration = test.failedJobRetentionDuration
+			if test.adaptiveResources != nil {
+				druidconfigv1alpha1.SetDefaults_CompactionJobAdaptiveResourcesConfiguration(test.adaptiveResources)
+				controllerConfig.AdaptiveResources = test.adaptiveResources
+			}
 			actualErrList := validateCompactionControllerConfiguration(*controllerConfig, fldPath)
 			g.Expect(len(actualErrList)).To(Equal(test.expectedErrors))
 			if test.matcher != nil {
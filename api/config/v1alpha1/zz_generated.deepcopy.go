@@ -9,6 +9,7 @@
 package v1alpha1
 
 import (
+	common "github.com/gardener/etcd-druid/api/common"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -39,6 +40,26 @@ func (in *CompactionControllerConfiguration) DeepCopyInto(out *CompactionControl
 	}
 	out.ActiveDeadlineDuration = in.ActiveDeadlineDuration
 	out.MetricsScrapeWaitDuration = in.MetricsScrapeWaitDuration
+	if in.MaxConcurrentJobs != nil {
+		in, out := &in.MaxConcurrentJobs, &out.MaxConcurrentJobs
+		*out = new(int)
+		**out = **in
+	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.FailedJobRetentionDuration != nil {
+		in, out := &in.FailedJobRetentionDuration, &out.FailedJobRetentionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AdaptiveResources != nil {
+		in, out := &in.AdaptiveResources, &out.AdaptiveResources
+		*out = new(CompactionJobAdaptiveResourcesConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -52,6 +73,28 @@ func (in *CompactionControllerConfiguration) DeepCopy() *CompactionControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompactionJobAdaptiveResourcesConfiguration) DeepCopyInto(out *CompactionJobAdaptiveResourcesConfiguration) {
+	*out = *in
+	out.MemoryPerDeltaEvent = in.MemoryPerDeltaEvent.DeepCopy()
+	if in.MaxMemory != nil {
+		in, out := &in.MaxMemory, &out.MaxMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompactionJobAdaptiveResourcesConfiguration.
+func (in *CompactionJobAdaptiveResourcesConfiguration) DeepCopy() *CompactionJobAdaptiveResourcesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CompactionJobAdaptiveResourcesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
@@ -114,6 +157,18 @@ func (in *EtcdControllerConfiguration) DeepCopyInto(out *EtcdControllerConfigura
 	}
 	out.EtcdStatusSyncPeriod = in.EtcdStatusSyncPeriod
 	out.EtcdMember = in.EtcdMember
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]common.HealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OperationHistoryLimit != nil {
+		in, out := &in.OperationHistoryLimit, &out.OperationHistoryLimit
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -192,6 +247,22 @@ func (in *EtcdOpsTaskControllerConfiguration) DeepCopy() *EtcdOpsTaskControllerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileTraceExporterConfiguration) DeepCopyInto(out *FileTraceExporterConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileTraceExporterConfiguration.
+func (in *FileTraceExporterConfiguration) DeepCopy() *FileTraceExporterConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FileTraceExporterConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaderElectionConfiguration) DeepCopyInto(out *LeaderElectionConfiguration) {
 	*out = *in
@@ -227,6 +298,22 @@ func (in *LogConfiguration) DeepCopy() *LogConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPTraceExporterConfiguration) DeepCopyInto(out *OTLPTraceExporterConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPTraceExporterConfiguration.
+func (in *OTLPTraceExporterConfiguration) DeepCopy() *OTLPTraceExporterConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPTraceExporterConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorConfiguration) DeepCopyInto(out *OperatorConfiguration) {
 	*out = *in
@@ -244,6 +331,11 @@ func (in *OperatorConfiguration) DeepCopyInto(out *OperatorConfiguration) {
 		}
 	}
 	out.Logging = in.Logging
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -361,6 +453,37 @@ func (in *TLSServer) DeepCopy() *TLSServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingConfiguration) DeepCopyInto(out *TracingConfiguration) {
+	*out = *in
+	if in.SamplingPercentage != nil {
+		in, out := &in.SamplingPercentage, &out.SamplingPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPTraceExporterConfiguration)
+		**out = **in
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileTraceExporterConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingConfiguration.
+func (in *TracingConfiguration) DeepCopy() *TracingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookConfiguration) DeepCopyInto(out *WebhookConfiguration) {
 	*out = *in
@@ -26,6 +26,9 @@ func SetObjectDefaults_OperatorConfiguration(in *OperatorConfiguration) {
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_EtcdControllerConfiguration(&in.Controllers.Etcd)
 	SetDefaults_CompactionControllerConfiguration(&in.Controllers.Compaction)
+	if in.Controllers.Compaction.AdaptiveResources != nil {
+		SetDefaults_CompactionJobAdaptiveResourcesConfiguration(in.Controllers.Compaction.AdaptiveResources)
+	}
 	SetDefaults_EtcdCopyBackupsTaskControllerConfiguration(&in.Controllers.EtcdCopyBackupsTask)
 	SetDefaults_SecretControllerConfiguration(&in.Controllers.Secret)
 	SetDefaults_EtcdOpsTaskControllerConfiguration(&in.Controllers.EtcdOpsTask)
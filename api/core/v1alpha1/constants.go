@@ -23,6 +23,52 @@ const (
 	LabelComponentKey = "app.kubernetes.io/component"
 )
 
+// Values of the LabelComponentKey label which is placed on the resources provisioned for an etcd cluster.
+const (
+	// ComponentNameClientService is the component name for client service resource.
+	ComponentNameClientService = "etcd-client-service"
+	// ComponentNameConfigMap is the component  name for config map resource.
+	ComponentNameConfigMap = "etcd-configmap"
+	// ComponentNameMemberLease is the component name for member lease resource.
+	ComponentNameMemberLease = "etcd-member-lease"
+	// ComponentNameSnapshotLease is the component name for snapshot lease resource.
+	ComponentNameSnapshotLease = "etcd-snapshot-lease"
+	// ComponentNamePeerService is the component name for peer service resource.
+	ComponentNamePeerService = "etcd-peer-service"
+	// ComponentNamePodDisruptionBudget is the component name for pod disruption budget resource.
+	ComponentNamePodDisruptionBudget = "etcd-pdb"
+	// ComponentNameRole is the component name for role resource.
+	ComponentNameRole = "etcd-role"
+	// ComponentNameRoleBinding is the component name for role binding resource.
+	ComponentNameRoleBinding = "etcd-role-binding"
+	// ComponentNameServiceAccount is the component name for service account resource.
+	ComponentNameServiceAccount = "etcd-service-account"
+	// ComponentNameStatefulSet is the component name for statefulset resource.
+	ComponentNameStatefulSet = "etcd-statefulset"
+	// ComponentNameManagedCertificates is the component name for the secrets holding the certificates managed by etcd-druid.
+	ComponentNameManagedCertificates = "etcd-managed-certificates"
+	// ComponentNameUserClientCertificate is the component name for the secrets holding the client certificates issued by etcd-druid for etcd users.
+	ComponentNameUserClientCertificate = "etcd-user-client-certificate"
+	// ComponentNameEtcdAuth is the component name for the secret holding the credentials of the etcd root user.
+	ComponentNameEtcdAuth = "etcd-auth"
+	// ComponentNameSnapshotCompactionJob is the component name for snapshot compaction job resource.
+	ComponentNameSnapshotCompactionJob = "etcd-snapshot-compaction-job"
+	// ComponentNameEtcdCopyBackupsJob is the component name for copy-backup task resource.
+	ComponentNameEtcdCopyBackupsJob = "etcd-copy-backups-job"
+)
+
+// Default ports of an etcd cluster, which are used if no port is configured in the Etcd spec.
+const (
+	// DefaultPortEtcdPeer is the default port for the etcd server used for peer communication.
+	DefaultPortEtcdPeer int32 = 2380
+	// DefaultPortEtcdClient is the default port for the etcd client.
+	DefaultPortEtcdClient int32 = 2379
+	// DefaultPortEtcdWrapper is the default port for the etcd-wrapper HTTP server.
+	DefaultPortEtcdWrapper int32 = 9095
+	// DefaultPortEtcdBackupRestore is the default port for the HTTP server in the etcd-backup-restore container.
+	DefaultPortEtcdBackupRestore int32 = 8080
+)
+
 // Annotation keys that can be placed on an Etcd custom resource.
 const (
 	// SuspendEtcdSpecReconcileAnnotation is an annotation set by an operator to temporarily suspend any etcd spec reconciliation.
@@ -44,6 +90,10 @@ const (
 	DisableEtcdRuntimeComponentCreationAnnotation = "druid.gardener.cloud/disable-etcd-runtime-component-creation"
 )
 
+// ManagedCertificatesCABundleDataKey is the data key of the secret holding the bundle of CA certificates trusted by
+// the members of an etcd cluster whose certificates are managed by etcd-druid.
+const ManagedCertificatesCABundleDataKey = "ca.crt"
+
 // Compaction Job/Pod reasons that are used to set the reason for a pod condition in the status of an Etcd resource.
 const (
 	// PodFailureReasonPreemptionByScheduler is a reason for a pod failure that indicates that the pod was preempted by the scheduler.
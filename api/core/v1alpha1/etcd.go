@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -66,6 +67,7 @@ const (
 // +kubebuilder:validation:XValidation:rule="!has(self.spec.etcd.bootstrapWithExistingCluster) || !has(oldSelf.spec.etcd.bootstrapWithExistingCluster) || !has(self.status) || !has(self.status.conditions) || !self.status.conditions.exists(c, c.type == 'BootstrappedWithExistingCluster' && c.status == 'False') || self.spec.etcd.bootstrapWithExistingCluster.clientEndpoints == oldSelf.spec.etcd.bootstrapWithExistingCluster.clientEndpoints",message="etcd.spec.etcd.bootstrapWithExistingCluster.clientEndpoints cannot be modified while the bootstrap is in progress"
 // +kubebuilder:validation:XValidation:rule="!has(self.spec.etcd.bootstrapWithExistingCluster) || self.spec.etcd.bootstrapWithExistingCluster.members.all(m1, self.spec.etcd.bootstrapWithExistingCluster.members.filter(m2, m1.name == m2.name).size() == 1)",message="bootstrapWithExistingCluster.members[*].name must be unique"
 // +kubebuilder:validation:XValidation:rule="!has(self.spec.etcd.bootstrapWithExistingCluster) || self.spec.etcd.bootstrapWithExistingCluster.members.all(m, has(self.spec.memberNamePrefix) ? !m.name.startsWith(self.spec.memberNamePrefix + '-' + self.metadata.name + '-') : !m.name.startsWith(self.metadata.name + '-'))",message="bootstrapWithExistingCluster.members[*].name must not collide with a target member (must not start with the target Etcd's member-name prefix)"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.managedCertificates) || !has(self.spec.etcd.auth) || !has(self.spec.etcd.auth.users) || !self.spec.etcd.auth.users.exists(u, u.name == self.metadata.name + '-client')",message="etcd.spec.etcd.auth.users[*].name must not be the common name of the client certificate of etcd-backup-restore"
 
 // Etcd is the Schema for the etcds API
 type Etcd struct {
@@ -161,6 +163,61 @@ type SecretReference struct {
 	DataKey *string `json:"dataKey,omitempty"`
 }
 
+// ManagedCertificatesSpec defines the parameters for the certificates which are generated and rotated by etcd-druid.
+type ManagedCertificatesSpec struct {
+	// CAValidity is the validity of the generated certificate authority. Defaults to 87600h (10 years).
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	CAValidity *metav1.Duration `json:"caValidity,omitempty"`
+	// CertificateValidity is the validity of the generated server, peer and client certificates. Defaults to 2160h (90 days).
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	CertificateValidity *metav1.Duration `json:"certificateValidity,omitempty"`
+	// RenewBefore is the duration before the expiry of a certificate or of the certificate authority at which it is
+	// rotated. Defaults to a third of the respective validity.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// RecoveryPointObjectives defines the recovery point objectives (RPOs) for the backups of an etcd cluster.
+type RecoveryPointObjectives struct {
+	// MaxFullSnapshotAge is the maximum tolerated age of the latest full snapshot.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	MaxFullSnapshotAge *metav1.Duration `json:"maxFullSnapshotAge,omitempty"`
+	// MaxDeltaSnapshotAge is the maximum tolerated age of the latest snapshot, full or delta, i.e. the maximum tolerated
+	// amount of data loss in terms of time.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	MaxDeltaSnapshotAge *metav1.Duration `json:"maxDeltaSnapshotAge,omitempty"`
+}
+
+// RestoreFromSpec defines the source of the backups from which a new etcd cluster is bootstrapped.
+// The new etcd cluster is restored to the latest state contained in the source backups, unless Revision or Timestamp
+// restrict the source backups to an earlier point in time.
+// +kubebuilder:validation:XValidation:message="etcd.spec.backup.restoreFrom.revision and etcd.spec.backup.restoreFrom.timestamp are mutually exclusive",rule="!has(self.revision) || !has(self.timestamp)"
+type RestoreFromSpec struct {
+	// Store defines the specification of the object store provider holding the source backups. The prefix of the store
+	// selects the backups of the source etcd cluster, e.g. the prefix configured in its etcd.spec.backup.store.
+	// The secret referenced by the store must exist in the namespace of the Etcd.
+	Store StoreSpec `json:"store"`
+	// Revision is the etcd revision to which the new etcd cluster is restored. Only the snapshots of the source backups
+	// up to this revision are copied, so the new etcd cluster is restored to the latest snapshot at or before it.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Revision *int64 `json:"revision,omitempty"`
+	// Timestamp is the point in time to which the new etcd cluster is restored. Only the snapshots of the source backups
+	// taken up to this time are copied, so the new etcd cluster is restored to the latest snapshot taken at or before it.
+	// +optional
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+}
+
 // CompressionSpec defines parameters related to compression of Snapshots(full as well as delta).
 type CompressionSpec struct {
 	// +optional
@@ -184,6 +241,7 @@ type LeaderElectionSpec struct {
 }
 
 // BackupSpec defines parameters associated with the full and delta snapshots of etcd.
+// +kubebuilder:validation:XValidation:message="etcd.spec.backup.store must be set if etcd.spec.backup.restoreFrom is set",rule="!has(self.restoreFrom) || has(self.store)"
 // +kubebuilder:validation:XValidation:message="etcd.spec.backup.garbageCollectionPeriod must be greater than etcd.spec.backup.deltaSnapshotPeriod",rule="!(has(self.deltaSnapshotPeriod) && has(self.garbageCollectionPeriod)) || duration(self.deltaSnapshotPeriod).getSeconds() < duration(self.garbageCollectionPeriod).getSeconds()"
 type BackupSpec struct {
 	// Port define the port on which etcd-backup-restore server will be exposed.
@@ -246,6 +304,14 @@ type BackupSpec struct {
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
 	EtcdSnapshotTimeout *metav1.Duration `json:"etcdSnapshotTimeout,omitempty"`
+	// RecoveryPointObjectives defines the maximum tolerated age of the latest snapshots. If set, the BackupReady
+	// condition reflects whether these objectives are met.
+	// +optional
+	RecoveryPointObjectives *RecoveryPointObjectives `json:"recoveryPointObjectives,omitempty"`
+	// RestoreFrom defines another object store from which the backups of a new etcd cluster are bootstrapped.
+	// It is only considered when the Etcd is created. Backups taken afterwards are stored in Store.
+	// +optional
+	RestoreFrom *RestoreFromSpec `json:"restoreFrom,omitempty"`
 	// LeaderElection defines parameters related to the LeaderElection configuration.
 	// +optional
 	LeaderElection *LeaderElectionSpec `json:"leaderElection,omitempty"`
@@ -263,6 +329,36 @@ type SnapshotCompactionSpec struct {
 	// TriggerFullSnapshotThreshold defines the upper threshold for the number of etcd events before giving up on compaction job and triggering a full snapshot.
 	// +optional
 	TriggerFullSnapshotThreshold *int64 `json:"triggerFullSnapshotThreshold,omitempty"`
+	// MaxFullSnapshotAge defines the maximum age of the latest full snapshot. Once the latest full snapshot is older than
+	// this duration and there are delta snapshots on top of it, a compaction job is triggered even if EventsThreshold has not been reached.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	MaxFullSnapshotAge *metav1.Duration `json:"maxFullSnapshotAge,omitempty"`
+	// DeltaSnapshotsTotalSizeThreshold defines the threshold for the total size of the delta snapshots taken since the latest
+	// full snapshot. Once it is reached, a compaction job is triggered even if EventsThreshold has not been reached.
+	// The total size is estimated by the growth of the largest database size reported by the etcd members since the latest
+	// full snapshot, see CompactionStatus.DeltaSnapshotsSizeBaseline.
+	// +optional
+	DeltaSnapshotsTotalSizeThreshold *resource.Quantity `json:"deltaSnapshotsTotalSizeThreshold,omitempty"`
+	// AllowedHours restricts the time of the day during which compaction jobs and compaction triggered full snapshots are started.
+	// If not set, compaction can be started at any time.
+	// +optional
+	AllowedHours *CompactionAllowedHours `json:"allowedHours,omitempty"`
+}
+
+// CompactionAllowedHours defines a daily window in UTC during which snapshot compaction is allowed to start.
+// The window starts at Start (inclusive) and ends at End (exclusive). If Start is greater than End, the window spans midnight.
+// +kubebuilder:validation:XValidation:rule="self.start != self.end",message="start and end of the allowed hours window must not be equal"
+type CompactionAllowedHours struct {
+	// Start is the hour of the day (UTC) from which compaction is allowed to start.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	Start int32 `json:"start"`
+	// End is the hour of the day (UTC) from which compaction is no longer allowed to start.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	End int32 `json:"end"`
 }
 
 // EtcdConfig defines the configuration for the etcd cluster to be deployed.
@@ -351,6 +447,88 @@ type EtcdConfig struct {
 	// BootstrapWithExistingCluster configures this etcd to join an existing cluster.
 	// +optional
 	BootstrapWithExistingCluster *BootstrapWithExistingCluster `json:"bootstrapWithExistingCluster,omitempty"`
+	// Auth defines the users and roles of the etcd cluster. If set, etcd-druid reconciles the auth store of etcd to the
+	// users and roles defined here, and enables authentication. Users and roles which have not been created by etcd-druid
+	// are left untouched. Users authenticate with client certificates whose common name is the user name.
+	// +optional
+	Auth *EtcdAuth `json:"auth,omitempty"`
+}
+
+// EtcdAuth defines the users and roles of an etcd cluster which are managed by etcd-druid.
+// +kubebuilder:validation:XValidation:rule="!has(self.users) || self.users.all(u, !has(u.roles) || u.roles.all(r, has(self.roles) && self.roles.exists(x, x.name == r)))",message="all roles of users must be defined in etcd.spec.etcd.auth.roles"
+type EtcdAuth struct {
+	// Roles are the roles of the etcd cluster, each of which grants permissions on key prefixes.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	Roles []EtcdAuthRole `json:"roles,omitempty"`
+	// Users are the users of the etcd cluster, each of which authenticates with a client certificate whose common
+	// name is the user name.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	Users []EtcdAuthUser `json:"users,omitempty"`
+}
+
+// EtcdAuthRole defines a role of an etcd cluster.
+type EtcdAuthRole struct {
+	// Name is the name of the role. The name `root` is reserved for the role of etcd-druid and etcd-backup-restore.
+	// +required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self != 'root'",message="the role name root is reserved"
+	Name string `json:"name"`
+	// Permissions are the permissions granted by the role.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Permissions []EtcdAuthPermission `json:"permissions,omitempty"`
+}
+
+// EtcdAuthPermissionType is the type of permission granted on keys.
+// +kubebuilder:validation:Enum=Read;Write;ReadWrite
+type EtcdAuthPermissionType string
+
+const (
+	// EtcdAuthPermissionTypeRead grants permission to read keys.
+	EtcdAuthPermissionTypeRead EtcdAuthPermissionType = "Read"
+	// EtcdAuthPermissionTypeWrite grants permission to write keys.
+	EtcdAuthPermissionTypeWrite EtcdAuthPermissionType = "Write"
+	// EtcdAuthPermissionTypeReadWrite grants permission to read and write keys.
+	EtcdAuthPermissionTypeReadWrite EtcdAuthPermissionType = "ReadWrite"
+)
+
+// EtcdAuthPermission defines a permission on all keys with a common prefix.
+type EtcdAuthPermission struct {
+	// KeyPrefix is the prefix of the keys on which the permission is granted. An empty prefix grants the permission
+	// on all keys.
+	// +required
+	// +kubebuilder:validation:MaxLength=256
+	KeyPrefix string `json:"keyPrefix"`
+	// Type is the type of the permission.
+	// +required
+	Type EtcdAuthPermissionType `json:"type"`
+}
+
+// EtcdAuthUser defines a user of an etcd cluster.
+type EtcdAuthUser struct {
+	// Name is the name of the user, which must match the common name of the client certificate of the user. The name
+	// `root` is reserved for etcd-druid, the common name of the client certificate of etcd-backup-restore is reserved
+	// for etcd-backup-restore.
+	// +required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self != 'root'",message="the user name root is reserved"
+	Name string `json:"name"`
+	// Roles are the names of the roles granted to the user.
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Roles []string `json:"roles,omitempty"`
+	// IssueClientCertificate specifies whether etcd-druid issues a client certificate for the user, which is stored
+	// in the secret `<etcd-name>-user-<user-name>-tls`. Requires etcd.spec.managedCertificates to be set.
+	// +optional
+	IssueClientCertificate *bool `json:"issueClientCertificate,omitempty"`
 }
 
 // ClientService defines the parameters of the client service that a user can specify
@@ -511,6 +689,11 @@ type SchedulingConstraints struct {
 // +kubebuilder:validation:XValidation:message="etcd.spec.storageClass is an immutable field.",rule="has(oldSelf.storageClass) ==  has(self.storageClass)"
 // +kubebuilder:validation:XValidation:message="etcd.spec.volumeClaimTemplate is an immutable field.",rule="has(oldSelf.volumeClaimTemplate) == has(self.volumeClaimTemplate)"
 // +kubebuilder:validation:XValidation:message="etcd.spec.memberNamePrefix is an immutable field.",rule="has(oldSelf.memberNamePrefix) == has(self.memberNamePrefix)"
+// +kubebuilder:validation:XValidation:message="etcd.spec.managedCertificates cannot be set together with etcd.spec.etcd.clientUrlTls, etcd.spec.etcd.peerUrlTls or etcd.spec.backup.tls",rule="!has(self.managedCertificates) || (!has(self.etcd.clientUrlTls) && !has(self.etcd.peerUrlTls) && !has(self.backup.tls))"
+// +kubebuilder:validation:XValidation:message="etcd.spec.managedCertificates cannot be set together with etcd.spec.etcd.additionalAdvertisePeerURLs or etcd.spec.etcd.bootstrapWithExistingCluster",rule="!has(self.managedCertificates) || (!has(self.etcd.additionalAdvertisePeerURLs) && !has(self.etcd.bootstrapWithExistingCluster))"
+// +kubebuilder:validation:XValidation:message="etcd.spec.etcd.auth requires TLS for client communication via etcd.spec.etcd.clientUrlTls or etcd.spec.managedCertificates",rule="!has(self.etcd.auth) || has(self.etcd.clientUrlTls) || has(self.managedCertificates)"
+// +kubebuilder:validation:XValidation:message="etcd.spec.etcd.auth cannot be set together with etcd.spec.etcd.bootstrapWithExistingCluster",rule="!has(self.etcd.auth) || !has(self.etcd.bootstrapWithExistingCluster)"
+// +kubebuilder:validation:XValidation:message="client certificates for users can only be issued if etcd.spec.managedCertificates is set",rule="!has(self.etcd.auth) || !has(self.etcd.auth.users) || has(self.managedCertificates) || self.etcd.auth.users.all(u, !has(u.issueClientCertificate) || !u.issueClientCertificate)"
 type EtcdSpec struct {
 	// MemberNamePrefix defines the prefix for the name of each etcd cluster member. When set, the member name would be `<prefix>-<pod-name>`, otherwise it defaults to the `pod-name`.
 	// The combined length of the member-prefix, pod-name, and separator must not exceed 253 characters (DNS subdomain limit for lease names).
@@ -537,6 +720,12 @@ type EtcdSpec struct {
 	Backup BackupSpec `json:"backup"`
 	// +optional
 	Common SharedConfig `json:"sharedConfig,omitempty"`
+	// ManagedCertificates enables TLS for client, peer and backup-restore communication using certificates which are
+	// generated, stored and rotated by etcd-druid. It cannot be combined with explicitly configured TLS secrets, nor
+	// with additional advertise peer URLs or bootstrapping with an existing cluster, both of which require a trust
+	// root shared with endpoints that are not managed by etcd-druid.
+	// +optional
+	ManagedCertificates *ManagedCertificatesSpec `json:"managedCertificates,omitempty"`
 	// +optional
 	SchedulingConstraints SchedulingConstraints `json:"schedulingConstraints,omitempty"`
 	// Replicas defines the number of etcd pods to be deployed, subsequently defining the etcd cluster size.
@@ -564,6 +753,14 @@ type EtcdSpec struct {
 	// run as root. By default, they run as non-root with user 'nobody'.
 	// +optional
 	RunAsRoot *bool `json:"runAsRoot,omitempty"`
+	// HealthChecks are custom health checks for the etcd cluster, whose results are published as additional conditions.
+	// They are evaluated in addition to the health checks configured for etcd-druid. A check with the same condition
+	// type as a check configured for etcd-druid replaces the latter for this etcd cluster.
+	// +optional
+	// +listType=map
+	// +listMapKey=conditionType
+	// +kubebuilder:validation:MaxItems=16
+	HealthChecks []EtcdHealthCheck `json:"healthChecks,omitempty"`
 }
 
 // CrossVersionObjectReference contains enough information to let you identify the referred resource.
@@ -602,6 +799,9 @@ const (
 	// to True once the target has successfully joined the existing cluster and remains
 	// sticky-True thereafter, surviving transient member outages.
 	ConditionTypeBootstrappedWithExistingCluster ConditionType = "BootstrappedWithExistingCluster"
+	// ConditionTypeCertificatesValid is a constant for a condition type indicating that the certificates in the TLS
+	// secrets referenced by the etcd cluster are valid, are not about to expire and match the names of its services.
+	ConditionTypeCertificatesValid ConditionType = "CertificatesValid"
 )
 
 // EtcdMemberConditionStatus is the status of an etcd cluster member.
@@ -616,6 +816,31 @@ const (
 	EtcdMemberStatusUnknown EtcdMemberConditionStatus = "Unknown"
 )
 
+// Reasons of the status of an etcd cluster member.
+const (
+	// EtcdMemberReasonLeaseSucceeded indicates that the member lease has been renewed recently.
+	EtcdMemberReasonLeaseSucceeded = "LeaseSucceeded"
+	// EtcdMemberReasonLeaseExpired indicates that the member lease has not been renewed within the unknown threshold.
+	EtcdMemberReasonLeaseExpired = "LeaseExpired"
+	// EtcdMemberReasonUnknownGracePeriodExceeded indicates that the member lease has not been renewed within the
+	// unknown and not-ready thresholds.
+	EtcdMemberReasonUnknownGracePeriodExceeded = "UnknownGracePeriodExceeded"
+	// EtcdMemberReasonContainersNotReady indicates that the containers of the member pod are not ready.
+	EtcdMemberReasonContainersNotReady = "ContainersNotReady"
+	// EtcdMemberReasonLearner indicates that the member is a learner, which does not vote and is not promoted yet.
+	EtcdMemberReasonLearner = "Learner"
+	// EtcdMemberReasonNoLeader indicates that the member does not know a leader of the etcd cluster.
+	EtcdMemberReasonNoLeader = "NoLeader"
+	// EtcdMemberReasonAppliedIndexLagging indicates that the raft applied index of the member lags behind the leader,
+	// e.g. because its apply loop is stuck.
+	EtcdMemberReasonAppliedIndexLagging = "AppliedIndexLagging"
+	// EtcdMemberReasonBackendQuotaExhausted indicates that the member has raised the NOSPACE alarm, as its database
+	// has reached the backend quota, which makes the etcd cluster reject writes.
+	EtcdMemberReasonBackendQuotaExhausted = "BackendQuotaExhausted"
+	// EtcdMemberReasonSlowDisk indicates that the WAL fsync latency of the member exceeds the threshold.
+	EtcdMemberReasonSlowDisk = "SlowDisk"
+)
+
 // EtcdRole is the role of an etcd cluster member.
 type EtcdRole string
 
@@ -643,6 +868,9 @@ type EtcdMemberStatus struct {
 	Reason string `json:"reason"`
 	// LastTransitionTime is the last time the condition's status changed.
 	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// DBSize is the size of the database of the etcd member, as last reported by its `etcd_mvcc_db_total_size_in_bytes` metric.
+	// +optional
+	DBSize *resource.Quantity `json:"dbSize,omitempty"`
 }
 
 // EtcdStatus defines the observed state of Etcd.
@@ -693,6 +921,105 @@ type EtcdStatus struct {
 	// condition first transitions to True, and is not updated thereafter.
 	// +optional
 	BootstrapWithExistingCluster *BootstrapWithExistingClusterStatus `json:"bootstrapWithExistingCluster,omitempty"`
+	// Compaction captures the status of snapshot compaction for the etcd cluster.
+	// +optional
+	Compaction *CompactionStatus `json:"compaction,omitempty"`
+	// OperationHistory is a bounded list of the most recent operations performed by etcd-druid on this resource,
+	// ordered from the most recent to the oldest. It is only recorded if enabled in the configuration of etcd-druid.
+	// Periodic reconciliations of an unchanged spec, which succeeded without changing any component, are not recorded.
+	// Consecutive requeued or failed runs of the same operation and generation are recorded as a single operation.
+	// +optional
+	// +listType=atomic
+	OperationHistory []EtcdOperationRecord `json:"operationHistory,omitempty"`
+}
+
+// EtcdOperationRecord captures the details of a single operation performed by etcd-druid on an Etcd resource.
+type EtcdOperationRecord struct {
+	// RunID is the ID of the reconciliation run which performed the operation. It matches the runID in the logs of etcd-druid.
+	RunID string `json:"runID"`
+	// Type is the type of the operation.
+	Type druidapicommon.LastOperationType `json:"type"`
+	// State is the state in which the operation has completed.
+	State druidapicommon.LastOperationState `json:"state"`
+	// StartTime is the time at which the operation was started.
+	StartTime metav1.Time `json:"startTime"`
+	// CompletionTime is the time at which the operation completed.
+	CompletionTime metav1.Time `json:"completionTime"`
+	// Generation is the generation of the Etcd spec which has been applied by the operation.
+	Generation int64 `json:"generation"`
+	// ChangedComponents are the kinds of the components whose resources have been created, updated or deleted by the operation.
+	// +optional
+	// +listType=atomic
+	ChangedComponents []string `json:"changedComponents,omitempty"`
+	// Description is a human-readable description of the result of the operation.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// CompactionStatus captures the status of snapshot compaction for the etcd cluster.
+type CompactionStatus struct {
+	// History is a bounded list of the most recent compaction job runs, ordered from the most recent to the oldest.
+	// +optional
+	// +listType=atomic
+	History []CompactionJobRun `json:"history,omitempty"`
+	// OOMKilledMemoryRequests are the memory requests of the most recent compaction job which was OOMKilled, as long as
+	// no compaction job has succeeded since. It is recorded regardless of whether the history is maintained.
+	// +optional
+	OOMKilledMemoryRequests *resource.Quantity `json:"oomKilledMemoryRequests,omitempty"`
+	// DeltaSnapshotsSizeBaseline is the database size from which the total size of the delta snapshots taken since the
+	// latest full snapshot is estimated. It is only maintained if SnapshotCompactionSpec.DeltaSnapshotsTotalSizeThreshold is set.
+	// +optional
+	DeltaSnapshotsSizeBaseline *DeltaSnapshotsSizeBaseline `json:"deltaSnapshotsSizeBaseline,omitempty"`
+}
+
+// DeltaSnapshotsSizeBaseline captures the database size of an etcd cluster at the time its latest full snapshot was observed.
+// The delta snapshots taken since then hold at least the data by which the database has grown beyond this size.
+type DeltaSnapshotsSizeBaseline struct {
+	// FullSnapshotRevision is the revision of the full snapshot for which the baseline has been recorded.
+	FullSnapshotRevision int64 `json:"fullSnapshotRevision"`
+	// DBSize is the largest database size reported by the etcd members when the baseline was recorded. It is lowered
+	// whenever the database shrinks below it, e.g. after a defragmentation.
+	DBSize resource.Quantity `json:"dbSize"`
+}
+
+// CompactionJobResult is the result of a compaction job run.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type CompactionJobResult string
+
+const (
+	// CompactionJobResultSucceeded indicates that the compaction job completed successfully.
+	CompactionJobResultSucceeded CompactionJobResult = "Succeeded"
+	// CompactionJobResultFailed indicates that the compaction job failed.
+	CompactionJobResultFailed CompactionJobResult = "Failed"
+)
+
+// CompactionJobRun captures the details of a single compaction job run.
+type CompactionJobRun struct {
+	// JobName is the name of the compaction job.
+	JobName string `json:"jobName"`
+	// StartTime is the time at which the compaction job was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time at which the compaction job completed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Result is the result of the compaction job run.
+	Result CompactionJobResult `json:"result"`
+	// Reason is a brief reason for the result of the compaction job run, e.g. the reason for its failure.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message is a human-readable message with details about the compaction job run.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// SnapshotRevision is the revision of the compacted full snapshot produced by a successful compaction job run.
+	// +optional
+	SnapshotRevision *int64 `json:"snapshotRevision,omitempty"`
+	// MemoryRequests are the memory requests of the compaction job.
+	// +optional
+	MemoryRequests *resource.Quantity `json:"memoryRequests,omitempty"`
+	// OOMKilled indicates whether the compaction job failed because its container was OOMKilled.
+	// +optional
+	OOMKilled bool `json:"oomKilled,omitempty"`
 }
 
 const (
@@ -715,6 +1042,54 @@ const (
 	LastOperationStateRequeue druidapicommon.LastOperationState = "Requeue"
 )
 
+// IsManagedCertificatesEnabled returns true if the TLS certificates of the Etcd are managed by etcd-druid, else returns false.
+func (e *Etcd) IsManagedCertificatesEnabled() bool {
+	return e.Spec.ManagedCertificates != nil
+}
+
+// GetClientURLTLS returns the TLS configuration for client communication with etcd. If managed certificates are enabled,
+// it refers to the secrets managed by etcd-druid, otherwise etcd.spec.etcd.clientUrlTls is returned.
+func (e *Etcd) GetClientURLTLS() *TLSConfig {
+	if e.IsManagedCertificatesEnabled() {
+		return e.getManagedTLSConfig()
+	}
+	return e.Spec.Etcd.ClientUrlTLS
+}
+
+// GetPeerURLTLS returns the TLS configuration for peer communication within the etcd cluster. If managed certificates
+// are enabled, it refers to the secrets managed by etcd-druid, otherwise etcd.spec.etcd.peerUrlTls is returned.
+func (e *Etcd) GetPeerURLTLS() *PeerTLSConfig {
+	if e.IsManagedCertificatesEnabled() {
+		tlsConfig := e.getManagedTLSConfig()
+		tlsConfig.ServerTLSSecretRef.Name = GetManagedPeerTLSSecretName(e.ObjectMeta)
+		tlsConfig.ClientTLSSecretRef = corev1.SecretReference{}
+		// The managed peer certificates carry wildcard DNS names of the peer service, which cannot be matched against the
+		// address of a connecting peer. Trust is instead established by the CA which is exclusive to the etcd cluster.
+		return &PeerTLSConfig{TLSConfig: *tlsConfig, SkipClientSANVerification: ptr.To(true)}
+	}
+	return e.Spec.Etcd.PeerUrlTLS
+}
+
+// GetBackupTLS returns the TLS configuration for communication with etcd-backup-restore. If managed certificates are
+// enabled, it refers to the secrets managed by etcd-druid, otherwise etcd.spec.backup.tls is returned.
+func (e *Etcd) GetBackupTLS() *TLSConfig {
+	if e.IsManagedCertificatesEnabled() {
+		return e.getManagedTLSConfig()
+	}
+	return e.Spec.Backup.TLS
+}
+
+func (e *Etcd) getManagedTLSConfig() *TLSConfig {
+	return &TLSConfig{
+		TLSCASecretRef: SecretReference{
+			SecretReference: corev1.SecretReference{Name: GetManagedCASecretName(e.ObjectMeta), Namespace: e.Namespace},
+			DataKey:         ptr.To(ManagedCertificatesCABundleDataKey),
+		},
+		ServerTLSSecretRef: corev1.SecretReference{Name: GetManagedServerTLSSecretName(e.ObjectMeta), Namespace: e.Namespace},
+		ClientTLSSecretRef: corev1.SecretReference{Name: GetManagedClientTLSSecretName(e.ObjectMeta), Namespace: e.Namespace},
+	}
+}
+
 // IsBackupStoreEnabled returns true if backup store has been enabled for the Etcd resource, else returns false.
 func (e *Etcd) IsBackupStoreEnabled() bool {
 	return e.Spec.Backup.Store != nil
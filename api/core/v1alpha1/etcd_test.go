@@ -52,6 +52,53 @@ func TestIsBackupStoreEnabled(t *testing.T) {
 
 }
 
+func TestGetTLSConfigs(t *testing.T) {
+	clientURLTLS := &TLSConfig{
+		TLSCASecretRef:     SecretReference{SecretReference: corev1.SecretReference{Name: "client-url-ca"}},
+		ServerTLSSecretRef: corev1.SecretReference{Name: "client-url-server"},
+		ClientTLSSecretRef: corev1.SecretReference{Name: "client-url-client"},
+	}
+	peerURLTLS := &PeerTLSConfig{TLSConfig: TLSConfig{
+		TLSCASecretRef:     SecretReference{SecretReference: corev1.SecretReference{Name: "peer-url-ca"}},
+		ServerTLSSecretRef: corev1.SecretReference{Name: "peer-url-server"},
+	}}
+	backupTLS := &TLSConfig{
+		TLSCASecretRef:     SecretReference{SecretReference: corev1.SecretReference{Name: "backup-ca"}},
+		ServerTLSSecretRef: corev1.SecretReference{Name: "backup-server"},
+		ClientTLSSecretRef: corev1.SecretReference{Name: "backup-client"},
+	}
+
+	t.Run("when managed certificates are not enabled", func(t *testing.T) {
+		g := NewWithT(t)
+		etcd := createEtcd("foo", "default")
+		etcd.Spec.Etcd.ClientUrlTLS = clientURLTLS
+		etcd.Spec.Etcd.PeerUrlTLS = peerURLTLS
+		etcd.Spec.Backup.TLS = backupTLS
+		g.Expect(etcd.IsManagedCertificatesEnabled()).To(BeFalse())
+		g.Expect(etcd.GetClientURLTLS()).To(Equal(clientURLTLS))
+		g.Expect(etcd.GetPeerURLTLS()).To(Equal(peerURLTLS))
+		g.Expect(etcd.GetBackupTLS()).To(Equal(backupTLS))
+	})
+
+	t.Run("when managed certificates are enabled", func(t *testing.T) {
+		g := NewWithT(t)
+		etcd := createEtcd("foo", "default")
+		etcd.Spec.ManagedCertificates = &ManagedCertificatesSpec{}
+		g.Expect(etcd.IsManagedCertificatesEnabled()).To(BeTrue())
+		for _, tlsConfig := range []*TLSConfig{etcd.GetClientURLTLS(), etcd.GetBackupTLS()} {
+			g.Expect(tlsConfig.TLSCASecretRef.Name).To(Equal("foo-managed-ca"))
+			g.Expect(*tlsConfig.TLSCASecretRef.DataKey).To(Equal(ManagedCertificatesCABundleDataKey))
+			g.Expect(tlsConfig.ServerTLSSecretRef.Name).To(Equal("foo-managed-server-tls"))
+			g.Expect(tlsConfig.ClientTLSSecretRef.Name).To(Equal("foo-managed-client-tls"))
+		}
+		peerTLSConfig := etcd.GetPeerURLTLS()
+		g.Expect(peerTLSConfig.TLSCASecretRef.Name).To(Equal("foo-managed-ca"))
+		g.Expect(peerTLSConfig.ServerTLSSecretRef.Name).To(Equal("foo-managed-peer-tls"))
+		g.Expect(peerTLSConfig.ClientTLSSecretRef.Name).To(BeEmpty())
+		g.Expect(*peerTLSConfig.SkipClientSANVerification).To(BeTrue())
+	})
+}
+
 func TestIsReconciliationInProgress(t *testing.T) {
 	tests := []struct {
 		name     string
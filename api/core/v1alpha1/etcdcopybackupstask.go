@@ -53,6 +53,15 @@ type EtcdCopyBackupsTaskSpec struct {
 	// +optional
 	// +kubebuilder:validation:Minimum=0
 	MaxBackups *uint32 `json:"maxBackups,omitempty"`
+	// MaxRevision is the maximum etcd revision of the backups that will be copied. Snapshots containing later revisions
+	// are not copied. By default, backups of all revisions will be copied.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRevision *int64 `json:"maxRevision,omitempty"`
+	// MaxTimestamp is the latest point in time at which a backup must have been taken in order to be copied.
+	// By default, backups of any time will be copied.
+	// +optional
+	MaxTimestamp *metav1.Time `json:"maxTimestamp,omitempty"`
 	// WaitForFinalSnapshot defines the parameters for waiting for a final full snapshot before copying backups.
 	// +optional
 	WaitForFinalSnapshot *WaitForFinalSnapshotSpec `json:"waitForFinalSnapshot,omitempty"`
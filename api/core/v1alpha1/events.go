@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+// Reasons of the Kubernetes events which are emitted by etcd-druid for Etcd resources. The reasons are stable and can
+// be relied upon, e.g. for alerting.
+const (
+	// EventReasonReconcileStarted indicates that the reconciliation of a new generation of the Etcd spec, or a
+	// reconciliation requested via annotation, has been started. It is emitted once per generation, also if the
+	// reconciliation is requeued.
+	EventReasonReconcileStarted = "ReconcileStarted"
+	// EventReasonReconcileSucceeded indicates that the reconciliation of the Etcd spec has succeeded.
+	EventReasonReconcileSucceeded = "ReconcileSucceeded"
+	// EventReasonReconcileFailed indicates that the reconciliation of the Etcd spec has failed, and is retried.
+	EventReasonReconcileFailed = "ReconcileFailed"
+	// EventReasonComponentSyncFailed indicates that the sync of a component of the etcd cluster has failed.
+	EventReasonComponentSyncFailed = "ComponentSyncFailed"
+	// EventReasonSpecReconciliationSkipped indicates that the reconciliation of the Etcd spec has been suspended via annotation.
+	EventReasonSpecReconciliationSkipped = "SpecReconciliationSkipped"
+	// EventReasonMemberNotReady indicates that a ready member of the etcd cluster has become not ready.
+	EventReasonMemberNotReady = "MemberNotReady"
+	// EventReasonMemberReady indicates that a member of the etcd cluster has become ready again.
+	EventReasonMemberReady = "MemberReady"
+	// EventReasonLeaderChanged indicates that another member has become the leader of the etcd cluster.
+	EventReasonLeaderChanged = "LeaderChanged"
+	// EventReasonSnapshotStale indicates that the snapshots of the etcd cluster are not taken as per schedule.
+	EventReasonSnapshotStale = "SnapshotStale"
+	// EventReasonBackupRPOBreached indicates that the recovery point objectives of the backups have been breached.
+	EventReasonBackupRPOBreached = "BackupRPOBreached"
+	// EventReasonBackupRPOMet indicates that the recovery point objectives of the backups are met again.
+	EventReasonBackupRPOMet = "BackupRPOMet"
+	// EventReasonCompactionSucceeded indicates that a snapshot compaction job has succeeded.
+	EventReasonCompactionSucceeded = "CompactionSucceeded"
+	// EventReasonCompactionFailed indicates that a snapshot compaction job has failed.
+	EventReasonCompactionFailed = "CompactionFailed"
+	// EventReasonCompactionJobMemoryCapped indicates that the memory computed for a snapshot compaction job has been capped
+	// at the configured maximum.
+	EventReasonCompactionJobMemoryCapped = "CompactionJobMemoryCapped"
+	// EventReasonClusterIDMismatchDetected indicates that the members of the etcd cluster report different cluster IDs.
+	EventReasonClusterIDMismatchDetected = "ClusterIDMismatchDetected"
+)
+
+// Reasons of the Kubernetes events which are emitted by etcd-druid for EtcdOpsTask resources whenever the state of the
+// task changes.
+const (
+	// EventReasonTaskPending indicates that the task has been accepted and is waiting to be executed.
+	EventReasonTaskPending = "TaskPending"
+	// EventReasonTaskInProgress indicates that the execution of the task has started.
+	EventReasonTaskInProgress = "TaskInProgress"
+	// EventReasonTaskSucceeded indicates that the task has completed successfully.
+	EventReasonTaskSucceeded = "TaskSucceeded"
+	// EventReasonTaskFailed indicates that the task has failed.
+	EventReasonTaskFailed = "TaskFailed"
+	// EventReasonTaskRejected indicates that the task has been rejected, e.g. because its preconditions are not met.
+	EventReasonTaskRejected = "TaskRejected"
+)
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"slices"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+)
+
+// EtcdHealthCheck defines a custom health check for an etcd cluster, whose result is published as a condition in the
+// status of the Etcd resource. Exactly one of HTTP and Metric must be set. Webhook health checks can only be configured
+// for etcd-druid.
+// +kubebuilder:validation:XValidation:message="exactly one of http and metric must be set",rule="[has(self.http), has(self.metric)].filter(x, x).size() == 1"
+type EtcdHealthCheck struct {
+	// ConditionType is the type of the condition in which the result of the check is published. It must not be the
+	// type of a condition maintained by etcd-druid itself.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[A-Z][A-Za-z0-9]*$`
+	// +kubebuilder:validation:XValidation:message="conditionType must not be the type of a condition maintained by etcd-druid",rule="!(self in ['Ready', 'LastSnapshotCompactionSucceeded', 'AllMembersReady', 'AllMembersUpdated', 'BackupReady', 'DataVolumesReady', 'ClusterIDMismatch', 'BootstrappedWithExistingCluster', 'CertificatesValid'])"
+	ConditionType string `json:"conditionType"`
+	// HTTP is a check which sends a GET request to the client service of the etcd cluster.
+	// +optional
+	HTTP *druidapicommon.HTTPHealthCheck `json:"http,omitempty"`
+	// Metric is a check which compares a metric served by etcd with thresholds.
+	// +optional
+	Metric *druidapicommon.MetricHealthCheck `json:"metric,omitempty"`
+}
+
+// druidManagedConditionTypes are the types of the conditions maintained by etcd-druid itself.
+var druidManagedConditionTypes = []ConditionType{
+	ConditionTypeReady,
+	ConditionTypeLastSnapshotCompactionSucceeded,
+	ConditionTypeAllMembersReady,
+	ConditionTypeAllMembersUpdated,
+	ConditionTypeBackupReady,
+	ConditionTypeDataVolumesReady,
+	ConditionTypeClusterIDMismatch,
+	ConditionTypeBootstrappedWithExistingCluster,
+	ConditionTypeCertificatesValid,
+}
+
+// IsDruidManagedConditionType checks if the given condition type is maintained by etcd-druid itself and can therefore
+// not be used for custom health checks.
+func IsDruidManagedConditionType(conditionType ConditionType) bool {
+	return slices.Contains(druidManagedConditionTypes, conditionType)
+}
@@ -6,7 +6,9 @@ package v1alpha1
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/robfig/cron/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -39,6 +41,49 @@ func GetCompactionJobName(etcdObjMeta metav1.ObjectMeta) string {
 	return fmt.Sprintf("%s-compactor", etcdObjMeta.Name)
 }
 
+// GetRestoreFromCopyBackupsTaskName returns the name of the EtcdCopyBackupsTask which copies the backups to restore from for the Etcd.
+func GetRestoreFromCopyBackupsTaskName(etcdObjMeta metav1.ObjectMeta) string {
+	return fmt.Sprintf("%s-restore-from", etcdObjMeta.Name)
+}
+
+// GetManagedCASecretName returns the name of the secret holding the bundle of CA certificates managed by etcd-druid for the Etcd.
+func GetManagedCASecretName(etcdObjMeta metav1.ObjectMeta) string {
+	return fmt.Sprintf("%s-managed-ca", etcdObjMeta.Name)
+}
+
+// GetManagedSigningCASecretName returns the name of the secret holding the CA certificates and private keys which are
+// used by etcd-druid to sign the managed certificates for the Etcd. This secret is never mounted into the etcd pods.
+func GetManagedSigningCASecretName(etcdObjMeta metav1.ObjectMeta) string {
+	return fmt.Sprintf("%s-managed-signing-ca", etcdObjMeta.Name)
+}
+
+// GetManagedServerTLSSecretName returns the name of the secret holding the server certificate managed by etcd-druid for the Etcd.
+func GetManagedServerTLSSecretName(etcdObjMeta metav1.ObjectMeta) string {
+	return fmt.Sprintf("%s-managed-server-tls", etcdObjMeta.Name)
+}
+
+// GetManagedClientTLSSecretName returns the name of the secret holding the client certificate managed by etcd-druid for the Etcd.
+func GetManagedClientTLSSecretName(etcdObjMeta metav1.ObjectMeta) string {
+	return fmt.Sprintf("%s-managed-client-tls", etcdObjMeta.Name)
+}
+
+// GetManagedPeerTLSSecretName returns the name of the secret holding the peer certificate managed by etcd-druid for the Etcd.
+func GetManagedPeerTLSSecretName(etcdObjMeta metav1.ObjectMeta) string {
+	return fmt.Sprintf("%s-managed-peer-tls", etcdObjMeta.Name)
+}
+
+// GetAuthRootSecretName returns the name of the secret holding the credentials of the etcd root user, which is used by
+// etcd-druid to manage the auth store of the Etcd.
+func GetAuthRootSecretName(etcdObjMeta metav1.ObjectMeta) string {
+	return fmt.Sprintf("%s-auth-root", etcdObjMeta.Name)
+}
+
+// GetUserClientTLSSecretName returns the name of the secret holding the client certificate issued by etcd-druid for the
+// given user of the Etcd.
+func GetUserClientTLSSecretName(etcdObjMeta metav1.ObjectMeta, userName string) string {
+	return fmt.Sprintf("%s-user-%s-tls", etcdObjMeta.Name, userName)
+}
+
 // GetOrdinalPodName returns the Etcd pod name based on the ordinal.
 func GetOrdinalPodName(etcdObjMeta metav1.ObjectMeta, ordinal int) string {
 	return fmt.Sprintf("%s-%d", etcdObjMeta.Name, ordinal)
@@ -181,3 +226,19 @@ func RemoveOperationAnnotation(etcdObjMeta metav1.ObjectMeta) {
 func IsEtcdRuntimeComponentCreationEnabled(etcdObjMeta metav1.ObjectMeta) bool {
 	return !metav1.HasAnnotation(etcdObjMeta, DisableEtcdRuntimeComponentCreationAnnotation)
 }
+
+// ComputeScheduleInterval computes the interval between two activations for the given cron schedule.
+// Assumes that every cron activation is at equal intervals apart, based on cron schedules such as
+// "once every X hours", "once every Y days", "at 1:00pm on every Tuesday", etc.
+// TODO: write a new function to accurately compute the previous activation time from the cron schedule
+// in order to compute when the previous activation of the cron schedule was supposed to have occurred,
+// instead of relying on the assumption that all the cron activations are evenly spaced.
+func ComputeScheduleInterval(cronSchedule string) (time.Duration, error) {
+	schedule, err := cron.ParseStandard(cronSchedule)
+	if err != nil {
+		return 0, err
+	}
+	nextScheduledTime := schedule.Next(time.Now())
+	nextNextScheduledTime := schedule.Next(nextScheduledTime)
+	return nextNextScheduledTime.Sub(nextScheduledTime), nil
+}
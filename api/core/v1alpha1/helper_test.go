@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -65,6 +66,23 @@ func TestGetCompactionJobName(t *testing.T) {
 	g.Expect(compactionJobName).To(Equal("etcd-test-compactor"))
 }
 
+func TestGetRestoreFromCopyBackupsTaskName(t *testing.T) {
+	g := NewWithT(t)
+	etcdObjMeta := createEtcdObjectMetadata(uuid.NewUUID(), nil, nil, false)
+	taskName := GetRestoreFromCopyBackupsTaskName(etcdObjMeta)
+	g.Expect(taskName).To(Equal("etcd-test-restore-from"))
+}
+
+func TestGetManagedCertificatesSecretNames(t *testing.T) {
+	g := NewWithT(t)
+	etcdObjMeta := createEtcdObjectMetadata(uuid.NewUUID(), nil, nil, false)
+	g.Expect(GetManagedCASecretName(etcdObjMeta)).To(Equal("etcd-test-managed-ca"))
+	g.Expect(GetManagedSigningCASecretName(etcdObjMeta)).To(Equal("etcd-test-managed-signing-ca"))
+	g.Expect(GetManagedServerTLSSecretName(etcdObjMeta)).To(Equal("etcd-test-managed-server-tls"))
+	g.Expect(GetManagedClientTLSSecretName(etcdObjMeta)).To(Equal("etcd-test-managed-client-tls"))
+	g.Expect(GetManagedPeerTLSSecretName(etcdObjMeta)).To(Equal("etcd-test-managed-peer-tls"))
+}
+
 func TestGetOrdinalPodName(t *testing.T) {
 	g := NewWithT(t)
 	etcdObjMeta := createEtcdObjectMetadata(uuid.NewUUID(), nil, nil, false)
@@ -486,6 +504,62 @@ func TestIsEtcdRuntimeComponentCreationEnabled(t *testing.T) {
 	}
 }
 
+func TestComputeScheduleInterval(t *testing.T) {
+	testCases := []struct {
+		name         string
+		cronSchedule string
+		expected     time.Duration
+		expectError  bool
+	}{
+		{
+			name:         "Valid cron schedule",
+			cronSchedule: "0 0 * * *",
+			expected:     24 * time.Hour,
+			expectError:  false,
+		},
+		{
+			name:         "Valid cron schedule",
+			cronSchedule: "0 0 * * 1",
+			expected:     24 * time.Hour * 7,
+			expectError:  false,
+		},
+		{
+			name:         "Valid cron schedule",
+			cronSchedule: "*/1 * * * *",
+			expected:     1 * time.Minute,
+			expectError:  false,
+		},
+		{
+			name:         "Valid cron schedule",
+			cronSchedule: "0 */1 * * *",
+			expected:     1 * time.Hour,
+			expectError:  false,
+		},
+		{
+			name:         "Invalid cron schedule",
+			cronSchedule: "invalid-cron",
+			expected:     0,
+			expectError:  true,
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			duration, err := ComputeScheduleInterval(tc.cronSchedule)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(duration).To(Equal(tc.expected))
+			}
+		})
+	}
+}
+
 func createEtcdObjectMetadata(uid types.UID, annotations, labels map[string]string, markedForDeletion bool) metav1.ObjectMeta {
 	etcdObjMeta := metav1.ObjectMeta{
 		Name:        etcdName,
@@ -98,6 +98,16 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.RecoveryPointObjectives != nil {
+		in, out := &in.RecoveryPointObjectives, &out.RecoveryPointObjectives
+		*out = new(RecoveryPointObjectives)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(RestoreFromSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LeaderElection != nil {
 		in, out := &in.LeaderElection, &out.LeaderElection
 		*out = new(LeaderElectionSpec)
@@ -245,6 +255,89 @@ func (in *ClientService) DeepCopy() *ClientService {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompactionAllowedHours) DeepCopyInto(out *CompactionAllowedHours) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompactionAllowedHours.
+func (in *CompactionAllowedHours) DeepCopy() *CompactionAllowedHours {
+	if in == nil {
+		return nil
+	}
+	out := new(CompactionAllowedHours)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompactionJobRun) DeepCopyInto(out *CompactionJobRun) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SnapshotRevision != nil {
+		in, out := &in.SnapshotRevision, &out.SnapshotRevision
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MemoryRequests != nil {
+		in, out := &in.MemoryRequests, &out.MemoryRequests
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompactionJobRun.
+func (in *CompactionJobRun) DeepCopy() *CompactionJobRun {
+	if in == nil {
+		return nil
+	}
+	out := new(CompactionJobRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompactionStatus) DeepCopyInto(out *CompactionStatus) {
+	*out = *in
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]CompactionJobRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OOMKilledMemoryRequests != nil {
+		in, out := &in.OOMKilledMemoryRequests, &out.OOMKilledMemoryRequests
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DeltaSnapshotsSizeBaseline != nil {
+		in, out := &in.DeltaSnapshotsSizeBaseline, &out.DeltaSnapshotsSizeBaseline
+		*out = new(DeltaSnapshotsSizeBaseline)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompactionStatus.
+func (in *CompactionStatus) DeepCopy() *CompactionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CompactionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CompressionSpec) DeepCopyInto(out *CompressionSpec) {
 	*out = *in
@@ -305,6 +398,23 @@ func (in *CrossVersionObjectReference) DeepCopy() *CrossVersionObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeltaSnapshotsSizeBaseline) DeepCopyInto(out *DeltaSnapshotsSizeBaseline) {
+	*out = *in
+	out.DBSize = in.DBSize.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeltaSnapshotsSizeBaseline.
+func (in *DeltaSnapshotsSizeBaseline) DeepCopy() *DeltaSnapshotsSizeBaseline {
+	if in == nil {
+		return nil
+	}
+	out := new(DeltaSnapshotsSizeBaseline)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Etcd) DeepCopyInto(out *Etcd) {
 	*out = *in
@@ -333,6 +443,99 @@ func (in *Etcd) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdAuth) DeepCopyInto(out *EtcdAuth) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]EtcdAuthRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]EtcdAuthUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdAuth.
+func (in *EtcdAuth) DeepCopy() *EtcdAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdAuthPermission) DeepCopyInto(out *EtcdAuthPermission) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdAuthPermission.
+func (in *EtcdAuthPermission) DeepCopy() *EtcdAuthPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdAuthPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdAuthRole) DeepCopyInto(out *EtcdAuthRole) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]EtcdAuthPermission, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdAuthRole.
+func (in *EtcdAuthRole) DeepCopy() *EtcdAuthRole {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdAuthRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdAuthUser) DeepCopyInto(out *EtcdAuthUser) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IssueClientCertificate != nil {
+		in, out := &in.IssueClientCertificate, &out.IssueClientCertificate
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdAuthUser.
+func (in *EtcdAuthUser) DeepCopy() *EtcdAuthUser {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdAuthUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 	*out = *in
@@ -433,6 +636,11 @@ func (in *EtcdConfig) DeepCopyInto(out *EtcdConfig) {
 		*out = new(BootstrapWithExistingCluster)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(EtcdAuth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -529,6 +737,15 @@ func (in *EtcdCopyBackupsTaskSpec) DeepCopyInto(out *EtcdCopyBackupsTaskSpec) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.MaxRevision != nil {
+		in, out := &in.MaxRevision, &out.MaxRevision
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxTimestamp != nil {
+		in, out := &in.MaxTimestamp, &out.MaxTimestamp
+		*out = (*in).DeepCopy()
+	}
 	if in.WaitForFinalSnapshot != nil {
 		in, out := &in.WaitForFinalSnapshot, &out.WaitForFinalSnapshot
 		*out = new(WaitForFinalSnapshotSpec)
@@ -580,6 +797,32 @@ func (in *EtcdCopyBackupsTaskStatus) DeepCopy() *EtcdCopyBackupsTaskStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdHealthCheck) DeepCopyInto(out *EtcdHealthCheck) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(common.HTTPHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(common.MetricHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdHealthCheck.
+func (in *EtcdHealthCheck) DeepCopy() *EtcdHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdList) DeepCopyInto(out *EtcdList) {
 	*out = *in
@@ -627,6 +870,11 @@ func (in *EtcdMemberStatus) DeepCopyInto(out *EtcdMemberStatus) {
 		**out = **in
 	}
 	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.DBSize != nil {
+		in, out := &in.DBSize, &out.DBSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -640,6 +888,29 @@ func (in *EtcdMemberStatus) DeepCopy() *EtcdMemberStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdOperationRecord) DeepCopyInto(out *EtcdOperationRecord) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+	if in.ChangedComponents != nil {
+		in, out := &in.ChangedComponents, &out.ChangedComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdOperationRecord.
+func (in *EtcdOperationRecord) DeepCopy() *EtcdOperationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdOperationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdOpsTask) DeepCopyInto(out *EtcdOpsTask) {
 	*out = *in
@@ -820,6 +1091,11 @@ func (in *EtcdSpec) DeepCopyInto(out *EtcdSpec) {
 	in.Etcd.DeepCopyInto(&out.Etcd)
 	in.Backup.DeepCopyInto(&out.Backup)
 	in.Common.DeepCopyInto(&out.Common)
+	if in.ManagedCertificates != nil {
+		in, out := &in.ManagedCertificates, &out.ManagedCertificates
+		*out = new(ManagedCertificatesSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	in.SchedulingConstraints.DeepCopyInto(&out.SchedulingConstraints)
 	if in.PriorityClassName != nil {
 		in, out := &in.PriorityClassName, &out.PriorityClassName
@@ -846,6 +1122,13 @@ func (in *EtcdSpec) DeepCopyInto(out *EtcdSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]EtcdHealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -923,6 +1206,18 @@ func (in *EtcdStatus) DeepCopyInto(out *EtcdStatus) {
 		*out = new(BootstrapWithExistingClusterStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Compaction != nil {
+		in, out := &in.Compaction, &out.Compaction
+		*out = new(CompactionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OperationHistory != nil {
+		in, out := &in.OperationHistory, &out.OperationHistory
+		*out = make([]EtcdOperationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -962,6 +1257,37 @@ func (in *LeaderElectionSpec) DeepCopy() *LeaderElectionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedCertificatesSpec) DeepCopyInto(out *ManagedCertificatesSpec) {
+	*out = *in
+	if in.CAValidity != nil {
+		in, out := &in.CAValidity, &out.CAValidity
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CertificateValidity != nil {
+		in, out := &in.CertificateValidity, &out.CertificateValidity
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedCertificatesSpec.
+func (in *ManagedCertificatesSpec) DeepCopy() *ManagedCertificatesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedCertificatesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemberPeerURLs) DeepCopyInto(out *MemberPeerURLs) {
 	*out = *in
@@ -1036,6 +1362,58 @@ func (in *PeerTLSConfig) DeepCopy() *PeerTLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecoveryPointObjectives) DeepCopyInto(out *RecoveryPointObjectives) {
+	*out = *in
+	if in.MaxFullSnapshotAge != nil {
+		in, out := &in.MaxFullSnapshotAge, &out.MaxFullSnapshotAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxDeltaSnapshotAge != nil {
+		in, out := &in.MaxDeltaSnapshotAge, &out.MaxDeltaSnapshotAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecoveryPointObjectives.
+func (in *RecoveryPointObjectives) DeepCopy() *RecoveryPointObjectives {
+	if in == nil {
+		return nil
+	}
+	out := new(RecoveryPointObjectives)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFromSpec) DeepCopyInto(out *RestoreFromSpec) {
+	*out = *in
+	in.Store.DeepCopyInto(&out.Store)
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFromSpec.
+func (in *RestoreFromSpec) DeepCopy() *RestoreFromSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreFromSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulingConstraints) DeepCopyInto(out *SchedulingConstraints) {
 	*out = *in
@@ -1130,6 +1508,21 @@ func (in *SnapshotCompactionSpec) DeepCopyInto(out *SnapshotCompactionSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.MaxFullSnapshotAge != nil {
+		in, out := &in.MaxFullSnapshotAge, &out.MaxFullSnapshotAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DeltaSnapshotsTotalSizeThreshold != nil {
+		in, out := &in.DeltaSnapshotsTotalSizeThreshold, &out.DeltaSnapshotsTotalSizeThreshold
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.AllowedHours != nil {
+		in, out := &in.AllowedHours, &out.AllowedHours
+		*out = new(CompactionAllowedHours)
+		**out = **in
+	}
 	return
 }
 
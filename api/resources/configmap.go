@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// BuildConfigMap sets the desired state of the configmap holding the etcd configuration of the given Etcd on the given
+// configmap.
+func BuildConfigMap(etcd *druidv1alpha1.Etcd, cm *corev1.ConfigMap) error {
+	cfg := createEtcdConfig(etcd)
+	cfgYaml, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	cm.Name = druidv1alpha1.GetConfigMapName(etcd.ObjectMeta)
+	cm.Namespace = etcd.Namespace
+	cm.Labels = getConfigMapLabels(etcd)
+	cm.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+	cm.Data = map[string]string{EtcdConfigFileName: string(cfgYaml)}
+
+	return nil
+}
+
+// ComputeConfigMapCheckSum computes the checksum of the data of the given configmap, which is placed on the pods of
+// the StatefulSet with the CheckSumKeyConfigMap annotation to roll them on configuration changes.
+func ComputeConfigMapCheckSum(cm *corev1.ConfigMap) (string, error) {
+	jsonData, err := json.Marshal(cm.Data)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(jsonData)
+	return hex.EncodeToString(h[:]), nil
+}
+
+func getConfigMapLabels(etcd *druidv1alpha1.Etcd) map[string]string {
+	cmLabels := map[string]string{
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameConfigMap,
+		druidv1alpha1.LabelAppNameKey:   druidv1alpha1.GetConfigMapName(etcd.ObjectMeta),
+	}
+	return mergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), cmLabels)
+}
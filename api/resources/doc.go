@@ -0,0 +1,7 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package resources builds the resources which etcd-druid provisions for an Etcd. It is used by etcd-druid to reconcile
+// an Etcd, and by clients which need to know the resources etcd-druid would provision for an Etcd.
+package resources
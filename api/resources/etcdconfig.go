@@ -2,7 +2,7 @@
 //
 // SPDX-License-Identifier: Apache-2.0
 
-package configmap
+package resources
 
 import (
 	"fmt"
@@ -11,7 +11,6 @@ import (
 
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
 
 	"k8s.io/utils/ptr"
 )
@@ -35,7 +34,7 @@ const (
 )
 
 var (
-	defaultDataDir = fmt.Sprintf("%s/new.etcd", common.VolumeMountPathEtcdData)
+	defaultDataDir = fmt.Sprintf("%s/new.etcd", VolumeMountPathEtcdData)
 )
 
 type etcdConfig struct {
@@ -77,35 +76,35 @@ type securityConfig struct {
 }
 
 func createEtcdConfig(etcd *druidv1alpha1.Etcd) *etcdConfig {
-	clientScheme, clientSecurityConfig := getSchemeAndSecurityConfig(etcd.Spec.Etcd.ClientUrlTLS, common.VolumeMountPathEtcdCA, common.VolumeMountPathEtcdServerTLS)
+	clientScheme, clientSecurityConfig := getSchemeAndSecurityConfig(etcd.GetClientURLTLS(), VolumeMountPathEtcdCA, VolumeMountPathEtcdServerTLS)
 	var peerTLS *druidv1alpha1.TLSConfig
-	if etcd.Spec.Etcd.PeerUrlTLS != nil {
-		peerTLS = &etcd.Spec.Etcd.PeerUrlTLS.TLSConfig
+	if etcd.GetPeerURLTLS() != nil {
+		peerTLS = &etcd.GetPeerURLTLS().TLSConfig
 	}
-	peerScheme, peerSecurityConfig := getSchemeAndSecurityConfig(peerTLS, common.VolumeMountPathEtcdPeerCA, common.VolumeMountPathEtcdPeerServerTLS)
+	peerScheme, peerSecurityConfig := getSchemeAndSecurityConfig(peerTLS, VolumeMountPathEtcdPeerCA, VolumeMountPathEtcdPeerServerTLS)
 	peerSvcName := druidv1alpha1.GetPeerServiceName(etcd.ObjectMeta)
 	cfg := &etcdConfig{
 		Name:                         "etcd-config",
 		DataDir:                      defaultDataDir,
 		Metrics:                      ptr.Deref(etcd.Spec.Etcd.Metrics, druidv1alpha1.Basic),
 		SnapshotCount:                getSnapshotCount(etcd),
-		EnableGRPCGateway:            ptr.Deref(etcd.Spec.Etcd.EnableGRPCGateway, false),
+		EnableGRPCGateway:            isGRPCGatewayEnabled(etcd),
 		QuotaBackendBytes:            getDBQuotaBytes(etcd),
 		InitialClusterToken:          defaultInitialClusterToken,
 		InitialClusterState:          defaultInitialClusterState,
 		InitialCluster:               prepareInitialCluster(etcd, peerScheme),
 		AutoCompactionMode:           ptr.Deref(etcd.Spec.Common.AutoCompactionMode, druidv1alpha1.Periodic),
 		AutoCompactionRetention:      ptr.Deref(etcd.Spec.Common.AutoCompactionRetention, defaultAutoCompactionRetention),
-		ListenPeerUrls:               fmt.Sprintf("%s://0.0.0.0:%d", peerScheme, ptr.Deref(etcd.Spec.Etcd.ServerPort, common.DefaultPortEtcdPeer)),
-		ListenClientUrls:             fmt.Sprintf("%s://0.0.0.0:%d", clientScheme, ptr.Deref(etcd.Spec.Etcd.ClientPort, common.DefaultPortEtcdClient)),
+		ListenPeerUrls:               fmt.Sprintf("%s://0.0.0.0:%d", peerScheme, ptr.Deref(etcd.Spec.Etcd.ServerPort, druidv1alpha1.DefaultPortEtcdPeer)),
+		ListenClientUrls:             fmt.Sprintf("%s://0.0.0.0:%d", clientScheme, ptr.Deref(etcd.Spec.Etcd.ClientPort, druidv1alpha1.DefaultPortEtcdClient)),
 		AdvertisePeerUrls:            getAdvertiseURLs(etcd, advertiseURLTypePeer, peerScheme, peerSvcName),
 		AdvertiseClientUrls:          getAdvertiseURLs(etcd, advertiseURLTypeClient, clientScheme, peerSvcName),
 		NextClusterVersionCompatible: true,
 	}
 	cfg.PeerSecurity = peerSecurityConfig
 	cfg.ClientSecurity = clientSecurityConfig
-	if etcd.Spec.Etcd.PeerUrlTLS != nil && cfg.PeerSecurity != nil &&
-		ptr.Deref(etcd.Spec.Etcd.PeerUrlTLS.SkipClientSANVerification, false) {
+	if etcd.GetPeerURLTLS() != nil && cfg.PeerSecurity != nil &&
+		ptr.Deref(etcd.GetPeerURLTLS().SkipClientSANVerification, false) {
 		cfg.PeerSecurity.SkipClientSANVerification = true
 	}
 	if etcd.Spec.MemberNamePrefix != nil {
@@ -148,7 +147,7 @@ func getSchemeAndSecurityConfig(tlsConfig *druidv1alpha1.TLSConfig, caPath, serv
 
 func prepareInitialCluster(etcd *druidv1alpha1.Etcd, peerScheme string) string {
 	domainName := fmt.Sprintf("%s.%s.%s", druidv1alpha1.GetPeerServiceName(etcd.ObjectMeta), etcd.Namespace, "svc")
-	serverPort := strconv.Itoa(int(ptr.Deref(etcd.Spec.Etcd.ServerPort, common.DefaultPortEtcdPeer)))
+	serverPort := strconv.Itoa(int(ptr.Deref(etcd.Spec.Etcd.ServerPort, druidv1alpha1.DefaultPortEtcdPeer)))
 	builder := strings.Builder{}
 	for i := range int(etcd.Spec.Replicas) {
 		podName := druidv1alpha1.GetOrdinalPodName(etcd.ObjectMeta, i)
@@ -179,9 +178,9 @@ func getAdvertiseURLs(etcd *druidv1alpha1.Etcd, advertiseURLType, scheme, peerSv
 	var port int32
 	switch advertiseURLType {
 	case advertiseURLTypePeer:
-		port = ptr.Deref(etcd.Spec.Etcd.ServerPort, common.DefaultPortEtcdPeer)
+		port = ptr.Deref(etcd.Spec.Etcd.ServerPort, druidv1alpha1.DefaultPortEtcdPeer)
 	case advertiseURLTypeClient:
-		port = ptr.Deref(etcd.Spec.Etcd.ClientPort, common.DefaultPortEtcdClient)
+		port = ptr.Deref(etcd.Spec.Etcd.ClientPort, druidv1alpha1.DefaultPortEtcdClient)
 	default:
 		return nil
 	}
@@ -209,3 +208,9 @@ func getAdvertiseURLs(etcd *druidv1alpha1.Etcd, advertiseURLType, scheme, peerSv
 	}
 	return advUrlsMap
 }
+
+// isGRPCGatewayEnabled checks if the gRPC gateway is enabled for the given Etcd. It is always enabled if the auth store
+// is managed by etcd-druid, as etcd-druid uses the gateway to manage users and roles.
+func isGRPCGatewayEnabled(etcd *druidv1alpha1.Etcd) bool {
+	return ptr.Deref(etcd.Spec.Etcd.EnableGRPCGateway, false) || etcd.Spec.Etcd.Auth != nil
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// annotationAllowUnhealthyPodEviction is an annotation that can be set on the Etcd resource, to allow unhealthy pod eviction.
+	// WARNING: this annotation may be removed at any point of time, and is NOT meant to be generally used.
+	annotationAllowUnhealthyPodEviction = "resources.druid.gardener.cloud/allow-unhealthy-pod-eviction"
+)
+
+// BuildPodDisruptionBudget sets the desired state of the pod disruption budget of the given Etcd on the given pod
+// disruption budget.
+func BuildPodDisruptionBudget(etcd *druidv1alpha1.Etcd, pdb *policyv1.PodDisruptionBudget) {
+	pdb.Labels = getPodDisruptionBudgetLabels(etcd)
+	pdb.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+	pdb.Spec.MinAvailable = &intstr.IntOrString{
+		IntVal: computePDBMinAvailable(int(etcd.Spec.Replicas)),
+		Type:   intstr.Int,
+	}
+	pdb.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta),
+	}
+	if metav1.HasAnnotation(etcd.ObjectMeta, annotationAllowUnhealthyPodEviction) {
+		pdb.Spec.UnhealthyPodEvictionPolicy = ptr.To(policyv1.AlwaysAllow)
+	} else {
+		pdb.Spec.UnhealthyPodEvictionPolicy = nil
+	}
+}
+
+func getPodDisruptionBudgetLabels(etcd *druidv1alpha1.Etcd) map[string]string {
+	pdbLabels := map[string]string{
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNamePodDisruptionBudget,
+		druidv1alpha1.LabelAppNameKey:   etcd.Name,
+	}
+	return mergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), pdbLabels)
+}
+
+func computePDBMinAvailable(etcdReplicas int) int32 {
+	// do not enable PDB for single node cluster
+	if etcdReplicas <= 1 {
+		return 0
+	}
+	return int32(etcdReplicas/2 + 1) // #nosec G115 -- etcdReplicas will never cross the size of int32, so conversion is safe.
+}
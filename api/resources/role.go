@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BuildRole sets the desired state of the role of the given Etcd on the given role.
+func BuildRole(etcd *druidv1alpha1.Etcd, role *rbacv1.Role) {
+	role.Labels = getRoleLabels(etcd)
+	role.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+	role.Rules = []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"coordination.k8s.io"},
+			Resources: []string{"leases"},
+			Verbs:     []string{"get", "list", "patch", "update", "watch"},
+		},
+		{
+			APIGroups: []string{"apps"},
+			Resources: []string{"statefulsets"},
+			Verbs:     []string{"get", "list", "patch", "update", "watch"},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+	}
+}
+
+func getRoleLabels(etcd *druidv1alpha1.Etcd) map[string]string {
+	roleLabels := map[string]string{
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameRole,
+		druidv1alpha1.LabelAppNameKey:   strings.ReplaceAll(druidv1alpha1.GetRoleName(etcd.ObjectMeta), ":", "-"), // role name contains `:` which is not an allowed character as a label value.
+	}
+	return mergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), roleLabels)
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BuildRoleBinding sets the desired state of the role binding of the given Etcd on the given role binding.
+func BuildRoleBinding(etcd *druidv1alpha1.Etcd, rb *rbacv1.RoleBinding) {
+	rb.Labels = getRoleBindingLabels(etcd)
+	rb.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+	rb.RoleRef = rbacv1.RoleRef{
+		APIGroup: "rbac.authorization.k8s.io",
+		Kind:     "Role",
+		Name:     druidv1alpha1.GetRoleName(etcd.ObjectMeta),
+	}
+	rb.Subjects = []rbacv1.Subject{
+		{
+			Kind:      "ServiceAccount",
+			Name:      druidv1alpha1.GetServiceAccountName(etcd.ObjectMeta),
+			Namespace: etcd.Namespace,
+		},
+	}
+}
+
+func getRoleBindingLabels(etcd *druidv1alpha1.Etcd) map[string]string {
+	rbLabels := map[string]string{
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameRoleBinding,
+		druidv1alpha1.LabelAppNameKey:   strings.ReplaceAll(druidv1alpha1.GetRoleBindingName(etcd.ObjectMeta), ":", "-"), // role-binding name contains `:` which is not an allowed character as a label value.
+	}
+	return mergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), rbLabels)
+}
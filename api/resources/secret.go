@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"slices"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GetReferencedSecretNames returns the sorted names of all secrets referenced in the spec of the given Etcd, i.e. the
+// TLS secrets for the client, peer and backup-restore communication as well as the backup store secret. All of them
+// are expected to be in the namespace of the Etcd.
+func GetReferencedSecretNames(etcd *druidv1alpha1.Etcd) []string {
+	var names []string
+	if tlsConfig := etcd.Spec.Etcd.ClientUrlTLS; tlsConfig != nil {
+		names = append(names, tlsConfig.TLSCASecretRef.Name, tlsConfig.ServerTLSSecretRef.Name, tlsConfig.ClientTLSSecretRef.Name)
+	}
+	if peerTLSConfig := etcd.Spec.Etcd.PeerUrlTLS; peerTLSConfig != nil {
+		// Currently, no client certificate for peer url is used in ETCD cluster
+		names = append(names, peerTLSConfig.TLSCASecretRef.Name, peerTLSConfig.ServerTLSSecretRef.Name)
+	}
+	if tlsConfig := etcd.Spec.Backup.TLS; tlsConfig != nil {
+		names = append(names, tlsConfig.TLSCASecretRef.Name, tlsConfig.ServerTLSSecretRef.Name, tlsConfig.ClientTLSSecretRef.Name)
+	}
+	if etcd.Spec.Backup.Store != nil && etcd.Spec.Backup.Store.SecretRef != nil {
+		names = append(names, etcd.Spec.Backup.Store.SecretRef.Name)
+	}
+	names = slices.DeleteFunc(names, func(name string) bool { return name == "" })
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// ComputeReferencedSecretsCheckSum computes the checksum of the content of the given secrets, which are expected to be
+// the existing secrets referenced by an Etcd in the order of GetReferencedSecretNames. The checksum is placed as the
+// CheckSumKeyReferencedSecrets annotation on the pod template of the StatefulSet.
+func ComputeReferencedSecretsCheckSum(secrets []*corev1.Secret) string {
+	var data []byte
+	for _, secret := range secrets {
+		data = append(data, secret.Name...)
+		for _, key := range slices.Sorted(maps.Keys(secret.Data)) {
+			data = append(data, 0)
+			data = append(data, key...)
+			data = append(data, 0)
+			data = append(data, secret.Data[key]...)
+		}
+		data = append(data, 0)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
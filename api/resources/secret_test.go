@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"slices"
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetReferencedSecretNames(t *testing.T) {
+	testCases := []struct {
+		name          string
+		etcd          *druidv1alpha1.Etcd
+		expectedNames []string
+	}{
+		{
+			name: "should return no names if no secrets are referenced",
+			etcd: buildTestEtcd(3, false, false),
+		},
+		{
+			name: "should return the names of all TLS and backup store secrets",
+			etcd: func() *druidv1alpha1.Etcd {
+				etcd := buildTestEtcd(3, true, true)
+				etcd.Spec.Backup.TLS = &druidv1alpha1.TLSConfig{
+					TLSCASecretRef: druidv1alpha1.SecretReference{
+						SecretReference: corev1.SecretReference{Name: "ca-backup-restore"},
+					},
+					ServerTLSSecretRef: corev1.SecretReference{Name: "backup-restore-server-tls"},
+					ClientTLSSecretRef: corev1.SecretReference{Name: "backup-restore-client-tls"},
+				}
+				etcd.Spec.Backup.Store = &druidv1alpha1.StoreSpec{
+					SecretRef: &corev1.SecretReference{Name: "etcd-backup"},
+				}
+				return etcd
+			}(),
+			expectedNames: []string{
+				"backup-restore-client-tls",
+				"backup-restore-server-tls",
+				"ca-backup-restore",
+				"client-url-ca-etcd",
+				"client-url-etcd-client-tls",
+				"client-url-etcd-server-tls",
+				"etcd-backup",
+				"peer-url-ca-etcd",
+				"peer-url-etcd-server-tls",
+			},
+		},
+		{
+			name: "should return secrets referenced multiple times only once",
+			etcd: func() *druidv1alpha1.Etcd {
+				etcd := buildTestEtcd(3, true, true)
+				etcd.Spec.Etcd.PeerUrlTLS.TLSCASecretRef.Name = "client-url-ca-etcd"
+				return etcd
+			}(),
+			expectedNames: []string{
+				"client-url-ca-etcd",
+				"client-url-etcd-client-tls",
+				"client-url-etcd-server-tls",
+				"peer-url-etcd-server-tls",
+			},
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			names := GetReferencedSecretNames(tc.etcd)
+			g.Expect(names).To(ConsistOf(tc.expectedNames))
+			g.Expect(slices.IsSorted(names)).To(BeTrue())
+		})
+	}
+}
+
+func TestComputeReferencedSecretsCheckSum(t *testing.T) {
+	newSecret := func(name string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace}, Data: data}
+	}
+	g := NewWithT(t)
+
+	checkSum := ComputeReferencedSecretsCheckSum([]*corev1.Secret{
+		newSecret("ca-etcd", map[string][]byte{"ca.crt": []byte("ca"), "bundle.crt": []byte("bundle")}),
+		newSecret("etcd-backup", map[string][]byte{"bucketName": []byte("bucket")}),
+	})
+	g.Expect(checkSum).To(HaveLen(64))
+	g.Expect(ComputeReferencedSecretsCheckSum([]*corev1.Secret{
+		newSecret("ca-etcd", map[string][]byte{"bundle.crt": []byte("bundle"), "ca.crt": []byte("ca")}),
+		newSecret("etcd-backup", map[string][]byte{"bucketName": []byte("bucket")}),
+	})).To(Equal(checkSum), "checksum should not depend on the order of the data keys")
+	g.Expect(ComputeReferencedSecretsCheckSum([]*corev1.Secret{
+		newSecret("ca-etcd", map[string][]byte{"ca.crt": []byte("ca"), "bundle.crt": []byte("bundle")}),
+		newSecret("etcd-backup", map[string][]byte{"bucketName": []byte("other-bucket")}),
+	})).ToNot(Equal(checkSum), "checksum should change with the content of a secret")
+	g.Expect(ComputeReferencedSecretsCheckSum([]*corev1.Secret{
+		newSecret("ca-etcd", map[string][]byte{"ca.crt": []byte("ca"), "bundle.crt": []byte("bundle")}),
+	})).ToNot(Equal(checkSum), "checksum should change if a secret is missing")
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+// BuildClientService sets the desired state of the client service of the given Etcd on the given service.
+func BuildClientService(etcd *druidv1alpha1.Etcd, svc *corev1.Service) {
+	svc.Labels = getClientServiceLabels(etcd)
+	svc.Annotations = getClientServiceAnnotations(etcd)
+	svc.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+	svc.Spec.Type = corev1.ServiceTypeClusterIP
+	svc.Spec.SessionAffinity = corev1.ServiceAffinityNone
+	// Client service should only target StatefulSet pods. Default labels are going to be present on anything that is managed by etcd-druid and started for an etcd cluster.
+	// Therefore, only using default labels as label selector can cause issues as we have already seen in https://github.com/gardener/etcd-druid/issues/914
+	svc.Spec.Selector = mergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), map[string]string{druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameStatefulSet})
+	svc.Spec.Ports = getClientServicePorts(etcd)
+	svc.Spec.TrafficDistribution = getClientServiceTrafficDistribution(etcd)
+}
+
+// BuildPeerService sets the desired state of the peer service of the given Etcd on the given service.
+func BuildPeerService(etcd *druidv1alpha1.Etcd, svc *corev1.Service) {
+	svc.Labels = getPeerServiceLabels(etcd)
+	svc.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+	svc.Spec.Type = corev1.ServiceTypeClusterIP
+	svc.Spec.ClusterIP = corev1.ClusterIPNone
+	svc.Spec.SessionAffinity = corev1.ServiceAffinityNone
+	// Peer service should only target StatefulSet pods. Default labels are going to be present on anything that is managed by etcd-druid and started for an etcd cluster.
+	// Therefore, only using default labels as label selector can cause issues as we have already seen in https://github.com/gardener/etcd-druid/issues/914
+	svc.Spec.Selector = mergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), map[string]string{druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameStatefulSet})
+	svc.Spec.PublishNotReadyAddresses = true
+	svc.Spec.Ports = getPeerServicePorts(etcd)
+}
+
+func getClientServiceLabels(etcd *druidv1alpha1.Etcd) map[string]string {
+	clientSvcLabels := map[string]string{
+		druidv1alpha1.LabelAppNameKey:   druidv1alpha1.GetClientServiceName(etcd.ObjectMeta),
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameClientService,
+	}
+	// Add any client service labels as defined in the etcd resource
+	specClientSvcLabels := map[string]string{}
+	if etcd.Spec.Etcd.ClientService != nil && etcd.Spec.Etcd.ClientService.Labels != nil {
+		specClientSvcLabels = etcd.Spec.Etcd.ClientService.Labels
+	}
+	return mergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), clientSvcLabels, specClientSvcLabels)
+}
+
+func getClientServiceAnnotations(etcd *druidv1alpha1.Etcd) map[string]string {
+	if etcd.Spec.Etcd.ClientService != nil {
+		return etcd.Spec.Etcd.ClientService.Annotations
+	}
+	return nil
+}
+
+func getClientServiceTrafficDistribution(etcd *druidv1alpha1.Etcd) *string {
+	if etcd.Spec.Etcd.ClientService != nil {
+		return etcd.Spec.Etcd.ClientService.TrafficDistribution
+	}
+	return nil
+}
+
+func getClientServicePorts(etcd *druidv1alpha1.Etcd) []corev1.ServicePort {
+	backupPort := ptr.Deref(etcd.Spec.Backup.Port, druidv1alpha1.DefaultPortEtcdBackupRestore)
+	clientPort := ptr.Deref(etcd.Spec.Etcd.ClientPort, druidv1alpha1.DefaultPortEtcdClient)
+	peerPort := ptr.Deref(etcd.Spec.Etcd.ServerPort, druidv1alpha1.DefaultPortEtcdPeer)
+
+	return []corev1.ServicePort{
+		{
+			Name:       "client",
+			Protocol:   corev1.ProtocolTCP,
+			Port:       clientPort,
+			TargetPort: intstr.FromInt(int(clientPort)),
+		},
+		// TODO: Remove the "server" port in a future release
+		{
+			Name:       "server",
+			Protocol:   corev1.ProtocolTCP,
+			Port:       peerPort,
+			TargetPort: intstr.FromInt(int(peerPort)),
+		},
+		{
+			Name:       "backuprestore",
+			Protocol:   corev1.ProtocolTCP,
+			Port:       backupPort,
+			TargetPort: intstr.FromInt(int(backupPort)),
+		},
+	}
+}
+
+func getPeerServiceLabels(etcd *druidv1alpha1.Etcd) map[string]string {
+	svcLabels := map[string]string{
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNamePeerService,
+		druidv1alpha1.LabelAppNameKey:   druidv1alpha1.GetPeerServiceName(etcd.ObjectMeta),
+	}
+	return mergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), svcLabels)
+}
+
+func getPeerServicePorts(etcd *druidv1alpha1.Etcd) []corev1.ServicePort {
+	peerPort := ptr.Deref(etcd.Spec.Etcd.ServerPort, druidv1alpha1.DefaultPortEtcdPeer)
+	return []corev1.ServicePort{
+		{
+			Name:       "peer",
+			Protocol:   corev1.ProtocolTCP,
+			Port:       peerPort,
+			TargetPort: intstr.FromInt(int(peerPort)),
+		},
+	}
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// BuildServiceAccount sets the desired state of the service account of the given Etcd on the given service account.
+func BuildServiceAccount(etcd *druidv1alpha1.Etcd, sa *corev1.ServiceAccount, autoMountServiceAccountToken bool) {
+	sa.Labels = getServiceAccountLabels(etcd)
+	sa.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+	sa.AutomountServiceAccountToken = ptr.To(autoMountServiceAccountToken)
+}
+
+func getServiceAccountLabels(etcd *druidv1alpha1.Etcd) map[string]string {
+	saLabels := map[string]string{
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameServiceAccount,
+		druidv1alpha1.LabelAppNameKey:   druidv1alpha1.GetServiceAccountName(etcd.ObjectMeta),
+	}
+	return mergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), saLabels)
+}
@@ -2,47 +2,45 @@
 //
 // SPDX-License-Identifier: Apache-2.0
 
-package statefulset
+package resources
 
 import (
 	"fmt"
 	"strings"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
-	"github.com/gardener/etcd-druid/internal/component"
-	druidstore "github.com/gardener/etcd-druid/internal/store"
-	"github.com/gardener/etcd-druid/internal/utils"
-	"github.com/gardener/etcd-druid/internal/utils/imagevector"
-	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
-
-	"github.com/go-logr/logr"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // defaults
 // -----------------------------------------------------------------------------------------
 const (
-	defaultMaxBackupsLimitBasedGC  int32 = 7
-	defaultQuota                   int64 = 8 * 1024 * 1024 * 1024 // 8Gi
-	defaultSnapshotMemoryLimit     int64 = 100 * 1024 * 1024      // 100Mi
-	defaultHeartbeatDuration             = "10s"
-	defaultGbcPolicy                     = "LimitBased"
-	defaultAutoCompactionRetention       = "30m"
-	defaultEtcdSnapshotTimeout           = "15m"
-	defaultEtcdDefragTimeout             = "15m"
-	defaultAutoCompactionMode            = "periodic"
-	defaultEtcdConnectionTimeout         = "5m"
-	defaultPodManagementPolicy           = appsv1.ParallelPodManagement
-	rootUser                             = int64(0)
-	nonRootUser                          = int64(65532)
-	etcdWrapperReadyEndpoint             = "/readyz"
+	defaultMaxBackupsLimitBasedGC int32 = 7
+	defaultQuota                  int64 = 8 * 1024 * 1024 * 1024 // 8Gi
+	defaultSnapshotMemoryLimit    int64 = 100 * 1024 * 1024      // 100Mi
+	defaultHeartbeatDuration            = "10s"
+	defaultGbcPolicy                    = "LimitBased"
+	defaultEtcdSnapshotTimeout          = "15m"
+	defaultEtcdDefragTimeout            = "15m"
+	defaultAutoCompactionMode           = "periodic"
+	defaultEtcdConnectionTimeout        = "5m"
+	defaultPodManagementPolicy          = appsv1.ParallelPodManagement
+	rootUser                            = int64(0)
+	nonRootUser                         = int64(65532)
+	etcdWrapperReadyEndpoint            = "/readyz"
+	etcdConfigFileMountPath             = "/var/etcd/config/"
+)
+
+// constants for container ports
+const (
+	serverPortName = "server"
+	clientPortName = "client"
 )
 
 var (
@@ -56,64 +54,58 @@ var (
 	defaultUpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType}
 )
 
+// StatefulSetOptions are the options for building the StatefulSet of an Etcd, which are not part of the Etcd spec.
+type StatefulSetOptions struct {
+	// Replicas is the number of replicas set on the StatefulSet, which can differ from the replicas in the Etcd spec
+	// while a scale-up or a rollout is in progress.
+	Replicas int32
+	// EtcdImage is the image of the etcd container.
+	EtcdImage string
+	// EtcdBackupRestoreImage is the image of the backup-restore container.
+	EtcdBackupRestoreImage string
+	// InitContainerImage is the image of the init containers.
+	InitContainerImage string
+	// LocalProviderHostPath is the path on the host under which the buckets of a Local backup store are located.
+	// It is only used for the Local provider, see GetLocalProviderHostPath.
+	LocalProviderHostPath string
+	// CheckSums are the checksums placed as annotations on the pod template, keyed by CheckSumKeyConfigMap,
+	// CheckSumKeyManagedCertificates and CheckSumKeyReferencedSecrets. Other keys are ignored.
+	CheckSums map[string]string
+	// SkipSetOrUpdateForbiddenFields skips setting the fields which are forbidden to be updated for an existing StatefulSet.
+	// Updates to statefulset spec for fields other than 'replicas', 'ordinals', 'template', 'updateStrategy', 'persistentVolumeClaimRetentionPolicy' and 'minReadySeconds' are forbidden.
+	SkipSetOrUpdateForbiddenFields bool
+}
+
 type stsBuilder struct {
-	client                 client.Client
-	etcd                   *druidv1alpha1.Etcd
-	replicas               int32
-	provider               *string
-	etcdImage              string
-	etcdBackupRestoreImage string
-	initContainerImage     string
-	sts                    *appsv1.StatefulSet
-	logger                 logr.Logger
+	etcd     *druidv1alpha1.Etcd
+	opts     StatefulSetOptions
+	provider *string
+	sts      *appsv1.StatefulSet
 
 	clientPort  int32
 	serverPort  int32
 	backupPort  int32
 	wrapperPort int32
-	// skipSetOrUpdateForbiddenFields if its true then it will set/update values to fields which are forbidden to be updated for an existing StatefulSet.
-	// Updates to statefulset spec for fields other than 'replicas', 'ordinals', 'template', 'updateStrategy', 'persistentVolumeClaimRetentionPolicy' and 'minReadySeconds' are forbidden.
-	// Only for a new StatefulSet should this be set to true.
-	skipSetOrUpdateForbiddenFields bool
-}
-
-func newStsBuilder(client client.Client,
-	logger logr.Logger,
-	etcd *druidv1alpha1.Etcd,
-	replicas int32,
-	imageVector imagevector.ImageVector,
-	skipSetOrUpdateForbiddenFields bool,
-	sts *appsv1.StatefulSet) (*stsBuilder, error) {
-	etcdImage, etcdBackupRestoreImage, initContainerImage, err := utils.GetEtcdImages(etcd, imageVector)
-	if err != nil {
-		return nil, err
-	}
-	provider, err := kubernetes.GetBackupStoreProvider(etcd)
-	if err != nil {
-		return nil, err
-	}
-	return &stsBuilder{
-		client:                         client,
-		logger:                         logger,
-		etcd:                           etcd,
-		replicas:                       replicas,
-		provider:                       provider,
-		etcdImage:                      etcdImage,
-		etcdBackupRestoreImage:         etcdBackupRestoreImage,
-		initContainerImage:             initContainerImage,
-		sts:                            sts,
-		clientPort:                     ptr.Deref(etcd.Spec.Etcd.ClientPort, common.DefaultPortEtcdClient),
-		serverPort:                     ptr.Deref(etcd.Spec.Etcd.ServerPort, common.DefaultPortEtcdPeer),
-		backupPort:                     ptr.Deref(etcd.Spec.Backup.Port, common.DefaultPortEtcdBackupRestore),
-		wrapperPort:                    ptr.Deref(etcd.Spec.Etcd.WrapperPort, common.DefaultPortEtcdWrapper),
-		skipSetOrUpdateForbiddenFields: skipSetOrUpdateForbiddenFields,
-	}, nil
 }
 
-// Build builds the StatefulSet for the given Etcd.
-func (b *stsBuilder) Build(ctx component.OperatorContext) error {
+// BuildStatefulSet sets the desired state of the StatefulSet of the given Etcd on the given StatefulSet.
+func BuildStatefulSet(etcd *druidv1alpha1.Etcd, sts *appsv1.StatefulSet, opts StatefulSetOptions) error {
+	provider, err := GetBackupStoreProvider(etcd)
+	if err != nil {
+		return err
+	}
+	b := &stsBuilder{
+		etcd:        etcd,
+		opts:        opts,
+		provider:    provider,
+		sts:         sts,
+		clientPort:  ptr.Deref(etcd.Spec.Etcd.ClientPort, druidv1alpha1.DefaultPortEtcdClient),
+		serverPort:  ptr.Deref(etcd.Spec.Etcd.ServerPort, druidv1alpha1.DefaultPortEtcdPeer),
+		backupPort:  ptr.Deref(etcd.Spec.Backup.Port, druidv1alpha1.DefaultPortEtcdBackupRestore),
+		wrapperPort: ptr.Deref(etcd.Spec.Etcd.WrapperPort, druidv1alpha1.DefaultPortEtcdWrapper),
+	}
 	b.createStatefulSetObjectMeta()
-	if err := b.createStatefulSetSpec(ctx); err != nil {
+	if err := b.createStatefulSetSpec(); err != nil {
 		return fmt.Errorf("[stsBuilder]: error in creating StatefulSet spec: %w", err)
 	}
 	return nil
@@ -130,21 +122,20 @@ func (b *stsBuilder) createStatefulSetObjectMeta() {
 
 func (b *stsBuilder) getStatefulSetLabels() map[string]string {
 	stsLabels := map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameStatefulSet,
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameStatefulSet,
 		druidv1alpha1.LabelAppNameKey:   b.etcd.Name,
 	}
-	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(b.etcd.ObjectMeta), stsLabels)
+	return mergeMaps(druidv1alpha1.GetDefaultLabels(b.etcd.ObjectMeta), stsLabels)
 }
 
-func (b *stsBuilder) createStatefulSetSpec(ctx component.OperatorContext) error {
-	err := b.createPodTemplateSpec(ctx)
-	b.sts.Spec.Replicas = ptr.To(utils.IfConditionOr(druidv1alpha1.IsEtcdRuntimeComponentCreationEnabled(b.etcd.ObjectMeta), b.replicas, 0))
-	b.logger.Info("Creating StatefulSet spec", "replicas", b.sts.Spec.Replicas, "name", b.sts.Name, "namespace", b.sts.Namespace)
+func (b *stsBuilder) createStatefulSetSpec() error {
+	err := b.createPodTemplateSpec()
+	b.sts.Spec.Replicas = ptr.To(ifConditionOr(druidv1alpha1.IsEtcdRuntimeComponentCreationEnabled(b.etcd.ObjectMeta), b.opts.Replicas, 0))
 	b.sts.Spec.UpdateStrategy = defaultUpdateStrategy
 	if err != nil {
 		return err
 	}
-	if !b.skipSetOrUpdateForbiddenFields {
+	if !b.opts.SkipSetOrUpdateForbiddenFields {
 		b.sts.Spec.Selector = &metav1.LabelSelector{
 			MatchLabels: druidv1alpha1.GetDefaultLabels(b.etcd.ObjectMeta),
 		}
@@ -157,8 +148,8 @@ func (b *stsBuilder) createStatefulSetSpec(ctx component.OperatorContext) error
 	return nil
 }
 
-func (b *stsBuilder) createPodTemplateSpec(ctx component.OperatorContext) error {
-	podVolumes, err := b.getPodVolumes(ctx)
+func (b *stsBuilder) createPodTemplateSpec() error {
+	podVolumes, err := b.getPodVolumes()
 	if err != nil {
 		return err
 	}
@@ -186,23 +177,23 @@ func (b *stsBuilder) createPodTemplateSpec(ctx component.OperatorContext) error
 		podTemplateSpec.Spec.ServiceAccountName = druidv1alpha1.GetServiceAccountName(b.etcd.ObjectMeta)
 	}
 	podTemplateLabels := b.getStatefulSetPodLabels()
-	selectorMatchesLabels, err := kubernetes.DoesLabelSelectorMatchLabels(b.sts.Spec.Selector, podTemplateLabels)
+	selectorMatchesLabels, err := doesLabelSelectorMatchLabels(b.sts.Spec.Selector, podTemplateLabels)
 	if err != nil {
 		return err
 	}
 	if !selectorMatchesLabels {
-		podTemplateLabels = utils.MergeMaps(podTemplateLabels, b.sts.Spec.Template.Labels)
+		podTemplateLabels = mergeMaps(podTemplateLabels, b.sts.Spec.Template.Labels)
 	}
 	podTemplateSpec.ObjectMeta = metav1.ObjectMeta{
 		Labels:      podTemplateLabels,
-		Annotations: b.getPodTemplateAnnotations(ctx),
+		Annotations: b.getPodTemplateAnnotations(),
 	}
 	b.sts.Spec.Template = podTemplateSpec
 	return nil
 }
 
 func (b *stsBuilder) getStatefulSetPodLabels() map[string]string {
-	return utils.MergeMaps(
+	return mergeMaps(
 		b.etcd.Spec.Labels,
 		b.getStatefulSetLabels())
 }
@@ -216,13 +207,17 @@ func (b *stsBuilder) getHostAliases() []corev1.HostAlias {
 	}
 }
 
-func (b *stsBuilder) getPodTemplateAnnotations(ctx component.OperatorContext) map[string]string {
-	if configMapCheckSum, ok := ctx.Data[common.CheckSumKeyConfigMap]; ok {
-		return utils.MergeMaps(b.etcd.Spec.Annotations, map[string]string{
-			common.CheckSumKeyConfigMap: configMapCheckSum,
-		})
+func (b *stsBuilder) getPodTemplateAnnotations() map[string]string {
+	checkSumAnnotations := make(map[string]string)
+	for _, checkSumKey := range []string{CheckSumKeyConfigMap, CheckSumKeyManagedCertificates, CheckSumKeyReferencedSecrets} {
+		if checkSum, ok := b.opts.CheckSums[checkSumKey]; ok {
+			checkSumAnnotations[checkSumKey] = checkSum
+		}
 	}
-	return b.etcd.Spec.Annotations
+	if len(checkSumAnnotations) == 0 {
+		return b.etcd.Spec.Annotations
+	}
+	return mergeMaps(b.etcd.Spec.Annotations, checkSumAnnotations)
 }
 
 func (b *stsBuilder) getVolumeClaimTemplates() []corev1.PersistentVolumeClaim {
@@ -253,7 +248,7 @@ func (b *stsBuilder) getVolumeClaimTemplates() []corev1.PersistentVolumeClaim {
 }
 
 func (b *stsBuilder) getPodInitContainers() []corev1.Container {
-	if !b.etcd.IsBackupStoreEnabled() || b.provider == nil || *b.provider != druidstore.Local || ptr.Deref(b.etcd.Spec.RunAsRoot, false) || b.getEtcdBackupVolumeMount() == nil {
+	if !b.etcd.IsBackupStoreEnabled() || b.provider == nil || *b.provider != Local || ptr.Deref(b.etcd.Spec.RunAsRoot, false) || b.getEtcdBackupVolumeMount() == nil {
 		return nil
 	}
 
@@ -263,10 +258,10 @@ func (b *stsBuilder) getPodInitContainers() []corev1.Container {
 	}
 
 	return []corev1.Container{{
-		Name:            common.InitContainerNameChangeBackupBucketPermissions,
-		Image:           b.initContainerImage,
+		Name:            InitContainerNameChangeBackupBucketPermissions,
+		Image:           b.opts.InitContainerImage,
 		ImagePullPolicy: corev1.PullIfNotPresent,
-		Command:         []string{"chown", "-R", fmt.Sprintf("%d:%d", nonRootUser, nonRootUser), kubernetes.MountPathLocalStore(b.etcd, b.provider)},
+		Command:         []string{"chown", "-R", fmt.Sprintf("%d:%d", nonRootUser, nonRootUser), MountPathLocalStore(b.etcd, b.provider)},
 		VolumeMounts:    []corev1.VolumeMount{*etcdBackupVolumeMount},
 		SecurityContext: &corev1.SecurityContext{
 			AllowPrivilegeEscalation: ptr.To(false),
@@ -280,7 +275,7 @@ func (b *stsBuilder) getPodInitContainers() []corev1.Container {
 func (b *stsBuilder) getEtcdContainerVolumeMounts() []corev1.VolumeMount {
 	etcdVolumeMounts := make([]corev1.VolumeMount, 0, 7)
 	etcdVolumeMounts = append(etcdVolumeMounts, b.getEtcdDataVolumeMount())
-	etcdVolumeMounts = append(etcdVolumeMounts, getEtcdContainerSecretVolumeMounts(b.etcd)...)
+	etcdVolumeMounts = append(etcdVolumeMounts, GetEtcdContainerSecretVolumeMounts(b.etcd)...)
 	return etcdVolumeMounts
 }
 
@@ -289,11 +284,11 @@ func (b *stsBuilder) getBackupRestoreContainerVolumeMounts() []corev1.VolumeMoun
 	brVolumeMounts = append(brVolumeMounts,
 		b.getEtcdDataVolumeMount(),
 		corev1.VolumeMount{
-			Name:      common.VolumeNameEtcdConfig,
+			Name:      VolumeNameEtcdConfig,
 			MountPath: etcdConfigFileMountPath,
 		},
 	)
-	brVolumeMounts = append(brVolumeMounts, getBackupRestoreContainerSecretVolumeMounts(b.etcd)...)
+	brVolumeMounts = append(brVolumeMounts, GetBackupRestoreContainerSecretVolumeMounts(b.etcd)...)
 
 	if b.etcd.IsBackupStoreEnabled() {
 		etcdBackupVolumeMount := b.getEtcdBackupVolumeMount()
@@ -304,25 +299,26 @@ func (b *stsBuilder) getBackupRestoreContainerVolumeMounts() []corev1.VolumeMoun
 	return brVolumeMounts
 }
 
-func getBackupRestoreContainerSecretVolumeMounts(etcd *druidv1alpha1.Etcd) []corev1.VolumeMount {
+// GetBackupRestoreContainerSecretVolumeMounts returns the mounts of the secret volumes of the backup-restore container.
+func GetBackupRestoreContainerSecretVolumeMounts(etcd *druidv1alpha1.Etcd) []corev1.VolumeMount {
 	secretVolumeMounts := make([]corev1.VolumeMount, 0, 3)
-	if etcd.Spec.Backup.TLS != nil {
+	if etcd.GetBackupTLS() != nil {
 		secretVolumeMounts = append(secretVolumeMounts,
 			corev1.VolumeMount{
-				Name:      common.VolumeNameBackupRestoreServerTLS,
-				MountPath: common.VolumeMountPathBackupRestoreServerTLS,
+				Name:      VolumeNameBackupRestoreServerTLS,
+				MountPath: VolumeMountPathBackupRestoreServerTLS,
 			},
 		)
 	}
-	if etcd.Spec.Etcd.ClientUrlTLS != nil {
+	if etcd.GetClientURLTLS() != nil {
 		secretVolumeMounts = append(secretVolumeMounts,
 			corev1.VolumeMount{
-				Name:      common.VolumeNameEtcdCA,
-				MountPath: common.VolumeMountPathEtcdCA,
+				Name:      VolumeNameEtcdCA,
+				MountPath: VolumeMountPathEtcdCA,
 			},
 			corev1.VolumeMount{
-				Name:      common.VolumeNameEtcdClientTLS,
-				MountPath: common.VolumeMountPathEtcdClientTLS,
+				Name:      VolumeNameEtcdClientTLS,
+				MountPath: VolumeMountPathEtcdClientTLS,
 			},
 		)
 	}
@@ -332,23 +328,23 @@ func getBackupRestoreContainerSecretVolumeMounts(etcd *druidv1alpha1.Etcd) []cor
 
 func (b *stsBuilder) getEtcdBackupVolumeMount() *corev1.VolumeMount {
 	switch *b.provider {
-	case druidstore.Local:
+	case Local:
 		if b.etcd.Spec.Backup.Store.Container != nil {
 			return &corev1.VolumeMount{
-				Name:      common.VolumeNameLocalBackup,
-				MountPath: kubernetes.MountPathLocalStore(b.etcd, b.provider),
+				Name:      VolumeNameLocalBackup,
+				MountPath: MountPathLocalStore(b.etcd, b.provider),
 			}
 		}
-	case druidstore.GCS, druidstore.S3, druidstore.ABS, druidstore.OSS, druidstore.Swift, druidstore.OCS:
+	case GCS, S3, ABS, OSS, Swift, OCS:
 		if b.etcd.Spec.Backup.Store.SecretRef == nil {
 			return nil
 		}
-		mountPath := common.VolumeMountPathNonGCSProviderBackupSecret
-		if *b.provider == druidstore.GCS {
-			mountPath = common.VolumeMountPathGCSBackupSecret
+		mountPath := VolumeMountPathNonGCSProviderBackupSecret
+		if *b.provider == GCS {
+			mountPath = VolumeMountPathGCSBackupSecret
 		}
 		return &corev1.VolumeMount{
-			Name:      common.VolumeNameProviderBackupSecret,
+			Name:      VolumeNameProviderBackupSecret,
 			MountPath: mountPath,
 		}
 	}
@@ -359,14 +355,14 @@ func (b *stsBuilder) getEtcdDataVolumeMount() corev1.VolumeMount {
 	volumeClaimTemplateName := ptr.Deref(b.etcd.Spec.VolumeClaimTemplate, b.etcd.Name)
 	return corev1.VolumeMount{
 		Name:      volumeClaimTemplateName,
-		MountPath: common.VolumeMountPathEtcdData,
+		MountPath: VolumeMountPathEtcdData,
 	}
 }
 
 func (b *stsBuilder) getEtcdContainer() corev1.Container {
 	return corev1.Container{
-		Name:            common.ContainerNameEtcd,
-		Image:           b.etcdImage,
+		Name:            ContainerNameEtcd,
+		Image:           b.opts.EtcdImage,
 		ImagePullPolicy: corev1.PullIfNotPresent,
 		Args:            b.getEtcdContainerCommandArgs(),
 		ReadinessProbe:  b.getEtcdContainerReadinessProbe(),
@@ -392,19 +388,19 @@ func (b *stsBuilder) getEtcdContainer() corev1.Container {
 }
 
 func (b *stsBuilder) getBackupRestoreContainer() (corev1.Container, error) {
-	env, err := utils.GetBackupRestoreContainerEnvVars(b.etcd.Spec.Backup.Store)
+	env, err := GetBackupRestoreContainerEnvVars(b.etcd.Spec.Backup.Store)
 	if err != nil {
 		return corev1.Container{}, err
 	}
-	providerEnv, err := druidstore.GetProviderEnvVars(b.etcd.Spec.Backup.Store)
+	providerEnv, err := GetProviderEnvVars(b.etcd.Spec.Backup.Store)
 	if err != nil {
 		return corev1.Container{}, err
 	}
 	env = append(env, providerEnv...)
 
 	return corev1.Container{
-		Name:            common.ContainerNameEtcdBackupRestore,
-		Image:           b.etcdBackupRestoreImage,
+		Name:            ContainerNameEtcdBackupRestore,
+		Image:           b.opts.EtcdBackupRestoreImage,
 		ImagePullPolicy: corev1.PullIfNotPresent,
 		Args:            b.getBackupRestoreContainerCommandArgs(),
 		Ports: []corev1.ContainerPort{
@@ -460,11 +456,11 @@ func (b *stsBuilder) getBackupRestoreContainerCommandArgs() []string {
 
 	// Client and Backup TLS command line args
 	// -----------------------------------------------------------------------------------------------------------------
-	if b.etcd.Spec.Etcd.ClientUrlTLS != nil {
-		dataKey := ptr.Deref(b.etcd.Spec.Etcd.ClientUrlTLS.TLSCASecretRef.DataKey, "ca.crt")
-		commandArgs = append(commandArgs, fmt.Sprintf("--cacert=%s/%s", common.VolumeMountPathEtcdCA, dataKey))
-		commandArgs = append(commandArgs, fmt.Sprintf("--cert=%s/tls.crt", common.VolumeMountPathEtcdClientTLS))
-		commandArgs = append(commandArgs, fmt.Sprintf("--key=%s/tls.key", common.VolumeMountPathEtcdClientTLS))
+	if b.etcd.GetClientURLTLS() != nil {
+		dataKey := ptr.Deref(b.etcd.GetClientURLTLS().TLSCASecretRef.DataKey, "ca.crt")
+		commandArgs = append(commandArgs, fmt.Sprintf("--cacert=%s/%s", VolumeMountPathEtcdCA, dataKey))
+		commandArgs = append(commandArgs, fmt.Sprintf("--cert=%s/tls.crt", VolumeMountPathEtcdClientTLS))
+		commandArgs = append(commandArgs, fmt.Sprintf("--key=%s/tls.key", VolumeMountPathEtcdClientTLS))
 		commandArgs = append(commandArgs, "--insecure-transport=false")
 		commandArgs = append(commandArgs, "--insecure-skip-tls-verify=false")
 		commandArgs = append(commandArgs, fmt.Sprintf("--endpoints=https://%s-local:%d", b.etcd.Name, b.clientPort))
@@ -479,16 +475,16 @@ func (b *stsBuilder) getBackupRestoreContainerCommandArgs() []string {
 			commandArgs = append(commandArgs, fmt.Sprintf("--service-endpoints=%s", b.getServiceEndpoints("http")))
 		}
 	}
-	if b.etcd.Spec.Backup.TLS != nil {
-		commandArgs = append(commandArgs, fmt.Sprintf("--server-cert=%s/tls.crt", common.VolumeMountPathBackupRestoreServerTLS))
-		commandArgs = append(commandArgs, fmt.Sprintf("--server-key=%s/tls.key", common.VolumeMountPathBackupRestoreServerTLS))
+	if b.etcd.GetBackupTLS() != nil {
+		commandArgs = append(commandArgs, fmt.Sprintf("--server-cert=%s/tls.crt", VolumeMountPathBackupRestoreServerTLS))
+		commandArgs = append(commandArgs, fmt.Sprintf("--server-key=%s/tls.key", VolumeMountPathBackupRestoreServerTLS))
 	}
 
 	// Other misc command line args
 	// -----------------------------------------------------------------------------------------------------------------
-	commandArgs = append(commandArgs, fmt.Sprintf("--data-dir=%s/new.etcd", common.VolumeMountPathEtcdData))
-	commandArgs = append(commandArgs, fmt.Sprintf("--restoration-temp-snapshots-dir=%s/restoration.temp", common.VolumeMountPathEtcdData))
-	commandArgs = append(commandArgs, fmt.Sprintf("--snapstore-temp-directory=%s/temp", common.VolumeMountPathEtcdData))
+	commandArgs = append(commandArgs, fmt.Sprintf("--data-dir=%s/new.etcd", VolumeMountPathEtcdData))
+	commandArgs = append(commandArgs, fmt.Sprintf("--restoration-temp-snapshots-dir=%s/restoration.temp", VolumeMountPathEtcdData))
+	commandArgs = append(commandArgs, fmt.Sprintf("--snapstore-temp-directory=%s/temp", VolumeMountPathEtcdData))
 	commandArgs = append(commandArgs, fmt.Sprintf("--etcd-connection-timeout=%s", defaultEtcdConnectionTimeout))
 	commandArgs = append(commandArgs, "--use-etcd-wrapper=true")
 	if druidv1alpha1.IsEtcdRuntimeComponentCreationEnabled(b.etcd.ObjectMeta) {
@@ -610,7 +606,7 @@ func (b *stsBuilder) getEtcdContainerReadinessProbe() *corev1.Probe {
 }
 
 func (b *stsBuilder) getEtcdContainerReadinessHandler() corev1.ProbeHandler {
-	scheme := utils.IfConditionOr(b.etcd.Spec.Backup.TLS == nil, corev1.URISchemeHTTP, corev1.URISchemeHTTPS)
+	scheme := ifConditionOr(b.etcd.GetBackupTLS() == nil, corev1.URISchemeHTTP, corev1.URISchemeHTTPS)
 
 	return corev1.ProbeHandler{
 		HTTPGet: &corev1.HTTPGetAction{
@@ -626,16 +622,16 @@ func (b *stsBuilder) getEtcdContainerCommandArgs() []string {
 	commandArgs = append(commandArgs, fmt.Sprintf("--backup-restore-host-port=%s-local:%d", b.etcd.Name, b.backupPort))
 	commandArgs = append(commandArgs, fmt.Sprintf("--etcd-server-name=%s-local", b.etcd.Name))
 
-	if b.etcd.Spec.Backup.TLS == nil {
+	if b.etcd.GetBackupTLS() == nil {
 		commandArgs = append(commandArgs, "--backup-restore-tls-enabled=false")
 	} else {
 		commandArgs = append(commandArgs, "--backup-restore-tls-enabled=true")
-		dataKey := ptr.Deref(b.etcd.Spec.Backup.TLS.TLSCASecretRef.DataKey, "ca.crt")
-		commandArgs = append(commandArgs, fmt.Sprintf("--backup-restore-ca-cert-bundle-path=%s/%s", common.VolumeMountPathBackupRestoreCA, dataKey))
+		dataKey := ptr.Deref(b.etcd.GetBackupTLS().TLSCASecretRef.DataKey, "ca.crt")
+		commandArgs = append(commandArgs, fmt.Sprintf("--backup-restore-ca-cert-bundle-path=%s/%s", VolumeMountPathBackupRestoreCA, dataKey))
 	}
-	if b.etcd.Spec.Etcd.ClientUrlTLS != nil {
-		commandArgs = append(commandArgs, fmt.Sprintf("--etcd-client-cert-path=%s/tls.crt", common.VolumeMountPathEtcdClientTLS))
-		commandArgs = append(commandArgs, fmt.Sprintf("--etcd-client-key-path=%s/tls.key", common.VolumeMountPathEtcdClientTLS))
+	if b.etcd.GetClientURLTLS() != nil {
+		commandArgs = append(commandArgs, fmt.Sprintf("--etcd-client-cert-path=%s/tls.crt", VolumeMountPathEtcdClientTLS))
+		commandArgs = append(commandArgs, fmt.Sprintf("--etcd-client-key-path=%s/tls.key", VolumeMountPathEtcdClientTLS))
 	}
 	if port := b.clientPort; port != 0 {
 		commandArgs = append(commandArgs, fmt.Sprintf("--etcd-client-port=%d", port))
@@ -669,47 +665,49 @@ func (b *stsBuilder) getPodSecurityContext() *corev1.PodSecurityContext {
 	}
 }
 
-func getEtcdContainerSecretVolumeMounts(etcd *druidv1alpha1.Etcd) []corev1.VolumeMount {
+// GetEtcdContainerSecretVolumeMounts returns the mounts of the secret volumes of the etcd container.
+func GetEtcdContainerSecretVolumeMounts(etcd *druidv1alpha1.Etcd) []corev1.VolumeMount {
 	secretVolumeMounts := make([]corev1.VolumeMount, 0, 6)
-	if etcd.Spec.Etcd.ClientUrlTLS != nil {
+	if etcd.GetClientURLTLS() != nil {
 		secretVolumeMounts = append(secretVolumeMounts,
 			corev1.VolumeMount{
-				Name:      common.VolumeNameEtcdCA,
-				MountPath: common.VolumeMountPathEtcdCA,
+				Name:      VolumeNameEtcdCA,
+				MountPath: VolumeMountPathEtcdCA,
 			},
 			corev1.VolumeMount{
-				Name:      common.VolumeNameEtcdServerTLS,
-				MountPath: common.VolumeMountPathEtcdServerTLS,
+				Name:      VolumeNameEtcdServerTLS,
+				MountPath: VolumeMountPathEtcdServerTLS,
 			},
 			corev1.VolumeMount{
-				Name:      common.VolumeNameEtcdClientTLS,
-				MountPath: common.VolumeMountPathEtcdClientTLS,
+				Name:      VolumeNameEtcdClientTLS,
+				MountPath: VolumeMountPathEtcdClientTLS,
 			},
 		)
 	}
-	secretVolumeMounts = append(secretVolumeMounts, getEtcdContainerPeerVolumeMounts(etcd)...)
-	if etcd.Spec.Backup.TLS != nil {
+	secretVolumeMounts = append(secretVolumeMounts, GetEtcdContainerPeerVolumeMounts(etcd)...)
+	if etcd.GetBackupTLS() != nil {
 		secretVolumeMounts = append(secretVolumeMounts,
 			corev1.VolumeMount{
-				Name:      common.VolumeNameBackupRestoreCA,
-				MountPath: common.VolumeMountPathBackupRestoreCA,
+				Name:      VolumeNameBackupRestoreCA,
+				MountPath: VolumeMountPathBackupRestoreCA,
 			},
 		)
 	}
 	return secretVolumeMounts
 }
 
-func getEtcdContainerPeerVolumeMounts(etcd *druidv1alpha1.Etcd) []corev1.VolumeMount {
+// GetEtcdContainerPeerVolumeMounts returns the mounts of the peer TLS secret volumes of the etcd container.
+func GetEtcdContainerPeerVolumeMounts(etcd *druidv1alpha1.Etcd) []corev1.VolumeMount {
 	peerTLSVolMounts := make([]corev1.VolumeMount, 0, 2)
-	if etcd.Spec.Etcd.PeerUrlTLS != nil {
+	if etcd.GetPeerURLTLS() != nil {
 		peerTLSVolMounts = append(peerTLSVolMounts,
 			corev1.VolumeMount{
-				Name:      common.VolumeNameEtcdPeerCA,
-				MountPath: common.VolumeMountPathEtcdPeerCA,
+				Name:      VolumeNameEtcdPeerCA,
+				MountPath: VolumeMountPathEtcdPeerCA,
 			},
 			corev1.VolumeMount{
-				Name:      common.VolumeNameEtcdPeerServerTLS,
-				MountPath: common.VolumeMountPathEtcdPeerServerTLS,
+				Name:      VolumeNameEtcdPeerServerTLS,
+				MountPath: VolumeMountPathEtcdPeerServerTLS,
 			},
 		)
 	}
@@ -717,10 +715,10 @@ func getEtcdContainerPeerVolumeMounts(etcd *druidv1alpha1.Etcd) []corev1.VolumeM
 }
 
 // getPodVolumes gets volumes that needs to be mounted onto the etcd StatefulSet pods
-func (b *stsBuilder) getPodVolumes(ctx component.OperatorContext) ([]corev1.Volume, error) {
+func (b *stsBuilder) getPodVolumes() ([]corev1.Volume, error) {
 	volumes := []corev1.Volume{
 		{
-			Name: common.VolumeNameEtcdConfig,
+			Name: VolumeNameEtcdConfig,
 			VolumeSource: corev1.VolumeSource{
 				ConfigMap: &corev1.ConfigMapVolumeSource{
 					LocalObjectReference: corev1.LocalObjectReference{
@@ -728,30 +726,27 @@ func (b *stsBuilder) getPodVolumes(ctx component.OperatorContext) ([]corev1.Volu
 					},
 					Items: []corev1.KeyToPath{
 						{
-							Key:  common.EtcdConfigFileName, // etcd.conf.yaml key in the configmap, which contains the config for the etcd as yaml
-							Path: common.EtcdConfigFileName, // sub-path under the volume mount path, to store the contents of configmap key etcd.conf.yaml
+							Key:  EtcdConfigFileName, // etcd.conf.yaml key in the configmap, which contains the config for the etcd as yaml
+							Path: EtcdConfigFileName, // sub-path under the volume mount path, to store the contents of configmap key etcd.conf.yaml
 						},
 					},
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(ModeOwnerReadWriteGroupRead),
 				},
 			},
 		},
 	}
 
-	if b.etcd.Spec.Etcd.ClientUrlTLS != nil {
+	if b.etcd.GetClientURLTLS() != nil {
 		volumes = append(volumes, b.getClientTLSVolumes()...)
 	}
-	if b.etcd.Spec.Etcd.PeerUrlTLS != nil {
+	if b.etcd.GetPeerURLTLS() != nil {
 		volumes = append(volumes, b.getPeerTLSVolumes()...)
 	}
-	if b.etcd.Spec.Backup.TLS != nil {
+	if b.etcd.GetBackupTLS() != nil {
 		volumes = append(volumes, b.getBackupRestoreTLSVolumes()...)
 	}
 	if b.etcd.IsBackupStoreEnabled() {
-		backupVolume, err := b.getBackupVolume(ctx)
-		if err != nil {
-			return nil, err
-		}
+		backupVolume := b.getBackupVolume()
 		if backupVolume != nil {
 			volumes = append(volumes, *backupVolume)
 		}
@@ -760,32 +755,32 @@ func (b *stsBuilder) getPodVolumes(ctx component.OperatorContext) ([]corev1.Volu
 }
 
 func (b *stsBuilder) getClientTLSVolumes() []corev1.Volume {
-	clientTLSConfig := b.etcd.Spec.Etcd.ClientUrlTLS
+	clientTLSConfig := b.etcd.GetClientURLTLS()
 	return []corev1.Volume{
 		{
-			Name: common.VolumeNameEtcdCA,
+			Name: VolumeNameEtcdCA,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  clientTLSConfig.TLSCASecretRef.Name,
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(ModeOwnerReadWriteGroupRead),
 				},
 			},
 		},
 		{
-			Name: common.VolumeNameEtcdServerTLS,
+			Name: VolumeNameEtcdServerTLS,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  clientTLSConfig.ServerTLSSecretRef.Name,
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(ModeOwnerReadWriteGroupRead),
 				},
 			},
 		},
 		{
-			Name: common.VolumeNameEtcdClientTLS,
+			Name: VolumeNameEtcdClientTLS,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  clientTLSConfig.ClientTLSSecretRef.Name,
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(ModeOwnerReadWriteGroupRead),
 				},
 			},
 		},
@@ -793,23 +788,23 @@ func (b *stsBuilder) getClientTLSVolumes() []corev1.Volume {
 }
 
 func (b *stsBuilder) getPeerTLSVolumes() []corev1.Volume {
-	peerTLSConfig := b.etcd.Spec.Etcd.PeerUrlTLS
+	peerTLSConfig := b.etcd.GetPeerURLTLS()
 	return []corev1.Volume{
 		{
-			Name: common.VolumeNameEtcdPeerCA,
+			Name: VolumeNameEtcdPeerCA,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  peerTLSConfig.TLSCASecretRef.Name,
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(ModeOwnerReadWriteGroupRead),
 				},
 			},
 		},
 		{
-			Name: common.VolumeNameEtcdPeerServerTLS,
+			Name: VolumeNameEtcdPeerServerTLS,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  peerTLSConfig.ServerTLSSecretRef.Name,
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(ModeOwnerReadWriteGroupRead),
 				},
 			},
 		},
@@ -817,78 +812,73 @@ func (b *stsBuilder) getPeerTLSVolumes() []corev1.Volume {
 }
 
 func (b *stsBuilder) getBackupRestoreTLSVolumes() []corev1.Volume {
-	tlsConfig := b.etcd.Spec.Backup.TLS
+	tlsConfig := b.etcd.GetBackupTLS()
 	return []corev1.Volume{
 		{
-			Name: common.VolumeNameBackupRestoreCA,
+			Name: VolumeNameBackupRestoreCA,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  tlsConfig.TLSCASecretRef.Name,
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(ModeOwnerReadWriteGroupRead),
 				},
 			},
 		},
 		{
-			Name: common.VolumeNameBackupRestoreServerTLS,
+			Name: VolumeNameBackupRestoreServerTLS,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  tlsConfig.ServerTLSSecretRef.Name,
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(ModeOwnerReadWriteGroupRead),
 				},
 			},
 		},
 		{
-			Name: common.VolumeNameBackupRestoreClientTLS,
+			Name: VolumeNameBackupRestoreClientTLS,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  tlsConfig.ClientTLSSecretRef.Name,
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(ModeOwnerReadWriteGroupRead),
 				},
 			},
 		},
 	}
 }
 
-func (b *stsBuilder) getBackupVolume(ctx component.OperatorContext) (*corev1.Volume, error) {
+func (b *stsBuilder) getBackupVolume() *corev1.Volume {
 	if b.provider == nil {
-		return nil, nil
+		return nil
 	}
 	store := b.etcd.Spec.Backup.Store
 	switch *b.provider {
-	case druidstore.Local:
-		hostPath, err := druidstore.GetHostMountPathFromSecretRef(ctx, b.client, b.logger, store, b.etcd.GetNamespace())
-		if err != nil {
-			return nil, fmt.Errorf("error getting host mount path for etcd: %v Err: %w", druidv1alpha1.GetNamespaceName(b.etcd.ObjectMeta), err)
-		}
-
+	case Local:
 		hpt := corev1.HostPathDirectoryOrCreate
 		return &corev1.Volume{
-			Name: common.VolumeNameLocalBackup,
+			Name: VolumeNameLocalBackup,
 			VolumeSource: corev1.VolumeSource{
 				HostPath: &corev1.HostPathVolumeSource{
-					Path: hostPath + "/" + ptr.Deref(store.Container, ""),
+					Path: b.opts.LocalProviderHostPath + "/" + ptr.Deref(store.Container, ""),
 					Type: &hpt,
 				},
 			},
-		}, nil
-	case druidstore.GCS, druidstore.S3, druidstore.OSS, druidstore.ABS, druidstore.Swift, druidstore.OCS:
+		}
+	case GCS, S3, OSS, ABS, Swift, OCS:
 		if store.SecretRef == nil {
 			// SecretRef is optional when the pod has ambient credentials (e.g. GKE
 			// Workload Identity, EKS IRSA, AKS Workload Identity). In that case the
 			// cloud SDK's Application Default Credentials chain will pick up the
 			// pod-level identity without a mounted secret.
-			return nil, nil
+			return nil
 		}
 
 		return &corev1.Volume{
-			Name: common.VolumeNameProviderBackupSecret,
+			Name: VolumeNameProviderBackupSecret,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  store.SecretRef.Name,
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(ModeOwnerReadWriteGroupRead),
 				},
 			},
-		}, nil
+		}
 	}
-	return nil, nil
+	return nil
 }
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// LocalProviderDefaultMountPath is the default path where the buckets directory is mounted.
+	LocalProviderDefaultMountPath = "/etc/gardener/local-backupbuckets"
+	// EtcdBackupSecretHostPath is the hostPath field in the etcd-backup secret.
+	EtcdBackupSecretHostPath = "hostPath"
+)
+
+const (
+	aws       = "aws"
+	azure     = "azure"
+	gcp       = "gcp"
+	alicloud  = "alicloud"
+	openstack = "openstack"
+	dell      = "dell"
+	openshift = "openshift"
+	stackit   = "stackit"
+)
+
+const (
+	// S3 is a constant for the AWS and S3 compliant storage provider.
+	S3 = "S3"
+	// ABS is a constant for the Azure storage provider.
+	ABS = "ABS"
+	// GCS is a constant for the Google storage provider.
+	GCS = "GCS"
+	// OSS is a constant for the Alicloud storage provider.
+	OSS = "OSS"
+	// Swift is a constant for the OpenStack storage provider.
+	Swift = "Swift"
+	// Local is a constant for the Local storage provider.
+	Local = "Local"
+	// ECS is a constant for the EMC storage provider.
+	ECS = "ECS"
+	// OCS is a constant for the OpenShift storage provider.
+	OCS = "OCS"
+)
+
+// StorageProviderFromInfraProvider converts infra to object store provider.
+func StorageProviderFromInfraProvider(infra *druidv1alpha1.StorageProvider) (string, error) {
+	if infra == nil {
+		return "", nil
+	}
+
+	switch *infra {
+	case aws, S3, druidv1alpha1.StorageProvider(strings.ToLower(S3)):
+		return S3, nil
+	case azure, ABS, druidv1alpha1.StorageProvider(strings.ToLower(ABS)):
+		return ABS, nil
+	case gcp, GCS, druidv1alpha1.StorageProvider(strings.ToLower(GCS)):
+		return GCS, nil
+	case openstack, Swift, druidv1alpha1.StorageProvider(strings.ToLower(Swift)):
+		return Swift, nil
+	case alicloud, OSS, druidv1alpha1.StorageProvider(strings.ToLower(OSS)):
+		return OSS, nil
+	case dell, ECS, druidv1alpha1.StorageProvider(strings.ToLower(ECS)):
+		return ECS, nil
+	case openshift, OCS, druidv1alpha1.StorageProvider(strings.ToLower(OCS)):
+		return OCS, nil
+	case stackit: // S3-compatible providers
+		return S3, nil
+	case Local, druidv1alpha1.StorageProvider(strings.ToLower(Local)):
+		return Local, nil
+	default:
+		return "", fmt.Errorf("unsupported storage provider: '%v'", *infra)
+	}
+}
+
+// GetBackupStoreProvider returns the provider name for the backup store. If the provider is not known, an error is
+// returned.
+func GetBackupStoreProvider(etcd *druidv1alpha1.Etcd) (*string, error) {
+	if !etcd.IsBackupStoreEnabled() {
+		return nil, nil
+	}
+	provider, err := StorageProviderFromInfraProvider(etcd.Spec.Backup.Store.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return &provider, nil
+}
+
+// GetLocalProviderHostPath returns the hostPath configured in the given backup secret of a Local store. The default
+// hostPath is returned if no secret is given or the secret does not configure one.
+func GetLocalProviderHostPath(secret *corev1.Secret) string {
+	if secret == nil {
+		return LocalProviderDefaultMountPath
+	}
+	hostPath, ok := secret.Data[EtcdBackupSecretHostPath]
+	if !ok {
+		return LocalProviderDefaultMountPath
+	}
+	return string(hostPath)
+}
+
+// MountPathLocalStore returns the mount path for the local store if the provider matches.
+func MountPathLocalStore(etcd *druidv1alpha1.Etcd, provider *string) string {
+	if !etcd.IsBackupStoreEnabled() || provider == nil || *provider != Local {
+		return ""
+	}
+
+	homeDir := "/home/nonroot"
+	if ptr.Deref(etcd.Spec.RunAsRoot, false) {
+		homeDir = "/root"
+	}
+
+	path := ""
+	if container := etcd.Spec.Backup.Store.Container; container != nil {
+		path = "/" + *container
+	}
+
+	return homeDir + path
+}
+
+// GetProviderEnvVars returns provider-specific environment variables for the given store.
+func GetProviderEnvVars(store *druidv1alpha1.StoreSpec) ([]corev1.EnvVar, error) {
+	if store == nil {
+		return nil, nil
+	}
+
+	var envVars []corev1.EnvVar
+
+	provider, err := StorageProviderFromInfraProvider(store.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("storage provider is not recognized while fetching secrets from environment variable")
+	}
+
+	switch provider {
+	case S3:
+		envVars = append(envVars, getEnvVarFromValue(EnvAWSApplicationCredentials, VolumeMountPathNonGCSProviderBackupSecret))
+
+	case ABS:
+		envVars = append(envVars, getEnvVarFromValue(EnvAzureApplicationCredentials, VolumeMountPathNonGCSProviderBackupSecret))
+
+	case GCS:
+		envVars = append(envVars, getEnvVarFromValue(EnvGoogleApplicationCredentials, fmt.Sprintf("%sserviceaccount.json", VolumeMountPathGCSBackupSecret)))
+
+	case Swift:
+		envVars = append(envVars, getEnvVarFromValue(EnvOpenstackApplicationCredentials, VolumeMountPathNonGCSProviderBackupSecret))
+
+	case OSS:
+		envVars = append(envVars, getEnvVarFromValue(EnvAlicloudApplicationCredentials, VolumeMountPathNonGCSProviderBackupSecret))
+
+	case ECS:
+		if store.SecretRef == nil {
+			return nil, fmt.Errorf("no secretRef could be configured for backup store of ECS")
+		}
+		envVars = append(envVars, getEnvVarFromSecret(EnvECSEndpoint, store.SecretRef.Name, "endpoint", false))
+		envVars = append(envVars, getEnvVarFromSecret(EnvECSAccessKeyID, store.SecretRef.Name, "accessKeyID", false))
+		envVars = append(envVars, getEnvVarFromSecret(EnvECSSecretAccessKey, store.SecretRef.Name, "secretAccessKey", false))
+
+	case OCS:
+		envVars = append(envVars, getEnvVarFromValue(EnvOpenshiftApplicationCredentials, VolumeMountPathNonGCSProviderBackupSecret))
+	}
+
+	return envVars, nil
+}
+
+// GetBackupRestoreContainerEnvVars returns non-provider-specific environment variables for the backup-restore container.
+func GetBackupRestoreContainerEnvVars(store *druidv1alpha1.StoreSpec) ([]corev1.EnvVar, error) {
+	var envVars []corev1.EnvVar
+
+	envVars = append(envVars, getEnvVarFromFieldPath(EnvPodName, "metadata.name"))
+	envVars = append(envVars, getEnvVarFromFieldPath(EnvPodNamespace, "metadata.namespace"))
+
+	if store == nil {
+		return envVars, nil
+	}
+
+	storageContainer := ptr.Deref(store.Container, "")
+	envVars = append(envVars, getEnvVarFromValue(EnvStorageContainer, storageContainer))
+
+	return envVars, nil
+}
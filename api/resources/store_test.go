@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestMountPathLocalStore(t *testing.T) {
+	newEtcdWithStore := func(provider string, runAsRoot bool) *druidv1alpha1.Etcd {
+		etcd := newTestEtcd()
+		etcd.Spec.RunAsRoot = ptr.To(runAsRoot)
+		etcd.Spec.Backup.Store = &druidv1alpha1.StoreSpec{
+			Container: ptr.To("default.bkp"),
+			Prefix:    "etcd-test",
+			Provider:  ptr.To(druidv1alpha1.StorageProvider(provider)),
+		}
+		return etcd
+	}
+	testCases := []struct {
+		name           string
+		etcd           *druidv1alpha1.Etcd
+		provider       *string
+		expectedResult string
+	}{
+		{
+			name:           "should return empty string if backup store is not enabled",
+			etcd:           newTestEtcd(),
+			expectedResult: "",
+		},
+		{
+			name:           "should return empty string if provider is nil",
+			etcd:           newEtcdWithStore("local", false),
+			expectedResult: "",
+		},
+		{
+			name:           "should return empty string if provider is not 'Local'",
+			etcd:           newEtcdWithStore("aws", false),
+			provider:       ptr.To(S3),
+			expectedResult: "",
+		},
+		{
+			name:           "should return non-root homedir with path if container is set",
+			etcd:           newEtcdWithStore("local", false),
+			provider:       ptr.To(Local),
+			expectedResult: "/home/nonroot/default.bkp",
+		},
+		{
+			name:           "should return root homedir with path if container is set",
+			etcd:           newEtcdWithStore("local", true),
+			provider:       ptr.To(Local),
+			expectedResult: "/root/default.bkp",
+		},
+		{
+			name: "should return non-root homedir without path if container is nil",
+			etcd: func() *druidv1alpha1.Etcd {
+				etcd := newEtcdWithStore("local", false)
+				etcd.Spec.Backup.Store.Container = nil
+				return etcd
+			}(),
+			provider:       ptr.To(Local),
+			expectedResult: "/home/nonroot",
+		},
+		{
+			name: "should return root homedir without path if container is nil",
+			etcd: func() *druidv1alpha1.Etcd {
+				etcd := newEtcdWithStore("local", true)
+				etcd.Spec.Backup.Store.Container = nil
+				return etcd
+			}(),
+			provider:       ptr.To(Local),
+			expectedResult: "/root",
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g.Expect(MountPathLocalStore(tc.etcd, tc.provider)).To(Equal(tc.expectedResult))
+		})
+	}
+}
+
+func TestGetLocalProviderHostPath(t *testing.T) {
+	testCases := []struct {
+		name             string
+		secret           *corev1.Secret
+		expectedHostPath string
+	}{
+		{
+			name:             "no secret is given, should return default host path",
+			expectedHostPath: LocalProviderDefaultMountPath,
+		},
+		{
+			name:             "secret does not configure a host path, should return default host path",
+			secret:           &corev1.Secret{Data: map[string][]byte{"bucketName": []byte("test-bucket")}},
+			expectedHostPath: LocalProviderDefaultMountPath,
+		},
+		{
+			name:             "secret configures a host path, should return configured host path",
+			secret:           &corev1.Secret{Data: map[string][]byte{EtcdBackupSecretHostPath: []byte("/var/data/etcd-backup")}},
+			expectedHostPath: "/var/data/etcd-backup",
+		},
+	}
+
+	g := NewWithT(t)
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g.Expect(GetLocalProviderHostPath(tc.secret)).To(Equal(tc.expectedHostPath))
+		})
+	}
+}
+
+func newTestEtcd() *druidv1alpha1.Etcd {
+	return &druidv1alpha1.Etcd{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testEtcdName,
+			Namespace: testNamespace,
+			UID:       "a9b8c7d6-e5f4-4321-b0a9-876543210fed",
+		},
+		Spec: druidv1alpha1.EtcdSpec{
+			Replicas: 3,
+		},
+	}
+}
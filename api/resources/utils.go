@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+)
+
+// mergeMaps merges the contents of maps. All maps will be processed in the order in which they are sent. For
+// overlapping keys across source maps, value in the merged map for this key will be from the last occurrence of the
+// key-value.
+func mergeMaps[K comparable, V any](sourceMaps ...map[K]V) map[K]V {
+	if sourceMaps == nil {
+		return nil
+	}
+	merged := make(map[K]V)
+	for _, m := range sourceMaps {
+		maps.Copy(merged, m)
+	}
+	return merged
+}
+
+// doesLabelSelectorMatchLabels checks if the given label selector matches the given labels.
+func doesLabelSelectorMatchLabels(labelSelector *metav1.LabelSelector, resourceLabels map[string]string) (bool, error) {
+	if labelSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(resourceLabels)), nil
+}
+
+func getEnvVarFromValue(name, value string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name:  name,
+		Value: value,
+	}
+}
+
+func getEnvVarFromFieldPath(name, fieldPath string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{
+				FieldPath: fieldPath,
+			},
+		},
+	}
+}
+
+func getEnvVarFromSecret(name, secretName, secretKey string, optional bool) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secretName,
+				},
+				Key:      secretKey,
+				Optional: ptr.To(optional),
+			},
+		},
+	}
+}
+
+// ifConditionOr implements a simple ternary operator, if the passed condition is true then trueVal is returned else falseVal is returned.
+func ifConditionOr[T any](condition bool, trueVal, falseVal T) T {
+	if condition {
+		return trueVal
+	}
+	return falseVal
+}
@@ -42,6 +42,13 @@ config.yaml: |
       etcdMember:
         notReadyThreshold: {{ .Values.operatorConfig.controllers.etcd.etcdMember.notReadyThreshold }}
         unknownThreshold: {{ .Values.operatorConfig.controllers.etcd.etcdMember.unknownThreshold }}
+      {{- if .Values.operatorConfig.controllers.etcd.healthChecks }}
+      healthChecks:
+        {{- toYaml .Values.operatorConfig.controllers.etcd.healthChecks | nindent 8 }}
+      {{- end }}
+      {{- if hasKey .Values.operatorConfig.controllers.etcd "operationHistoryLimit" }}
+      operationHistoryLimit: {{ .Values.operatorConfig.controllers.etcd.operationHistoryLimit }}
+      {{- end }}
     compaction:
       enabled: {{ .Values.operatorConfig.controllers.compaction.enabled }}
       concurrentSyncs: {{ .Values.operatorConfig.controllers.compaction.concurrentSyncs }}
@@ -49,6 +56,19 @@ config.yaml: |
       triggerFullSnapshotThreshold: {{ .Values.operatorConfig.controllers.compaction.triggerFullSnapshotThreshold }}
       activeDeadlineDuration: {{ .Values.operatorConfig.controllers.compaction.activeDeadlineDuration }}
       metricsScrapeWaitDuration: {{ .Values.operatorConfig.controllers.compaction.metricsScrapeWaitDuration }}
+      {{- if hasKey .Values.operatorConfig.controllers.compaction "maxConcurrentJobs" }}
+      maxConcurrentJobs: {{ .Values.operatorConfig.controllers.compaction.maxConcurrentJobs }}
+      {{- end }}
+      {{- if hasKey .Values.operatorConfig.controllers.compaction "historyLimit" }}
+      historyLimit: {{ .Values.operatorConfig.controllers.compaction.historyLimit }}
+      {{- end }}
+      {{- if hasKey .Values.operatorConfig.controllers.compaction "failedJobRetentionDuration" }}
+      failedJobRetentionDuration: {{ .Values.operatorConfig.controllers.compaction.failedJobRetentionDuration }}
+      {{- end }}
+      {{- if .Values.operatorConfig.controllers.compaction.adaptiveResources }}
+      adaptiveResources:
+        {{- toYaml .Values.operatorConfig.controllers.compaction.adaptiveResources | nindent 8 }}
+      {{- end }}
     etcdCopyBackupsTask:
       enabled: {{ .Values.operatorConfig.controllers.etcdCopyBackupsTask.enabled }}
       concurrentSyncs: {{ .Values.operatorConfig.controllers.etcdCopyBackupsTask.concurrentSyncs }}
@@ -72,6 +92,10 @@ config.yaml: |
   logConfiguration:
     logLevel: {{ .Values.operatorConfig.logConfiguration.logLevel }}
     logFormat: {{ .Values.operatorConfig.logConfiguration.logFormat }}
+{{- with .Values.operatorConfig.tracing }}
+  tracing:
+  {{- toYaml . | nindent 4 }}
+{{- end }}
 {{- end -}}
 
 {{- define "operator.config.name" -}}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/snapstore"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// validateEtcdSelection validates that exactly one etcd resource has been selected.
+func (o *backupOptions) validateEtcdSelection() error {
+	if err := o.ValidateResourceSelection(); err != nil {
+		return err
+	}
+	if o.AllNamespaces {
+		return fmt.Errorf("backup commands operate on a single etcd resource and cannot be used with --all-namespaces/-A")
+	}
+	if len(o.ResourceArgs) != 1 {
+		return fmt.Errorf("exactly one etcd resource must be specified")
+	}
+	return nil
+}
+
+// complete creates the clients and the printer, and resolves the selected etcd resource.
+func (r *backupRuntime) complete(opts *backupOptions) error {
+	etcdClient, err := r.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	r.etcdClient = etcdClient
+
+	genericClient, err := r.Clients.GenericClient()
+	if err != nil {
+		return fmt.Errorf("unable to create generic kube clients: %w", err)
+	}
+	r.genericClient = genericClient
+
+	r.Printer, err = opts.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("failed to create formatter: %w", err)
+	}
+
+	r.etcdRef = opts.BuildEtcdRefList()[0]
+	return nil
+}
+
+// openBackupStore resolves the backup store of the selected Etcd from its StoreSpec and credentials secret.
+func (r *backupRuntime) openBackupStore(ctx context.Context, localPath string) (snapstore.SnapStore, BackupStore, error) {
+	etcd, err := r.etcdClient.GetEtcd(ctx, r.etcdRef.Namespace, r.etcdRef.Name)
+	if err != nil {
+		return nil, BackupStore{}, fmt.Errorf("etcd %q not found in namespace %q: %w", r.etcdRef.Name, r.etcdRef.Namespace, err)
+	}
+	store := etcd.Spec.Backup.Store
+	if store == nil {
+		return nil, BackupStore{}, fmt.Errorf("etcd %s has no backup store configured", r.etcdRef)
+	}
+
+	provider, err := druidresources.StorageProviderFromInfraProvider(store.Provider)
+	if err != nil {
+		return nil, BackupStore{}, err
+	}
+
+	backupStore := BackupStore{
+		Etcd:      cmdutils.EtcdRef{Name: etcd.Name, Namespace: etcd.Namespace},
+		Provider:  provider,
+		Container: ptr.Deref(store.Container, ""),
+		Prefix:    store.Prefix,
+	}
+	if store.SecretRef != nil {
+		backupStore.SecretName = store.SecretRef.Name
+	}
+	secret, err := r.getBackupSecret(ctx, etcd)
+	if err != nil {
+		return nil, backupStore, err
+	}
+
+	config := snapstore.Config{
+		Provider:         backupStore.Provider,
+		Container:        backupStore.Container,
+		Prefix:           backupStore.Prefix,
+		EndpointOverride: ptr.Deref(store.EndpointOverride, ""),
+	}
+	if backupStore.Provider == druidresources.Local {
+		if localPath == "" {
+			localPath = druidresources.GetLocalProviderHostPath(secret)
+		}
+		backupStore.Location = filepath.Join(localPath, backupStore.Container, backupStore.Prefix)
+		config.Location = backupStore.Location
+	} else {
+		if secret == nil {
+			return nil, backupStore, fmt.Errorf("etcd %s has no backup secret configured, which holds the credentials of the %s provider", r.etcdRef, backupStore.Provider)
+		}
+		backupStore.Location = fmt.Sprintf("%s/%s", backupStore.Container, backupStore.Prefix)
+		config.Credentials = secret.Data
+	}
+
+	snapStore, err := snapstore.New(ctx, config)
+	if err != nil {
+		return nil, backupStore, err
+	}
+	return snapStore, backupStore, nil
+}
+
+// getBackupSecret returns the backup secret of the given Etcd, which holds the credentials of the backup store, or the
+// hostPath for the Local provider. It returns nil if the Etcd does not reference a backup secret.
+func (r *backupRuntime) getBackupSecret(ctx context.Context, etcd *druidv1alpha1.Etcd) (*corev1.Secret, error) {
+	secretRef := etcd.Spec.Backup.Store.SecretRef
+	if secretRef == nil {
+		return nil, nil
+	}
+	secret, err := r.genericClient.Kube().CoreV1().Secrets(etcd.Namespace).Get(ctx, secretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("backup secret %s/%s not found", etcd.Namespace, secretRef.Name)
+		}
+		return nil, fmt.Errorf("failed to get backup secret %s/%s: %w", etcd.Namespace, secretRef.Name, err)
+	}
+	return secret, nil
+}
+
+// listSnapshots lists the snapshots in the backup store of the selected Etcd.
+func (r *backupRuntime) listSnapshots(ctx context.Context, localPath string) ([]snapstore.Snapshot, BackupStore, error) {
+	snapStore, backupStore, err := r.openBackupStore(ctx, localPath)
+	if err != nil {
+		return nil, backupStore, err
+	}
+	snapshots, err := snapStore.List(ctx)
+	if err != nil {
+		return nil, backupStore, err
+	}
+	return snapshots, backupStore, nil
+}
+
+// print prints the given result with the configured printer.
+func (r *backupRuntime) print(result any) error {
+	outputData, err := r.Printer.Print(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result to desired format: %w", err)
+	}
+	fmt.Fprintf(r.IOStreams.Out, "%s\n", string(outputData))
+	return nil
+}
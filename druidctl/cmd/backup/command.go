@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	listExample = `
+# List the snapshots in the backup store of an etcd resource in the default namespace
+kubectl druid backup list my-etcd
+
+# List the snapshots of an etcd resource with their location, sorted by size
+kubectl druid backup list test/my-etcd -o wide --sort-by=.size
+
+# List the snapshots of an etcd resource using the Local provider, whose backup buckets are mounted at a custom path
+kubectl druid backup list test/my-etcd --local-path /mnt/local-backupbuckets
+`
+	inspectExample = `
+# Show a summary of the backup store of an etcd resource
+kubectl druid backup inspect test/my-etcd
+
+# Show a summary of the backup store of an etcd resource as JSON
+kubectl druid backup inspect test/my-etcd -o json
+`
+	downloadExample = `
+# Download the latest full snapshot of an etcd resource to the current directory
+kubectl druid backup download test/my-etcd
+
+# Download a specific snapshot of an etcd resource to a directory
+kubectl druid backup download test/my-etcd --snapshot Incr-00000043-00000050-1700000060.gz --output-dir /tmp/snapshots
+`
+)
+
+// NewBackupCommand creates the 'backup' command with nested subcommands
+// Structure:
+//   - `kubectl druid backup list <etcd-resource>` - list the snapshots in the backup store
+//   - `kubectl druid backup inspect <etcd-resource>` - summarize the state of the backup store
+//   - `kubectl druid backup download <etcd-resource>` - download a snapshot
+func NewBackupCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	backupCmd := &cobra.Command{
+		Use:   "backup",
+		Short: "List, inspect and download the snapshots of etcd clusters",
+		Long: `List, inspect and download the snapshots of etcd clusters.
+The backup store is resolved from the StoreSpec of the etcd resource. The snapshots are read directly from the backup
+store, so these commands also work while the etcd cluster itself is down.
+All storage providers of etcd-backup-restore are supported: S3, GCS, ABS, Swift, OSS, ECS, OCS and Local. The
+credentials of the object stores are read from the backup secret of the etcd resource, in the format in which
+etcd-backup-restore reads them. The directory of a Local backup store is taken from the hostPath in the backup secret
+and has to be accessible by druidctl, e.g. via --local-path.`,
+	}
+
+	backupCmd.AddCommand(NewListCommand(cmdCtx))
+	backupCmd.AddCommand(NewInspectCommand(cmdCtx))
+	backupCmd.AddCommand(NewDownloadCommand(cmdCtx))
+
+	return backupCmd
+}
+
+// NewListCommand creates the 'backup list' subcommand
+func NewListCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newBackupOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:     "list <etcd-resource-name> [flags]",
+		Aliases: []string{"ls"},
+		Short:   "List the full and delta snapshots of an etcd cluster",
+		Long:    "List the full and delta snapshots in the backup store of an etcd cluster with their revisions and sizes.",
+		Example: listExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			listCmdCtx := &listCmdCtx{
+				backupOptions: opts,
+				backupRuntime: newBackupRuntime(cmdCtx.Runtime),
+			}
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, listCmdCtx, "Listing snapshots failed")
+		},
+	}
+
+	opts.addFlags(cmd)
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+// NewInspectCommand creates the 'backup inspect' subcommand
+func NewInspectCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newBackupOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:   "inspect <etcd-resource-name> [flags]",
+		Short: "Summarize the backup store of an etcd cluster",
+		Long: `Summarize the backup store of an etcd cluster: the number and total size of the snapshots, the latest full snapshot,
+the delta snapshots taken since then, the latest revision which can be restored and gaps in the revisions of the delta snapshots.`,
+		Example: inspectExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			inspectCmdCtx := &inspectCmdCtx{
+				backupOptions: opts,
+				backupRuntime: newBackupRuntime(cmdCtx.Runtime),
+			}
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, inspectCmdCtx, "Inspecting the backup store failed")
+		},
+	}
+
+	opts.addFlags(cmd)
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+// NewDownloadCommand creates the 'backup download' subcommand
+func NewDownloadCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newBackupOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:     "download <etcd-resource-name> [flags]",
+		Short:   "Download a snapshot of an etcd cluster",
+		Long:    "Download a snapshot from the backup store of an etcd cluster. By default the latest full snapshot is downloaded.",
+		Example: downloadExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			downloadCmdCtx := &downloadCmdCtx{
+				backupOptions: opts,
+				backupRuntime: newBackupRuntime(cmdCtx.Runtime),
+			}
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, downloadCmdCtx, "Downloading snapshot failed")
+		},
+	}
+
+	opts.addFlags(cmd)
+	cmd.Flags().StringVar(&opts.snapshotName, "snapshot", "", "Name of the snapshot to download. Defaults to the latest full snapshot")
+	cmd.Flags().StringVar(&opts.outputDir, "output-dir", ".", "Directory to download the snapshot to")
+
+	return cmd
+}
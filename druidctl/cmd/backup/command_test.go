@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fake "github.com/gardener/etcd-druid/druidctl/internal/client/fake"
+
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+var testSnapshots = map[string]string{
+	"Full-00000000-00000010-1700000000":       "full-1",
+	"Incr-00000011-00000020-1700000010.gz":    "delta-1",
+	"Full-00000000-00000020-1700000020":       "full-2",
+	"Incr-00000021-00000030-1700000030.gz":    "delta-2",
+	"Incr-00000031-00000040-1700000040.gz":    "delta-3",
+	"Incr-00000046-00000050-1700000050.gz":    "delta-4",
+	"Incr-00000051-00000060-1700000060-final": "delta-5",
+}
+
+// newTestBackupStore writes the test snapshots into a bucket of the Local provider and returns a test helper with the
+// objects describing it.
+func newTestBackupStore(t *testing.T) *fake.TestHelper {
+	t.Helper()
+	hostPath := t.TempDir()
+	snapshotDir := filepath.Join(hostPath, "test-container", "test-prefix", "v2")
+	if err := os.MkdirAll(snapshotDir, 0o755); err != nil {
+		t.Fatalf("Failed to create snapshot directory: %v", err)
+	}
+	for name, content := range testSnapshots {
+		if err := os.WriteFile(filepath.Join(snapshotDir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("Failed to write snapshot %s: %v", name, err)
+		}
+	}
+
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").WithBackupStore("local").Build()
+	etcd.Spec.Backup.Store.Container = ptr.To("test-container")
+	etcd.Spec.Backup.Store.Prefix = "test-prefix"
+	etcd.Spec.Backup.Store.SecretRef = &corev1.SecretReference{Name: "test-backup"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-backup", Namespace: "default"},
+		Data:       map[string][]byte{druidresources.EtcdBackupSecretHostPath: []byte(hostPath)},
+	}
+	return fake.NewTestHelper().WithEtcdObjects([]runtime.Object{etcd}).WithK8sObjects([]runtime.Object{secret})
+}
+
+func TestListCommand(t *testing.T) {
+	helper := newTestBackupStore(t)
+
+	_, buf, err := helper.RunCommand(t, NewListCommand, []string{"test-etcd"}, map[string]string{"output": "json"})
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	var result ListResult
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, buf.String())
+	}
+	if result.Store.Provider != "Local" || !strings.HasSuffix(result.Store.Location, filepath.Join("test-container", "test-prefix")) {
+		t.Errorf("Unexpected backup store: %+v", result.Store)
+	}
+	if len(result.Snapshots) != len(testSnapshots) {
+		t.Fatalf("Expected %d snapshots, got %d", len(testSnapshots), len(result.Snapshots))
+	}
+	if first, last := result.Snapshots[0], result.Snapshots[len(result.Snapshots)-1]; first.LastRevision != 10 || last.LastRevision != 60 || !last.IsFinal {
+		t.Errorf("Expected snapshots sorted by revision, got first %+v and last %+v", first, last)
+	}
+
+	_, buf, err = helper.RunCommand(t, NewListCommand, []string{"test-etcd"}, nil)
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != len(testSnapshots)+1 || !strings.HasPrefix(lines[0], "NAME") || !strings.Contains(lines[3], "Delta") {
+		t.Errorf("Expected a table with all snapshots, got:\n%s", buf.String())
+	}
+}
+
+func TestListCommandErrors(t *testing.T) {
+	awsEtcd := fake.NewEtcdBuilder("default", "aws-etcd").WithBackupStore("aws").Build()
+	awsEtcd.Spec.Backup.Store.SecretRef = &corev1.SecretReference{Name: "aws-backup"}
+	helper := newTestBackupStore(t).WithEtcdObjects([]runtime.Object{
+		fake.NewEtcdBuilder("default", "no-store").Build(),
+		fake.NewEtcdBuilder("default", "unknown-provider").WithBackupStore("unknown").Build(),
+		fake.NewEtcdBuilder("default", "no-secret").WithBackupStore("gcp").Build(),
+		awsEtcd,
+	}).WithK8sObjects([]runtime.Object{&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-backup", Namespace: "default"},
+		Data:       map[string][]byte{"accessKeyID": []byte("test-access-key-id"), "secretAccessKey": []byte("test-secret-access-key")},
+	}})
+
+	tests := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{"unknown etcd", []string{"unknown"}, "not found"},
+		{"no backup store", []string{"no-store"}, "no backup store configured"},
+		{"unsupported provider", []string{"unknown-provider"}, "unsupported storage provider: 'unknown'"},
+		{"no backup secret", []string{"no-secret"}, "no backup secret configured, which holds the credentials of the GCS provider"},
+		{"incomplete credentials", []string{"aws-etcd"}, `the backup secret does not contain the key "region"`},
+		{"multiple etcds", []string{"test-etcd", "no-store"}, "exactly one etcd resource"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := helper.RunCommand(t, NewListCommand, tt.args, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+				t.Errorf("Expected error containing %q, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestInspectCommand(t *testing.T) {
+	helper := newTestBackupStore(t)
+
+	_, buf, err := helper.RunCommand(t, NewInspectCommand, []string{"test-etcd"}, map[string]string{"output": "json"})
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	var result InspectResult
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, buf.String())
+	}
+	if result.FullSnapshotCount != 2 || result.DeltaSnapshotCount != 5 {
+		t.Errorf("Expected 2 full and 5 delta snapshots, got %d and %d", result.FullSnapshotCount, result.DeltaSnapshotCount)
+	}
+	if result.LatestFullSnapshot == nil || result.LatestFullSnapshot.Name != "Full-00000000-00000020-1700000020" {
+		t.Errorf("Unexpected latest full snapshot: %+v", result.LatestFullSnapshot)
+	}
+	if result.DeltaSnapshotsSinceLatestFull != 4 {
+		t.Errorf("Expected 4 delta snapshots since the latest full snapshot, got %d", result.DeltaSnapshotsSinceLatestFull)
+	}
+	if result.RestorableRevision != 40 {
+		t.Errorf("Expected restorable revision 40, got %d", result.RestorableRevision)
+	}
+	if len(result.Gaps) != 1 || result.Gaps[0] != (RevisionGap{From: 41, To: 45}) {
+		t.Errorf("Expected a single gap 41-45, got %+v", result.Gaps)
+	}
+
+	_, buf, err = helper.RunCommand(t, NewInspectCommand, []string{"test-etcd"}, nil)
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	for _, expected := range []string{"Backup store of Etcd [default/test-etcd]", "2 full, 5 delta", "Restorable Revision:    40", "41-45"} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, buf.String())
+		}
+	}
+}
+
+func TestDownloadCommand(t *testing.T) {
+	helper := newTestBackupStore(t)
+
+	tests := []struct {
+		name             string
+		snapshot         string
+		expectedFile     string
+		expectedContent  string
+		expectedErrorMsg string
+	}{
+		{"latest full snapshot", "", "Full-00000000-00000020-1700000020", "full-2", ""},
+		{"named snapshot", "Incr-00000021-00000030-1700000030.gz", "Incr-00000021-00000030-1700000030.gz", "delta-2", ""},
+		{"unknown snapshot", "Full-00000000-00000099-1700000099", "", "", "not found"},
+		{"snapshot path", "../Full-00000000-00000020-1700000020", "", "", "not a path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputDir := t.TempDir()
+			_, _, err := helper.RunCommand(t, NewDownloadCommand, []string{"test-etcd"},
+				map[string]string{"snapshot": tt.snapshot, "output-dir": outputDir})
+			if tt.expectedErrorMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErrorMsg) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Command failed: %v", err)
+			}
+			content, err := os.ReadFile(filepath.Join(outputDir, tt.expectedFile))
+			if err != nil {
+				t.Fatalf("Failed to read downloaded snapshot: %v", err)
+			}
+			if string(content) != tt.expectedContent {
+				t.Errorf("Expected content %q, got %q", tt.expectedContent, string(content))
+			}
+		})
+	}
+}
+
+func TestDownloadCommandDoesNotOverwrite(t *testing.T) {
+	helper := newTestBackupStore(t)
+	outputDir := t.TempDir()
+	existing := filepath.Join(outputDir, "Full-00000000-00000020-1700000020")
+	if err := os.WriteFile(existing, []byte("existing"), 0o600); err != nil {
+		t.Fatalf("Failed to write existing file: %v", err)
+	}
+
+	_, _, err := helper.RunCommand(t, NewDownloadCommand, []string{"test-etcd"}, map[string]string{"output-dir": outputDir})
+	if err == nil {
+		t.Fatal("Expected download to fail because the file exists")
+	}
+	if content, _ := os.ReadFile(existing); string(content) != "existing" {
+		t.Errorf("Expected existing file to be left untouched, got %q", string(content))
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/snapstore"
+)
+
+func (d *downloadCmdCtx) Validate() error {
+	if err := d.validateEtcdSelection(); err != nil {
+		return err
+	}
+	if d.snapshotName != "" && filepath.Base(d.snapshotName) != d.snapshotName {
+		return fmt.Errorf("--snapshot must be the name of a snapshot, not a path")
+	}
+	return nil
+}
+
+func (d *downloadCmdCtx) Complete() error {
+	return d.backupRuntime.complete(d.backupOptions)
+}
+
+// Execute downloads the chosen snapshot of the selected Etcd into the output directory.
+func (d *downloadCmdCtx) Execute(ctx context.Context) error {
+	snapStore, backupStore, err := d.openBackupStore(ctx, d.localPath)
+	if err != nil {
+		return err
+	}
+	snapshots, err := snapStore.List(ctx)
+	if err != nil {
+		return err
+	}
+	snapshot, err := selectSnapshot(snapshots, d.snapshotName)
+	if err != nil {
+		return fmt.Errorf("%w in %s", err, backupStore.Location)
+	}
+
+	targetPath := filepath.Join(d.outputDir, snapshot.Name)
+	written, err := downloadSnapshot(ctx, snapStore, *snapshot, targetPath)
+	if err != nil {
+		return err
+	}
+	d.Logger.Success(d.IOStreams.Out, fmt.Sprintf("Downloaded snapshot %s (revisions %d-%d, %s) to %s",
+		snapshot.Name, snapshot.StartRevision, snapshot.LastRevision, cmdutils.FormatSize(written), targetPath))
+	return nil
+}
+
+// selectSnapshot returns the snapshot with the given name, or the latest full snapshot if no name is given.
+func selectSnapshot(snapshots []snapstore.Snapshot, name string) (*snapstore.Snapshot, error) {
+	var selected *snapstore.Snapshot
+	for idx := range snapshots {
+		snapshot := &snapshots[idx]
+		if name == "" && snapshot.Kind == snapstore.SnapshotKindFull || snapshot.Name == name {
+			selected = snapshot
+		}
+	}
+	if selected == nil {
+		if name == "" {
+			return nil, errors.New("no full snapshot found")
+		}
+		return nil, fmt.Errorf("snapshot %q not found", name)
+	}
+	return selected, nil
+}
+
+// downloadSnapshot copies the content of the snapshot into a new file at targetPath. An existing file is never
+// overwritten, and the file is removed again if the download fails.
+func downloadSnapshot(ctx context.Context, snapStore snapstore.SnapStore, snapshot snapstore.Snapshot, targetPath string) (int64, error) {
+	reader, err := snapStore.Open(ctx, snapshot)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open snapshot %s: %w", snapshot.Name, err)
+	}
+	defer reader.Close()
+
+	file, err := os.OpenFile(targetPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create %s: %w", targetPath, err)
+	}
+	written, err := io.Copy(file, reader)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(targetPath)
+		return 0, fmt.Errorf("failed to download snapshot %s: %w", snapshot.Name, err)
+	}
+	return written, nil
+}
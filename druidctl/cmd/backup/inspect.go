@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"context"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/snapstore"
+)
+
+func (i *inspectCmdCtx) Validate() error {
+	return i.validateEtcdSelection()
+}
+
+func (i *inspectCmdCtx) Complete() error {
+	return i.backupRuntime.complete(i.backupOptions)
+}
+
+// Execute summarizes the backup store of the selected Etcd.
+func (i *inspectCmdCtx) Execute(ctx context.Context) error {
+	snapshots, backupStore, err := i.listSnapshots(ctx, i.localPath)
+	if err != nil {
+		return err
+	}
+	result := inspectSnapshots(snapshots)
+	result.Store = backupStore
+	if i.PrintFlags.IsTableOutput() {
+		i.renderInspectResult(result)
+		return nil
+	}
+	return i.print(result)
+}
+
+// inspectSnapshots summarizes the given snapshots, which must be sorted by their last revision.
+func inspectSnapshots(snapshots []snapstore.Snapshot) InspectResult {
+	result := InspectResult{Kind: "BackupInspection"}
+	for idx := range snapshots {
+		snapshot := &snapshots[idx]
+		result.TotalSize += snapshot.Size
+		if snapshot.Kind == snapstore.SnapshotKindFull {
+			result.FullSnapshotCount++
+			result.LatestFullSnapshot = snapshot
+		} else {
+			result.DeltaSnapshotCount++
+		}
+		result.LatestSnapshot = snapshot
+	}
+	if result.LatestFullSnapshot == nil {
+		return result
+	}
+
+	// Follow the chain of delta snapshots after the latest full snapshot. A restoration applies all of them, so
+	// the restorable revision is the last revision before the first gap.
+	result.RestorableRevision = result.LatestFullSnapshot.LastRevision
+	lastRevision := result.LatestFullSnapshot.LastRevision
+	for _, snapshot := range snapshots {
+		if snapshot.Kind != snapstore.SnapshotKindDelta || snapshot.LastRevision <= result.LatestFullSnapshot.LastRevision {
+			continue
+		}
+		result.DeltaSnapshotsSinceLatestFull++
+		if snapshot.StartRevision > lastRevision+1 {
+			result.Gaps = append(result.Gaps, RevisionGap{From: lastRevision + 1, To: snapshot.StartRevision - 1})
+		}
+		if len(result.Gaps) == 0 {
+			result.RestorableRevision = snapshot.LastRevision
+		}
+		lastRevision = max(lastRevision, snapshot.LastRevision)
+	}
+	return result
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"context"
+)
+
+func (l *listCmdCtx) Validate() error {
+	return l.validateEtcdSelection()
+}
+
+func (l *listCmdCtx) Complete() error {
+	return l.backupRuntime.complete(l.backupOptions)
+}
+
+// Execute lists the full and delta snapshots in the backup store of the selected Etcd.
+func (l *listCmdCtx) Execute(ctx context.Context) error {
+	snapshots, backupStore, err := l.listSnapshots(ctx, l.localPath)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 && l.PrintFlags.IsTableOutput() {
+		l.Logger.Info(l.IOStreams.Out, "No snapshots found in ", backupStore.Location)
+		return nil
+	}
+	return l.print(ListResult{Store: backupStore, Snapshots: snapshots, Kind: "SnapshotList"})
+}
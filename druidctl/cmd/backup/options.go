@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// backupOptions holds options shared by all backup subcommands
+type backupOptions struct {
+	*cmdutils.GlobalOptions
+	PrintFlags *printer.PrintFlags
+	// localPath overrides the directory in which the buckets of the Local provider are located.
+	localPath    string
+	snapshotName string
+	outputDir    string
+}
+
+func newBackupOptions(options *cmdutils.GlobalOptions) *backupOptions {
+	return &backupOptions{
+		GlobalOptions: options,
+		PrintFlags:    printer.NewPrintFlags(),
+	}
+}
+
+func (o *backupOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.localPath, "local-path", "", "Directory in which the buckets of the Local provider are located. Defaults to the hostPath configured in the backup secret")
+}
+
+// backupRuntime holds runtime state shared by all backup subcommands
+type backupRuntime struct {
+	*cmdutils.RuntimeEnv
+	etcdRef       types.NamespacedName
+	etcdClient    client.EtcdClientInterface
+	genericClient client.GenericClientInterface
+	Printer       printer.Printer
+}
+
+func newBackupRuntime(runtime *cmdutils.RuntimeEnv) *backupRuntime {
+	return &backupRuntime{
+		RuntimeEnv: runtime,
+	}
+}
+
+// listCmdCtx composes options and runtime for the backup list command
+type listCmdCtx struct {
+	*backupOptions
+	*backupRuntime
+}
+
+// inspectCmdCtx composes options and runtime for the backup inspect command
+type inspectCmdCtx struct {
+	*backupOptions
+	*backupRuntime
+}
+
+// downloadCmdCtx composes options and runtime for the backup download command
+type downloadCmdCtx struct {
+	*backupOptions
+	*backupRuntime
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+	"github.com/gardener/etcd-druid/druidctl/internal/snapstore"
+)
+
+// ToTable converts the result into a table with one row per snapshot.
+func (r ListResult) ToTable() *printer.Table {
+	table := &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "NAME"},
+			{Name: "KIND"},
+			{Name: "START REVISION"},
+			{Name: "LAST REVISION"},
+			{Name: "SIZE"},
+			{Name: "AGE"},
+			{Name: "FINAL"},
+			{Name: "COMPRESSION", Wide: true},
+			{Name: "CREATED", Wide: true},
+			{Name: "PATH", Wide: true},
+		},
+	}
+	for _, snapshot := range r.Snapshots {
+		compression := snapshot.Compression
+		if compression == "" {
+			compression = "none"
+		}
+		table.Rows = append(table.Rows, printer.TableRow{
+			Cells: []string{
+				snapshot.Name,
+				snapshotKind(snapshot),
+				fmt.Sprintf("%d", snapshot.StartRevision),
+				fmt.Sprintf("%d", snapshot.LastRevision),
+				cmdutils.FormatSize(snapshot.Size),
+				cmdutils.ShortDuration(time.Since(snapshot.CreatedOn)),
+				fmt.Sprintf("%t", snapshot.IsFinal),
+				compression,
+				snapshot.CreatedOn.Format(time.RFC3339),
+				snapshot.Path,
+			},
+			Object: snapshot,
+		})
+	}
+	return table
+}
+
+// renderInspectResult prints the summary of the backup store as a list of fields.
+func (i *inspectCmdCtx) renderInspectResult(result InspectResult) {
+	i.Logger.RawHeader(i.IOStreams.Out, fmt.Sprintf("Backup store of Etcd [%s/%s]", result.Store.Etcd.Namespace, result.Store.Etcd.Name))
+	w := tabwriter.NewWriter(i.IOStreams.Out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Provider:\t%s\n", result.Store.Provider)
+	fmt.Fprintf(w, "Location:\t%s\n", result.Store.Location)
+	if result.Store.SecretName != "" {
+		fmt.Fprintf(w, "Secret:\t%s\n", result.Store.SecretName)
+	}
+	fmt.Fprintf(w, "Snapshots:\t%d full, %d delta, %s in total\n", result.FullSnapshotCount, result.DeltaSnapshotCount, cmdutils.FormatSize(result.TotalSize))
+	if full := result.LatestFullSnapshot; full != nil {
+		fmt.Fprintf(w, "Latest Full Snapshot:\t%s (revision %d, %s ago)\n", full.Name, full.LastRevision, cmdutils.ShortDuration(time.Since(full.CreatedOn)))
+		fmt.Fprintf(w, "Delta Snapshots Since:\t%d\n", result.DeltaSnapshotsSinceLatestFull)
+		fmt.Fprintf(w, "Restorable Revision:\t%d\n", result.RestorableRevision)
+	} else {
+		fmt.Fprintf(w, "Latest Full Snapshot:\t<none>\n")
+	}
+	if latest := result.LatestSnapshot; latest != nil {
+		fmt.Fprintf(w, "Latest Snapshot:\t%s (revision %d, %s ago)\n", latest.Name, latest.LastRevision, cmdutils.ShortDuration(time.Since(latest.CreatedOn)))
+	}
+	if len(result.Gaps) > 0 {
+		gaps := make([]string, 0, len(result.Gaps))
+		for _, gap := range result.Gaps {
+			gaps = append(gaps, fmt.Sprintf("%d-%d", gap.From, gap.To))
+		}
+		fmt.Fprintf(w, "Revision Gaps:\t%s\n", strings.Join(gaps, ", "))
+	}
+	if err := w.Flush(); err != nil {
+		i.Logger.Warning(i.IOStreams.ErrOut, "Failed writing backup summary: ", err.Error())
+	}
+}
+
+func snapshotKind(snapshot snapstore.Snapshot) string {
+	if snapshot.Kind == snapstore.SnapshotKindDelta {
+		return "Delta"
+	}
+	return snapshot.Kind
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/snapstore"
+)
+
+// BackupStore describes the backup store of an Etcd as resolved from its StoreSpec.
+type BackupStore struct {
+	Etcd       cmdutils.EtcdRef `json:"etcd" yaml:"etcd"`
+	Provider   string           `json:"provider" yaml:"provider"`
+	Container  string           `json:"container,omitempty" yaml:"container,omitempty"`
+	Prefix     string           `json:"prefix" yaml:"prefix"`
+	SecretName string           `json:"secretName,omitempty" yaml:"secretName,omitempty"`
+	// Location is the provider specific location of the snapshots, e.g. the directory for the Local provider.
+	Location string `json:"location" yaml:"location"`
+}
+
+// ListResult contains the snapshots in the backup store of an Etcd.
+type ListResult struct {
+	Store     BackupStore          `json:"store" yaml:"store"`
+	Snapshots []snapstore.Snapshot `json:"snapshots" yaml:"snapshots"`
+	Kind      string               `json:"kind" yaml:"kind"`
+}
+
+// RevisionGap describes revisions which are not covered by any delta snapshot.
+type RevisionGap struct {
+	// From is the first missing revision.
+	From int64 `json:"from" yaml:"from"`
+	// To is the last missing revision.
+	To int64 `json:"to" yaml:"to"`
+}
+
+// InspectResult summarizes the backup store of an Etcd.
+type InspectResult struct {
+	Store              BackupStore         `json:"store" yaml:"store"`
+	FullSnapshotCount  int                 `json:"fullSnapshotCount" yaml:"fullSnapshotCount"`
+	DeltaSnapshotCount int                 `json:"deltaSnapshotCount" yaml:"deltaSnapshotCount"`
+	TotalSize          int64               `json:"totalSize" yaml:"totalSize"`
+	LatestFullSnapshot *snapstore.Snapshot `json:"latestFullSnapshot,omitempty" yaml:"latestFullSnapshot,omitempty"`
+	LatestSnapshot     *snapstore.Snapshot `json:"latestSnapshot,omitempty" yaml:"latestSnapshot,omitempty"`
+	// DeltaSnapshotsSinceLatestFull is the number of delta snapshots taken after the latest full snapshot.
+	DeltaSnapshotsSinceLatestFull int `json:"deltaSnapshotsSinceLatestFull" yaml:"deltaSnapshotsSinceLatestFull"`
+	// RestorableRevision is the latest revision which can be restored from the latest full snapshot and the
+	// contiguous delta snapshots following it.
+	RestorableRevision int64 `json:"restorableRevision" yaml:"restorableRevision"`
+	// Gaps are the ranges of revisions after the latest full snapshot which are not covered by any delta snapshot.
+	Gaps []RevisionGap `json:"gaps,omitempty" yaml:"gaps,omitempty"`
+	Kind string        `json:"kind" yaml:"kind"`
+}
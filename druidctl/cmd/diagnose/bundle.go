@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const redactedValuePrefix = "REDACTED"
+
+// bundleWriter writes files into a gzipped tarball below a common root directory.
+type bundleWriter struct {
+	file        *os.File
+	gzipWriter  *gzip.Writer
+	tarWriter   *tar.Writer
+	rootDir     string
+	collectedAt time.Time
+	files       []string
+}
+
+// newBundleWriter creates the bundle at the given path. An existing file is never overwritten.
+func newBundleWriter(bundlePath, rootDir string, collectedAt time.Time) (*bundleWriter, error) {
+	file, err := os.OpenFile(bundlePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bundle %s: %w", bundlePath, err)
+	}
+	gzipWriter := gzip.NewWriter(file)
+	return &bundleWriter{
+		file:        file,
+		gzipWriter:  gzipWriter,
+		tarWriter:   tar.NewWriter(gzipWriter),
+		rootDir:     rootDir,
+		collectedAt: collectedAt,
+	}, nil
+}
+
+// add writes a file with the given content at the given path relative to the root directory of the bundle.
+func (b *bundleWriter) add(name string, content []byte) error {
+	header := &tar.Header{
+		Name:    path.Join(b.rootDir, name),
+		Mode:    0o644,
+		Size:    int64(len(content)),
+		ModTime: b.collectedAt,
+	}
+	if err := b.tarWriter.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write %s to bundle: %w", name, err)
+	}
+	if _, err := b.tarWriter.Write(content); err != nil {
+		return fmt.Errorf("failed to write %s to bundle: %w", name, err)
+	}
+	b.files = append(b.files, name)
+	return nil
+}
+
+// addYAML writes the given object as YAML at the given path relative to the root directory of the bundle.
+func (b *bundleWriter) addYAML(name string, obj any) error {
+	content, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+	return b.add(name, content)
+}
+
+// close flushes and closes the bundle.
+func (b *bundleWriter) close() error {
+	if err := b.tarWriter.Close(); err != nil {
+		_ = b.file.Close()
+		return fmt.Errorf("failed to finish bundle: %w", err)
+	}
+	if err := b.gzipWriter.Close(); err != nil {
+		_ = b.file.Close()
+		return fmt.Errorf("failed to finish bundle: %w", err)
+	}
+	return b.file.Close()
+}
+
+// abort closes and removes the incomplete bundle.
+func (b *bundleWriter) abort() {
+	_ = b.tarWriter.Close()
+	_ = b.gzipWriter.Close()
+	_ = b.file.Close()
+	_ = os.Remove(b.file.Name())
+}
+
+// sanitizeObject removes the managed fields and the last applied configuration of the given object, which only add
+// noise and can contain the data of Secrets, and redacts the data and annotations of Secrets.
+func sanitizeObject(obj *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+	annotations := obj.GetAnnotations()
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	if obj.GetKind() == "Secret" {
+		for key, value := range annotations {
+			annotations[key] = redactedValue("annotations", value)
+		}
+	}
+	if len(annotations) > 0 {
+		obj.SetAnnotations(annotations)
+	} else {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	}
+	if obj.GetKind() != "Secret" {
+		return
+	}
+	for _, field := range []string{"data", "stringData"} {
+		values, found, err := unstructured.NestedMap(obj.Object, field)
+		if err != nil || !found {
+			unstructured.RemoveNestedField(obj.Object, field)
+			continue
+		}
+		for key, value := range values {
+			values[key] = redactedValue(field, value)
+		}
+		_ = unstructured.SetNestedMap(obj.Object, values, field)
+	}
+}
+
+// redactedValue replaces a value of a Secret with a placeholder which only reveals its size.
+func redactedValue(field string, value any) string {
+	s, _ := value.(string)
+	size := len(s)
+	if field == "data" {
+		if decoded, err := base64.StdEncoding.DecodeString(s); err == nil {
+			size = len(decoded)
+		}
+	}
+	return fmt.Sprintf("%s (%d bytes)", redactedValuePrefix, size)
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSanitizeObject(t *testing.T) {
+	const lastAppliedSecret = `{"apiVersion":"v1","kind":"Secret","data":{"tls.key":"dG9wLXNlY3JldA=="}}`
+	tests := []struct {
+		name                string
+		kind                string
+		annotations         map[string]string
+		expectedAnnotations map[string]string
+	}{
+		{
+			name: "secret",
+			kind: "Secret",
+			annotations: map[string]string{
+				corev1.LastAppliedConfigAnnotation: lastAppliedSecret,
+				"example.com/token":                "top-secret",
+			},
+			expectedAnnotations: map[string]string{"example.com/token": redactedValuePrefix + " (10 bytes)"},
+		},
+		{
+			name:        "secret with only the last applied configuration",
+			kind:        "Secret",
+			annotations: map[string]string{corev1.LastAppliedConfigAnnotation: lastAppliedSecret},
+		},
+		{
+			name: "other object",
+			kind: "ConfigMap",
+			annotations: map[string]string{
+				corev1.LastAppliedConfigAnnotation: `{"apiVersion":"v1","kind":"ConfigMap"}`,
+				"example.com/owner":                "team",
+			},
+			expectedAnnotations: map[string]string{"example.com/owner": "team"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": tt.kind}}
+			obj.SetName("test")
+			obj.SetAnnotations(tt.annotations)
+
+			sanitizeObject(obj)
+
+			annotations := obj.GetAnnotations()
+			if len(annotations) != len(tt.expectedAnnotations) {
+				t.Fatalf("Expected annotations %v, got %v", tt.expectedAnnotations, annotations)
+			}
+			for key, value := range tt.expectedAnnotations {
+				if annotations[key] != value {
+					t.Errorf("Expected annotation %s to be %q, got %q", key, value, annotations[key])
+				}
+			}
+			if _, found, _ := unstructured.NestedMap(obj.Object, "metadata", "annotations"); found != (len(tt.expectedAnnotations) > 0) {
+				t.Errorf("Expected annotations to be removed if none are left, got %v", obj.Object["metadata"])
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// podRestartsThreshold is the number of container restarts from which on a pod is considered to be crash looping.
+	podRestartsThreshold = 3
+)
+
+// maxRecommendedQuota is the largest backend quota recommended by etcd.
+var maxRecommendedQuota = resource.MustParse("8Gi")
+
+// diagnosis holds the state of an Etcd and its resources as collected for the built-in checks.
+type diagnosis struct {
+	etcd *druidv1alpha1.Etcd
+	// statefulSet is nil if the StatefulSet does not exist.
+	statefulSet *appsv1.StatefulSet
+	pods        []corev1.Pod
+	pvcs        []corev1.PersistentVolumeClaim
+	// backupSecretMissing is true if the Secret referenced by the backup store does not exist.
+	backupSecretMissing bool
+	// fullSnapshotLease is nil if the full snapshot lease does not exist.
+	fullSnapshotLease *coordinationv1.Lease
+	now               time.Time
+}
+
+// check is a built-in check which flags a common misconfiguration or problem. It returns CheckSeverityPass and an empty
+// message if no problem has been found.
+type check struct {
+	name string
+	run  func(d *diagnosis) (CheckSeverity, string)
+}
+
+// checks are all built-in checks in the order in which they are run.
+var checks = []check{
+	{name: "ReplicaCount", run: checkReplicaCount},
+	{name: "BackupStore", run: checkBackupStore},
+	{name: "BackupSecret", run: checkBackupSecret},
+	{name: "BackendQuota", run: checkBackendQuota},
+	{name: "SpecReconciliation", run: checkSpecReconciliation},
+	{name: "ComponentProtection", run: checkComponentProtection},
+	{name: "LastErrors", run: checkLastErrors},
+	{name: "Conditions", run: checkConditions},
+	{name: "StatefulSet", run: checkStatefulSet},
+	{name: "PodRestarts", run: checkPodRestarts},
+	{name: "PersistentVolumeClaims", run: checkPersistentVolumeClaims},
+	{name: "FullSnapshot", run: checkFullSnapshot},
+}
+
+// runChecks runs all built-in checks against the given diagnosis.
+func runChecks(d *diagnosis) []CheckResult {
+	results := make([]CheckResult, 0, len(checks))
+	for _, c := range checks {
+		severity, message := c.run(d)
+		results = append(results, CheckResult{Name: c.name, Severity: severity, Message: message})
+	}
+	return results
+}
+
+func checkReplicaCount(d *diagnosis) (CheckSeverity, string) {
+	if replicas := d.etcd.Spec.Replicas; replicas > 0 && replicas%2 == 0 {
+		return CheckSeverityWarning, fmt.Sprintf("%d replicas tolerate as many member failures as %d replicas, use an odd number of replicas", replicas, replicas-1)
+	}
+	return CheckSeverityPass, ""
+}
+
+func checkBackupStore(d *diagnosis) (CheckSeverity, string) {
+	if d.etcd.Spec.Replicas > 0 && !d.etcd.IsBackupStoreEnabled() {
+		return CheckSeverityWarning, "no backup store is configured, the data cannot be restored after a loss of quorum or of the volumes"
+	}
+	return CheckSeverityPass, ""
+}
+
+func checkBackupSecret(d *diagnosis) (CheckSeverity, string) {
+	if d.backupSecretMissing {
+		return CheckSeverityError, fmt.Sprintf("the backup secret %s referenced by the backup store does not exist", d.etcd.Spec.Backup.Store.SecretRef.Name)
+	}
+	return CheckSeverityPass, ""
+}
+
+func checkBackendQuota(d *diagnosis) (CheckSeverity, string) {
+	if quota := d.etcd.Spec.Etcd.Quota; quota != nil && quota.Cmp(maxRecommendedQuota) > 0 {
+		return CheckSeverityWarning, fmt.Sprintf("the backend quota %s exceeds the maximum of %s recommended by etcd", quota.String(), maxRecommendedQuota.String())
+	}
+	return CheckSeverityPass, ""
+}
+
+func checkSpecReconciliation(d *diagnosis) (CheckSeverity, string) {
+	if druidv1alpha1.GetSuspendEtcdSpecReconcileAnnotationKey(d.etcd.ObjectMeta) != nil {
+		return CheckSeverityWarning, fmt.Sprintf("the reconciliation of the spec is suspended by the annotation %s", druidv1alpha1.SuspendEtcdSpecReconcileAnnotation)
+	}
+	return CheckSeverityPass, ""
+}
+
+func checkComponentProtection(d *diagnosis) (CheckSeverity, string) {
+	if !druidv1alpha1.AreManagedResourcesProtected(d.etcd.ObjectMeta) {
+		return CheckSeverityWarning, fmt.Sprintf("the protection of the managed resources is disabled by the annotation %s", druidv1alpha1.DisableEtcdComponentProtectionAnnotation)
+	}
+	return CheckSeverityPass, ""
+}
+
+func checkLastErrors(d *diagnosis) (CheckSeverity, string) {
+	lastErrors := d.etcd.Status.LastErrors
+	if len(lastErrors) == 0 {
+		return CheckSeverityPass, ""
+	}
+	codes := make([]string, 0, len(lastErrors))
+	for _, lastError := range lastErrors {
+		codes = append(codes, string(lastError.Code))
+	}
+	return CheckSeverityError, fmt.Sprintf("the last reconciliation failed with %s", strings.Join(codes, ", "))
+}
+
+func checkConditions(d *diagnosis) (CheckSeverity, string) {
+	var notTrue []string
+	for _, condition := range d.etcd.Status.Conditions {
+		if condition.Status != druidv1alpha1.ConditionTrue {
+			notTrue = append(notTrue, fmt.Sprintf("%s=%s (%s)", condition.Type, condition.Status, condition.Reason))
+		}
+	}
+	if len(notTrue) > 0 {
+		return CheckSeverityWarning, fmt.Sprintf("conditions which are not True: %s", strings.Join(notTrue, ", "))
+	}
+	return CheckSeverityPass, ""
+}
+
+func checkStatefulSet(d *diagnosis) (CheckSeverity, string) {
+	replicas := d.etcd.Spec.Replicas
+	if d.statefulSet == nil {
+		if replicas > 0 {
+			return CheckSeverityError, "the StatefulSet does not exist"
+		}
+		return CheckSeverityPass, ""
+	}
+	if readyReplicas := d.statefulSet.Status.ReadyReplicas; readyReplicas < replicas {
+		return CheckSeverityError, fmt.Sprintf("only %d of %d replicas of the StatefulSet are ready", readyReplicas, replicas)
+	}
+	return CheckSeverityPass, ""
+}
+
+func checkPodRestarts(d *diagnosis) (CheckSeverity, string) {
+	var restarting []string
+	for _, pod := range d.pods {
+		for _, containerStatus := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			if containerStatus.RestartCount >= podRestartsThreshold {
+				restarting = append(restarting, fmt.Sprintf("%s/%s (%d restarts)", pod.Name, containerStatus.Name, containerStatus.RestartCount))
+			}
+		}
+	}
+	if len(restarting) > 0 {
+		return CheckSeverityWarning, fmt.Sprintf("containers are restarting repeatedly: %s", strings.Join(restarting, ", "))
+	}
+	return CheckSeverityPass, ""
+}
+
+func checkPersistentVolumeClaims(d *diagnosis) (CheckSeverity, string) {
+	var unbound []string
+	for _, pvc := range d.pvcs {
+		if pvc.Status.Phase != corev1.ClaimBound {
+			unbound = append(unbound, fmt.Sprintf("%s (%s)", pvc.Name, pvc.Status.Phase))
+		}
+	}
+	if len(unbound) > 0 {
+		return CheckSeverityError, fmt.Sprintf("PersistentVolumeClaims are not bound: %s", strings.Join(unbound, ", "))
+	}
+	return CheckSeverityPass, ""
+}
+
+func checkFullSnapshot(d *diagnosis) (CheckSeverity, string) {
+	if !d.etcd.IsBackupStoreEnabled() || d.etcd.Spec.Replicas == 0 {
+		return CheckSeverityPass, ""
+	}
+	fullSnapshotMaxAge, err := cmdutils.GetFullSnapshotMaxAge(d.etcd)
+	if err != nil {
+		return CheckSeverityWarning, err.Error()
+	}
+	if d.fullSnapshotLease == nil || d.fullSnapshotLease.Spec.RenewTime == nil {
+		return CheckSeverityWarning, "no full snapshot has been taken yet"
+	}
+	if age := d.now.Sub(d.fullSnapshotLease.Spec.RenewTime.Time); age > fullSnapshotMaxAge {
+		return CheckSeverityWarning, fmt.Sprintf("the latest full snapshot has been taken %s ago", cmdutils.ShortDuration(age))
+	}
+	return CheckSeverityPass, ""
+}
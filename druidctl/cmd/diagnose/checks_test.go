@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// newHealthyDiagnosis returns a diagnosis for which all checks pass.
+func newHealthyDiagnosis(now time.Time) *diagnosis {
+	etcd := &druidv1alpha1.Etcd{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-etcd", Namespace: "default"},
+		Spec:       druidv1alpha1.EtcdSpec{Replicas: 3},
+		Status: druidv1alpha1.EtcdStatus{Conditions: []druidv1alpha1.Condition{
+			{Type: druidv1alpha1.ConditionTypeReady, Status: druidv1alpha1.ConditionTrue},
+		}},
+	}
+	etcd.Spec.Backup.Store = &druidv1alpha1.StoreSpec{Provider: ptr.To(druidv1alpha1.StorageProvider("Local")), Prefix: "test"}
+	return &diagnosis{
+		etcd:              etcd,
+		statefulSet:       &appsv1.StatefulSet{Status: appsv1.StatefulSetStatus{ReadyReplicas: 3}},
+		pvcs:              []corev1.PersistentVolumeClaim{{Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound}}},
+		fullSnapshotLease: &coordinationv1.Lease{Spec: coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: now.Add(-time.Hour)}}},
+		now:               now,
+	}
+}
+
+func TestRunChecks(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name             string
+		modify           func(d *diagnosis)
+		expectedCheck    string
+		expectedSeverity CheckSeverity
+		expectedMessage  string
+	}{
+		{"even replicas", func(d *diagnosis) { d.etcd.Spec.Replicas = 2; d.statefulSet.Status.ReadyReplicas = 2 }, "ReplicaCount", CheckSeverityWarning, "odd number"},
+		{"no backup store", func(d *diagnosis) { d.etcd.Spec.Backup.Store = nil }, "BackupStore", CheckSeverityWarning, "no backup store"},
+		{"missing backup secret", func(d *diagnosis) {
+			d.etcd.Spec.Backup.Store.SecretRef = &corev1.SecretReference{Name: "test-backup"}
+			d.backupSecretMissing = true
+		}, "BackupSecret", CheckSeverityError, "test-backup"},
+		{"large quota", func(d *diagnosis) { d.etcd.Spec.Etcd.Quota = ptr.To(resource.MustParse("16Gi")) }, "BackendQuota", CheckSeverityWarning, "16Gi"},
+		{"suspended reconciliation", func(d *diagnosis) {
+			d.etcd.Annotations = map[string]string{druidv1alpha1.SuspendEtcdSpecReconcileAnnotation: ""}
+		}, "SpecReconciliation", CheckSeverityWarning, "suspended"},
+		{"disabled protection", func(d *diagnosis) {
+			d.etcd.Annotations = map[string]string{druidv1alpha1.DisableEtcdComponentProtectionAnnotation: ""}
+		}, "ComponentProtection", CheckSeverityWarning, "disabled"},
+		{"last errors", func(d *diagnosis) {
+			d.etcd.Status.LastErrors = []druidapicommon.LastError{{Code: "ERR_SYNC_STATEFULSET"}}
+		}, "LastErrors", CheckSeverityError, "ERR_SYNC_STATEFULSET"},
+		{"condition not true", func(d *diagnosis) {
+			d.etcd.Status.Conditions[0].Status = druidv1alpha1.ConditionFalse
+		}, "Conditions", CheckSeverityWarning, "Ready=False"},
+		{"missing statefulset", func(d *diagnosis) { d.statefulSet = nil }, "StatefulSet", CheckSeverityError, "does not exist"},
+		{"unready statefulset", func(d *diagnosis) { d.statefulSet.Status.ReadyReplicas = 1 }, "StatefulSet", CheckSeverityError, "only 1 of 3"},
+		{"restarting pod", func(d *diagnosis) {
+			d.pods = []corev1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-etcd-0"},
+				Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{Name: "etcd", RestartCount: 4}}},
+			}}
+		}, "PodRestarts", CheckSeverityWarning, "test-etcd-0/etcd (4 restarts)"},
+		{"pending pvc", func(d *diagnosis) {
+			d.pvcs[0].Name = "test-etcd-0"
+			d.pvcs[0].Status.Phase = corev1.ClaimPending
+		}, "PersistentVolumeClaims", CheckSeverityError, "test-etcd-0 (Pending)"},
+		{"no full snapshot", func(d *diagnosis) { d.fullSnapshotLease = nil }, "FullSnapshot", CheckSeverityWarning, "no full snapshot"},
+		{"overdue full snapshot", func(d *diagnosis) {
+			d.fullSnapshotLease.Spec.RenewTime = &metav1.MicroTime{Time: now.Add(-48 * time.Hour)}
+		}, "FullSnapshot", CheckSeverityWarning, "2d ago"},
+		{"overdue full snapshot by schedule", func(d *diagnosis) {
+			d.etcd.Spec.Backup.FullSnapshotSchedule = ptr.To("0 */6 * * *")
+			d.fullSnapshotLease.Spec.RenewTime = &metav1.MicroTime{Time: now.Add(-8 * time.Hour)}
+		}, "FullSnapshot", CheckSeverityWarning, "8h ago"},
+		{"invalid full snapshot schedule", func(d *diagnosis) {
+			d.etcd.Spec.Backup.FullSnapshotSchedule = ptr.To("invalid")
+		}, "FullSnapshot", CheckSeverityWarning, "invalid full snapshot schedule"},
+	}
+
+	for _, result := range runChecks(newHealthyDiagnosis(now)) {
+		if result.Severity != CheckSeverityPass {
+			t.Errorf("Expected check %s to pass for a healthy etcd, got %s: %s", result.Name, result.Severity, result.Message)
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newHealthyDiagnosis(now)
+			tt.modify(d)
+			for _, result := range runChecks(d) {
+				if result.Name != tt.expectedCheck {
+					if result.Severity != CheckSeverityPass {
+						t.Errorf("Expected check %s to pass, got %s: %s", result.Name, result.Severity, result.Message)
+					}
+					continue
+				}
+				if result.Severity != tt.expectedSeverity || !strings.Contains(result.Message, tt.expectedMessage) {
+					t.Errorf("Expected check %s to report %s containing %q, got %s: %s", result.Name, tt.expectedSeverity, tt.expectedMessage, result.Severity, result.Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultTailLines     = 5000
+	defaultDruidSelector = "app.kubernetes.io/name=etcd-druid"
+)
+
+var (
+	example = `
+# Collect a support bundle for an etcd resource in the default namespace into diagnose-default-my-etcd-<timestamp>.tar.gz
+kubectl druid diagnose my-etcd
+
+# Collect a support bundle for an etcd resource in a specific namespace into a given file
+kubectl druid diagnose test/my-etcd --output bundle.tar.gz
+
+# Collect a support bundle with the complete logs of all containers
+kubectl druid diagnose test/my-etcd --tail=-1
+
+# Collect a support bundle, looking for the etcd-druid operator only in the garden namespace
+kubectl druid diagnose test/my-etcd --druid-namespace garden
+
+# Only run the built-in checks without collecting logs
+kubectl druid diagnose test/my-etcd --skip-logs
+`
+)
+
+// NewDiagnoseCommand creates the diagnose command
+func NewDiagnoseCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	diagnoseOptions := newDiagnoseOptions(cmdCtx.Options)
+
+	diagnoseCmd := &cobra.Command{
+		Use:   "diagnose <etcd-resource-name> --output=<bundle-file> (optional flag)",
+		Short: "Collect a support bundle for an etcd cluster and check it for common misconfigurations",
+		Long: `Collect a support bundle for an etcd cluster and check it for common misconfigurations.
+The bundle is a gzipped tarball which contains the etcd resource, all resources managed for it by etcd-druid, the logs
+of the etcd and backup-restore containers, the events of these resources, the logs of the etcd-druid operator and the
+results of the built-in checks. The data of Secrets is redacted, only their metadata and keys are included.`,
+		Args:    cobra.ExactArgs(1),
+		Example: example,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			diagnoseCmdCtx := &diagnoseCmdCtx{
+				diagnoseOptions: diagnoseOptions,
+				diagnoseRuntime: newDiagnoseRuntime(cmdCtx.Runtime),
+			}
+			if err := diagnoseCmdCtx.validate(); err != nil {
+				if herr := cmd.Help(); herr != nil {
+					cmdCtx.Runtime.Logger.Warning(cmdCtx.Runtime.IOStreams.ErrOut, "Failed to show help: ", herr.Error())
+				}
+				return err
+			}
+
+			if err := diagnoseCmdCtx.complete(); err != nil {
+				return err
+			}
+
+			if err := diagnoseCmdCtx.execute(cmdutils.CmdContext(cmd)); err != nil {
+				cmdCtx.Runtime.Logger.Error(cmdCtx.Runtime.IOStreams.ErrOut, "Diagnosing Etcd failed", err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	diagnoseCmd.Flags().StringVarP(&diagnoseOptions.OutputFile, "output", "o", "", "Path of the support bundle to write. Defaults to diagnose-<namespace>-<name>-<timestamp>.tar.gz in the current directory")
+	diagnoseCmd.Flags().Int64Var(&diagnoseOptions.TailLines, "tail", defaultTailLines, "Number of most recent log lines to collect per container. Use -1 to collect the complete logs")
+	diagnoseCmd.Flags().BoolVar(&diagnoseOptions.SkipLogs, "skip-logs", false, "Do not collect container logs")
+	diagnoseCmd.Flags().StringVar(&diagnoseOptions.DruidNamespace, "druid-namespace", "", "Namespace of the etcd-druid operator. Defaults to searching all namespaces")
+	diagnoseCmd.Flags().StringVar(&diagnoseOptions.DruidSelector, "druid-selector", defaultDruidSelector, "Label selector of the etcd-druid operator pods")
+
+	return diagnoseCmd
+}
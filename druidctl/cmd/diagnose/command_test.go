@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fake "github.com/gardener/etcd-druid/druidctl/internal/client/fake"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// readBundle returns the content of all files in the bundle by their path relative to the root directory.
+func readBundle(t *testing.T, bundlePath string) map[string]string {
+	t.Helper()
+	file, err := os.Open(bundlePath)
+	if err != nil {
+		t.Fatalf("Failed to open bundle: %v", err)
+	}
+	defer file.Close()
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("Failed to read bundle: %v", err)
+	}
+	files := make(map[string]string)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to read bundle: %v", err)
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			t.Fatalf("Failed to read %s from bundle: %v", header.Name, err)
+		}
+		rootDir, name, _ := strings.Cut(header.Name, "/")
+		if !strings.HasPrefix(rootDir, "diagnose-default-test-etcd-") {
+			t.Errorf("Unexpected root directory of %s", header.Name)
+		}
+		files[name] = string(content)
+	}
+	return files
+}
+
+func newTestObjects() ([]runtime.Object, []runtime.Object) {
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").WithBackupStore("Local").Build()
+	etcd.Spec.Replicas = 1
+	etcd.Spec.Backup.Store.SecretRef = &corev1.SecretReference{Name: "test-backup"}
+	labels := map[string]string{druidv1alpha1.LabelPartOfKey: "test-etcd"}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-etcd-0", Namespace: "default", Labels: labels},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "etcd"}, {Name: "backup-restore"}}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "etcd", RestartCount: 5},
+			{Name: "backup-restore"},
+		}},
+	}
+	backupSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-backup", Namespace: "default"},
+		Data:       map[string][]byte{"hostPath": []byte("/etc/gardener/local-backupbuckets")},
+	}
+	managedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-etcd-client-tls", Namespace: "default", Labels: labels},
+		Data:       map[string][]byte{"tls.key": []byte("top-secret")},
+	}
+	event := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "test-etcd-0.1", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "test-etcd-0"},
+		Reason:         "BackOff",
+	}
+	unrelatedEvent := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "other-pod.1", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "other-pod"},
+		Reason:         "Unrelated",
+	}
+	druidPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "etcd-druid-abc", Namespace: "garden", Labels: map[string]string{"app.kubernetes.io/name": "etcd-druid"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "etcd-druid"}}},
+	}
+	return []runtime.Object{etcd}, []runtime.Object{pod, backupSecret, managedSecret, event, unrelatedEvent, druidPod}
+}
+
+func TestDiagnoseCommand(t *testing.T) {
+	etcdObjects, k8sObjects := newTestObjects()
+	bundlePath := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	_, buf, err := fake.NewTestHelper().WithEtcdObjects(etcdObjects).WithK8sObjects(k8sObjects).
+		RunCommand(t, NewDiagnoseCommand, []string{"test-etcd"}, map[string]string{"output": bundlePath})
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	files := readBundle(t, bundlePath)
+
+	for _, name := range []string{
+		"etcd.yaml",
+		"resources/pod/test-etcd-0.yaml",
+		"resources/secret/test-etcd-client-tls.yaml",
+		"resources/secret/test-backup.yaml",
+		"events.yaml",
+		"logs/test-etcd-0/etcd.log",
+		"logs/test-etcd-0/etcd.previous.log",
+		"logs/test-etcd-0/backup-restore.log",
+		"logs/etcd-druid/garden/etcd-druid-abc/etcd-druid.log",
+		"summary.yaml",
+	} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("Expected bundle to contain %s", name)
+		}
+	}
+	if _, ok := files["logs/test-etcd-0/backup-restore.previous.log"]; ok {
+		t.Error("Expected no previous logs of a container which has not been restarted")
+	}
+
+	for _, name := range []string{"resources/secret/test-etcd-client-tls.yaml", "resources/secret/test-backup.yaml"} {
+		if strings.Contains(files[name], "dG9wLXNlY3JldA==") || strings.Contains(files[name], "L2V0Yy9nYXJkZW5lci9sb2NhbC1iYWNrdXBidWNrZXRz") {
+			t.Errorf("Expected data of %s to be redacted, got:\n%s", name, files[name])
+		}
+		if !strings.Contains(files[name], redactedValuePrefix) {
+			t.Errorf("Expected %s to contain redacted keys, got:\n%s", name, files[name])
+		}
+	}
+	if !strings.Contains(files["events.yaml"], "BackOff") || strings.Contains(files["events.yaml"], "Unrelated") {
+		t.Errorf("Expected only events of the collected objects, got:\n%s", files["events.yaml"])
+	}
+
+	var summary Summary
+	if err := yaml.Unmarshal([]byte(files["summary.yaml"]), &summary); err != nil {
+		t.Fatalf("Failed to parse summary: %v", err)
+	}
+	if len(summary.Checks) != len(checks) || len(summary.Files) != len(files)-1 {
+		t.Errorf("Expected %d checks and %d files in summary, got %d and %d", len(checks), len(files)-1, len(summary.Checks), len(summary.Files))
+	}
+	if len(summary.CollectionErrors) != 0 {
+		t.Errorf("Expected no collection errors, got %v", summary.CollectionErrors)
+	}
+
+	for _, expected := range []string{"Checks of Etcd [default/test-etcd]", "PodRestarts", "test-etcd-0/etcd (5 restarts)", "does not exist"} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, buf.String())
+		}
+	}
+}
+
+func TestDiagnoseCommandSkipLogs(t *testing.T) {
+	etcdObjects, k8sObjects := newTestObjects()
+	bundlePath := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	_, _, err := fake.NewTestHelper().WithEtcdObjects(etcdObjects).WithK8sObjects(k8sObjects).
+		RunCommand(t, NewDiagnoseCommand, []string{"test-etcd"}, map[string]string{"output": bundlePath, "skip-logs": "true"})
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	for name := range readBundle(t, bundlePath) {
+		if strings.HasPrefix(name, "logs/") {
+			t.Errorf("Expected no logs in bundle, found %s", name)
+		}
+	}
+}
+
+func TestDiagnoseCommandErrors(t *testing.T) {
+	etcdObjects, k8sObjects := newTestObjects()
+	tests := []struct {
+		name          string
+		etcdObjects   []runtime.Object
+		flags         map[string]string
+		expectedError string
+	}{
+		{"unknown etcd", nil, nil, "not found"},
+		{"invalid tail", etcdObjects, map[string]string{"tail": "0"}, "--tail"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := map[string]string{"output": filepath.Join(t.TempDir(), "bundle.tar.gz")}
+			maps.Copy(flags, tt.flags)
+			_, _, err := fake.NewTestHelper().WithEtcdObjects(tt.etcdObjects).WithK8sObjects(k8sObjects).
+				RunCommand(t, NewDiagnoseCommand, []string{"test-etcd"}, flags)
+			if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+				t.Errorf("Expected error containing %q, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/version"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func (d *diagnoseCmdCtx) complete() error {
+	etcdClient, err := d.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	d.EtcdClient = etcdClient
+
+	genericClient, err := d.Clients.GenericClient()
+	if err != nil {
+		return fmt.Errorf("unable to create generic kube clients: %w", err)
+	}
+	d.GenericClient = genericClient
+
+	d.etcdRef = d.BuildEtcdRefList()[0]
+	return nil
+}
+
+func (d *diagnoseCmdCtx) validate() error {
+	if err := d.ValidateResourceSelection(); err != nil {
+		return err
+	}
+	if d.AllNamespaces {
+		return fmt.Errorf("diagnose operates on a single etcd resource and cannot be used with --all-namespaces/-A")
+	}
+	if len(d.ResourceArgs) != 1 {
+		return fmt.Errorf("exactly one etcd resource must be specified")
+	}
+	if d.TailLines == 0 || d.TailLines < -1 {
+		return fmt.Errorf("--tail must be a positive number of lines or -1")
+	}
+	return nil
+}
+
+// execute collects the support bundle of the selected Etcd and runs the built-in checks against it.
+func (d *diagnoseCmdCtx) execute(ctx context.Context) error {
+	etcd, err := d.EtcdClient.GetEtcd(ctx, d.etcdRef.Namespace, d.etcdRef.Name)
+	if err != nil {
+		return fmt.Errorf("etcd %q not found in namespace %q: %w", d.etcdRef.Name, d.etcdRef.Namespace, err)
+	}
+
+	collectedAt := time.Now()
+	rootDir := fmt.Sprintf("diagnose-%s-%s-%s", etcd.Namespace, etcd.Name, collectedAt.UTC().Format("20060102-150405"))
+	bundlePath := d.OutputFile
+	if bundlePath == "" {
+		bundlePath = rootDir + ".tar.gz"
+	}
+	bundle, err := newBundleWriter(bundlePath, rootDir, collectedAt)
+	if err != nil {
+		return err
+	}
+
+	summary := Summary{
+		Etcd:            cmdutils.EtcdRef{Name: etcd.Name, Namespace: etcd.Namespace},
+		CollectedAt:     collectedAt,
+		DruidctlVersion: version.Get().String(),
+		Kind:            "DiagnosticSummary",
+	}
+	if err := d.collect(ctx, bundle, etcd, &summary); err != nil {
+		bundle.abort()
+		return err
+	}
+	summary.Files = bundle.files
+	if err := bundle.addYAML("summary.yaml", summary); err != nil {
+		bundle.abort()
+		return err
+	}
+	if err := bundle.close(); err != nil {
+		return err
+	}
+
+	d.renderChecks(summary.Checks)
+	d.Logger.Success(d.IOStreams.Out, fmt.Sprintf("Wrote support bundle with %d files to %s", len(summary.Files)+1, bundlePath))
+	return nil
+}
+
+// collect writes all data of the given Etcd into the bundle and runs the built-in checks. Failures to collect single
+// pieces of data are recorded in the summary, only failures to write the bundle are returned.
+func (d *diagnoseCmdCtx) collect(ctx context.Context, bundle *bundleWriter, etcd *druidv1alpha1.Etcd, summary *Summary) error {
+	diag := &diagnosis{etcd: etcd, now: summary.CollectedAt}
+	// involvedObjects contains the kind and name of all collected objects whose events are collected.
+	involvedObjects := map[string]bool{involvedObjectKey("Etcd", etcd.Name): true}
+
+	etcdObj, err := toUnstructured(etcd, druidv1alpha1.SchemeGroupVersion.String(), "Etcd")
+	if err != nil {
+		return err
+	}
+	if err := bundle.addYAML("etcd.yaml", etcdObj); err != nil {
+		return err
+	}
+
+	resourceMetas, err := cmdutils.ResolveResourceMetas(d.GenericClient.RESTMapper(), cmdutils.DefaultResourceTokens())
+	if err != nil {
+		return err
+	}
+	for _, resourceMeta := range resourceMetas {
+		resourceList, err := cmdutils.ListManagedResources(ctx, d.GenericClient, etcd, resourceMeta)
+		if err != nil {
+			d.recordCollectionError(summary, "failed to list %s: %v", resourceMeta.GVR.Resource, err)
+			continue
+		}
+		for _, item := range resourceList.Items {
+			item.SetAPIVersion(resourceMeta.GVR.GroupVersion().String())
+			item.SetKind(resourceMeta.Kind)
+			if err := d.addResource(bundle, &item); err != nil {
+				return err
+			}
+			involvedObjects[involvedObjectKey(item.GetKind(), item.GetName())] = true
+			if err := addToDiagnosis(diag, etcd, &item); err != nil {
+				d.recordCollectionError(summary, "failed to convert %s %s: %v", item.GetKind(), item.GetName(), err)
+			}
+		}
+	}
+
+	if err := d.collectBackupSecret(ctx, bundle, diag, involvedObjects, summary); err != nil {
+		return err
+	}
+	if etcd.IsBackupStoreEnabled() {
+		leaseName := druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta)
+		lease, err := d.GenericClient.Kube().CoordinationV1().Leases(etcd.Namespace).Get(ctx, leaseName, metav1.GetOptions{})
+		if err == nil {
+			diag.fullSnapshotLease = lease
+		} else if !apierrors.IsNotFound(err) {
+			d.recordCollectionError(summary, "failed to get lease %s: %v", leaseName, err)
+		}
+	}
+
+	if err := d.collectEvents(ctx, bundle, etcd.Namespace, involvedObjects, summary); err != nil {
+		return err
+	}
+
+	if !d.SkipLogs {
+		for _, pod := range diag.pods {
+			if err := d.collectPodLogs(ctx, bundle, path.Join("logs", pod.Name), &pod, summary); err != nil {
+				return err
+			}
+		}
+		if err := d.collectDruidLogs(ctx, bundle, summary); err != nil {
+			return err
+		}
+	}
+
+	summary.Checks = runChecks(diag)
+	return nil
+}
+
+// addResource writes the given managed resource into the bundle.
+func (d *diagnoseCmdCtx) addResource(bundle *bundleWriter, obj *unstructured.Unstructured) error {
+	sanitizeObject(obj)
+	return bundle.addYAML(path.Join("resources", strings.ToLower(obj.GetKind()), obj.GetName()+".yaml"), obj.Object)
+}
+
+// addToDiagnosis records the managed resources which are needed by the built-in checks.
+func addToDiagnosis(diag *diagnosis, etcd *druidv1alpha1.Etcd, obj *unstructured.Unstructured) error {
+	switch obj.GetKind() {
+	case "StatefulSet":
+		if obj.GetName() != druidv1alpha1.GetStatefulSetName(etcd.ObjectMeta) {
+			return nil
+		}
+		diag.statefulSet = &appsv1.StatefulSet{}
+		return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, diag.statefulSet)
+	case "Pod":
+		pod := corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pod); err != nil {
+			return err
+		}
+		diag.pods = append(diag.pods, pod)
+	case "PersistentVolumeClaim":
+		pvc := corev1.PersistentVolumeClaim{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pvc); err != nil {
+			return err
+		}
+		diag.pvcs = append(diag.pvcs, pvc)
+	}
+	return nil
+}
+
+// collectBackupSecret writes the metadata of the backup secret into the bundle, unless it has already been collected
+// as a managed resource.
+func (d *diagnoseCmdCtx) collectBackupSecret(ctx context.Context, bundle *bundleWriter, diag *diagnosis, involvedObjects map[string]bool, summary *Summary) error {
+	store := diag.etcd.Spec.Backup.Store
+	if store == nil || store.SecretRef == nil || involvedObjects[involvedObjectKey("Secret", store.SecretRef.Name)] {
+		return nil
+	}
+	namespace := store.SecretRef.Namespace
+	if namespace == "" {
+		namespace = diag.etcd.Namespace
+	}
+	secret, err := d.GenericClient.Kube().CoreV1().Secrets(namespace).Get(ctx, store.SecretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			diag.backupSecretMissing = true
+			return nil
+		}
+		d.recordCollectionError(summary, "failed to get backup secret %s: %v", store.SecretRef.Name, err)
+		return nil
+	}
+	obj, err := toUnstructured(secret, "v1", "Secret")
+	if err != nil {
+		return err
+	}
+	involvedObjects[involvedObjectKey("Secret", secret.Name)] = true
+	return d.addResource(bundle, obj)
+}
+
+// collectEvents writes the events of all collected objects into the bundle.
+func (d *diagnoseCmdCtx) collectEvents(ctx context.Context, bundle *bundleWriter, namespace string, involvedObjects map[string]bool, summary *Summary) error {
+	eventList, err := d.GenericClient.Kube().CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		d.recordCollectionError(summary, "failed to list events: %v", err)
+		return nil
+	}
+	events := &corev1.EventList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "EventList"}}
+	for _, event := range eventList.Items {
+		if involvedObjects[involvedObjectKey(event.InvolvedObject.Kind, event.InvolvedObject.Name)] {
+			event.ManagedFields = nil
+			events.Items = append(events.Items, event)
+		}
+	}
+	sort.SliceStable(events.Items, func(i, j int) bool {
+		return eventTime(&events.Items[i]).Before(eventTime(&events.Items[j]))
+	})
+	return bundle.addYAML("events.yaml", events)
+}
+
+// collectPodLogs writes the logs of all containers of the given pod into the given directory of the bundle. The logs
+// of the previous instance of a container are collected as well if it has been restarted.
+func (d *diagnoseCmdCtx) collectPodLogs(ctx context.Context, bundle *bundleWriter, dir string, pod *corev1.Pod, summary *Summary) error {
+	restartCounts := make(map[string]int32)
+	for _, containerStatus := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		restartCounts[containerStatus.Name] = containerStatus.RestartCount
+	}
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if err := d.collectContainerLogs(ctx, bundle, path.Join(dir, container.Name+".log"), pod, container.Name, false, summary); err != nil {
+			return err
+		}
+		if restartCounts[container.Name] == 0 {
+			continue
+		}
+		if err := d.collectContainerLogs(ctx, bundle, path.Join(dir, container.Name+".previous.log"), pod, container.Name, true, summary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *diagnoseCmdCtx) collectContainerLogs(ctx context.Context, bundle *bundleWriter, name string, pod *corev1.Pod, container string, previous bool, summary *Summary) error {
+	logOptions := &corev1.PodLogOptions{Container: container, Previous: previous}
+	if d.TailLines > 0 {
+		logOptions.TailLines = ptr.To(d.TailLines)
+	}
+	logs, err := d.GenericClient.Kube().CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).DoRaw(ctx)
+	if err != nil {
+		d.recordCollectionError(summary, "failed to get logs of container %s of pod %s/%s: %v", container, pod.Namespace, pod.Name, err)
+		return nil
+	}
+	return bundle.add(name, logs)
+}
+
+// collectDruidLogs writes the logs of the etcd-druid operator pods into the bundle.
+func (d *diagnoseCmdCtx) collectDruidLogs(ctx context.Context, bundle *bundleWriter, summary *Summary) error {
+	podList, err := d.GenericClient.Kube().CoreV1().Pods(d.DruidNamespace).List(ctx, metav1.ListOptions{LabelSelector: d.DruidSelector})
+	if err != nil {
+		d.recordCollectionError(summary, "failed to list etcd-druid pods: %v", err)
+		return nil
+	}
+	if len(podList.Items) == 0 {
+		d.recordCollectionError(summary, "no etcd-druid pods found with selector %q", d.DruidSelector)
+		return nil
+	}
+	for _, pod := range podList.Items {
+		if err := d.collectPodLogs(ctx, bundle, path.Join("logs", "etcd-druid", pod.Namespace, pod.Name), &pod, summary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordCollectionError records a failure to collect data in the summary and warns the user about it.
+func (d *diagnoseCmdCtx) recordCollectionError(summary *Summary, format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	summary.CollectionErrors = append(summary.CollectionErrors, message)
+	d.Logger.Warning(d.IOStreams.ErrOut, message)
+}
+
+// toUnstructured converts the given typed object into a sanitized unstructured object.
+func toUnstructured(obj runtime.Object, apiVersion, kind string) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s: %w", kind, err)
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	sanitizeObject(u)
+	return u, nil
+}
+
+func involvedObjectKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+func eventTime(event *corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
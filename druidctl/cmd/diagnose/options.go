@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type diagnoseOptions struct {
+	*cmdutils.GlobalOptions
+	// OutputFile is the path of the support bundle.
+	OutputFile string
+	// TailLines is the number of log lines collected per container, a negative value collects the complete logs.
+	TailLines int64
+	// SkipLogs disables the collection of container logs.
+	SkipLogs bool
+	// DruidNamespace is the namespace of the etcd-druid operator, an empty value searches all namespaces.
+	DruidNamespace string
+	// DruidSelector is the label selector of the etcd-druid operator pods.
+	DruidSelector string
+}
+
+type diagnoseRuntime struct {
+	*cmdutils.RuntimeEnv
+	etcdRef       types.NamespacedName
+	EtcdClient    client.EtcdClientInterface
+	GenericClient client.GenericClientInterface
+}
+
+type diagnoseCmdCtx struct {
+	*diagnoseOptions
+	*diagnoseRuntime
+}
+
+func newDiagnoseOptions(options *cmdutils.GlobalOptions) *diagnoseOptions {
+	return &diagnoseOptions{
+		GlobalOptions: options,
+	}
+}
+
+func newDiagnoseRuntime(runtime *cmdutils.RuntimeEnv) *diagnoseRuntime {
+	return &diagnoseRuntime{
+		RuntimeEnv: runtime,
+	}
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"fmt"
+	"text/tabwriter"
+)
+
+// renderChecks prints the results of the built-in checks as a table.
+func (d *diagnoseCmdCtx) renderChecks(results []CheckResult) {
+	d.Logger.RawHeader(d.IOStreams.Out, fmt.Sprintf("Checks of Etcd [%s/%s]", d.etcdRef.Namespace, d.etcdRef.Name))
+	w := tabwriter.NewWriter(d.IOStreams.Out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tRESULT\tMESSAGE")
+	for _, result := range results {
+		message := result.Message
+		if message == "" {
+			message = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", result.Name, result.Severity, message)
+	}
+	if err := w.Flush(); err != nil {
+		d.Logger.Warning(d.IOStreams.ErrOut, "Failed writing check results: ", err.Error())
+	}
+}
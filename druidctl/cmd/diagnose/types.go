@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+)
+
+// CheckSeverity is the outcome of a built-in check.
+type CheckSeverity string
+
+const (
+	// CheckSeverityPass indicates that no problem has been found.
+	CheckSeverityPass CheckSeverity = "Pass"
+	// CheckSeverityWarning indicates a misconfiguration or a degraded state which does not yet affect availability.
+	CheckSeverityWarning CheckSeverity = "Warning"
+	// CheckSeverityError indicates a problem which affects the availability or the recoverability of the etcd cluster.
+	CheckSeverityError CheckSeverity = "Error"
+)
+
+// CheckResult is the result of a single built-in check.
+type CheckResult struct {
+	Name     string        `json:"name" yaml:"name"`
+	Severity CheckSeverity `json:"severity" yaml:"severity"`
+	Message  string        `json:"message" yaml:"message"`
+}
+
+// Summary describes the contents of a support bundle. It is stored as summary.yaml in the bundle.
+type Summary struct {
+	Etcd        cmdutils.EtcdRef `json:"etcd" yaml:"etcd"`
+	CollectedAt time.Time        `json:"collectedAt" yaml:"collectedAt"`
+	// DruidctlVersion is the version of druidctl which collected the bundle.
+	DruidctlVersion string        `json:"druidctlVersion" yaml:"druidctlVersion"`
+	Checks          []CheckResult `json:"checks" yaml:"checks"`
+	// Files are the paths of all files in the bundle, relative to its root directory.
+	Files []string `json:"files" yaml:"files"`
+	// CollectionErrors are the errors which occurred while collecting data. The bundle is still written in this case,
+	// but lacks the affected data.
+	CollectionErrors []string `json:"collectionErrors,omitempty" yaml:"collectionErrors,omitempty"`
+	Kind             string   `json:"kind" yaml:"kind"`
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdctl
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultDialTimeout    = 10 * time.Second
+	defaultCommandTimeout = 30 * time.Second
+)
+
+var (
+	example = `
+# Get all keys with the prefix /registry/pods from an etcd resource in the test namespace
+kubectl druid etcdctl test/my-etcd -- get /registry/pods --prefix --keys-only
+
+# Show the status of a specific member
+kubectl druid etcdctl test/my-etcd --pod my-etcd-1 -- endpoint status
+
+# List the members of the cluster as JSON
+kubectl druid etcdctl test/my-etcd -o json -- member list
+
+# Disarm all alarms, which modifies etcd and therefore has to be allowed explicitly
+kubectl druid etcdctl test/my-etcd --allow-mutation -- alarm disarm
+
+# Start an interactive session
+kubectl druid etcdctl test/my-etcd
+`
+)
+
+// NewEtcdctlCommand creates the etcdctl command
+func NewEtcdctlCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	etcdctlOptions := newEtcdctlOptions(cmdCtx.Options)
+
+	etcdctlCmd := &cobra.Command{
+		Use:   "etcdctl <etcd-resource-name> [flags] [-- <etcd-command> [args]]",
+		Short: "Run etcdctl-style commands against an etcd cluster",
+		Long: `Run etcdctl-style commands against an etcd cluster.
+The client TLS secrets referenced by the etcd resource are resolved and a temporary port-forward to the client port
+of a ready member pod is set up, over which the commands are run with the etcd client. The port-forward is closed when
+the command completes. If no etcd command is given after --, an interactive session is started.
+
+Supported commands: get, member list, endpoint status, endpoint health and alarm list.
+The commands put, del and alarm disarm modify etcd and are only run with --allow-mutation.`,
+		Args:    cobra.MinimumNArgs(1),
+		Example: example,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			etcdctlCmdCtx := &etcdctlCmdCtx{
+				etcdctlOptions: etcdctlOptions,
+				etcdctlRuntime: newEtcdctlRuntime(cmdCtx.Runtime),
+			}
+			etcdctlCmdCtx.ResourceArgs, etcdctlCmdCtx.etcdArgs = splitArgs(cmd, args)
+			if err := etcdctlCmdCtx.validate(); err != nil {
+				if herr := cmd.Help(); herr != nil {
+					cmdCtx.Runtime.Logger.Warning(cmdCtx.Runtime.IOStreams.ErrOut, "Failed to show help: ", herr.Error())
+				}
+				return err
+			}
+
+			if err := etcdctlCmdCtx.complete(); err != nil {
+				return err
+			}
+
+			if err := etcdctlCmdCtx.execute(cmdutils.CmdContext(cmd)); err != nil {
+				cmdCtx.Runtime.Logger.Error(cmdCtx.Runtime.IOStreams.ErrOut, "Running etcd command failed", err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	etcdctlCmd.Flags().StringVar(&etcdctlOptions.Pod, "pod", "", "Name of the etcd member pod to connect to. Defaults to the first ready member pod")
+	etcdctlCmd.Flags().BoolVar(&etcdctlOptions.AllowMutation, "allow-mutation", false, "Allow commands which modify etcd, i.e. put, del and alarm disarm")
+	etcdctlCmd.Flags().StringVar(&etcdctlOptions.TLSServerName, "tls-server-name", "", "Server name to verify the server certificate of etcd with. Defaults to the DNS name of the client service")
+	etcdctlCmd.Flags().DurationVar(&etcdctlOptions.DialTimeout, "dial-timeout", defaultDialTimeout, "Timeout for establishing the connection to etcd")
+	etcdctlCmd.Flags().DurationVar(&etcdctlOptions.CommandTimeout, "command-timeout", defaultCommandTimeout, "Timeout of a single etcd command")
+	etcdctlOptions.PrintFlags.AddFlags(etcdctlCmd)
+
+	return etcdctlCmd
+}
+
+// splitArgs splits the arguments into the etcd resource and the etcd command, which follows after `--`. Without
+// `--`, all arguments after the first one are treated as the etcd command.
+func splitArgs(cmd *cobra.Command, args []string) ([]string, []string) {
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+		return args[:dash], args[dash:]
+	}
+	if len(args) == 0 {
+		return nil, nil
+	}
+	return args[:1], args[1:]
+}
@@ -0,0 +1,298 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdctl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/etcdconn"
+
+	"github.com/spf13/pflag"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// etcdCommand is a command which is run against etcd, modelled after the corresponding etcdctl command.
+type etcdCommand struct {
+	// name is the name of the command, which consists of one or two words, e.g. `get` or `member list`.
+	name        string
+	usage       string
+	description string
+	// mutating is true if the command modifies the data or the state of etcd.
+	mutating bool
+	run      func(ctx context.Context, conn *etcdconn.Connection, args []string) (any, error)
+}
+
+// successMessage is the result of a command which is printed as a message instead of with the printer.
+type successMessage string
+
+// etcdCommands are the supported etcd commands.
+var etcdCommands = []etcdCommand{
+	{
+		name:        "get",
+		usage:       "get <key> [--prefix] [--keys-only] [--limit=<n>] [--rev=<revision>]",
+		description: "Get the key or the keys with the given prefix",
+		run:         runGet,
+	},
+	{
+		name:        "member list",
+		usage:       "member list",
+		description: "List the members of the cluster",
+		run:         runMemberList,
+	},
+	{
+		name:        "endpoint status",
+		usage:       "endpoint status",
+		description: "Show the status of the connected member",
+		run:         runEndpointStatus,
+	},
+	{
+		name:        "endpoint health",
+		usage:       "endpoint health",
+		description: "Check the health of the connected member",
+		run:         runEndpointHealth,
+	},
+	{
+		name:        "alarm list",
+		usage:       "alarm list",
+		description: "List the active alarms of the cluster",
+		run:         runAlarmList,
+	},
+	{
+		name:        "put",
+		usage:       "put <key> <value>",
+		description: "Put the given key",
+		mutating:    true,
+		run:         runPut,
+	},
+	{
+		name:        "del",
+		usage:       "del <key> [--prefix]",
+		description: "Delete the key or the keys with the given prefix",
+		mutating:    true,
+		run:         runDel,
+	},
+	{
+		name:        "alarm disarm",
+		usage:       "alarm disarm",
+		description: "Disarm all active alarms of the cluster",
+		mutating:    true,
+		run:         runAlarmDisarm,
+	},
+}
+
+// findCommand returns the etcd command with which the given arguments start, and the remaining arguments.
+func findCommand(args []string) (*etcdCommand, []string, error) {
+	if len(args) == 0 {
+		return nil, nil, fmt.Errorf("no etcd command specified")
+	}
+	for i := range etcdCommands {
+		words := strings.Fields(etcdCommands[i].name)
+		if len(args) >= len(words) && strings.Join(args[:len(words)], " ") == etcdCommands[i].name {
+			return &etcdCommands[i], args[len(words):], nil
+		}
+	}
+	return nil, nil, fmt.Errorf("unknown etcd command %q, supported commands are: %s", strings.Join(args, " "), strings.Join(commandNames(), ", "))
+}
+
+func commandNames() []string {
+	names := make([]string, 0, len(etcdCommands))
+	for _, command := range etcdCommands {
+		names = append(names, command.name)
+	}
+	return names
+}
+
+// parseCommandArgs parses the flags of a command from the given arguments and checks the number of its positional
+// arguments.
+func parseCommandArgs(name string, flags *pflag.FlagSet, args []string, numArgs int) ([]string, error) {
+	flags.SetOutput(io.Discard)
+	if err := flags.Parse(args); err != nil {
+		return nil, fmt.Errorf("invalid arguments for %s: %w", name, err)
+	}
+	if flags.NArg() != numArgs {
+		return nil, fmt.Errorf("%s expects %d argument(s), got %d", name, numArgs, flags.NArg())
+	}
+	return flags.Args(), nil
+}
+
+func runGet(ctx context.Context, conn *etcdconn.Connection, args []string) (any, error) {
+	flags := pflag.NewFlagSet("get", pflag.ContinueOnError)
+	prefix := flags.Bool("prefix", false, "Get the keys with the given prefix")
+	keysOnly := flags.Bool("keys-only", false, "Get only the keys")
+	limit := flags.Int64("limit", 0, "Maximum number of keys to get")
+	rev := flags.Int64("rev", 0, "Revision to get the keys at")
+	positional, err := parseCommandArgs("get", flags, args, 1)
+	if err != nil {
+		return nil, err
+	}
+	opts := []clientv3.OpOption{clientv3.WithLimit(*limit), clientv3.WithRev(*rev)}
+	if *prefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+	if *keysOnly {
+		opts = append(opts, clientv3.WithKeysOnly())
+	}
+	resp, err := conn.API.Get(ctx, positional[0], opts...)
+	if err != nil {
+		return nil, err
+	}
+	result := KeyValueList{Revision: resp.Header.GetRevision(), Count: resp.Count, More: resp.More, Kind: "KeyValueList"}
+	for _, kv := range resp.Kvs {
+		result.KeyValues = append(result.KeyValues, KeyValue{
+			Key:            string(kv.Key),
+			Value:          kv.Value,
+			CreateRevision: kv.CreateRevision,
+			ModRevision:    kv.ModRevision,
+			Version:        kv.Version,
+			Lease:          kv.Lease,
+		})
+	}
+	return result, nil
+}
+
+func runMemberList(ctx context.Context, conn *etcdconn.Connection, args []string) (any, error) {
+	if _, err := parseCommandArgs("member list", pflag.NewFlagSet("member list", pflag.ContinueOnError), args, 0); err != nil {
+		return nil, err
+	}
+	resp, err := conn.API.MemberList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := MemberList{Kind: "MemberList"}
+	for _, member := range resp.Members {
+		result.Members = append(result.Members, Member{
+			ID:         etcdconn.FormatID(member.ID),
+			Name:       member.Name,
+			PeerURLs:   member.PeerURLs,
+			ClientURLs: member.ClientURLs,
+			IsLearner:  member.IsLearner,
+		})
+	}
+	return result, nil
+}
+
+func runEndpointStatus(ctx context.Context, conn *etcdconn.Connection, args []string) (any, error) {
+	if _, err := parseCommandArgs("endpoint status", pflag.NewFlagSet("endpoint status", pflag.ContinueOnError), args, 0); err != nil {
+		return nil, err
+	}
+	resp, err := conn.API.Status(ctx, conn.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return EndpointStatus{
+		Member:      conn.Member,
+		ID:          etcdconn.FormatID(resp.Header.GetMemberId()),
+		Version:     resp.Version,
+		DBSize:      resp.DbSize,
+		DBSizeInUse: resp.DbSizeInUse,
+		Leader:      etcdconn.FormatID(resp.Leader),
+		IsLeader:    resp.Header.GetMemberId() == resp.Leader,
+		IsLearner:   resp.IsLearner,
+		RaftTerm:    resp.RaftTerm,
+		RaftIndex:   resp.RaftIndex,
+		Revision:    resp.Header.GetRevision(),
+		Errors:      resp.Errors,
+		Kind:        "EndpointStatus",
+	}, nil
+}
+
+// runEndpointHealth checks the health of the connected member the same way as etcdctl does: the member is healthy if
+// it serves a linearizable read and has no active alarms.
+func runEndpointHealth(ctx context.Context, conn *etcdconn.Connection, args []string) (any, error) {
+	if _, err := parseCommandArgs("endpoint health", pflag.NewFlagSet("endpoint health", pflag.ContinueOnError), args, 0); err != nil {
+		return nil, err
+	}
+	result := EndpointHealth{Member: conn.Member, Healthy: true, Kind: "EndpointHealth"}
+	start := time.Now()
+	// A permission denied error is expected if auth is enabled, and still means that the member is healthy.
+	_, err := conn.API.Get(ctx, "health")
+	result.Took = time.Since(start).Round(time.Millisecond).String()
+	if err != nil && !errors.Is(err, rpctypes.ErrPermissionDenied) {
+		result.Healthy = false
+		result.Error = err.Error()
+		return result, nil
+	}
+	alarms, err := conn.API.AlarmList(ctx)
+	if err != nil {
+		result.Healthy = false
+		result.Error = fmt.Sprintf("failed to list alarms: %v", err)
+		return result, nil
+	}
+	if len(alarms.Alarms) > 0 {
+		result.Healthy = false
+		activeAlarms := make([]string, 0, len(alarms.Alarms))
+		for _, alarm := range alarms.Alarms {
+			activeAlarms = append(activeAlarms, alarm.Alarm.String())
+		}
+		result.Error = fmt.Sprintf("active alarms: %s", strings.Join(activeAlarms, ", "))
+	}
+	return result, nil
+}
+
+func runAlarmList(ctx context.Context, conn *etcdconn.Connection, args []string) (any, error) {
+	if _, err := parseCommandArgs("alarm list", pflag.NewFlagSet("alarm list", pflag.ContinueOnError), args, 0); err != nil {
+		return nil, err
+	}
+	resp, err := conn.API.AlarmList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toAlarmList(resp), nil
+}
+
+func runPut(ctx context.Context, conn *etcdconn.Connection, args []string) (any, error) {
+	positional, err := parseCommandArgs("put", pflag.NewFlagSet("put", pflag.ContinueOnError), args, 2)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := conn.API.Put(ctx, positional[0], positional[1])
+	if err != nil {
+		return nil, err
+	}
+	return successMessage(fmt.Sprintf("Put key %q at revision %d", positional[0], resp.Header.GetRevision())), nil
+}
+
+func runDel(ctx context.Context, conn *etcdconn.Connection, args []string) (any, error) {
+	flags := pflag.NewFlagSet("del", pflag.ContinueOnError)
+	prefix := flags.Bool("prefix", false, "Delete the keys with the given prefix")
+	positional, err := parseCommandArgs("del", flags, args, 1)
+	if err != nil {
+		return nil, err
+	}
+	var opts []clientv3.OpOption
+	if *prefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+	resp, err := conn.API.Delete(ctx, positional[0], opts...)
+	if err != nil {
+		return nil, err
+	}
+	return successMessage(fmt.Sprintf("Deleted %d key(s) at revision %d", resp.Deleted, resp.Header.GetRevision())), nil
+}
+
+func runAlarmDisarm(ctx context.Context, conn *etcdconn.Connection, args []string) (any, error) {
+	if _, err := parseCommandArgs("alarm disarm", pflag.NewFlagSet("alarm disarm", pflag.ContinueOnError), args, 0); err != nil {
+		return nil, err
+	}
+	resp, err := conn.API.AlarmDisarm(ctx, &clientv3.AlarmMember{})
+	if err != nil {
+		return nil, err
+	}
+	return successMessage(fmt.Sprintf("Disarmed %d alarm(s)", len(resp.Alarms))), nil
+}
+
+func toAlarmList(resp *clientv3.AlarmResponse) AlarmList {
+	result := AlarmList{Kind: "AlarmList"}
+	for _, alarm := range resp.Alarms {
+		result.Alarms = append(result.Alarms, Alarm{MemberID: etcdconn.FormatID(alarm.MemberID), Type: alarm.Alarm.String()})
+	}
+	return result
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdctl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/etcdconn"
+)
+
+// interactivePrompt is the prompt of an interactive session.
+const interactivePrompt = "etcdctl> "
+
+func (e *etcdctlCmdCtx) validate() error {
+	if err := e.ValidateResourceSelection(); err != nil {
+		return err
+	}
+	if e.AllNamespaces {
+		return fmt.Errorf("etcdctl operates on a single etcd resource and cannot be used with --all-namespaces/-A")
+	}
+	if len(e.ResourceArgs) != 1 {
+		return fmt.Errorf("exactly one etcd resource must be specified, the etcd command must follow after --")
+	}
+	if len(e.etcdArgs) == 0 {
+		return nil
+	}
+	command, _, err := findCommand(e.etcdArgs)
+	if err != nil {
+		return err
+	}
+	return e.checkMutation(command)
+}
+
+// checkMutation checks that the given command is allowed to run.
+func (e *etcdctlCmdCtx) checkMutation(command *etcdCommand) error {
+	if command.mutating && !e.AllowMutation {
+		return fmt.Errorf("%q modifies etcd and is only run with --allow-mutation", command.name)
+	}
+	return nil
+}
+
+func (e *etcdctlCmdCtx) complete() error {
+	etcdClient, err := e.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	e.EtcdClient = etcdClient
+
+	genericClient, err := e.Clients.GenericClient()
+	if err != nil {
+		return fmt.Errorf("unable to create generic kube clients: %w", err)
+	}
+	e.GenericClient = genericClient
+
+	e.Printer, err = e.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("failed to create formatter: %w", err)
+	}
+
+	e.etcdRef = e.BuildEtcdRefList()[0]
+	return nil
+}
+
+// execute connects to a member of the selected Etcd and runs the given etcd command, or starts an interactive session
+// if no command is given.
+func (e *etcdctlCmdCtx) execute(ctx context.Context) error {
+	etcd, err := e.EtcdClient.GetEtcd(ctx, e.etcdRef.Namespace, e.etcdRef.Name)
+	if err != nil {
+		return fmt.Errorf("etcd %q not found in namespace %q: %w", e.etcdRef.Name, e.etcdRef.Namespace, err)
+	}
+	config, err := etcdconn.ResolveConfig(ctx, e.GenericClient, etcd, etcdconn.Options{
+		Pod:           e.Pod,
+		TLSServerName: e.TLSServerName,
+		DialTimeout:   e.DialTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	if e.Verbose {
+		e.Logger.Info(e.IOStreams.ErrOut, fmt.Sprintf("Connecting to etcd member %s on port %d", config.PodName, config.Port))
+	}
+	conn, err := e.connect(ctx, e.GenericClient, config, e.IOStreams.ErrOut)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if len(e.etcdArgs) > 0 {
+		return e.runCommand(ctx, conn, e.etcdArgs)
+	}
+	return e.runInteractive(ctx, conn)
+}
+
+// runCommand runs the etcd command given by args and prints its result.
+func (e *etcdctlCmdCtx) runCommand(ctx context.Context, conn *etcdconn.Connection, args []string) error {
+	command, commandArgs, err := findCommand(args)
+	if err != nil {
+		return err
+	}
+	if err := e.checkMutation(command); err != nil {
+		return err
+	}
+	commandCtx, cancel := context.WithTimeout(ctx, e.CommandTimeout)
+	defer cancel()
+	result, err := command.run(commandCtx, conn, commandArgs)
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", command.name, err)
+	}
+
+	if message, ok := result.(successMessage); ok {
+		e.Logger.Success(e.IOStreams.Out, string(message))
+		return nil
+	}
+	if kvList, ok := result.(KeyValueList); ok && len(kvList.KeyValues) == 0 && e.PrintFlags.IsTableOutput() {
+		e.Logger.Info(e.IOStreams.Out, "No keys found")
+		return nil
+	}
+	outputData, err := e.Printer.Print(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result to desired format: %w", err)
+	}
+	fmt.Fprintf(e.IOStreams.Out, "%s\n", string(outputData))
+	return nil
+}
+
+// runInteractive reads etcd commands line by line from the input stream and runs them over the same connection, until
+// the input ends or `exit` is entered. Failing commands do not end the session.
+func (e *etcdctlCmdCtx) runInteractive(ctx context.Context, conn *etcdconn.Connection) error {
+	e.Logger.Info(e.IOStreams.ErrOut, fmt.Sprintf("Connected to etcd member %s. Enter 'help' to list the supported commands and 'exit' to quit.", conn.Member))
+	scanner := bufio.NewScanner(e.IOStreams.In)
+	for {
+		fmt.Fprint(e.IOStreams.Out, interactivePrompt)
+		if !scanner.Scan() {
+			fmt.Fprintln(e.IOStreams.Out)
+			return scanner.Err()
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line := strings.TrimSpace(scanner.Text())
+		switch line {
+		case "":
+			continue
+		case "exit", "quit":
+			return nil
+		case "help":
+			e.printHelp()
+			continue
+		}
+		args, err := splitCommandLine(line)
+		if err == nil {
+			err = e.runCommand(ctx, conn, args)
+		}
+		if err != nil {
+			e.Logger.Error(e.IOStreams.ErrOut, "Command failed", err)
+		}
+	}
+}
+
+func (e *etcdctlCmdCtx) printHelp() {
+	w := tabwriter.NewWriter(e.IOStreams.Out, 0, 8, 2, ' ', 0)
+	for _, command := range etcdCommands {
+		description := command.description
+		if command.mutating {
+			description += " (requires --allow-mutation)"
+		}
+		fmt.Fprintf(w, "  %s\t%s\n", command.usage, description)
+	}
+	fmt.Fprintf(w, "  exit\tEnd the session\n")
+	_ = w.Flush()
+}
+
+// splitCommandLine splits a command line into arguments at whitespace. Single and double quotes group characters into
+// one argument, and a backslash escapes the following character outside of single quotes.
+func splitCommandLine(line string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in %q", line)
+	}
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash in %q", line)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
@@ -0,0 +1,421 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdctl
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+	fake "github.com/gardener/etcd-druid/druidctl/internal/client/fake"
+	"github.com/gardener/etcd-druid/druidctl/internal/etcdconn"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/utils/ptr"
+)
+
+// fakeEtcdAPI is an in-memory etcdAPI, which records the keys which were put and deleted.
+type fakeEtcdAPI struct {
+	kvs     []*mvccpb.KeyValue
+	members []*etcdserverpb.Member
+	alarms  []*etcdserverpb.AlarmMember
+	puts    map[string]string
+	deletes []string
+}
+
+func newFakeEtcdAPI() *fakeEtcdAPI {
+	return &fakeEtcdAPI{
+		kvs: []*mvccpb.KeyValue{
+			{Key: []byte("/foo/a"), Value: []byte("1"), CreateRevision: 2, ModRevision: 3, Version: 2},
+			{Key: []byte("/foo/b"), Value: []byte{0xff, 0x00}, CreateRevision: 4, ModRevision: 4, Version: 1},
+		},
+		members: []*etcdserverpb.Member{
+			{ID: 0xa1, Name: "test-etcd-0", PeerURLs: []string{"http://test-etcd-0:2380"}, ClientURLs: []string{"http://test-etcd-0:2379"}},
+			{ID: 0xb2, Name: "test-etcd-1", PeerURLs: []string{"http://test-etcd-1:2380"}, ClientURLs: []string{"http://test-etcd-1:2379"}},
+		},
+		puts: make(map[string]string),
+	}
+}
+
+func (f *fakeEtcdAPI) Get(_ context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	op := clientv3.OpGet(key, opts...)
+	resp := &clientv3.GetResponse{Header: &etcdserverpb.ResponseHeader{Revision: 10}}
+	for _, kv := range f.kvs {
+		if string(kv.Key) == key || (len(op.RangeBytes()) > 0 && strings.HasPrefix(string(kv.Key), key)) {
+			resp.Kvs = append(resp.Kvs, kv)
+		}
+	}
+	resp.Count = int64(len(resp.Kvs))
+	return resp, nil
+}
+
+func (f *fakeEtcdAPI) Put(_ context.Context, key, val string, _ ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	f.puts[key] = val
+	return &clientv3.PutResponse{Header: &etcdserverpb.ResponseHeader{Revision: 11}}, nil
+}
+
+func (f *fakeEtcdAPI) Delete(_ context.Context, key string, _ ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
+	f.deletes = append(f.deletes, key)
+	return &clientv3.DeleteResponse{Header: &etcdserverpb.ResponseHeader{Revision: 11}, Deleted: 1}, nil
+}
+
+func (f *fakeEtcdAPI) MemberRemove(_ context.Context, _ uint64) (*clientv3.MemberRemoveResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeEtcdAPI) MoveLeader(_ context.Context, _ uint64) (*clientv3.MoveLeaderResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeEtcdAPI) MemberList(_ context.Context, _ ...clientv3.OpOption) (*clientv3.MemberListResponse, error) {
+	return &clientv3.MemberListResponse{Members: f.members}, nil
+}
+
+func (f *fakeEtcdAPI) Status(_ context.Context, _ string) (*clientv3.StatusResponse, error) {
+	return &clientv3.StatusResponse{
+		Header:  &etcdserverpb.ResponseHeader{MemberId: 0xa1, Revision: 10},
+		Version: "3.5.21",
+		DbSize:  2048,
+		Leader:  0xa1,
+	}, nil
+}
+
+func (f *fakeEtcdAPI) AlarmList(_ context.Context) (*clientv3.AlarmResponse, error) {
+	return &clientv3.AlarmResponse{Alarms: f.alarms}, nil
+}
+
+func (f *fakeEtcdAPI) AlarmDisarm(_ context.Context, _ *clientv3.AlarmMember) (*clientv3.AlarmResponse, error) {
+	resp := &clientv3.AlarmResponse{Alarms: f.alarms}
+	f.alarms = nil
+	return resp, nil
+}
+
+func newTestPod(name string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    druidv1alpha1.GetDefaultLabels(fake.NewEtcdBuilder("default", "test-etcd").Build().ObjectMeta),
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+// newTestCmdCtx returns a completed etcdctl command context for the given objects, which connects to the given fake
+// etcd API, and the buffers of its output streams.
+func newTestCmdCtx(t *testing.T, etcdObjects, k8sObjects []runtime.Object, api etcdconn.API, args, etcdArgs []string) (*etcdctlCmdCtx, *bytes.Buffer, *bytes.Buffer, *etcdconn.Config) {
+	t.Helper()
+	cmdCtx := fake.NewTestHelper().WithEtcdObjects(etcdObjects).WithK8sObjects(k8sObjects).CreateTestCommandContext()
+	streams, _, out, errOut := genericiooptions.NewTestIOStreams()
+	cmdCtx.Runtime.IOStreams = streams
+
+	cmd := NewEtcdctlCommand(cmdCtx)
+	if err := cmdCtx.Complete(cmd, args); err != nil {
+		t.Fatalf("Failed to complete options: %v", err)
+	}
+	opts := newEtcdctlOptions(cmdCtx.Options)
+	opts.DialTimeout = defaultDialTimeout
+	opts.CommandTimeout = defaultCommandTimeout
+	opts.etcdArgs = etcdArgs
+	runtime := newEtcdctlRuntime(cmdCtx.Runtime)
+	usedConfig := &etcdconn.Config{}
+	runtime.connect = func(_ context.Context, _ client.GenericClientInterface, config etcdconn.Config, _ io.Writer) (*etcdconn.Connection, error) {
+		*usedConfig = config
+		return etcdconn.NewConnection(api, "http://127.0.0.1:12379", config.PodName, nil), nil
+	}
+	e := &etcdctlCmdCtx{etcdctlOptions: opts, etcdctlRuntime: runtime}
+	return e, out, errOut, usedConfig
+}
+
+func run(e *etcdctlCmdCtx) error {
+	if err := e.validate(); err != nil {
+		return err
+	}
+	if err := e.complete(); err != nil {
+		return err
+	}
+	return e.execute(context.Background())
+}
+
+func TestGetCommand(t *testing.T) {
+	etcdObjects := []runtime.Object{fake.NewEtcdBuilder("default", "test-etcd").Build()}
+	k8sObjects := []runtime.Object{newTestPod("test-etcd-1", true), newTestPod("test-etcd-0", false)}
+
+	e, out, _, config := newTestCmdCtx(t, etcdObjects, k8sObjects, newFakeEtcdAPI(), []string{"test-etcd"}, []string{"get", "/foo", "--prefix"})
+	if err := run(e); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if config.PodName != "test-etcd-1" {
+		t.Errorf("Expected to connect to the ready pod test-etcd-1, got %q", config.PodName)
+	}
+	if config.Port != 2379 || config.TLSConfig != nil {
+		t.Errorf("Expected plain connection to port 2379, got port %d with TLS %v", config.Port, config.TLSConfig != nil)
+	}
+	for _, expected := range []string{"KEY", "/foo/a", "/foo/b", `"\xff\x00"`} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, out.String())
+		}
+	}
+}
+
+func TestMemberListJSON(t *testing.T) {
+	etcdObjects := []runtime.Object{fake.NewEtcdBuilder("default", "test-etcd").Build()}
+	k8sObjects := []runtime.Object{newTestPod("test-etcd-0", true)}
+
+	e, out, _, _ := newTestCmdCtx(t, etcdObjects, k8sObjects, newFakeEtcdAPI(), []string{"test-etcd"}, []string{"member", "list"})
+	e.PrintFlags.OutputFormat = "json"
+	if err := run(e); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	var result MemberList
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal output: %v\n%s", err, out.String())
+	}
+	if result.Kind != "MemberList" || len(result.Members) != 2 || result.Members[0].ID != "a1" {
+		t.Errorf("Unexpected member list: %+v", result)
+	}
+}
+
+func TestEndpointHealthWithAlarms(t *testing.T) {
+	api := newFakeEtcdAPI()
+	api.alarms = []*etcdserverpb.AlarmMember{{MemberID: 0xa1, Alarm: etcdserverpb.AlarmType_NOSPACE}}
+
+	result, err := runEndpointHealth(context.Background(), etcdconn.NewConnection(api, "", "test-etcd-0", nil), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	health := result.(EndpointHealth)
+	if health.Healthy || !strings.Contains(health.Error, "NOSPACE") {
+		t.Errorf("Expected unhealthy member with NOSPACE alarm, got %+v", health)
+	}
+}
+
+func TestMutatingCommands(t *testing.T) {
+	etcdObjects := []runtime.Object{fake.NewEtcdBuilder("default", "test-etcd").Build()}
+	k8sObjects := []runtime.Object{newTestPod("test-etcd-0", true)}
+
+	api := newFakeEtcdAPI()
+	e, _, _, _ := newTestCmdCtx(t, etcdObjects, k8sObjects, api, []string{"test-etcd"}, []string{"put", "/foo/c", "3"})
+	err := run(e)
+	if err == nil || !strings.Contains(err.Error(), "--allow-mutation") {
+		t.Fatalf("Expected mutation to be rejected without --allow-mutation, got: %v", err)
+	}
+	if len(api.puts) != 0 {
+		t.Fatalf("Expected no key to be put, got %v", api.puts)
+	}
+
+	e, out, _, _ := newTestCmdCtx(t, etcdObjects, k8sObjects, api, []string{"test-etcd"}, []string{"put", "/foo/c", "3"})
+	e.AllowMutation = true
+	if err := run(e); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if api.puts["/foo/c"] != "3" {
+		t.Errorf("Expected key /foo/c to be put, got %v", api.puts)
+	}
+	if !strings.Contains(out.String(), "revision 11") {
+		t.Errorf("Expected success message, got: %s", out.String())
+	}
+}
+
+func TestInteractiveSession(t *testing.T) {
+	etcdObjects := []runtime.Object{fake.NewEtcdBuilder("default", "test-etcd").Build()}
+	k8sObjects := []runtime.Object{newTestPod("test-etcd-0", true)}
+	api := newFakeEtcdAPI()
+
+	e, out, errOut, _ := newTestCmdCtx(t, etcdObjects, k8sObjects, api, []string{"test-etcd"}, nil)
+	e.IOStreams.In = strings.NewReader("get /foo/a\ndel /foo/a\nbogus\nput '/foo/d' \"a b\"\nexit\nget /foo/b\n")
+	if err := run(e); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(out.String(), "/foo/a") || strings.Contains(out.String(), "/foo/b") {
+		t.Errorf("Expected only the commands before exit to run, got:\n%s", out.String())
+	}
+	if len(api.deletes) != 0 || len(api.puts) != 0 {
+		t.Errorf("Expected mutating commands to be rejected, got puts %v and deletes %v", api.puts, api.deletes)
+	}
+	if !strings.Contains(errOut.String(), "unknown etcd command") {
+		t.Errorf("Expected unknown command to be reported, got:\n%s", errOut.String())
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		etcdArgs      []string
+		expectedError string
+	}{
+		{"no etcd", nil, []string{"get", "/foo"}, "etcd resource"},
+		{"multiple etcds", []string{"etcd-a", "etcd-b"}, nil, "exactly one etcd resource"},
+		{"unknown command", []string{"test-etcd"}, []string{"compact", "10"}, "unknown etcd command"},
+		{"mutating command", []string{"test-etcd"}, []string{"alarm", "disarm"}, "--allow-mutation"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e, _, _, _ := newTestCmdCtx(t, nil, nil, newFakeEtcdAPI(), tc.args, tc.etcdArgs)
+			err := e.validate()
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Errorf("Expected error containing %q, got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestSelectPod(t *testing.T) {
+	etcdObjects := []runtime.Object{fake.NewEtcdBuilder("default", "test-etcd").Build()}
+	otherPod := newTestPod("other-etcd-0", true)
+	otherPod.Labels = map[string]string{druidv1alpha1.LabelPartOfKey: "other-etcd"}
+	k8sObjects := []runtime.Object{newTestPod("test-etcd-0", false), otherPod}
+
+	e, _, _, _ := newTestCmdCtx(t, etcdObjects, k8sObjects, newFakeEtcdAPI(), []string{"test-etcd"}, []string{"endpoint", "status"})
+	if err := run(e); err == nil || !strings.Contains(err.Error(), "no ready member pod") {
+		t.Errorf("Expected no ready member pod to be found, got: %v", err)
+	}
+
+	e, _, _, _ = newTestCmdCtx(t, etcdObjects, k8sObjects, newFakeEtcdAPI(), []string{"test-etcd"}, []string{"endpoint", "status"})
+	e.Pod = "other-etcd-0"
+	if err := run(e); err == nil || !strings.Contains(err.Error(), "not a member") {
+		t.Errorf("Expected pod of another etcd to be rejected, got: %v", err)
+	}
+}
+
+func TestConnectionConfigWithTLS(t *testing.T) {
+	caCert, clientCert, clientKey := newTestCertificates(t)
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").Build()
+	etcd.Spec.Etcd.ClientPort = ptr.To[int32](3379)
+	etcd.Spec.Etcd.ClientUrlTLS = &druidv1alpha1.TLSConfig{
+		TLSCASecretRef:     druidv1alpha1.SecretReference{SecretReference: corev1.SecretReference{Name: "test-ca"}, DataKey: ptr.To("bundle.crt")},
+		ServerTLSSecretRef: corev1.SecretReference{Name: "test-server"},
+		ClientTLSSecretRef: corev1.SecretReference{Name: "test-client"},
+	}
+	k8sObjects := []runtime.Object{
+		newTestPod("test-etcd-0", true),
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-ca", Namespace: "default"}, Data: map[string][]byte{"bundle.crt": caCert}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-client", Namespace: "default"}, Data: map[string][]byte{
+			corev1.TLSCertKey:       clientCert,
+			corev1.TLSPrivateKeyKey: clientKey,
+		}},
+	}
+
+	e, _, _, config := newTestCmdCtx(t, []runtime.Object{etcd}, k8sObjects, newFakeEtcdAPI(), []string{"test-etcd"}, []string{"endpoint", "status"})
+	if err := run(e); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if config.Port != 3379 {
+		t.Errorf("Expected client port 3379, got %d", config.Port)
+	}
+	if config.TLSConfig == nil {
+		t.Fatalf("Expected TLS config to be set")
+	}
+	if config.TLSConfig.ServerName != "test-etcd-client.default.svc" {
+		t.Errorf("Expected server name of the client service, got %q", config.TLSConfig.ServerName)
+	}
+	if len(config.TLSConfig.Certificates) != 1 {
+		t.Errorf("Expected client certificate to be set")
+	}
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected []string
+		isError  bool
+	}{
+		{line: "get /foo --prefix", expected: []string{"get", "/foo", "--prefix"}},
+		{line: `put /foo "a b"`, expected: []string{"put", "/foo", "a b"}},
+		{line: `put /foo 'a "b"'`, expected: []string{"put", "/foo", `a "b"`}},
+		{line: `put /foo a\ b ''`, expected: []string{"put", "/foo", "a b", ""}},
+		{line: `put /foo "a`, isError: true},
+	}
+	for _, tc := range tests {
+		args, err := splitCommandLine(tc.line)
+		if tc.isError {
+			if err == nil {
+				t.Errorf("Expected error for %q", tc.line)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Expected no error for %q, got: %v", tc.line, err)
+			continue
+		}
+		if strings.Join(args, "|") != strings.Join(tc.expected, "|") || len(args) != len(tc.expected) {
+			t.Errorf("Expected %q to be split into %q, got %q", tc.line, tc.expected, args)
+		}
+	}
+}
+
+// newTestCertificates returns a self-signed CA certificate and a client certificate with its key, issued by the CA,
+// all PEM encoded.
+func newTestCertificates(t *testing.T) ([]byte, []byte, []byte) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate CA key: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %v", err)
+	}
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate client key: %v", err)
+	}
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "etcd-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, clientTemplate, caTemplate, &clientKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Failed to create client certificate: %v", err)
+	}
+	clientKeyDER, err := x509.MarshalECPrivateKey(clientKey)
+	if err != nil {
+		t.Fatalf("Failed to marshal client key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: clientKeyDER})
+}
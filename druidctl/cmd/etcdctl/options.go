@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdctl
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+	"github.com/gardener/etcd-druid/druidctl/internal/etcdconn"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type etcdctlOptions struct {
+	*cmdutils.GlobalOptions
+	PrintFlags *printer.PrintFlags
+	// Pod is the name of the etcd member pod to connect to. Defaults to a ready member pod.
+	Pod string
+	// AllowMutation allows commands which modify the data or the state of etcd.
+	AllowMutation bool
+	// TLSServerName is the server name used to verify the server certificate of etcd. Defaults to the DNS name of the
+	// client service.
+	TLSServerName string
+	// DialTimeout is the timeout for establishing the connection to etcd.
+	DialTimeout time.Duration
+	// CommandTimeout is the timeout of a single etcd command.
+	CommandTimeout time.Duration
+	// etcdArgs is the etcd command and its arguments, which are passed after `--`. If empty, an interactive session is
+	// started.
+	etcdArgs []string
+}
+
+type etcdctlRuntime struct {
+	*cmdutils.RuntimeEnv
+	etcdRef       types.NamespacedName
+	EtcdClient    client.EtcdClientInterface
+	GenericClient client.GenericClientInterface
+	Printer       printer.Printer
+	// connect establishes a connection to the etcd cluster described by the given connection config.
+	connect etcdconn.ConnectFunc
+}
+
+type etcdctlCmdCtx struct {
+	*etcdctlOptions
+	*etcdctlRuntime
+}
+
+func newEtcdctlOptions(options *cmdutils.GlobalOptions) *etcdctlOptions {
+	return &etcdctlOptions{
+		GlobalOptions: options,
+		PrintFlags:    printer.NewPrintFlags(),
+	}
+}
+
+func newEtcdctlRuntime(runtime *cmdutils.RuntimeEnv) *etcdctlRuntime {
+	return &etcdctlRuntime{
+		RuntimeEnv: runtime,
+		connect:    etcdconn.Connect,
+	}
+}
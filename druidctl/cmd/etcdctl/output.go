@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdctl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+)
+
+// maxValueLength is the maximum length of a value shown in a table.
+const maxValueLength = 80
+
+// ToTable converts the result into a table with one row per key.
+func (r KeyValueList) ToTable() *printer.Table {
+	table := &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "KEY"},
+			{Name: "VALUE"},
+			{Name: "MOD REVISION"},
+			{Name: "CREATE REVISION", Wide: true},
+			{Name: "VERSION", Wide: true},
+			{Name: "LEASE", Wide: true},
+		},
+	}
+	for _, kv := range r.KeyValues {
+		table.Rows = append(table.Rows, printer.TableRow{
+			Cells: []string{
+				kv.Key,
+				formatValue(kv.Value),
+				fmt.Sprintf("%d", kv.ModRevision),
+				fmt.Sprintf("%d", kv.CreateRevision),
+				fmt.Sprintf("%d", kv.Version),
+				fmt.Sprintf("%x", kv.Lease),
+			},
+			Object: kv,
+		})
+	}
+	return table
+}
+
+// ToTable converts the result into a table with one row per member.
+func (r MemberList) ToTable() *printer.Table {
+	table := &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "ID"},
+			{Name: "NAME"},
+			{Name: "PEER URLS"},
+			{Name: "CLIENT URLS"},
+			{Name: "LEARNER"},
+		},
+	}
+	for _, member := range r.Members {
+		table.Rows = append(table.Rows, printer.TableRow{
+			Cells: []string{
+				member.ID,
+				member.Name,
+				strings.Join(member.PeerURLs, ","),
+				strings.Join(member.ClientURLs, ","),
+				fmt.Sprintf("%t", member.IsLearner),
+			},
+			Object: member,
+		})
+	}
+	return table
+}
+
+// ToTable converts the result into a table with a single row.
+func (r EndpointStatus) ToTable() *printer.Table {
+	return &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "MEMBER"},
+			{Name: "ID"},
+			{Name: "VERSION"},
+			{Name: "DB SIZE"},
+			{Name: "IS LEADER"},
+			{Name: "RAFT TERM"},
+			{Name: "RAFT INDEX"},
+			{Name: "REVISION"},
+			{Name: "ERRORS"},
+			{Name: "DB SIZE IN USE", Wide: true},
+			{Name: "LEADER", Wide: true},
+			{Name: "LEARNER", Wide: true},
+		},
+		Rows: []printer.TableRow{{
+			Cells: []string{
+				r.Member,
+				r.ID,
+				r.Version,
+				cmdutils.FormatSize(r.DBSize),
+				fmt.Sprintf("%t", r.IsLeader),
+				fmt.Sprintf("%d", r.RaftTerm),
+				fmt.Sprintf("%d", r.RaftIndex),
+				fmt.Sprintf("%d", r.Revision),
+				strings.Join(r.Errors, ", "),
+				cmdutils.FormatSize(r.DBSizeInUse),
+				r.Leader,
+				fmt.Sprintf("%t", r.IsLearner),
+			},
+			Object: r,
+		}},
+	}
+}
+
+// ToTable converts the result into a table with a single row.
+func (r EndpointHealth) ToTable() *printer.Table {
+	return &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "MEMBER"},
+			{Name: "HEALTHY"},
+			{Name: "TOOK"},
+			{Name: "ERROR"},
+		},
+		Rows: []printer.TableRow{{
+			Cells:  []string{r.Member, fmt.Sprintf("%t", r.Healthy), r.Took, r.Error},
+			Object: r,
+		}},
+	}
+}
+
+// ToTable converts the result into a table with one row per alarm.
+func (r AlarmList) ToTable() *printer.Table {
+	table := &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "MEMBER ID"},
+			{Name: "ALARM"},
+		},
+	}
+	for _, alarm := range r.Alarms {
+		table.Rows = append(table.Rows, printer.TableRow{
+			Cells:  []string{alarm.MemberID, alarm.Type},
+			Object: alarm,
+		})
+	}
+	return table
+}
+
+// formatValue formats a value for a table. Values which are not printable text, such as the protobuf encoded objects
+// stored by the Kubernetes API server, are quoted, and long values are truncated.
+func formatValue(value []byte) string {
+	formatted := string(value)
+	if !utf8.Valid(value) || strings.ContainsFunc(formatted, func(r rune) bool { return !strconv.IsPrint(r) }) {
+		formatted = strconv.Quote(formatted)
+	}
+	if len(formatted) > maxValueLength {
+		return formatted[:maxValueLength-3] + "..."
+	}
+	return formatted
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdctl
+
+// KeyValue is a key and its value in etcd.
+type KeyValue struct {
+	Key string `json:"key" yaml:"key"`
+	// Value is the raw value of the key, which is base64 encoded in JSON and YAML output.
+	Value          []byte `json:"value,omitempty" yaml:"value,omitempty"`
+	CreateRevision int64  `json:"createRevision" yaml:"createRevision"`
+	ModRevision    int64  `json:"modRevision" yaml:"modRevision"`
+	Version        int64  `json:"version" yaml:"version"`
+	Lease          int64  `json:"lease,omitempty" yaml:"lease,omitempty"`
+}
+
+// KeyValueList is the result of the get command.
+type KeyValueList struct {
+	// Revision is the revision of etcd at which the keys were read.
+	Revision int64 `json:"revision" yaml:"revision"`
+	// Count is the number of keys in the requested range, which can exceed the number of returned keys if a limit is set.
+	Count     int64      `json:"count" yaml:"count"`
+	More      bool       `json:"more" yaml:"more"`
+	KeyValues []KeyValue `json:"keyValues" yaml:"keyValues"`
+	Kind      string     `json:"kind" yaml:"kind"`
+}
+
+// Member is a member of an etcd cluster.
+type Member struct {
+	ID         string   `json:"id" yaml:"id"`
+	Name       string   `json:"name" yaml:"name"`
+	PeerURLs   []string `json:"peerURLs" yaml:"peerURLs"`
+	ClientURLs []string `json:"clientURLs" yaml:"clientURLs"`
+	IsLearner  bool     `json:"isLearner" yaml:"isLearner"`
+}
+
+// MemberList is the result of the member list command.
+type MemberList struct {
+	Members []Member `json:"members" yaml:"members"`
+	Kind    string   `json:"kind" yaml:"kind"`
+}
+
+// EndpointStatus is the result of the endpoint status command.
+type EndpointStatus struct {
+	// Member is the name of the pod of the member.
+	Member      string   `json:"member" yaml:"member"`
+	ID          string   `json:"id" yaml:"id"`
+	Version     string   `json:"version" yaml:"version"`
+	DBSize      int64    `json:"dbSize" yaml:"dbSize"`
+	DBSizeInUse int64    `json:"dbSizeInUse" yaml:"dbSizeInUse"`
+	Leader      string   `json:"leader" yaml:"leader"`
+	IsLeader    bool     `json:"isLeader" yaml:"isLeader"`
+	IsLearner   bool     `json:"isLearner" yaml:"isLearner"`
+	RaftTerm    uint64   `json:"raftTerm" yaml:"raftTerm"`
+	RaftIndex   uint64   `json:"raftIndex" yaml:"raftIndex"`
+	Revision    int64    `json:"revision" yaml:"revision"`
+	Errors      []string `json:"errors,omitempty" yaml:"errors,omitempty"`
+	Kind        string   `json:"kind" yaml:"kind"`
+}
+
+// EndpointHealth is the result of the endpoint health command.
+type EndpointHealth struct {
+	// Member is the name of the pod of the member.
+	Member  string `json:"member" yaml:"member"`
+	Healthy bool   `json:"healthy" yaml:"healthy"`
+	Took    string `json:"took" yaml:"took"`
+	Error   string `json:"error,omitempty" yaml:"error,omitempty"`
+	Kind    string `json:"kind" yaml:"kind"`
+}
+
+// Alarm is an active alarm of an etcd member.
+type Alarm struct {
+	MemberID string `json:"memberID" yaml:"memberID"`
+	Type     string `json:"type" yaml:"type"`
+}
+
+// AlarmList is the result of the alarm list command.
+type AlarmList struct {
+	Alarms []Alarm `json:"alarms" yaml:"alarms"`
+	Kind   string  `json:"kind" yaml:"kind"`
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	ruleSecretExists       = "SecretExists"
+	ruleStorageClassExists = "StorageClassExists"
+	ruleServerValidation   = "ServerValidation"
+)
+
+// checkClusterReferences checks that the Secrets and the StorageClass referenced by the given Etcd exist in the cluster.
+// Secrets are only looked up once, as the same secret is often referenced more than once, e.g. the CA.
+func (l *lintCmdCtx) checkClusterReferences(ctx context.Context, etcd *druidv1alpha1.Etcd) ([]issue, error) {
+	var issues []issue
+	secretExists := make(map[types.NamespacedName]bool)
+	for _, ref := range secretReferences(etcd) {
+		if ref.Name == "" {
+			continue
+		}
+		key := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+		if key.Namespace == "" {
+			key.Namespace = etcd.Namespace
+		}
+		exists, checked := secretExists[key]
+		if !checked {
+			_, err := l.genericClient.Kube().CoreV1().Secrets(key.Namespace).Get(ctx, key.Name, metav1.GetOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get secret %s: %w", key, err)
+			}
+			exists = err == nil
+			secretExists[key] = exists
+		}
+		if !exists {
+			issues = append(issues, issue{rule: ruleSecretExists, severity: SeverityError, field: ref.path.Child("name").String(), message: fmt.Sprintf("the secret %s does not exist", key)})
+		}
+	}
+
+	if storageClass := etcd.Spec.StorageClass; storageClass != nil && *storageClass != "" {
+		_, err := l.genericClient.Kube().StorageV1().StorageClasses().Get(ctx, *storageClass, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get storage class %s: %w", *storageClass, err)
+		}
+		if err != nil {
+			issues = append(issues, issue{
+				rule:     ruleStorageClassExists,
+				severity: SeverityError,
+				field:    field.NewPath("spec", "storageClass").String(),
+				message:  fmt.Sprintf("the storage class %s does not exist", *storageClass),
+			})
+		}
+	}
+	return issues, nil
+}
+
+// checkServerValidation applies the given Etcd in a server-side dry-run with the credentials of the user. The API server
+// thereby validates it against the installed CRD, including the transition rules against an existing Etcd, and runs the
+// admission webhooks. Violations which have already been found by the given offline issues are not reported again.
+func (l *lintCmdCtx) checkServerValidation(ctx context.Context, etcd *druidv1alpha1.Etcd, offlineIssues []issue) ([]issue, error) {
+	err := l.etcdClient.DryRunEtcd(ctx, etcd)
+	if err == nil {
+		return nil, nil
+	}
+	var statusErr *apierrors.StatusError
+	if !apierrors.IsInvalid(err) || !errors.As(err, &statusErr) || statusErr.ErrStatus.Details == nil {
+		return nil, fmt.Errorf("failed to apply etcd %s/%s in a dry-run: %w", etcd.Namespace, etcd.Name, err)
+	}
+	return serverValidationIssues(statusErr.ErrStatus.Details.Causes, offlineIssues), nil
+}
+
+// serverValidationIssues converts the given causes of an invalid request into issues, skipping the causes which are
+// already covered by one of the given offline issues for the same field.
+func serverValidationIssues(causes []metav1.StatusCause, offlineIssues []issue) []issue {
+	var issues []issue
+	for _, cause := range causes {
+		fieldPath := cause.Field
+		if fieldPath == rootFieldPath {
+			fieldPath = ""
+		}
+		if slices.ContainsFunc(offlineIssues, func(i issue) bool {
+			return i.field == fieldPath && strings.Contains(cause.Message, i.message)
+		}) {
+			continue
+		}
+		issues = append(issues, issue{rule: ruleServerValidation, severity: SeverityError, field: fieldPath, message: cause.Message})
+	}
+	return issues
+}
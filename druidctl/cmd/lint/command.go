@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	example = `
+# Lint the etcd resources in a manifest
+kubectl druid lint -f etcd.yaml
+
+# Lint the etcd resources in all manifests of a directory, failing on warnings as well
+kubectl druid lint -f manifests/ --strict
+
+# Lint the etcd resources read from stdin, e.g. rendered by helm
+helm template my-chart | kubectl druid lint -f -
+
+# Lint the etcd resources, check that the Secrets and StorageClasses they reference exist in the cluster and validate
+# them in a server-side dry-run
+kubectl druid lint -f etcd.yaml --cluster
+
+# Lint the etcd resources in a GitHub Actions workflow, which shows the findings as annotations of the manifests
+kubectl druid lint -f manifests/ -o github
+`
+)
+
+// NewLintCommand creates the lint command
+func NewLintCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	lintOptions := newLintOptions(cmdCtx.Options)
+
+	lintCmd := &cobra.Command{
+		Use:   "lint -f <file> [flags]",
+		Short: "Check etcd resource manifests for mistakes before applying them",
+		Long: `Check etcd resource manifests for mistakes before applying them.
+The manifests are checked offline with the validation of etcd-druid, the validation rules of the Etcd CRD and with
+best-practice checks, e.g. for invalid cron schedules, backend quotas, even replica counts, missing secret references or
+storage providers. With --cluster, it is additionally checked that the Secrets and StorageClasses referenced by the etcd
+resources exist in the cluster, and the etcd resources are applied in a server-side dry-run, in which the API server
+validates them with your credentials.
+Manifests of other resources are skipped. The command fails if an error has been found, with --strict also if a
+warning has been found.`,
+		Args:    cobra.NoArgs,
+		Example: example,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			lintCmdCtx := &lintCmdCtx{
+				lintOptions: lintOptions,
+				lintRuntime: newLintRuntime(cmdCtx.Runtime),
+			}
+			if err := lintCmdCtx.validate(); err != nil {
+				if herr := cmd.Help(); herr != nil {
+					cmdCtx.Runtime.Logger.Warning(cmdCtx.Runtime.IOStreams.ErrOut, "Failed to show help: ", herr.Error())
+				}
+				return err
+			}
+
+			if err := lintCmdCtx.complete(); err != nil {
+				return err
+			}
+
+			if err := lintCmdCtx.execute(cmdutils.CmdContext(cmd)); err != nil {
+				cmdCtx.Runtime.Logger.Error(cmdCtx.Runtime.IOStreams.ErrOut, "Linting failed", err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	lintCmd.Flags().StringSliceVarP(&lintOptions.filenames, "filename", "f", nil, "Files or directories containing the manifests to lint, - reads them from stdin. Directories are not read recursively")
+	lintCmd.Flags().BoolVar(&lintOptions.cluster, "cluster", false, "Additionally check that the Secrets and StorageClasses referenced by the etcd resources exist in the cluster, and validate the etcd resources in a server-side dry-run")
+	lintCmd.Flags().BoolVar(&lintOptions.strict, "strict", false, "Fail if a warning has been found as well")
+	lintOptions.PrintFlags.AddFlags(lintCmd)
+	lintCmd.Flags().Lookup("output").Usage = "Output format. One of: table, wide, json, yaml, github, custom-columns=<header>:<json-path>[,<header>:<json-path>...]"
+
+	return lintCmd
+}
@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	fake "github.com/gardener/etcd-druid/druidctl/internal/client/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const validManifest = `apiVersion: druid.gardener.cloud/v1alpha1
+kind: Etcd
+metadata:
+  name: test-etcd
+spec:
+  replicas: 3
+  labels:
+    app: etcd
+  storageClass: test-storage-class
+  managedCertificates: {}
+  etcd:
+    quota: 8Gi
+  backup:
+    fullSnapshotSchedule: "0 */12 * * *"
+    store:
+      provider: aws
+      container: test-bucket
+      prefix: default--test-etcd
+      secretRef:
+        name: test-backup
+`
+
+// invalidManifest contains an Etcd with mistakes in the lines 12, 18, 20 and 23, which follows a ConfigMap.
+const invalidManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+---
+apiVersion: druid.gardener.cloud/v1alpha1
+kind: Etcd
+metadata:
+  name: test-etcd
+  namespace: test
+spec:
+  replicas: 2
+  labels:
+    app: etcd
+  managedCertificates: {}
+  etcd:
+    quota: 8Gi
+    unknownField: true
+  backup:
+    fullSnapshotSchedule: "0 */12 * *"
+    store:
+      provider: local
+      prefix: etcd-backups
+      container: test-bucket
+`
+
+func writeManifest(t *testing.T, manifest string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "etcd.yaml")
+	if err := os.WriteFile(path, []byte(manifest), 0600); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+	return path
+}
+
+func TestLintCommand(t *testing.T) {
+	path := writeManifest(t, invalidManifest)
+	_, out, err := fake.NewTestHelper().RunCommand(t, NewLintCommand, nil, map[string]string{"filename": path, "output": "json"})
+	if exitCode := cmdutils.ExitCode(err); exitCode != cmdutils.ExitCodeLintFailed {
+		t.Fatalf("Expected exit code %d, got %d: %v", cmdutils.ExitCodeLintFailed, exitCode, err)
+	}
+
+	var result Result
+	if err := yaml.Unmarshal(out.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse result: %v\n%s", err, out)
+	}
+	if result.Etcds != 1 || result.Errors != 3 || result.Warnings != 1 {
+		t.Errorf("Expected 1 etcd with 3 errors and 1 warning, got %d etcds with %d errors and %d warnings: %v", result.Etcds, result.Errors, result.Warnings, result.Findings)
+	}
+	expected := []struct {
+		line int
+		rule string
+	}{{6, "Schema"}, {12, "ReplicaCount"}, {20, "Schedules"}, {23, "Validation"}}
+	if len(result.Findings) != len(expected) {
+		t.Fatalf("Expected %d findings, got %v", len(expected), result.Findings)
+	}
+	for i, finding := range result.Findings {
+		if finding.Line != expected[i].line || finding.Rule != expected[i].rule || finding.File != path || finding.Etcd != "test/test-etcd" {
+			t.Errorf("Expected finding of rule %s in %s:%d for test/test-etcd, got %+v", expected[i].rule, path, expected[i].line, finding)
+		}
+	}
+	if !strings.Contains(result.Findings[0].Message, `unknown field "unknownField"`) {
+		t.Errorf("Expected the unknown field to be reported, got %q", result.Findings[0].Message)
+	}
+}
+
+func TestLintCommandValidManifest(t *testing.T) {
+	_, out, err := fake.NewTestHelper().RunCommand(t, NewLintCommand, nil, map[string]string{"filename": writeManifest(t, validManifest)})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(out.String(), "No problems found in 1 etcd resource(s)") {
+		t.Errorf("Expected no problems to be found, got:\n%s", out)
+	}
+}
+
+func TestLintCommandCRDValidation(t *testing.T) {
+	// The API server rejects the Etcd, as the user test-etcd-client is reserved for etcd-backup-restore and the health
+	// check sets both http and metric.
+	manifest := validManifest + `  healthChecks:
+  - conditionType: DatabaseSize
+    http:
+      path: /health
+    metric:
+      name: etcd_mvcc_db_total_size_in_bytes
+      max: 6Gi
+`
+	manifest = strings.Replace(manifest, "    quota: 8Gi\n", "    quota: 8Gi\n    auth:\n      users:\n      - name: test-etcd-client\n", 1)
+	_, out, err := fake.NewTestHelper().RunCommand(t, NewLintCommand, nil, map[string]string{"filename": writeManifest(t, manifest), "output": "json"})
+	if exitCode := cmdutils.ExitCode(err); exitCode != cmdutils.ExitCodeLintFailed {
+		t.Fatalf("Expected exit code %d, got %d: %v", cmdutils.ExitCodeLintFailed, exitCode, err)
+	}
+
+	var result Result
+	if err := yaml.Unmarshal(out.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse result: %v\n%s", err, out)
+	}
+	expected := []struct {
+		line    int
+		message string
+	}{{1, "client certificate of etcd-backup-restore"}, {25, "exactly one of http and metric"}}
+	if len(result.Findings) != len(expected) {
+		t.Fatalf("Expected %d findings, got %v", len(expected), result.Findings)
+	}
+	for i, finding := range result.Findings {
+		if finding.Rule != "Validation" || finding.Line != expected[i].line || !strings.Contains(finding.Message, expected[i].message) {
+			t.Errorf("Expected a Validation finding in line %d containing %q, got %+v", expected[i].line, expected[i].message, finding)
+		}
+	}
+}
+
+func TestLintCommandStrict(t *testing.T) {
+	path := writeManifest(t, strings.Replace(validManifest, "replicas: 3", "replicas: 4", 1))
+	if _, _, err := fake.NewTestHelper().RunCommand(t, NewLintCommand, nil, map[string]string{"filename": path}); err != nil {
+		t.Errorf("Expected warnings not to fail the command, got: %v", err)
+	}
+	if _, _, err := fake.NewTestHelper().RunCommand(t, NewLintCommand, nil, map[string]string{"filename": path, "strict": "true"}); cmdutils.ExitCode(err) != cmdutils.ExitCodeLintFailed {
+		t.Errorf("Expected warnings to fail the command in strict mode, got: %v", err)
+	}
+}
+
+func TestLintCommandGitHubOutput(t *testing.T) {
+	_, out, err := fake.NewTestHelper().WithStdin(invalidManifest).RunCommand(t, NewLintCommand, nil, map[string]string{"filename": stdinFilename, "output": outputTypeGitHub})
+	if cmdutils.ExitCode(err) != cmdutils.ExitCodeLintFailed {
+		t.Errorf("Expected the command to fail, got: %v", err)
+	}
+	for _, expected := range []string{
+		"::warning line=12,title=ReplicaCount (test/test-etcd)::2 replicas tolerate",
+		`::error line=20,title=Schedules (test/test-etcd)::invalid cron schedule "0 */12 * *"`,
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, out)
+		}
+	}
+}
+
+func TestLintCommandCluster(t *testing.T) {
+	path := writeManifest(t, validManifest)
+	backupSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-backup", Namespace: "default"}}
+	storageClass := &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "test-storage-class"}}
+
+	if _, _, err := fake.NewTestHelper().WithK8sObjects([]runtime.Object{backupSecret, storageClass}).RunCommand(t, NewLintCommand, nil, map[string]string{"filename": path, "cluster": "true"}); err != nil {
+		t.Errorf("Expected no error if the referenced resources exist, got: %v", err)
+	}
+	if _, _, err := fake.NewTestHelper().RunCommand(t, NewLintCommand, nil, map[string]string{"filename": path}); err != nil {
+		t.Errorf("Expected the cluster not to be checked by default, got: %v", err)
+	}
+
+	_, out, err := fake.NewTestHelper().RunCommand(t, NewLintCommand, nil, map[string]string{"filename": path, "cluster": "true"})
+	if cmdutils.ExitCode(err) != cmdutils.ExitCodeLintFailed {
+		t.Errorf("Expected the command to fail, got: %v", err)
+	}
+	for _, expected := range []string{"the secret default/test-backup does not exist", "the storage class test-storage-class does not exist"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, out)
+		}
+	}
+}
+
+func TestServerValidationIssues(t *testing.T) {
+	offlineIssues := []issue{{rule: "Validation", severity: SeverityError, field: "spec.backup", message: "garbageCollectionPeriod must be greater than deltaSnapshotPeriod"}}
+	causes := []metav1.StatusCause{
+		{Field: "spec.backup", Message: `Invalid value: "object": garbageCollectionPeriod must be greater than deltaSnapshotPeriod`},
+		{Field: "spec.storageCapacity", Message: `Invalid value: "string": storageCapacity is immutable`},
+		{Field: "<nil>", Message: `Invalid value: "object": denied by the webhook`},
+	}
+	issues := serverValidationIssues(causes, offlineIssues)
+	if len(issues) != 2 {
+		t.Fatalf("Expected the causes found offline to be skipped, got %+v", issues)
+	}
+	if issues[0].rule != ruleServerValidation || issues[0].field != "spec.storageCapacity" || !strings.Contains(issues[0].message, "immutable") {
+		t.Errorf("Expected an issue for the immutable storage capacity, got %+v", issues[0])
+	}
+	if issues[1].field != "" {
+		t.Errorf("Expected the cause on the etcd itself to be reported without field, got %+v", issues[1])
+	}
+}
+
+func TestLintCommandErrors(t *testing.T) {
+	configMapManifest := strings.SplitN(invalidManifest, "---", 2)[0]
+	tests := []struct {
+		name          string
+		flags         map[string]string
+		expectedError string
+	}{
+		{"no file", nil, "--filename"},
+		{"stdin twice", map[string]string{"filename": "-,-"}, "stdin"},
+		{"missing file", map[string]string{"filename": filepath.Join(t.TempDir(), "missing.yaml")}, "no such file"},
+		{"no etcd", map[string]string{"filename": writeManifest(t, configMapManifest)}, "no etcd resources found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := fake.NewTestHelper().RunCommand(t, NewLintCommand, nil, tt.flags)
+			if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+				t.Errorf("Expected error containing %q, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestLineOf(t *testing.T) {
+	documents, err := parseDocuments("etcd.yaml", []byte(invalidManifest))
+	if err != nil || len(documents) != 2 {
+		t.Fatalf("Expected two documents, got %d: %v", len(documents), err)
+	}
+	doc := documents[1]
+	tests := []struct {
+		path         string
+		expectedLine int
+	}{
+		{"", 6},
+		{"spec.replicas", 12},
+		{"spec.labels[app]", 14},
+		{"spec.backup.store.prefix", 23},
+		// Fields which are not set are mapped to their closest parent.
+		{"spec.backup.store.secretRef.name", 21},
+		{"spec.etcd.auth.users[0]", 16},
+	}
+	for _, tt := range tests {
+		if line := doc.lineOf(tt.path); line != tt.expectedLine {
+			t.Errorf("Expected %q in line %d, got %d", tt.path, tt.expectedLine, line)
+		}
+	}
+}
+
+func TestParseDocumentsSkipsEmptyDocuments(t *testing.T) {
+	var manifest bytes.Buffer
+	manifest.WriteString("---\n# only a comment\n---\n")
+	manifest.WriteString(validManifest)
+	documents, err := parseDocuments("etcd.yaml", manifest.Bytes())
+	if err != nil || len(documents) != 1 || !documents[0].isEtcd() {
+		t.Errorf("Expected a single etcd document, got %d: %v", len(documents), err)
+	}
+}
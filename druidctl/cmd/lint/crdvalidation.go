@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/api/core/v1alpha1/crds"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"sigs.k8s.io/yaml"
+)
+
+// etcdCRDSchema holds the structural schema of the Etcd CRD and the validator of its CEL rules, which are only compiled
+// once.
+type etcdCRDSchema struct {
+	schema    *structuralschema.Structural
+	validator *cel.Validator
+}
+
+// rootFieldPath is the field path of the errors on the Etcd itself, as the rules are evaluated from a nil path.
+var rootFieldPath = (*field.Path)(nil).String()
+
+var getEtcdCRDSchema = sync.OnceValues(func() (*etcdCRDSchema, error) {
+	allCRDs, err := crds.GetAll("1.29")
+	if err != nil {
+		return nil, err
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal([]byte(allCRDs[crds.ResourceNameEtcd]), crd); err != nil {
+		return nil, fmt.Errorf("failed to parse the Etcd CRD: %w", err)
+	}
+	for _, version := range crd.Spec.Versions {
+		if version.Name != druidv1alpha1.SchemeGroupVersion.Version || version.Schema == nil {
+			continue
+		}
+		validation := &apiextensions.CustomResourceValidation{}
+		if err := apiextensionsv1.Convert_v1_CustomResourceValidation_To_apiextensions_CustomResourceValidation(version.Schema, validation, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert the schema of the Etcd CRD: %w", err)
+		}
+		schema, err := structuralschema.NewStructural(validation.OpenAPIV3Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build the structural schema of the Etcd CRD: %w", err)
+		}
+		return &etcdCRDSchema{schema: schema, validator: cel.NewValidator(schema, true, celconfig.PerCallLimit)}, nil
+	}
+	return nil, fmt.Errorf("the Etcd CRD has no schema for version %s", druidv1alpha1.SchemeGroupVersion.Version)
+})
+
+// checkCRDValidation evaluates the CEL validation rules (x-kubernetes-validations) of the Etcd CRD with the validator
+// of the API server, after the defaults of the CRD have been applied. Transition rules, which compare an Etcd with its
+// previous version, are skipped, as a manifest has no previous version. Violations of the rules on the Etcd itself are
+// reported without field, as the API server does.
+func checkCRDValidation(etcd *druidv1alpha1.Etcd) []issue {
+	crdSchema, err := getEtcdCRDSchema()
+	if err != nil {
+		return []issue{{severity: SeverityError, message: fmt.Sprintf("failed to load the validation rules of the Etcd CRD: %v", err)}}
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(etcd)
+	if err != nil {
+		return []issue{{severity: SeverityError, message: fmt.Sprintf("failed to convert the etcd: %v", err)}}
+	}
+	structuraldefaulting.Default(obj, crdSchema.schema)
+
+	errs, _ := crdSchema.validator.Validate(context.Background(), nil, crdSchema.schema, obj, nil, celconfig.RuntimeCELCostBudget)
+	issues := make([]issue, 0, len(errs))
+	for _, err := range errs {
+		i := issue{severity: SeverityError, message: err.Detail}
+		if err.Field != rootFieldPath {
+			i.field = err.Field
+		}
+		issues = append(issues, i)
+	}
+	return issues
+}
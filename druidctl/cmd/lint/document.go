@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	yamlv3 "go.yaml.in/yaml/v3"
+	"sigs.k8s.io/yaml"
+)
+
+// stdinFilename is the filename which reads the manifests from stdin.
+const stdinFilename = "-"
+
+// manifestExtensions are the extensions of the files which are read from a directory.
+var manifestExtensions = []string{".yaml", ".yml", ".json"}
+
+// document is a single YAML document of a manifest file.
+type document struct {
+	file string
+	// node is the root node of the document, which is kept to map the paths of fields to lines.
+	node *yamlv3.Node
+}
+
+// typeMeta is the part of a document which identifies its kind.
+type typeMeta struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+}
+
+// expandFilenames returns the files of the given filenames, replacing directories with the manifests they contain.
+// Directories are not read recursively.
+func expandFilenames(filenames []string) ([]string, error) {
+	var files []string
+	for _, filename := range filenames {
+		if filename == stdinFilename {
+			files = append(files, filename)
+			continue
+		}
+		info, err := os.Stat(filename)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, filename)
+			continue
+		}
+		entries, err := os.ReadDir(filename)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && slices.Contains(manifestExtensions, filepath.Ext(entry.Name())) {
+				files = append(files, filepath.Join(filename, entry.Name()))
+			}
+		}
+	}
+	return files, nil
+}
+
+// parseDocuments splits the given manifest into its YAML documents. Documents which are not mappings, e.g. empty ones,
+// are skipped, as they cannot contain an object.
+func parseDocuments(file string, data []byte) ([]document, error) {
+	decoder := yamlv3.NewDecoder(bytes.NewReader(data))
+	var documents []document
+	for {
+		node := &yamlv3.Node{}
+		if err := decoder.Decode(node); err != nil {
+			if errors.Is(err, io.EOF) {
+				return documents, nil
+			}
+			return nil, err
+		}
+		if len(node.Content) == 0 || node.Content[0].Kind != yamlv3.MappingNode {
+			continue
+		}
+		documents = append(documents, document{file: file, node: node})
+	}
+}
+
+// line returns the line of the document itself.
+func (d document) line() int {
+	return d.content().Line
+}
+
+func (d document) content() *yamlv3.Node {
+	if d.node.Kind == yamlv3.DocumentNode && len(d.node.Content) > 0 {
+		return d.node.Content[0]
+	}
+	return d.node
+}
+
+// isEtcd returns true if the document is an Etcd manifest.
+func (d document) isEtcd() bool {
+	var meta typeMeta
+	if err := d.content().Decode(&meta); err != nil {
+		return false
+	}
+	group, _, _ := strings.Cut(meta.APIVersion, "/")
+	return meta.Kind == "Etcd" && group == druidv1alpha1.SchemeGroupVersion.Group
+}
+
+// decodeEtcd decodes the Etcd in the document. If strict is true, unknown fields are rejected.
+func (d document) decodeEtcd(strict bool) (*druidv1alpha1.Etcd, error) {
+	data, err := yamlv3.Marshal(d.node)
+	if err != nil {
+		return nil, err
+	}
+	etcd := &druidv1alpha1.Etcd{}
+	if strict {
+		err = yaml.UnmarshalStrict(data, etcd)
+	} else {
+		err = yaml.Unmarshal(data, etcd)
+	}
+	if err != nil {
+		return nil, errors.New(decodingErrorMessage(err))
+	}
+	return etcd, nil
+}
+
+// decodingErrorMessage strips the prefixes added by the JSON conversion from the given decoding error, e.g. leaves
+// only unknown field "foo" for an unknown field.
+func decodingErrorMessage(err error) string {
+	message := err.Error()
+	if i := strings.LastIndex(message, "json: "); i >= 0 {
+		return message[i+len("json: "):]
+	}
+	return message
+}
+
+// lineOf returns the line of the field with the given path, e.g. spec.backup.store.prefix or spec.etcd.auth.users[0].
+// If the field is not set in the document, the line of its closest parent which is set is returned.
+func (d document) lineOf(path string) int {
+	node := d.content()
+	line := node.Line
+	for _, segment := range splitPath(path) {
+		next := childOf(node, segment)
+		if next == nil {
+			break
+		}
+		node, line = next.value, next.line
+	}
+	return line
+}
+
+// child is a child of a node, with the line of its key if the parent is a mapping.
+type child struct {
+	value *yamlv3.Node
+	line  int
+}
+
+func childOf(node *yamlv3.Node, segment string) *child {
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == segment {
+				return &child{value: node.Content[i+1], line: node.Content[i].Line}
+			}
+		}
+	case yamlv3.SequenceNode:
+		if index, err := strconv.Atoi(segment); err == nil && index >= 0 && index < len(node.Content) {
+			return &child{value: node.Content[index], line: node.Content[index].Line}
+		}
+	}
+	return nil
+}
+
+// splitPath splits a field path into the keys and indices it consists of, e.g. spec.etcd.auth.users[0].name into
+// spec, etcd, auth, users, 0 and name.
+func splitPath(path string) []string {
+	var segments []string
+	for _, part := range strings.Split(path, ".") {
+		name, rest, _ := strings.Cut(part, "[")
+		if name != "" {
+			segments = append(segments, name)
+		}
+		for rest != "" {
+			var index string
+			index, rest, _ = strings.Cut(rest, "]")
+			segments = append(segments, index)
+			rest = strings.TrimPrefix(rest, "[")
+		}
+	}
+	return segments
+}
+
+// readManifest reads the manifest with the given filename, - reads it from the given reader.
+func readManifest(filename string, stdin io.Reader) ([]byte, error) {
+	if filename == stdinFilename {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the manifests from stdin: %w", err)
+		}
+		return data, nil
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the manifests from %s: %w", filename, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+)
+
+const (
+	resultKind = "LintResult"
+	ruleSchema = "Schema"
+)
+
+func (l *lintCmdCtx) validate() error {
+	if len(l.filenames) == 0 {
+		return fmt.Errorf("at least one file must be specified with --filename/-f")
+	}
+	if len(slices.DeleteFunc(slices.Clone(l.filenames), func(filename string) bool { return filename != stdinFilename })) > 1 {
+		return fmt.Errorf("the manifests can only be read once from stdin")
+	}
+	return nil
+}
+
+func (l *lintCmdCtx) complete() error {
+	if l.PrintFlags.OutputFormat != outputTypeGitHub {
+		p, err := l.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		l.Printer = p
+	}
+	if l.cluster {
+		genericClient, err := l.Clients.GenericClient()
+		if err != nil {
+			return fmt.Errorf("unable to create generic kube clients: %w", err)
+		}
+		l.genericClient = genericClient
+		etcdClient, err := l.Clients.EtcdClient()
+		if err != nil {
+			return fmt.Errorf("unable to create etcd client: %w", err)
+		}
+		l.etcdClient = etcdClient
+	}
+	return nil
+}
+
+// execute lints all Etcd manifests in the given files and prints the findings. Other manifests in the files are
+// skipped. It fails if an error has been found, or a warning in strict mode.
+func (l *lintCmdCtx) execute(ctx context.Context) error {
+	files, err := expandFilenames(l.filenames)
+	if err != nil {
+		return err
+	}
+	result := Result{Findings: []Finding{}, Kind: resultKind}
+	for _, file := range files {
+		data, err := readManifest(file, l.IOStreams.In)
+		if err != nil {
+			return err
+		}
+		documents, err := parseDocuments(file, data)
+		if err != nil {
+			result.Findings = append(result.Findings, Finding{File: file, Rule: ruleSchema, Severity: SeverityError, Message: err.Error()})
+			continue
+		}
+		for _, doc := range documents {
+			if !doc.isEtcd() {
+				continue
+			}
+			result.Etcds++
+			findings, err := l.lintDocument(ctx, doc)
+			if err != nil {
+				return err
+			}
+			result.Findings = append(result.Findings, findings...)
+		}
+	}
+	for _, finding := range result.Findings {
+		if finding.Severity == SeverityError {
+			result.Errors++
+		} else {
+			result.Warnings++
+		}
+	}
+
+	if result.Etcds == 0 && result.Errors == 0 {
+		return fmt.Errorf("no etcd resources found in the given files")
+	}
+	if err := l.printResult(result); err != nil {
+		return err
+	}
+	if result.Errors > 0 || (l.strict && result.Warnings > 0) {
+		return cmdutils.NewExitError(cmdutils.ExitCodeLintFailed, fmt.Errorf("found %d error(s) and %d warning(s)", result.Errors, result.Warnings))
+	}
+	return nil
+}
+
+// lintDocument runs the rules against the Etcd in the given document. The findings are sorted by their line.
+func (l *lintCmdCtx) lintDocument(ctx context.Context, doc document) ([]Finding, error) {
+	etcd, err := doc.decodeEtcd(false)
+	if err != nil {
+		return []Finding{{File: doc.file, Line: doc.line(), Rule: ruleSchema, Severity: SeverityError, Message: err.Error()}}, nil
+	}
+	if etcd.Namespace == "" {
+		etcd.Namespace = l.GetNamespace()
+	}
+
+	var issues []issue
+	if _, err := doc.decodeEtcd(true); err != nil {
+		issues = append(issues, issue{rule: ruleSchema, severity: SeverityError, message: err.Error()})
+	}
+	issues = append(issues, runRules(etcd)...)
+	if l.cluster {
+		clusterIssues, err := l.checkClusterReferences(ctx, etcd)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, clusterIssues...)
+		serverIssues, err := l.checkServerValidation(ctx, etcd, issues)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, serverIssues...)
+	}
+
+	findings := make([]Finding, 0, len(issues))
+	for _, i := range issues {
+		findings = append(findings, Finding{
+			File:     doc.file,
+			Line:     doc.lineOf(i.field),
+			Etcd:     fmt.Sprintf("%s/%s", etcd.Namespace, etcd.Name),
+			Rule:     i.rule,
+			Severity: i.severity,
+			Field:    i.field,
+			Message:  i.message,
+		})
+	}
+	slices.SortStableFunc(findings, func(a, b Finding) int { return a.Line - b.Line })
+	return findings, nil
+}
+
+func (l *lintCmdCtx) printResult(result Result) error {
+	if l.PrintFlags.OutputFormat == outputTypeGitHub {
+		writeAnnotations(l.IOStreams.Out, result.Findings)
+		return nil
+	}
+	if len(result.Findings) == 0 && l.PrintFlags.IsTableOutput() {
+		l.Logger.Success(l.IOStreams.Out, fmt.Sprintf("No problems found in %d etcd resource(s)", result.Etcds))
+		return nil
+	}
+	outputData, err := l.Printer.Print(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result to desired format: %w", err)
+	}
+	fmt.Fprintf(l.IOStreams.Out, "%s\n", string(outputData))
+	if l.PrintFlags.IsTableOutput() {
+		l.Logger.Info(l.IOStreams.Out, fmt.Sprintf("Found %d error(s) and %d warning(s) in %d etcd resource(s)", result.Errors, result.Warnings, result.Etcds))
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+)
+
+type lintOptions struct {
+	*cmdutils.GlobalOptions
+	PrintFlags *printer.PrintFlags
+	// filenames are the files or directories containing the manifests, - reads them from stdin.
+	filenames []string
+	// cluster enables the checks which need access to the cluster, e.g. whether the referenced Secrets exist and whether
+	// the API server accepts the Etcds.
+	cluster bool
+	// strict fails the command on warnings as well.
+	strict bool
+}
+
+type lintRuntime struct {
+	*cmdutils.RuntimeEnv
+	// genericClient and etcdClient are only set if the checks against the cluster are enabled.
+	genericClient client.GenericClientInterface
+	etcdClient    client.EtcdClientInterface
+	// Printer is nil for the GitHub annotations output format, which is written by the command itself.
+	Printer printer.Printer
+}
+
+type lintCmdCtx struct {
+	*lintOptions
+	*lintRuntime
+}
+
+func newLintOptions(options *cmdutils.GlobalOptions) *lintOptions {
+	return &lintOptions{
+		GlobalOptions: options,
+		PrintFlags:    printer.NewPrintFlags(),
+	}
+}
+
+func newLintRuntime(runtime *cmdutils.RuntimeEnv) *lintRuntime {
+	return &lintRuntime{
+		RuntimeEnv: runtime,
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+)
+
+// outputTypeGitHub is the output format which writes the findings as workflow commands, which GitHub Actions shows as
+// annotations of the manifests.
+const outputTypeGitHub = "github"
+
+// ToTable converts the result into a table with one row per finding.
+func (r Result) ToTable() *printer.Table {
+	table := &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "LOCATION"},
+			{Name: "ETCD"},
+			{Name: "SEVERITY"},
+			{Name: "RULE"},
+			{Name: "MESSAGE"},
+			{Name: "FIELD", Wide: true},
+		},
+	}
+	for _, finding := range r.Findings {
+		table.Rows = append(table.Rows, printer.TableRow{
+			Cells: []string{
+				location(finding),
+				valueOrNone(finding.Etcd),
+				string(finding.Severity),
+				finding.Rule,
+				finding.Message,
+				valueOrNone(finding.Field),
+			},
+			Object: finding,
+		})
+	}
+	return table
+}
+
+func location(finding Finding) string {
+	if finding.Line == 0 {
+		return finding.File
+	}
+	return fmt.Sprintf("%s:%d", finding.File, finding.Line)
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
+// writeAnnotations writes the findings as GitHub Actions workflow commands, e.g.
+// ::error file=etcd.yaml,line=12,title=Schedules (default/test)::invalid cron schedule.
+func writeAnnotations(w io.Writer, findings []Finding) {
+	for _, finding := range findings {
+		level := "warning"
+		if finding.Severity == SeverityError {
+			level = "error"
+		}
+		var properties []string
+		if finding.File != stdinFilename {
+			properties = append(properties, "file="+escapeProperty(finding.File))
+		}
+		if finding.Line > 0 {
+			properties = append(properties, "line="+strconv.Itoa(finding.Line))
+		}
+		title := finding.Rule
+		if finding.Etcd != "" {
+			title = fmt.Sprintf("%s (%s)", finding.Rule, finding.Etcd)
+		}
+		properties = append(properties, "title="+escapeProperty(title))
+		fmt.Fprintf(w, "::%s %s::%s\n", level, strings.Join(properties, ","), escapeData(finding.Message))
+	}
+}
+
+var (
+	dataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	propertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+func escapeData(value string) string {
+	return dataEscaper.Replace(value)
+}
+
+func escapeProperty(value string) string {
+	return propertyEscaper.Replace(value)
+}
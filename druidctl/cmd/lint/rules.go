@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"fmt"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+	"github.com/gardener/etcd-druid/api/validation"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// maxFullSnapshotInterval is the interval from which on full snapshots are considered to be taken too rarely, as the
+// number of delta snapshots which have to be applied on a restoration grows with it.
+const maxFullSnapshotInterval = 24 * time.Hour
+
+// maxRecommendedQuota is the largest backend quota recommended by etcd.
+var maxRecommendedQuota = resource.MustParse("8Gi")
+
+// issue is a problem found by a rule. The field is empty if the issue refers to the Etcd as a whole.
+type issue struct {
+	rule     string
+	severity Severity
+	field    string
+	message  string
+}
+
+// rule is a check of an Etcd which runs offline. It returns the issues it has found, without setting their rule.
+type rule struct {
+	name string
+	run  func(etcd *druidv1alpha1.Etcd) []issue
+}
+
+// rules are all offline rules in the order in which they are run.
+var rules = []rule{
+	{name: "Validation", run: checkValidation},
+	{name: "ReplicaCount", run: checkReplicaCount},
+	{name: "BackupStore", run: checkBackupStore},
+	{name: "Schedules", run: checkSchedules},
+	{name: "BackendQuota", run: checkBackendQuota},
+	{name: "SecretReferences", run: checkSecretReferences},
+	{name: "TLS", run: checkTLS},
+}
+
+// runRules runs all offline rules against the given Etcd.
+func runRules(etcd *druidv1alpha1.Etcd) []issue {
+	var issues []issue
+	for _, r := range rules {
+		for _, i := range r.run(etcd) {
+			i.rule = r.name
+			issues = append(issues, i)
+		}
+	}
+	return issues
+}
+
+// checkValidation runs the validation of etcd-druid and the validation rules of the Etcd CRD, which reject invalid
+// Etcds when they are applied.
+func checkValidation(etcd *druidv1alpha1.Etcd) []issue {
+	var issues []issue
+	for _, err := range validation.ValidateEtcd(etcd) {
+		issues = append(issues, issue{severity: SeverityError, field: err.Field, message: err.ErrorBody()})
+	}
+	return append(issues, checkCRDValidation(etcd)...)
+}
+
+func checkReplicaCount(etcd *druidv1alpha1.Etcd) []issue {
+	if replicas := etcd.Spec.Replicas; replicas > 0 && replicas%2 == 0 {
+		return []issue{{
+			severity: SeverityWarning,
+			field:    field.NewPath("spec", "replicas").String(),
+			message:  fmt.Sprintf("%d replicas tolerate as many member failures as %d replicas, use an odd number of replicas", replicas, replicas-1),
+		}}
+	}
+	return nil
+}
+
+func checkBackupStore(etcd *druidv1alpha1.Etcd) []issue {
+	store := etcd.Spec.Backup.Store
+	if store == nil {
+		if etcd.Spec.Replicas > 0 {
+			return []issue{{
+				severity: SeverityWarning,
+				field:    field.NewPath("spec", "backup").String(),
+				message:  "no backup store is configured, the data cannot be restored after a loss of quorum or of the volumes",
+			}}
+		}
+		return nil
+	}
+
+	var issues []issue
+	storePath := field.NewPath("spec", "backup", "store")
+	if store.Provider == nil || *store.Provider == "" {
+		issues = append(issues, issue{severity: SeverityError, field: storePath.Child("provider").String(), message: "no storage provider is configured, no snapshots can be taken"})
+	}
+	if store.Container == nil || *store.Container == "" {
+		issues = append(issues, issue{severity: SeverityError, field: storePath.Child("container").String(), message: "no container is configured to store the snapshots in"})
+	}
+	if provider, err := druidresources.StorageProviderFromInfraProvider(store.Provider); err == nil && provider != "" && provider != druidresources.Local && store.SecretRef == nil {
+		issues = append(issues, issue{
+			severity: SeverityWarning,
+			field:    storePath.Child("secretRef").String(),
+			message:  fmt.Sprintf("no secret is referenced for the %s store, backup-restore falls back to the cloud identity of the pods", *store.Provider),
+		})
+	}
+	return issues
+}
+
+func checkSchedules(etcd *druidv1alpha1.Etcd) []issue {
+	var issues []issue
+	if schedule := etcd.Spec.Backup.FullSnapshotSchedule; schedule != nil {
+		schedulePath := field.NewPath("spec", "backup", "fullSnapshotSchedule").String()
+		interval, err := druidv1alpha1.ComputeScheduleInterval(*schedule)
+		switch {
+		case err != nil:
+			issues = append(issues, issue{severity: SeverityError, field: schedulePath, message: fmt.Sprintf("invalid cron schedule %q: %v", *schedule, err)})
+		case interval > maxFullSnapshotInterval:
+			issues = append(issues, issue{
+				severity: SeverityWarning,
+				field:    schedulePath,
+				message:  fmt.Sprintf("full snapshots are taken only every %s, take them at least daily to limit the delta snapshots which have to be applied on a restoration", cmdutils.ShortDuration(interval)),
+			})
+		}
+	}
+	if schedule := etcd.Spec.Etcd.DefragmentationSchedule; schedule != nil {
+		if _, err := druidv1alpha1.ComputeScheduleInterval(*schedule); err != nil {
+			issues = append(issues, issue{
+				severity: SeverityError,
+				field:    field.NewPath("spec", "etcd", "defragmentationSchedule").String(),
+				message:  fmt.Sprintf("invalid cron schedule %q: %v", *schedule, err),
+			})
+		}
+	}
+	return issues
+}
+
+func checkBackendQuota(etcd *druidv1alpha1.Etcd) []issue {
+	quota := etcd.Spec.Etcd.Quota
+	if quota == nil {
+		return nil
+	}
+	quotaPath := field.NewPath("spec", "etcd", "quota").String()
+	if quota.Sign() <= 0 {
+		return []issue{{severity: SeverityError, field: quotaPath, message: fmt.Sprintf("the backend quota %s must be positive", quota.String())}}
+	}
+	var issues []issue
+	if capacity := etcd.Spec.StorageCapacity; capacity != nil && quota.Cmp(*capacity) >= 0 {
+		issues = append(issues, issue{
+			severity: SeverityError,
+			field:    quotaPath,
+			message:  fmt.Sprintf("the backend quota %s is not smaller than the storage capacity %s, the volume runs full before the quota is exceeded", quota.String(), capacity.String()),
+		})
+	}
+	if quota.Cmp(maxRecommendedQuota) > 0 {
+		issues = append(issues, issue{
+			severity: SeverityWarning,
+			field:    quotaPath,
+			message:  fmt.Sprintf("the backend quota %s exceeds the maximum of %s recommended by etcd", quota.String(), maxRecommendedQuota.String()),
+		})
+	}
+	return issues
+}
+
+func checkSecretReferences(etcd *druidv1alpha1.Etcd) []issue {
+	var issues []issue
+	for _, ref := range secretReferences(etcd) {
+		if ref.Name == "" && !ref.optional {
+			issues = append(issues, issue{severity: SeverityError, field: ref.path.Child("name").String(), message: "the name of the referenced secret is missing"})
+		}
+	}
+	return issues
+}
+
+func checkTLS(etcd *druidv1alpha1.Etcd) []issue {
+	if etcd.Spec.ManagedCertificates != nil {
+		return nil
+	}
+	var issues []issue
+	if etcd.Spec.Etcd.ClientUrlTLS == nil {
+		issues = append(issues, issue{
+			severity: SeverityWarning,
+			field:    field.NewPath("spec", "etcd").String(),
+			message:  "the communication with clients is not encrypted, configure spec.managedCertificates or spec.etcd.clientUrlTls",
+		})
+	}
+	if etcd.Spec.Etcd.PeerUrlTLS == nil && etcd.Spec.Replicas > 1 {
+		issues = append(issues, issue{
+			severity: SeverityWarning,
+			field:    field.NewPath("spec", "etcd").String(),
+			message:  "the communication between members is not encrypted, configure spec.managedCertificates or spec.etcd.peerUrlTls",
+		})
+	}
+	return issues
+}
+
+// secretReference is a reference to a secret in the spec of an Etcd.
+type secretReference struct {
+	corev1.SecretReference
+	path *field.Path
+	// optional is true if the reference may be left empty.
+	optional bool
+}
+
+// secretReferences returns all references to secrets in the spec of the given Etcd.
+func secretReferences(etcd *druidv1alpha1.Etcd) []secretReference {
+	var refs []secretReference
+	specPath := field.NewPath("spec")
+	if store := etcd.Spec.Backup.Store; store != nil && store.SecretRef != nil {
+		refs = append(refs, secretReference{SecretReference: *store.SecretRef, path: specPath.Child("backup", "store", "secretRef")})
+	}
+	if restoreFrom := etcd.Spec.Backup.RestoreFrom; restoreFrom != nil && restoreFrom.Store.SecretRef != nil {
+		refs = append(refs, secretReference{SecretReference: *restoreFrom.Store.SecretRef, path: specPath.Child("backup", "restoreFrom", "store", "secretRef")})
+	}
+	if authSecretRef := etcd.Spec.Etcd.AuthSecretRef; authSecretRef != nil {
+		refs = append(refs, secretReference{SecretReference: *authSecretRef, path: specPath.Child("etcd", "authSecretRef")})
+	}
+	if tls := etcd.Spec.Etcd.ClientUrlTLS; tls != nil {
+		refs = append(refs, tlsSecretReferences(tls, specPath.Child("etcd", "clientUrlTls"), false)...)
+	}
+	if tls := etcd.Spec.Etcd.PeerUrlTLS; tls != nil {
+		// Peers do not authenticate with client certificates.
+		refs = append(refs, tlsSecretReferences(&tls.TLSConfig, specPath.Child("etcd", "peerUrlTls"), true)...)
+	}
+	if tls := etcd.Spec.Backup.TLS; tls != nil {
+		refs = append(refs, tlsSecretReferences(tls, specPath.Child("backup", "tls"), false)...)
+	}
+	return refs
+}
+
+func tlsSecretReferences(tls *druidv1alpha1.TLSConfig, path *field.Path, clientOptional bool) []secretReference {
+	return []secretReference{
+		{SecretReference: tls.TLSCASecretRef.SecretReference, path: path.Child("tlsCASecretRef")},
+		{SecretReference: tls.ServerTLSSecretRef, path: path.Child("serverTLSSecretRef")},
+		{SecretReference: tls.ClientTLSSecretRef, path: path.Child("clientTLSSecretRef"), optional: clientOptional},
+	}
+}
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// newValidEtcd returns an Etcd for which no rule reports an issue.
+func newValidEtcd() *druidv1alpha1.Etcd {
+	etcd := &druidv1alpha1.Etcd{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-etcd", Namespace: "default"},
+		Spec: druidv1alpha1.EtcdSpec{
+			Replicas:            3,
+			StorageCapacity:     ptr.To(resource.MustParse("16Gi")),
+			ManagedCertificates: &druidv1alpha1.ManagedCertificatesSpec{},
+		},
+	}
+	etcd.Spec.Etcd.Quota = ptr.To(resource.MustParse("8Gi"))
+	etcd.Spec.Etcd.DefragmentationSchedule = ptr.To("0 3 * * *")
+	etcd.Spec.Backup.FullSnapshotSchedule = ptr.To("0 */12 * * *")
+	etcd.Spec.Backup.Store = &druidv1alpha1.StoreSpec{
+		Provider:  ptr.To(druidv1alpha1.StorageProvider("aws")),
+		Container: ptr.To("test-bucket"),
+		Prefix:    "default--test-etcd",
+		SecretRef: &corev1.SecretReference{Name: "test-backup"},
+	}
+	return etcd
+}
+
+func TestRunRules(t *testing.T) {
+	tests := []struct {
+		name             string
+		modify           func(etcd *druidv1alpha1.Etcd)
+		expectedRule     string
+		expectedSeverity Severity
+		expectedField    string
+		expectedMessage  string
+	}{
+		{"invalid prefix", func(etcd *druidv1alpha1.Etcd) { etcd.Spec.Backup.Store.Prefix = "test" }, "Validation", SeverityError, "spec.backup.store.prefix", "must contain object name and namespace"},
+		{"unsupported provider", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Backup.Store.Provider = ptr.To(druidv1alpha1.StorageProvider("ftp"))
+		}, "Validation", SeverityError, "spec.backup.store.provider", "unsupported storage provider"},
+		{"equal allowed hours", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Backup.SnapshotCompaction = &druidv1alpha1.SnapshotCompactionSpec{AllowedHours: &druidv1alpha1.CompactionAllowedHours{Start: 2, End: 2}}
+		}, "Validation", SeverityError, "spec.backup.snapshotCompaction.allowedHours", "must not be equal"},
+		{"managed certificates with client TLS", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Etcd.ClientUrlTLS = ptr.To(newTestTLSConfig())
+		}, "Validation", SeverityError, "spec", "cannot be set together with etcd.spec.etcd.clientUrlTls"},
+		{"reserved user name", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Etcd.Auth = &druidv1alpha1.EtcdAuth{Users: []druidv1alpha1.EtcdAuthUser{{Name: "root"}}}
+		}, "Validation", SeverityError, "spec.etcd.auth.users[0].name", "the user name root is reserved"},
+		{"undefined role", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Etcd.Auth = &druidv1alpha1.EtcdAuth{Users: []druidv1alpha1.EtcdAuthUser{{Name: "app", Roles: []string{"app"}}}}
+		}, "Validation", SeverityError, "spec.etcd.auth", "must be defined in etcd.spec.etcd.auth.roles"},
+		{"user of backup-restore", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Etcd.Auth = &druidv1alpha1.EtcdAuth{Users: []druidv1alpha1.EtcdAuthUser{{Name: "test-etcd-client"}}}
+		}, "Validation", SeverityError, "", "client certificate of etcd-backup-restore"},
+		{"health check with http and metric", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.HealthChecks = []druidv1alpha1.EtcdHealthCheck{{
+				ConditionType: "DatabaseSize",
+				HTTP:          &druidapicommon.HTTPHealthCheck{Path: "/health"},
+				Metric:        &druidapicommon.MetricHealthCheck{Name: "etcd_mvcc_db_total_size_in_bytes", Max: ptr.To(resource.MustParse("6Gi"))},
+			}}
+		}, "Validation", SeverityError, "spec.healthChecks[0]", "exactly one of http and metric"},
+		{"health check with druid-managed condition type", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.HealthChecks = []druidv1alpha1.EtcdHealthCheck{{ConditionType: "Ready", HTTP: &druidapicommon.HTTPHealthCheck{Path: "/health"}}}
+		}, "Validation", SeverityError, "spec.healthChecks[0].conditionType", "maintained by etcd-druid"},
+		{"garbage collection period not greater than delta snapshot period", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Backup.DeltaSnapshotPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+			etcd.Spec.Backup.GarbageCollectionPeriod = &metav1.Duration{Duration: 5*time.Minute + 500*time.Millisecond}
+		}, "Validation", SeverityError, "spec.backup", "garbageCollectionPeriod must be greater than"},
+		{"invalid endpoint override", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Backup.Store.EndpointOverride = ptr.To("s3 endpoint")
+		}, "Validation", SeverityError, "spec.backup.store.endpointOverride", "must be a valid URL"},
+		{"additional advertise peer URL of another Etcd", func(etcd *druidv1alpha1.Etcd) {
+			withPeerURLs(etcd, druidv1alpha1.MemberPeerURLs{MemberName: "other-etcd-0", URLs: []string{"https://10.0.0.1:2380"}})
+		}, "Validation", SeverityError, "", "must start with the Etcd resource name"},
+		{"additional advertise peer URL of a member beyond the replicas", func(etcd *druidv1alpha1.Etcd) {
+			withPeerURLs(etcd, druidv1alpha1.MemberPeerURLs{MemberName: "test-etcd-3", URLs: []string{"https://10.0.0.1:2380"}})
+		}, "Validation", SeverityError, "", "index must be less than replicas"},
+		{"additional advertise peer URL without TLS", func(etcd *druidv1alpha1.Etcd) {
+			withPeerURLs(etcd, druidv1alpha1.MemberPeerURLs{MemberName: "test-etcd-0", URLs: []string{"http://10.0.0.1:2380"}})
+		}, "Validation", SeverityError, "spec.etcd", "when peerUrlTls is enabled, all additional advertise peer URLs must use https://"},
+		{"invalid additional advertise peer URL", func(etcd *druidv1alpha1.Etcd) {
+			withPeerURLs(etcd, druidv1alpha1.MemberPeerURLs{MemberName: "test-etcd-0", URLs: []string{"https://10.0.0.1:2380/%zz"}})
+		}, "Validation", SeverityError, "spec.etcd.additionalAdvertisePeerURLs[0].urls[0]", "must be a valid http:// or https:// URL"},
+		{"duplicate members of the existing cluster", func(etcd *druidv1alpha1.Etcd) {
+			withExistingCluster(etcd, []string{"https://source-client:2379"}, "source-0", "source-0")
+		}, "Validation", SeverityError, "", "members[*].name must be unique"},
+		{"member of the existing cluster colliding with a target member", func(etcd *druidv1alpha1.Etcd) {
+			withExistingCluster(etcd, []string{"https://source-client:2379"}, "test-etcd-0")
+		}, "Validation", SeverityError, "", "must not collide with a target member"},
+		{"client endpoint of the existing cluster without TLS", func(etcd *druidv1alpha1.Etcd) {
+			withExistingCluster(etcd, []string{"http://source-client:2379"}, "source-0")
+		}, "Validation", SeverityError, "spec.etcd", "when clientUrlTls is enabled, all bootstrapWithExistingCluster clientEndpoints must use https://"},
+		{"even replicas", func(etcd *druidv1alpha1.Etcd) { etcd.Spec.Replicas = 4 }, "ReplicaCount", SeverityWarning, "spec.replicas", "odd number"},
+		{"no backup store", func(etcd *druidv1alpha1.Etcd) { etcd.Spec.Backup.Store = nil }, "BackupStore", SeverityWarning, "spec.backup", "no backup store"},
+		{"no provider", func(etcd *druidv1alpha1.Etcd) { etcd.Spec.Backup.Store.Provider = nil }, "BackupStore", SeverityError, "spec.backup.store.provider", "no storage provider"},
+		{"no container", func(etcd *druidv1alpha1.Etcd) { etcd.Spec.Backup.Store.Container = nil }, "BackupStore", SeverityError, "spec.backup.store.container", "no container"},
+		{"no backup secret", func(etcd *druidv1alpha1.Etcd) { etcd.Spec.Backup.Store.SecretRef = nil }, "BackupStore", SeverityWarning, "spec.backup.store.secretRef", "cloud identity"},
+		{"invalid full snapshot schedule", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Backup.FullSnapshotSchedule = ptr.To("0 */12 * *")
+		}, "Schedules", SeverityError, "spec.backup.fullSnapshotSchedule", "invalid cron schedule"},
+		{"weekly full snapshots", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Backup.FullSnapshotSchedule = ptr.To("0 0 * * 0")
+		}, "Schedules", SeverityWarning, "spec.backup.fullSnapshotSchedule", "only every 7d"},
+		{"invalid defragmentation schedule", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Etcd.DefragmentationSchedule = ptr.To("daily")
+		}, "Schedules", SeverityError, "spec.etcd.defragmentationSchedule", "invalid cron schedule"},
+		{"zero quota", func(etcd *druidv1alpha1.Etcd) { etcd.Spec.Etcd.Quota = ptr.To(resource.MustParse("0")) }, "BackendQuota", SeverityError, "spec.etcd.quota", "must be positive"},
+		{"quota exceeds capacity", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.StorageCapacity = ptr.To(resource.MustParse("8Gi"))
+		}, "BackendQuota", SeverityError, "spec.etcd.quota", "not smaller than the storage capacity 8Gi"},
+		{"large quota", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.Etcd.Quota = ptr.To(resource.MustParse("12Gi"))
+		}, "BackendQuota", SeverityWarning, "spec.etcd.quota", "recommended by etcd"},
+		{"missing client TLS secret", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.ManagedCertificates = nil
+			etcd.Spec.Etcd.PeerUrlTLS = &druidv1alpha1.PeerTLSConfig{TLSConfig: newTestTLSConfig()}
+			etcd.Spec.Etcd.ClientUrlTLS = ptr.To(newTestTLSConfig())
+			etcd.Spec.Etcd.ClientUrlTLS.ClientTLSSecretRef.Name = ""
+		}, "SecretReferences", SeverityError, "spec.etcd.clientUrlTls.clientTLSSecretRef.name", "missing"},
+		{"no client TLS", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.ManagedCertificates = nil
+			etcd.Spec.Etcd.PeerUrlTLS = &druidv1alpha1.PeerTLSConfig{TLSConfig: newTestTLSConfig()}
+		}, "TLS", SeverityWarning, "spec.etcd", "communication with clients is not encrypted"},
+		{"no peer TLS", func(etcd *druidv1alpha1.Etcd) {
+			etcd.Spec.ManagedCertificates = nil
+			etcd.Spec.Etcd.ClientUrlTLS = ptr.To(newTestTLSConfig())
+		}, "TLS", SeverityWarning, "spec.etcd", "communication between members is not encrypted"},
+	}
+
+	if issues := runRules(newValidEtcd()); len(issues) > 0 {
+		t.Errorf("Expected no issues for a valid etcd, got %v", issues)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			etcd := newValidEtcd()
+			tt.modify(etcd)
+			issues := runRules(etcd)
+			if len(issues) != 1 {
+				t.Fatalf("Expected exactly one issue, got %v", issues)
+			}
+			i := issues[0]
+			if i.rule != tt.expectedRule || i.severity != tt.expectedSeverity || i.field != tt.expectedField || !strings.Contains(i.message, tt.expectedMessage) {
+				t.Errorf("Expected rule %s to report %s for %s containing %q, got %s %s for %s: %s",
+					tt.expectedRule, tt.expectedSeverity, tt.expectedField, tt.expectedMessage, i.rule, i.severity, i.field, i.message)
+			}
+		})
+	}
+}
+
+func TestSecretReferencesOfPeerTLS(t *testing.T) {
+	etcd := newValidEtcd()
+	etcd.Spec.ManagedCertificates = nil
+	etcd.Spec.Etcd.ClientUrlTLS = ptr.To(newTestTLSConfig())
+	etcd.Spec.Etcd.PeerUrlTLS = &druidv1alpha1.PeerTLSConfig{TLSConfig: newTestTLSConfig()}
+	etcd.Spec.Etcd.PeerUrlTLS.ClientTLSSecretRef.Name = ""
+	if issues := runRules(etcd); len(issues) > 0 {
+		t.Errorf("Expected no issues if peers are configured without a client secret, got %v", issues)
+	}
+}
+
+// withPeerURLs configures TLS and the given additional advertise peer URLs, which cannot be used with managed
+// certificates.
+func withPeerURLs(etcd *druidv1alpha1.Etcd, peerURLs ...druidv1alpha1.MemberPeerURLs) {
+	etcd.Spec.ManagedCertificates = nil
+	etcd.Spec.Etcd.ClientUrlTLS = ptr.To(newTestTLSConfig())
+	etcd.Spec.Etcd.PeerUrlTLS = &druidv1alpha1.PeerTLSConfig{TLSConfig: newTestTLSConfig()}
+	etcd.Spec.Etcd.AdditionalAdvertisePeerURLs = peerURLs
+}
+
+// withExistingCluster configures TLS and the bootstrap with an existing cluster with the given client endpoints and
+// members, which cannot be used with managed certificates.
+func withExistingCluster(etcd *druidv1alpha1.Etcd, clientEndpoints []string, memberNames ...string) {
+	withPeerURLs(etcd)
+	bootstrap := &druidv1alpha1.BootstrapWithExistingCluster{ClientEndpoints: clientEndpoints}
+	for i, name := range memberNames {
+		bootstrap.Members = append(bootstrap.Members, druidv1alpha1.BootstrapExistingMember{Name: name, PeerURLs: []string{fmt.Sprintf("https://10.0.0.%d:2380", i+1)}})
+	}
+	etcd.Spec.Etcd.BootstrapWithExistingCluster = bootstrap
+}
+
+func newTestTLSConfig() druidv1alpha1.TLSConfig {
+	return druidv1alpha1.TLSConfig{
+		TLSCASecretRef:     druidv1alpha1.SecretReference{SecretReference: corev1.SecretReference{Name: "test-ca"}},
+		ServerTLSSecretRef: corev1.SecretReference{Name: "test-server-tls"},
+		ClientTLSSecretRef: corev1.SecretReference{Name: "test-client-tls"},
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+// Severity is the severity of a finding.
+type Severity string
+
+const (
+	// SeverityWarning indicates a deviation from best practices, which does not prevent the Etcd from being applied.
+	SeverityWarning Severity = "Warning"
+	// SeverityError indicates a mistake which is rejected when the Etcd is applied, or which breaks the etcd cluster.
+	SeverityError Severity = "Error"
+)
+
+// Finding is a problem found in an Etcd manifest.
+type Finding struct {
+	// File is the path of the manifest, or - if it has been read from stdin.
+	File string `json:"file" yaml:"file"`
+	// Line is the line in the manifest the finding refers to, starting at 1.
+	Line int `json:"line" yaml:"line"`
+	// Etcd is the name of the Etcd, prefixed by its namespace. It is empty if the manifest cannot be parsed.
+	Etcd     string   `json:"etcd,omitempty" yaml:"etcd,omitempty"`
+	Rule     string   `json:"rule" yaml:"rule"`
+	Severity Severity `json:"severity" yaml:"severity"`
+	// Field is the path of the field the finding refers to, e.g. spec.backup.fullSnapshotSchedule.
+	Field   string `json:"field,omitempty" yaml:"field,omitempty"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// Result is the result of the lint command.
+type Result struct {
+	// Etcds is the number of Etcd manifests which have been linted.
+	Etcds    int       `json:"etcds" yaml:"etcds"`
+	Errors   int       `json:"errors" yaml:"errors"`
+	Warnings int       `json:"warnings" yaml:"warnings"`
+	Findings []Finding `json:"findings" yaml:"findings"`
+	Kind     string    `json:"kind" yaml:"kind"`
+}
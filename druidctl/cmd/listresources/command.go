@@ -6,7 +6,6 @@ package listresources
 
 import (
 	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
-	"github.com/gardener/etcd-druid/druidctl/internal/printer"
 
 	"github.com/spf13/cobra"
 )
@@ -41,12 +40,18 @@ kubectl druid list-resources my-etcd --filter=pods,services
 
 # Output in JSON format
 kubectl druid list-resources my-etcd -n test --output=json
+
+# Output a table with additional columns, sorted by age
+kubectl druid list-resources -A --output=wide --sort-by=.age
+
+# Output a table with custom columns and without headers
+kubectl druid list-resources my-etcd --output=custom-columns=KIND:.kind,NAME:.name --no-headers
 `
 )
 
 // NewListResourcesCommand creates the list-resources command
 func NewListResourcesCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
-	listResourcesOptions := newListResourcesOptions(cmdCtx.Options, defaultFilter, string(printer.OutputTypeNone))
+	listResourcesOptions := newListResourcesOptions(cmdCtx.Options, defaultFilter)
 
 	listResourcesCmd := &cobra.Command{
 		Use:     "list-resources <etcd-resource-name> --filter=<comma separated types> (optional flag) --output=<output-format> (optional flag)",
@@ -80,7 +85,7 @@ func NewListResourcesCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
 	}
 
 	listResourcesCmd.Flags().StringVarP(&listResourcesOptions.Filter, "filter", "f", defaultFilter, "Comma-separated list of resource types to include (short or full names). Use 'all' for a curated default set.")
-	listResourcesCmd.Flags().StringVarP(&listResourcesOptions.OutputFormat, "output", "o", string(printer.OutputTypeNone), "Output format. One of: json, yaml")
+	listResourcesOptions.PrintFlags.AddFlags(listResourcesCmd)
 
 	return listResourcesCmd
 }
@@ -277,6 +277,9 @@ func TestListResourcesOutputFormats(t *testing.T) {
 	}{
 		{"json_output", "json", `"kind": "EtcdResourceList"`},
 		{"yaml_output", "yaml", "kind: EtcdResourceList"},
+		{"table_output", "table", "default/test-etcd   Pod"},
+		{"wide_output", "wide", "RESOURCE"},
+		{"custom_columns_output", "custom-columns=KIND:.kind,NAME:.name", "Service   test-etcd-client"},
 	}
 
 	for _, tt := range tests {
@@ -12,22 +12,10 @@ import (
 	"time"
 
 	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
-	"github.com/gardener/etcd-druid/druidctl/internal/log"
-	"github.com/gardener/etcd-druid/druidctl/internal/printer"
 
-	"k8s.io/apimachinery/pkg/api/meta"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
-// resourceMeta captures details needed to operate against a resource type.
-type resourceMeta struct {
-	GVR        schema.GroupVersionResource
-	Kind       string
-	Namespaced bool
-}
-
 func (l *listResourcesCmdCtx) complete() error {
 	etcdClient, err := l.Clients.EtcdClient()
 	if err != nil {
@@ -41,7 +29,7 @@ func (l *listResourcesCmdCtx) complete() error {
 	}
 	l.GenericClient = genericClient
 
-	l.Printer, err = printer.NewFormatter(printer.OutputFormat(l.OutputFormat))
+	l.Printer, err = l.PrintFlags.ToPrinter()
 	if err != nil {
 		return fmt.Errorf("failed to create formatter: %w", err)
 	}
@@ -71,36 +59,12 @@ func (l *listResourcesCmdCtx) execute(ctx context.Context) error {
 
 	resourceTokens := parseFilter(l.Filter)
 	if len(resourceTokens) == 0 || (len(resourceTokens) == 1 && resourceTokens[0] == "all") {
-		resourceTokens = defaultResourceTokens()
+		resourceTokens = cmdutils.DefaultResourceTokens()
 	}
 
-	mapper := genClient.RESTMapper()
-	resourceMetas := make([]resourceMeta, 0, len(resourceTokens))
-	seen := make(map[schema.GroupVersionResource]bool)
-
-	for _, token := range resourceTokens {
-		gvr, err := mapper.ResourceFor(schema.GroupVersionResource{Resource: token})
-		if err != nil {
-			return fmt.Errorf("unknown resource '%s': %w", token, err)
-		}
-		if seen[gvr] {
-			continue
-		}
-		seen[gvr] = true
-
-		gvk, err := mapper.KindFor(gvr)
-		if err != nil {
-			return fmt.Errorf("failed to get kind for '%s': %w", token, err)
-		}
-		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
-		if err != nil {
-			return fmt.Errorf("failed to get mapping for '%s': %w", token, err)
-		}
-		resourceMetas = append(resourceMetas, resourceMeta{
-			GVR:        gvr,
-			Kind:       gvk.Kind,
-			Namespaced: mapping.Scope.Name() == meta.RESTScopeNameNamespace,
-		})
+	resourceMetas, err := cmdutils.ResolveResourceMetas(genClient.RESTMapper(), resourceTokens)
+	if err != nil {
+		return err
 	}
 
 	// Identify etcds to operate on
@@ -123,17 +87,12 @@ func (l *listResourcesCmdCtx) execute(ctx context.Context) error {
 	}
 	for _, etcd := range etcdList.Items {
 		etcdResult := EtcdResourceResult{
-			Etcd:  EtcdRef{Name: etcd.Name, Namespace: etcd.Namespace},
+			Etcd:  cmdutils.EtcdRef{Name: etcd.Name, Namespace: etcd.Namespace},
 			Items: make([]ResourceListPerKey, 0),
 		}
 
-		labelSelector := fmt.Sprintf("app.kubernetes.io/part-of=%s", etcd.Name)
 		for _, resMeta := range resourceMetas {
-			resourceNamespace := ""
-			if resMeta.Namespaced {
-				resourceNamespace = etcd.Namespace
-			}
-			resourceList, err := genClient.Dynamic().Resource(resMeta.GVR).Namespace(resourceNamespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+			resourceList, err := cmdutils.ListManagedResources(ctx, genClient, &etcd, resMeta)
 			if err != nil {
 				out.Warning(l.IOStreams.Out, "Failed to list ", resMeta.GVR.Resource, " for etcd ", etcd.Name, ": ", err.Error())
 				continue
@@ -179,16 +138,11 @@ func (l *listResourcesCmdCtx) execute(ctx context.Context) error {
 		}
 		return result.Etcds[i].Etcd.Namespace < result.Etcds[j].Etcd.Namespace
 	})
-	if l.Printer != nil {
-		var outputData []byte
-		outputData, err = l.Printer.Print(result)
-		if err != nil {
-			return fmt.Errorf("failed to marshal result to desired format: %w", err)
-		}
-		fmt.Fprintf(l.IOStreams.Out, "%s\n", string(outputData))
-		return nil
+	outputData, err := l.Printer.Print(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result to desired format: %w", err)
 	}
-	l.renderListResources(out, result.Etcds)
+	fmt.Fprintf(l.IOStreams.Out, "%s\n", string(outputData))
 	return nil
 }
 
@@ -209,11 +163,6 @@ func parseFilter(filter string) []string {
 	return out
 }
 
-// defaultResourceTokens returns the curated default set for "all"
-func defaultResourceTokens() []string {
-	return []string{"pods", "statefulsets", "services", "configmaps", "secrets", "persistentvolumeclaims", "leases", "poddisruptionbudgets", "roles", "rolebindings", "serviceaccounts"}
-}
-
 func toResourceRef(u *unstructured.Unstructured) ResourceRef {
 	age := time.Since(u.GetCreationTimestamp().Time)
 	return ResourceRef{
@@ -222,26 +171,3 @@ func toResourceRef(u *unstructured.Unstructured) ResourceRef {
 		Age:       age,
 	}
 }
-
-// renderListResources prints results in a grouped, neat format using the Logger.
-func (l *listResourcesCmdCtx) renderListResources(log log.Logger, results []EtcdResourceResult) {
-	for _, etcdResourceResult := range results {
-		log.RawHeader(l.IOStreams.Out, fmt.Sprintf("Etcd [%s/%s]", etcdResourceResult.Etcd.Namespace, etcdResourceResult.Etcd.Name))
-		if len(etcdResourceResult.Items) == 0 {
-			log.Info(l.IOStreams.Out, "No resources found for selected filters")
-			continue
-		}
-
-		keyList := etcdResourceResult.Items
-		sort.Slice(keyList, func(i, j int) bool {
-			if keyList[i].Key.Kind == keyList[j].Key.Kind {
-				return len(keyList[i].Key.Resource) < len(keyList[j].Key.Resource)
-			}
-			return len(keyList[i].Key.Kind) < len(keyList[j].Key.Kind)
-		})
-		table := SetupListResourcesTable(keyList)
-		if _, err := fmt.Fprintln(l.IOStreams.Out, table); err != nil {
-			log.Warning(l.IOStreams.ErrOut, "Failed writing resources table: ", err.Error())
-		}
-	}
-}
@@ -15,8 +15,8 @@ import (
 // listResourcesOptions holds command-specific options for the list-resources command
 type listResourcesOptions struct {
 	*cmdutils.GlobalOptions
-	Filter       string
-	OutputFormat string
+	Filter     string
+	PrintFlags *printer.PrintFlags
 }
 
 // listResourcesRuntime holds runtime state for the list-resources command
@@ -34,11 +34,11 @@ type listResourcesCmdCtx struct {
 	*listResourcesRuntime
 }
 
-func newListResourcesOptions(options *cmdutils.GlobalOptions, filter string, outputFormat string) *listResourcesOptions {
+func newListResourcesOptions(options *cmdutils.GlobalOptions, filter string) *listResourcesOptions {
 	return &listResourcesOptions{
 		GlobalOptions: options,
 		Filter:        filter,
-		OutputFormat:  outputFormat,
+		PrintFlags:    printer.NewPrintFlags(),
 	}
 }
 
@@ -5,39 +5,57 @@
 package listresources
 
 import (
-	"fmt"
-
 	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
-
-	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/lipgloss/table"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
 )
 
-// SetupListResourcesTable constructs a lipgloss table representing the provided resource keys.
-func SetupListResourcesTable(keyList []ResourceListPerKey) *table.Table {
-	columns := []string{"Kind", "NamespacedName", "Age"}
-	var rows [][]string
-	for _, resourceKey := range keyList {
-		resourceList := resourceKey.Resources
-		for _, r := range resourceList {
-			age := cmdutils.ShortDuration(r.Age)
-			namespace := r.Namespace
-			if namespace == "" {
-				namespace = "N/A"
+// resourceRow is the object a table row is built from. Custom columns and sort-by expressions refer to its fields.
+type resourceRow struct {
+	Etcd cmdutils.EtcdRef `json:"etcd"`
+	ResourceKey
+	ResourceRef
+}
+
+// ToTable converts the result into a table with one row per managed resource.
+func (r Result) ToTable() *printer.Table {
+	table := &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "ETCD"},
+			{Name: "KIND"},
+			{Name: "NAMESPACE"},
+			{Name: "NAME"},
+			{Name: "AGE"},
+			{Name: "GROUP", Wide: true},
+			{Name: "VERSION", Wide: true},
+			{Name: "RESOURCE", Wide: true},
+		},
+	}
+	for _, etcdResult := range r.Etcds {
+		for _, resourceListPerKey := range etcdResult.Items {
+			for _, resource := range resourceListPerKey.Resources {
+				namespace := resource.Namespace
+				if namespace == "" {
+					namespace = "N/A"
+				}
+				group := resourceListPerKey.Key.Group
+				if group == "" {
+					group = "core"
+				}
+				table.Rows = append(table.Rows, printer.TableRow{
+					Cells: []string{
+						etcdResult.Etcd.Namespace + "/" + etcdResult.Etcd.Name,
+						resourceListPerKey.Key.Kind,
+						namespace,
+						resource.Name,
+						cmdutils.ShortDuration(resource.Age),
+						group,
+						resourceListPerKey.Key.Version,
+						resourceListPerKey.Key.Resource,
+					},
+					Object: resourceRow{Etcd: etcdResult.Etcd, ResourceKey: resourceListPerKey.Key, ResourceRef: resource},
+				})
 			}
-			rows = append(rows, []string{
-				resourceKey.Key.Kind,
-				fmt.Sprintf("%s/%s", namespace, r.Name),
-				age,
-			})
 		}
 	}
-	t := table.New().
-		Border(lipgloss.NormalBorder()).
-		Headers(columns...).
-		Rows(rows...).
-		StyleFunc(func(_, _ int) lipgloss.Style {
-			return lipgloss.NewStyle()
-		})
-	return t
+	return table
 }
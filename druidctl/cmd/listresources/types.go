@@ -4,7 +4,11 @@
 
 package listresources
 
-import "time"
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+)
 
 // ResourceKey identifies a Kubernetes resource kind by group/version/resource/Kind.
 type ResourceKey struct {
@@ -21,12 +25,6 @@ type ResourceRef struct {
 	Age       time.Duration `json:"age" yaml:"age"`
 }
 
-// EtcdRef identifies an Etcd custom resource by name and namespace.
-type EtcdRef struct {
-	Name      string `json:"name" yaml:"name"`
-	Namespace string `json:"namespace" yaml:"namespace"`
-}
-
 // ResourceListPerKey groups resources under a single ResourceKey.
 type ResourceListPerKey struct {
 	Key       ResourceKey   `json:"key" yaml:"key"`
@@ -35,7 +33,7 @@ type ResourceListPerKey struct {
 
 // EtcdResourceResult contains all managed resources for a single Etcd.
 type EtcdResourceResult struct {
-	Etcd  EtcdRef              `json:"etcd" yaml:"etcd"`
+	Etcd  cmdutils.EtcdRef     `json:"etcd" yaml:"etcd"`
 	Items []ResourceListPerKey `json:"items" yaml:"items"`
 }
 
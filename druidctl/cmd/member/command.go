@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package member
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultDialTimeout    = 10 * time.Second
+	defaultTimeout        = time.Minute
+	defaultRestartTimeout = 5 * time.Minute
+)
+
+var (
+	listExample = `
+# List the members of an etcd resource in the default namespace
+kubectl druid member list my-etcd
+
+# List the members of an etcd resource with their IDs and nodes
+kubectl druid member list test/my-etcd -o wide
+`
+	restartExample = `
+# Restart a member of an etcd resource
+kubectl druid member restart test/my-etcd my-etcd-1
+
+# Restart a member and wait until it is ready again
+kubectl druid member restart test/my-etcd my-etcd-1 --wait
+`
+	removeExample = `
+# Remove a member of an etcd resource, which then joins the cluster again with an empty data directory
+kubectl druid member remove test/my-etcd my-etcd-2
+`
+	moveLeaderExample = `
+# Transfer the leadership of an etcd cluster to a member
+kubectl druid member move-leader test/my-etcd my-etcd-0
+`
+)
+
+// NewMemberCommand creates the 'member' command with nested subcommands
+// Structure:
+//   - `kubectl druid member list <etcd-resource>` - list the members of an etcd cluster
+//   - `kubectl druid member restart <etcd-resource> <member>` - restart a member
+//   - `kubectl druid member remove <etcd-resource> <member>` - remove a member and clean up its PVC
+//   - `kubectl druid member move-leader <etcd-resource> <member>` - transfer the leadership to a member
+func NewMemberCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	memberCmd := &cobra.Command{
+		Use:   "member",
+		Short: "List and operate on the members of etcd clusters",
+		Long: `List and operate on the members of etcd clusters.
+Members are given by their name or the name of their pod. Operations which make a member unavailable are refused if the
+remaining members would not keep the quorum of the cluster, according to the member status of the etcd resource.`,
+	}
+
+	memberCmd.AddCommand(NewListCommand(cmdCtx))
+	memberCmd.AddCommand(NewRestartCommand(cmdCtx))
+	memberCmd.AddCommand(NewRemoveCommand(cmdCtx))
+	memberCmd.AddCommand(NewMoveLeaderCommand(cmdCtx))
+
+	return memberCmd
+}
+
+// NewListCommand creates the 'member list' subcommand
+func NewListCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newMemberOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:     "list <etcd-resource-name> [flags]",
+		Aliases: []string{"ls"},
+		Short:   "List the members of an etcd cluster",
+		Long:    "List the members of an etcd cluster with their roles and readiness as reported in the status of the etcd resource.",
+		Example: listExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			listCmdCtx := &listCmdCtx{
+				memberOptions: opts,
+				memberRuntime: newMemberRuntime(cmdCtx.Runtime),
+			}
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, listCmdCtx, "Listing members failed")
+		},
+	}
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+// NewRestartCommand creates the 'member restart' subcommand
+func NewRestartCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newMemberOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:   "restart <etcd-resource-name> <member> [flags]",
+		Short: "Restart a member of an etcd cluster",
+		Long: `Restart a member of an etcd cluster by deleting its pod, which is then recreated by the StatefulSet.
+The member is only restarted if the remaining members are ready and keep the quorum of the cluster.`,
+		Example: restartExample,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			restartCmdCtx := &restartCmdCtx{
+				memberOptions: opts,
+				memberRuntime: newMemberRuntime(cmdCtx.Runtime),
+			}
+			opts.ResourceArgs, opts.memberName = args[:1], args[1]
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, restartCmdCtx, "Restarting member failed")
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.wait, "wait", false, "Wait until the restarted member is ready again")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", defaultRestartTimeout, "Maximum time to wait for the restarted member")
+
+	return cmd
+}
+
+// NewRemoveCommand creates the 'member remove' subcommand
+func NewRemoveCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newMemberOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:   "remove <etcd-resource-name> <member> [flags]",
+		Short: "Remove a member from an etcd cluster and delete its PVC",
+		Long: `Remove a member from an etcd cluster and delete its PVC and its pod.
+The command waits until the PVC has been deleted, and deletes the pod again if the StatefulSet recreates it before, so
+that it does not reuse the old volume. The StatefulSet then recreates the pod with an empty volume, which joins the
+cluster again as a new member. Use this to replace a member whose data is corrupted or whose volume has to be recreated.
+The member is only removed if the remaining members are ready and keep the quorum of the cluster. The leader is not
+removed, move the leadership to another member first.`,
+		Example: removeExample,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			removeCmdCtx := &removeCmdCtx{
+				memberOptions: opts,
+				memberRuntime: newMemberRuntime(cmdCtx.Runtime),
+			}
+			opts.ResourceArgs, opts.memberName = args[:1], args[1]
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, removeCmdCtx, "Removing member failed")
+		},
+	}
+
+	opts.addConnectionFlags(cmd)
+
+	return cmd
+}
+
+// NewMoveLeaderCommand creates the 'member move-leader' subcommand
+func NewMoveLeaderCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newMemberOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:     "move-leader <etcd-resource-name> <member> [flags]",
+		Short:   "Transfer the leadership of an etcd cluster to a member",
+		Long:    "Transfer the leadership of an etcd cluster to a member. The member has to be ready and the cluster has to have quorum.",
+		Example: moveLeaderExample,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			moveLeaderCmdCtx := &moveLeaderCmdCtx{
+				memberOptions: opts,
+				memberRuntime: newMemberRuntime(cmdCtx.Runtime),
+			}
+			opts.ResourceArgs, opts.memberName = args[:1], args[1]
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, moveLeaderCmdCtx, "Moving leadership failed")
+		},
+	}
+
+	opts.addConnectionFlags(cmd)
+
+	return cmd
+}
+
+// addConnectionFlags adds the flags of subcommands which connect to the etcd cluster.
+func (o *memberOptions) addConnectionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.tlsServerName, "tls-server-name", "", "Server name to verify the server certificate of etcd with. Defaults to the DNS name of the client service")
+	cmd.Flags().DurationVar(&o.dialTimeout, "dial-timeout", defaultDialTimeout, "Timeout for establishing the connection to etcd")
+	cmd.Flags().DurationVar(&o.timeout, "timeout", defaultTimeout, "Timeout of the operation on the etcd cluster")
+}
@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package member
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+	fake "github.com/gardener/etcd-druid/druidctl/internal/client/fake"
+	"github.com/gardener/etcd-druid/druidctl/internal/etcdconn"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// fakeMemberAPI is an etcdconn.API which only supports the member operations, and records the removed members and
+// the transferee of the leadership.
+type fakeMemberAPI struct {
+	etcdconn.API
+	members    []*etcdserverpb.Member
+	removed    []uint64
+	transferee uint64
+}
+
+func (f *fakeMemberAPI) MemberList(_ context.Context, _ ...clientv3.OpOption) (*clientv3.MemberListResponse, error) {
+	return &clientv3.MemberListResponse{Members: f.members}, nil
+}
+
+func (f *fakeMemberAPI) MemberRemove(_ context.Context, id uint64) (*clientv3.MemberRemoveResponse, error) {
+	f.removed = append(f.removed, id)
+	return &clientv3.MemberRemoveResponse{}, nil
+}
+
+func (f *fakeMemberAPI) MoveLeader(_ context.Context, id uint64) (*clientv3.MoveLeaderResponse, error) {
+	f.transferee = id
+	return &clientv3.MoveLeaderResponse{}, nil
+}
+
+func newFakeMemberAPI() *fakeMemberAPI {
+	return &fakeMemberAPI{members: []*etcdserverpb.Member{
+		{ID: 0xa0, Name: "test-etcd-0"},
+		{ID: 0xa1, Name: "test-etcd-1"},
+		{ID: 0xa2, Name: "test-etcd-2"},
+	}}
+}
+
+// testMemberIDs are the IDs of the members of the fake member API.
+var testMemberIDs = []string{etcdconn.FormatID(0xa0), etcdconn.FormatID(0xa1), etcdconn.FormatID(0xa2)}
+
+func newTestPods(etcd *druidv1alpha1.Etcd) []runtime.Object {
+	var objects []runtime.Object
+	for i := range int(etcd.Spec.Replicas) {
+		podName := druidv1alpha1.GetOrdinalPodName(etcd.ObjectMeta, i)
+		objects = append(objects,
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: "default", Labels: druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), UID: "old"},
+				Spec:       corev1.PodSpec{NodeName: "node-" + podName},
+				Status: corev1.PodStatus{
+					Phase:      corev1.PodRunning,
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				},
+			},
+			&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "test-etcd-" + podName, Namespace: "default"}},
+		)
+	}
+	return objects
+}
+
+// newTestRuntime returns the options and a completed runtime of a member subcommand for the given Etcd, which connects
+// to the given fake etcd API, and the name of the pod it connected to.
+func newTestRuntime(t *testing.T, etcd *druidv1alpha1.Etcd, api etcdconn.API, args []string) (*memberOptions, *memberRuntime, *string) {
+	t.Helper()
+	k8sObjects := newTestPods(etcd)
+	cmdCtx := fake.NewTestHelper().WithEtcdObjects([]runtime.Object{etcd}).WithK8sObjects(k8sObjects).CreateTestCommandContext()
+	streams, _, _, _ := genericiooptions.NewTestIOStreams()
+	cmdCtx.Runtime.IOStreams = streams
+
+	opts := newMemberOptions(cmdCtx.Options)
+	if err := cmdCtx.Options.Complete(nil, args[:1]); err != nil {
+		t.Fatalf("Failed to complete options: %v", err)
+	}
+	if len(args) > 1 {
+		opts.memberName = args[1]
+	}
+	opts.timeout = defaultTimeout
+	runtime := newMemberRuntime(cmdCtx.Runtime)
+	connectedPod := new(string)
+	runtime.connect = func(_ context.Context, _ client.GenericClientInterface, config etcdconn.Config, _ io.Writer) (*etcdconn.Connection, error) {
+		*connectedPod = config.PodName
+		return etcdconn.NewConnection(api, "http://127.0.0.1:12379", config.PodName, nil), nil
+	}
+	if err := runtime.complete(opts); err != nil {
+		t.Fatalf("Failed to complete runtime: %v", err)
+	}
+	return opts, runtime, connectedPod
+}
+
+func runSubcommand(t *testing.T, sub cmdutils.Subcommand) error {
+	t.Helper()
+	if err := sub.Validate(); err != nil {
+		return err
+	}
+	return sub.Execute(context.Background())
+}
+
+func TestListCommand(t *testing.T) {
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").WithMemberStatuses(druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusNotReady).WithMemberIDs(testMemberIDs...).Build()
+	_, out, err := fake.NewTestHelper().WithEtcdObjects([]runtime.Object{etcd}).WithK8sObjects(newTestPods(etcd)).
+		RunCommand(t, NewListCommand, []string{"test-etcd"}, map[string]string{"output": "json"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var result Result
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal output: %v\n%s", err, out.String())
+	}
+	if result.ClusterSize != 3 || result.Quorum != 2 || result.ReadyMembers != 2 {
+		t.Errorf("Expected 2 of 3 ready members with a quorum of 2, got %+v", result)
+	}
+	if len(result.Members) != 3 || result.Members[0].Role != "Leader" || result.Members[2].Node != "node-test-etcd-2" {
+		t.Errorf("Unexpected members: %+v", result.Members)
+	}
+}
+
+func TestRestartCommand(t *testing.T) {
+	tests := []struct {
+		name          string
+		statuses      []druidv1alpha1.EtcdMemberConditionStatus
+		member        string
+		expectedError string
+	}{
+		{"healthy quorum", []druidv1alpha1.EtcdMemberConditionStatus{"Ready", "Ready", "Ready"}, "test-etcd-1", ""},
+		{"restart unready member", []druidv1alpha1.EtcdMemberConditionStatus{"Ready", "Ready", "NotReady"}, "test-etcd-2", ""},
+		{"quorum would break", []druidv1alpha1.EtcdMemberConditionStatus{"Ready", "Ready", "NotReady"}, "test-etcd-1", "would break the quorum"},
+		{"single member", []druidv1alpha1.EtcdMemberConditionStatus{"Ready"}, "test-etcd-0", "would break the quorum"},
+		{"unknown member", []druidv1alpha1.EtcdMemberConditionStatus{"Ready", "Ready", "Ready"}, "test-etcd-5", "not found"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			etcd := fake.NewEtcdBuilder("default", "test-etcd").WithMemberStatuses(tc.statuses...).WithMemberIDs(testMemberIDs...).Build()
+			opts, runtime, _ := newTestRuntime(t, etcd, newFakeMemberAPI(), []string{"test-etcd", tc.member})
+			err := runSubcommand(t, &restartCmdCtx{memberOptions: opts, memberRuntime: runtime})
+
+			if tc.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+					t.Fatalf("Expected error containing %q, got: %v", tc.expectedError, err)
+				}
+				pods, listErr := runtime.genericClient.Kube().CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+				if listErr != nil || len(pods.Items) != len(tc.statuses) {
+					t.Errorf("Expected no pod to be deleted, got: %v", listErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			_, getErr := runtime.genericClient.Kube().CoreV1().Pods("default").Get(context.Background(), tc.member, metav1.GetOptions{})
+			if !apierrors.IsNotFound(getErr) {
+				t.Errorf("Expected pod %s to be deleted, got: %v", tc.member, getErr)
+			}
+		})
+	}
+}
+
+func TestRemoveCommand(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").WithMemberStatuses(druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusReady).WithMemberIDs(testMemberIDs...).Build()
+	api := newFakeMemberAPI()
+	opts, runtime, connectedPod := newTestRuntime(t, etcd, api, []string{"test-etcd", "test-etcd-2"})
+	if err := runSubcommand(t, &removeCmdCtx{memberOptions: opts, memberRuntime: runtime}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if *connectedPod == "test-etcd-2" {
+		t.Errorf("Expected the member to be removed through another member")
+	}
+	if len(api.removed) != 1 || api.removed[0] != 0xa2 {
+		t.Errorf("Expected member a2 to be removed, got %v", api.removed)
+	}
+	_, err := runtime.genericClient.Kube().CoreV1().PersistentVolumeClaims("default").Get(context.Background(), "test-etcd-test-etcd-2", metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("Expected PVC to be deleted, got: %v", err)
+	}
+	_, err = runtime.genericClient.Kube().CoreV1().PersistentVolumeClaims("default").Get(context.Background(), "test-etcd-test-etcd-1", metav1.GetOptions{})
+	if err != nil {
+		t.Errorf("Expected PVC of other member to be kept, got: %v", err)
+	}
+}
+
+func TestRemoveCommandDeletesRecreatedPod(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").WithMemberStatuses(druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusReady).WithMemberIDs(testMemberIDs...).Build()
+	opts, memberRuntime, _ := newTestRuntime(t, etcd, newFakeMemberAPI(), []string{"test-etcd", "test-etcd-2"})
+	clientset := memberRuntime.genericClient.Kube().(*kubefake.Clientset)
+
+	// the PVC is kept while it is used by a pod, and the StatefulSet recreates the pod once after it has been deleted
+	var deletedPodUIDs []types.UID
+	clientset.PrependReactor("delete", "persistentvolumeclaims", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, nil
+	})
+	clientset.PrependReactor("get", "persistentvolumeclaims", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if len(deletedPodUIDs) < 2 {
+			return false, nil, nil
+		}
+		return true, nil, apierrors.NewNotFound(corev1.Resource("persistentvolumeclaims"), action.(k8stesting.GetAction).GetName())
+	})
+	clientset.PrependReactor("delete", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		deleteAction := action.(k8stesting.DeleteAction)
+		deletedPodUIDs = append(deletedPodUIDs, *deleteAction.GetDeleteOptions().Preconditions.UID)
+		tracker := clientset.Tracker()
+		podsResource := corev1.SchemeGroupVersion.WithResource("pods")
+		if err := tracker.Delete(podsResource, deleteAction.GetNamespace(), deleteAction.GetName()); err != nil {
+			return true, nil, err
+		}
+		if len(deletedPodUIDs) == 1 {
+			recreatedPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: deleteAction.GetName(), Namespace: deleteAction.GetNamespace(), UID: "recreated"}}
+			return true, nil, tracker.Add(recreatedPod)
+		}
+		return true, nil, nil
+	})
+
+	if err := runSubcommand(t, &removeCmdCtx{memberOptions: opts, memberRuntime: memberRuntime}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(deletedPodUIDs) != 2 || deletedPodUIDs[0] != "old" || deletedPodUIDs[1] != "recreated" {
+		t.Errorf("Expected the pod to be deleted again after it has been recreated, got deletions of %v", deletedPodUIDs)
+	}
+}
+
+func TestRemoveCommandRefusals(t *testing.T) {
+	tests := []struct {
+		name          string
+		statuses      []druidv1alpha1.EtcdMemberConditionStatus
+		member        string
+		expectedError string
+	}{
+		{"leader", []druidv1alpha1.EtcdMemberConditionStatus{"Ready", "Ready", "Ready"}, "test-etcd-0", "is the leader"},
+		{"quorum would break", []druidv1alpha1.EtcdMemberConditionStatus{"Ready", "Unknown", "Ready"}, "test-etcd-2", "would break the quorum"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			api := newFakeMemberAPI()
+			opts, runtime, _ := newTestRuntime(t, fake.NewEtcdBuilder("default", "test-etcd").WithMemberStatuses(tc.statuses...).WithMemberIDs(testMemberIDs...).Build(), api, []string{"test-etcd", tc.member})
+			err := runSubcommand(t, &removeCmdCtx{memberOptions: opts, memberRuntime: runtime})
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Fatalf("Expected error containing %q, got: %v", tc.expectedError, err)
+			}
+			if len(api.removed) != 0 {
+				t.Errorf("Expected no member to be removed, got %v", api.removed)
+			}
+		})
+	}
+}
+
+func TestMoveLeaderCommand(t *testing.T) {
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").WithMemberStatuses(druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusNotReady).WithMemberIDs(testMemberIDs...).Build()
+
+	api := newFakeMemberAPI()
+	opts, runtime, connectedPod := newTestRuntime(t, etcd, api, []string{"test-etcd", "test-etcd-1"})
+	if err := runSubcommand(t, &moveLeaderCmdCtx{memberOptions: opts, memberRuntime: runtime}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if *connectedPod != "test-etcd-0" {
+		t.Errorf("Expected the request to be sent to the leader, got %q", *connectedPod)
+	}
+	if api.transferee != 0xa1 {
+		t.Errorf("Expected leadership to be moved to member a1, got %x", api.transferee)
+	}
+
+	api = newFakeMemberAPI()
+	opts, runtime, _ = newTestRuntime(t, etcd, api, []string{"test-etcd", "test-etcd-2"})
+	err := runSubcommand(t, &moveLeaderCmdCtx{memberOptions: opts, memberRuntime: runtime})
+	if err == nil || !strings.Contains(err.Error(), "NotReady") {
+		t.Errorf("Expected moving the leadership to an unready member to be refused, got: %v", err)
+	}
+	if api.transferee != 0 {
+		t.Errorf("Expected leadership not to be moved, got %x", api.transferee)
+	}
+}
+
+func TestCheckQuorum(t *testing.T) {
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").WithMemberStatuses(druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusReady).WithMemberIDs(testMemberIDs...).Build()
+	etcd.Spec.Replicas = 3
+	if err := checkQuorum(etcd, ""); err != nil {
+		t.Errorf("Expected quorum with 2 of 3 ready members, got: %v", err)
+	}
+	if err := checkQuorum(etcd, "test-etcd-1"); err == nil {
+		t.Errorf("Expected quorum to break without a ready member, as the third member has not reported its status")
+	}
+	etcd.Status.Members = nil
+	if err := checkQuorum(etcd, ""); err == nil || !strings.Contains(err.Error(), "no members") {
+		t.Errorf("Expected error for missing member status, got: %v", err)
+	}
+}
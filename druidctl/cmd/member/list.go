@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package member
+
+import (
+	"context"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+)
+
+func (l *listCmdCtx) Validate() error {
+	return l.validateSelection(false)
+}
+
+func (l *listCmdCtx) Complete() error {
+	return l.memberRuntime.complete(l.memberOptions)
+}
+
+// Execute lists the members of the selected Etcd as reported in its status, together with the nodes of their pods.
+func (l *listCmdCtx) Execute(ctx context.Context) error {
+	etcd, err := l.getEtcd(ctx)
+	if err != nil {
+		return err
+	}
+	podList, err := l.genericClient.Kube().CoreV1().Pods(etcd.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta)).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pods of etcd %s: %w", etcd.Name, err)
+	}
+	nodes := make(map[string]string, len(podList.Items))
+	for _, pod := range podList.Items {
+		nodes[pod.Name] = pod.Spec.NodeName
+	}
+
+	size := clusterSize(etcd)
+	result := Result{
+		Etcd:         etcd.Name,
+		Namespace:    etcd.Namespace,
+		ClusterSize:  size,
+		Quorum:       quorumOf(size),
+		ReadyMembers: countReadyMembers(etcd, ""),
+		Members:      make([]MemberSummary, 0, len(etcd.Status.Members)),
+		Kind:         resultKind,
+	}
+	for i := range etcd.Status.Members {
+		member := &etcd.Status.Members[i]
+		podName := podNameOf(etcd, member)
+		result.Members = append(result.Members, MemberSummary{
+			Name:               member.Name,
+			PodName:            podName,
+			ID:                 ptr.Deref(member.ID, ""),
+			Role:               string(ptr.Deref(member.Role, "")),
+			Status:             string(member.Status),
+			Reason:             member.Reason,
+			LastTransitionTime: member.LastTransitionTime,
+			Node:               nodes[podName],
+		})
+	}
+
+	if len(result.Members) == 0 && l.PrintFlags.IsTableOutput() {
+		l.Logger.Info(l.IOStreams.Out, fmt.Sprintf("No members reported in the status of etcd %s", etcd.Name))
+		return nil
+	}
+	outputData, err := l.Printer.Print(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result to desired format: %w", err)
+	}
+	fmt.Fprintf(l.IOStreams.Out, "%s\n", string(outputData))
+	if l.PrintFlags.IsTableOutput() {
+		l.Logger.Info(l.IOStreams.Out, fmt.Sprintf("%d of %d members are ready, %d are required for quorum", result.ReadyMembers, result.ClusterSize, result.Quorum))
+	}
+	return nil
+}
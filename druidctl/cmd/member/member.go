@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/etcdconn"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"k8s.io/utils/ptr"
+)
+
+const resultKind = "EtcdMemberList"
+
+// pollInterval is the interval in which the state of a restarted member is checked.
+var pollInterval = 2 * time.Second
+
+// validateSelection validates that exactly one etcd resource has been selected, and a member if required.
+func (o *memberOptions) validateSelection(requiresMember bool) error {
+	if err := o.ValidateResourceSelection(); err != nil {
+		return err
+	}
+	if o.AllNamespaces {
+		return fmt.Errorf("member commands operate on a single etcd resource and cannot be used with --all-namespaces/-A")
+	}
+	if len(o.ResourceArgs) != 1 {
+		return fmt.Errorf("exactly one etcd resource must be specified")
+	}
+	if requiresMember && o.memberName == "" {
+		return fmt.Errorf("a member must be specified")
+	}
+	return nil
+}
+
+// complete creates the clients and the printer, and resolves the selected etcd resource.
+func (r *memberRuntime) complete(opts *memberOptions) error {
+	etcdClient, err := r.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	r.etcdClient = etcdClient
+
+	genericClient, err := r.Clients.GenericClient()
+	if err != nil {
+		return fmt.Errorf("unable to create generic kube clients: %w", err)
+	}
+	r.genericClient = genericClient
+
+	r.Printer, err = opts.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("failed to create formatter: %w", err)
+	}
+
+	r.etcdRef = opts.BuildEtcdRefList()[0]
+	return nil
+}
+
+// getEtcd fetches the selected Etcd.
+func (r *memberRuntime) getEtcd(ctx context.Context) (*druidv1alpha1.Etcd, error) {
+	etcd, err := r.etcdClient.GetEtcd(ctx, r.etcdRef.Namespace, r.etcdRef.Name)
+	if err != nil {
+		return nil, fmt.Errorf("etcd %q not found in namespace %q: %w", r.etcdRef.Name, r.etcdRef.Namespace, err)
+	}
+	return etcd, nil
+}
+
+// findMember returns the status of the member with the given name in the status of the given Etcd. The member can also
+// be given by the name of its pod.
+func findMember(etcd *druidv1alpha1.Etcd, name string) (*druidv1alpha1.EtcdMemberStatus, error) {
+	memberName := druidv1alpha1.GetMemberName(etcd.Spec.MemberNamePrefix, name)
+	for i, member := range etcd.Status.Members {
+		if member.Name == name || member.Name == memberName {
+			return &etcd.Status.Members[i], nil
+		}
+	}
+	return nil, fmt.Errorf("member %s not found in the status of etcd %s", name, etcd.Name)
+}
+
+// podNameOf returns the name of the pod of the given member.
+func podNameOf(etcd *druidv1alpha1.Etcd, member *druidv1alpha1.EtcdMemberStatus) string {
+	if prefix := ptr.Deref(etcd.Spec.MemberNamePrefix, ""); prefix != "" {
+		return strings.TrimPrefix(member.Name, prefix+"-")
+	}
+	return member.Name
+}
+
+func isLeader(member *druidv1alpha1.EtcdMemberStatus) bool {
+	return ptr.Deref(member.Role, "") == druidv1alpha1.EtcdRoleLeader
+}
+
+func isReady(member *druidv1alpha1.EtcdMemberStatus) bool {
+	return member.Status == druidv1alpha1.EtcdMemberStatusReady
+}
+
+// clusterSize returns the number of members the cluster of the given Etcd is expected to have. Members which have not
+// yet reported their status are accounted for with the desired number of replicas.
+func clusterSize(etcd *druidv1alpha1.Etcd) int {
+	return max(int(etcd.Spec.Replicas), len(etcd.Status.Members))
+}
+
+func quorumOf(size int) int {
+	return size/2 + 1
+}
+
+// countReadyMembers returns the number of ready members of the given Etcd, apart from the excluded member.
+func countReadyMembers(etcd *druidv1alpha1.Etcd, excluded string) int {
+	ready := 0
+	for i := range etcd.Status.Members {
+		if etcd.Status.Members[i].Name != excluded && isReady(&etcd.Status.Members[i]) {
+			ready++
+		}
+	}
+	return ready
+}
+
+// checkQuorum returns an error if the cluster of the given Etcd does not keep its quorum while the excluded member is
+// unavailable, according to the member status reported in the status of the Etcd. If excluded is empty, it checks that
+// the cluster currently has quorum.
+func checkQuorum(etcd *druidv1alpha1.Etcd, excluded string) error {
+	if len(etcd.Status.Members) == 0 {
+		return fmt.Errorf("etcd %s reports no members in its status, the state of its quorum is unknown", etcd.Name)
+	}
+	size := clusterSize(etcd)
+	quorum := quorumOf(size)
+	ready := countReadyMembers(etcd, excluded)
+	if ready >= quorum {
+		return nil
+	}
+	if excluded == "" {
+		return fmt.Errorf("etcd %s has no quorum: %d of %d members are ready, but %d are required", etcd.Name, ready, size, quorum)
+	}
+	return fmt.Errorf("this would break the quorum of etcd %s: without member %s only %d of %d members are ready, but %d are required",
+		etcd.Name, excluded, ready, size, quorum)
+}
+
+// connectToMember connects to the member with the given pod name.
+func (r *memberRuntime) connectToMember(ctx context.Context, opts *memberOptions, etcd *druidv1alpha1.Etcd, podName string) (*etcdconn.Connection, error) {
+	config, err := etcdconn.ResolveConfig(ctx, r.genericClient, etcd, etcdconn.Options{
+		Pod:           podName,
+		TLSServerName: opts.tlsServerName,
+		DialTimeout:   opts.dialTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.connect(ctx, r.genericClient, config, r.IOStreams.ErrOut)
+}
+
+// getMemberID returns the ID of the member with the given name from the member list of the etcd cluster. It returns
+// false if the member is not part of the cluster.
+func getMemberID(ctx context.Context, conn *etcdconn.Connection, memberName string) (uint64, bool, error) {
+	resp, err := conn.API.MemberList(ctx)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to list the members of the etcd cluster: %w", err)
+	}
+	for _, member := range resp.Members {
+		if member.Name == memberName {
+			return member.ID, true, nil
+		}
+	}
+	return 0, false, nil
+}
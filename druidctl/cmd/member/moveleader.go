@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package member
+
+import (
+	"context"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+)
+
+func (m *moveLeaderCmdCtx) Validate() error {
+	return m.validateSelection(true)
+}
+
+func (m *moveLeaderCmdCtx) Complete() error {
+	return m.memberRuntime.complete(m.memberOptions)
+}
+
+// Execute transfers the leadership of the etcd cluster to the selected member. The request is sent to the current
+// leader, as etcd requires.
+func (m *moveLeaderCmdCtx) Execute(ctx context.Context) error {
+	etcd, err := m.getEtcd(ctx)
+	if err != nil {
+		return err
+	}
+	transferee, err := findMember(etcd, m.memberName)
+	if err != nil {
+		return err
+	}
+	if isLeader(transferee) {
+		m.Logger.Info(m.IOStreams.Out, fmt.Sprintf("Member %s is already the leader", transferee.Name))
+		return nil
+	}
+	if !isReady(transferee) {
+		return fmt.Errorf("refusing to move the leadership to member %s as it is %s", transferee.Name, transferee.Status)
+	}
+	if err := checkQuorum(etcd, ""); err != nil {
+		return fmt.Errorf("refusing to move the leadership: %w", err)
+	}
+	var leader *druidv1alpha1.EtcdMemberStatus
+	for i := range etcd.Status.Members {
+		if isLeader(&etcd.Status.Members[i]) {
+			leader = &etcd.Status.Members[i]
+		}
+	}
+	if leader == nil {
+		return fmt.Errorf("no leader reported in the status of etcd %s", etcd.Name)
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+	conn, err := m.connectToMember(opCtx, m.memberOptions, etcd, podNameOf(etcd, leader))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	id, found, err := getMemberID(opCtx, conn, transferee.Name)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("member %s is not part of the etcd cluster", transferee.Name)
+	}
+	if _, err := conn.API.MoveLeader(opCtx, id); err != nil {
+		return fmt.Errorf("failed to move the leadership from member %s to member %s: %w", leader.Name, transferee.Name, err)
+	}
+	m.Logger.Success(m.IOStreams.Out, fmt.Sprintf("Moved the leadership from member %s to member %s", leader.Name, transferee.Name))
+	return nil
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package member
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+	"github.com/gardener/etcd-druid/druidctl/internal/etcdconn"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// memberOptions holds the options shared by all member subcommands
+type memberOptions struct {
+	*cmdutils.GlobalOptions
+	PrintFlags *printer.PrintFlags
+	// memberName is the name of the member, or of the pod of the member, to operate on.
+	memberName    string
+	tlsServerName string
+	dialTimeout   time.Duration
+	timeout       time.Duration
+	wait          bool
+}
+
+// memberRuntime holds runtime state shared by all member subcommands
+type memberRuntime struct {
+	*cmdutils.RuntimeEnv
+	etcdRef       types.NamespacedName
+	etcdClient    client.EtcdClientInterface
+	genericClient client.GenericClientInterface
+	Printer       printer.Printer
+	// connect establishes a connection to a member of the etcd cluster.
+	connect etcdconn.ConnectFunc
+}
+
+// listCmdCtx composes options and runtime for the member list command
+type listCmdCtx struct {
+	*memberOptions
+	*memberRuntime
+}
+
+// restartCmdCtx composes options and runtime for the member restart command
+type restartCmdCtx struct {
+	*memberOptions
+	*memberRuntime
+}
+
+// removeCmdCtx composes options and runtime for the member remove command
+type removeCmdCtx struct {
+	*memberOptions
+	*memberRuntime
+}
+
+// moveLeaderCmdCtx composes options and runtime for the member move-leader command
+type moveLeaderCmdCtx struct {
+	*memberOptions
+	*memberRuntime
+}
+
+func newMemberOptions(options *cmdutils.GlobalOptions) *memberOptions {
+	return &memberOptions{
+		GlobalOptions: options,
+		PrintFlags:    printer.NewPrintFlags(),
+	}
+}
+
+func newMemberRuntime(runtime *cmdutils.RuntimeEnv) *memberRuntime {
+	return &memberRuntime{
+		RuntimeEnv: runtime,
+		connect:    etcdconn.Connect,
+	}
+}
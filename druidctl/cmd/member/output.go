@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package member
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+)
+
+const noneValue = "<none>"
+
+// ToTable converts the result into a table with one row per member.
+func (r Result) ToTable() *printer.Table {
+	table := &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "NAME"},
+			{Name: "ROLE"},
+			{Name: "STATUS"},
+			{Name: "SINCE"},
+			{Name: "ID", Wide: true},
+			{Name: "POD", Wide: true},
+			{Name: "NODE", Wide: true},
+			{Name: "REASON", Wide: true},
+		},
+	}
+	now := time.Now()
+	for _, member := range r.Members {
+		since := noneValue
+		if !member.LastTransitionTime.IsZero() {
+			since = cmdutils.ShortDuration(now.Sub(member.LastTransitionTime.Time))
+		}
+		table.Rows = append(table.Rows, printer.TableRow{
+			Cells: []string{
+				member.Name,
+				valueOrNone(member.Role),
+				member.Status,
+				since,
+				valueOrNone(member.ID),
+				member.PodName,
+				valueOrNone(member.Node),
+				valueOrNone(member.Reason),
+			},
+			Object: member,
+		})
+	}
+	return table
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return noneValue
+	}
+	return value
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package member
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/etcdconn"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func (r *removeCmdCtx) Validate() error {
+	return r.validateSelection(true)
+}
+
+func (r *removeCmdCtx) Complete() error {
+	return r.memberRuntime.complete(r.memberOptions)
+}
+
+// Execute removes the selected member from the etcd cluster, deletes its PVC and its pod, and waits until the PVC is
+// gone. The StatefulSet recreates the pod with an empty volume, whose backup-restore sidecar adds it to the cluster
+// again as a new member.
+func (r *removeCmdCtx) Execute(ctx context.Context) error {
+	etcd, err := r.getEtcd(ctx)
+	if err != nil {
+		return err
+	}
+	member, err := findMember(etcd, r.memberName)
+	if err != nil {
+		return err
+	}
+	if isLeader(member) {
+		return fmt.Errorf("refusing to remove member %s as it is the leader, move the leadership to another member with `member move-leader` first", member.Name)
+	}
+	if err := checkQuorum(etcd, member.Name); err != nil {
+		return fmt.Errorf("refusing to remove member %s: %w", member.Name, err)
+	}
+
+	if err := r.removeFromCluster(ctx, etcd, member); err != nil {
+		return err
+	}
+
+	podName := podNameOf(etcd, member)
+	pvcName := fmt.Sprintf("%s-%s", ptr.Deref(etcd.Spec.VolumeClaimTemplate, etcd.Name), podName)
+	if err := r.genericClient.Kube().CoreV1().PersistentVolumeClaims(etcd.Namespace).Delete(ctx, pvcName, metav1.DeleteOptions{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete PVC %s of member %s: %w", pvcName, member.Name, err)
+		}
+		r.Logger.Warning(r.IOStreams.ErrOut, fmt.Sprintf("PVC %s of member %s not found", pvcName, member.Name))
+	}
+	// The PVC is only deleted once it is not used by a pod anymore. The pod has to be deleted until then, also if the
+	// StatefulSet recreates it in the meantime, as the recreated pod would otherwise reuse the volume of the member.
+	r.Logger.Progress(r.IOStreams.Out, fmt.Sprintf("Deleting pod %s and waiting for PVC %s to be deleted", podName, pvcName))
+	if err := r.deletePodUntilPVCIsDeleted(ctx, etcd.Namespace, podName, pvcName); err != nil {
+		return err
+	}
+	r.Logger.Success(r.IOStreams.Out, fmt.Sprintf("Deleted PVC %s and pod %s, the pod is recreated with an empty volume and joins the cluster as a new member", pvcName, podName))
+	return nil
+}
+
+// deletePodUntilPVCIsDeleted deletes the pod with the given name, and any pod with this name which is recreated while
+// the PVC with the given name still exists, until the PVC has been deleted.
+func (r *removeCmdCtx) deletePodUntilPVCIsDeleted(ctx context.Context, namespace, podName, pvcName string) error {
+	waitCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := r.deletePod(waitCtx, namespace, podName); err != nil {
+			return err
+		}
+		select {
+		case <-waitCtx.Done():
+			if errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timed out after %s waiting for PVC %s to be deleted", r.timeout, pvcName)
+			}
+			return waitCtx.Err()
+		case <-ticker.C:
+		}
+		_, err := r.genericClient.Kube().CoreV1().PersistentVolumeClaims(namespace).Get(waitCtx, pvcName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("failed to get PVC %s: %w", pvcName, err)
+		}
+	}
+}
+
+// deletePod deletes the pod with the given name unless it does not exist or is already being deleted.
+func (r *removeCmdCtx) deletePod(ctx context.Context, namespace, podName string) error {
+	pods := r.genericClient.Kube().CoreV1().Pods(namespace)
+	pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err) || errors.Is(err, context.DeadlineExceeded):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get pod %s: %w", podName, err)
+	case pod.DeletionTimestamp != nil:
+		return nil
+	}
+	if err := pods.Delete(ctx, podName, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &pod.UID}}); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+		return fmt.Errorf("failed to delete pod %s: %w", podName, err)
+	}
+	return nil
+}
+
+// removeFromCluster removes the given member from the etcd cluster through another ready member.
+func (r *removeCmdCtx) removeFromCluster(ctx context.Context, etcd *druidv1alpha1.Etcd, member *druidv1alpha1.EtcdMemberStatus) error {
+	var peer *druidv1alpha1.EtcdMemberStatus
+	for i := range etcd.Status.Members {
+		if etcd.Status.Members[i].Name != member.Name && isReady(&etcd.Status.Members[i]) {
+			peer = &etcd.Status.Members[i]
+			break
+		}
+	}
+	if peer == nil {
+		return fmt.Errorf("no other ready member found to remove member %s through", member.Name)
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	conn, err := r.connectToMember(opCtx, r.memberOptions, etcd, podNameOf(etcd, peer))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	id, found, err := getMemberID(opCtx, conn, member.Name)
+	if err != nil {
+		return err
+	}
+	if !found {
+		r.Logger.Warning(r.IOStreams.ErrOut, fmt.Sprintf("Member %s is not part of the etcd cluster, only cleaning up its PVC and pod", member.Name))
+		return nil
+	}
+	if _, err := conn.API.MemberRemove(opCtx, id); err != nil {
+		return fmt.Errorf("failed to remove member %s from the etcd cluster: %w", member.Name, err)
+	}
+	r.Logger.Success(r.IOStreams.Out, fmt.Sprintf("Removed member %s (%s) from the etcd cluster", member.Name, etcdconn.FormatID(id)))
+	return nil
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package member
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/etcdconn"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func (r *restartCmdCtx) Validate() error {
+	return r.validateSelection(true)
+}
+
+func (r *restartCmdCtx) Complete() error {
+	return r.memberRuntime.complete(r.memberOptions)
+}
+
+// Execute restarts the selected member by deleting its pod, if the remaining members keep the quorum.
+func (r *restartCmdCtx) Execute(ctx context.Context) error {
+	etcd, err := r.getEtcd(ctx)
+	if err != nil {
+		return err
+	}
+	member, err := findMember(etcd, r.memberName)
+	if err != nil {
+		return err
+	}
+	if err := checkQuorum(etcd, member.Name); err != nil {
+		return fmt.Errorf("refusing to restart member %s: %w", member.Name, err)
+	}
+
+	podName := podNameOf(etcd, member)
+	pods := r.genericClient.Kube().CoreV1().Pods(etcd.Namespace)
+	pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get pod %s: %w", podName, err)
+	}
+	if err := pods.Delete(ctx, podName, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &pod.UID}}); err != nil {
+		return fmt.Errorf("failed to delete pod %s: %w", podName, err)
+	}
+	r.Logger.Success(r.IOStreams.Out, fmt.Sprintf("Deleted pod %s of member %s", podName, member.Name))
+
+	if !r.wait {
+		return nil
+	}
+	r.Logger.Progress(r.IOStreams.Out, fmt.Sprintf("Waiting for pod %s to be ready again", podName))
+	if err := r.waitForRestartedPod(ctx, etcd.Namespace, podName, pod.UID); err != nil {
+		return err
+	}
+	r.Logger.Success(r.IOStreams.Out, fmt.Sprintf("Member %s has been restarted", member.Name))
+	return nil
+}
+
+// waitForRestartedPod waits until the pod with the given name has been recreated, i.e. has a different UID than the
+// deleted pod, and is ready.
+func (r *restartCmdCtx) waitForRestartedPod(ctx context.Context, namespace, podName string, deletedUID types.UID) error {
+	waitCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		pod, err := r.genericClient.Kube().CoreV1().Pods(namespace).Get(waitCtx, podName, metav1.GetOptions{})
+		switch {
+		case err == nil:
+			if pod.UID != deletedUID && etcdconn.IsPodReady(pod) {
+				return nil
+			}
+		case !apierrors.IsNotFound(err) && !errors.Is(err, context.DeadlineExceeded):
+			return fmt.Errorf("failed to get pod %s: %w", podName, err)
+		}
+		select {
+		case <-waitCtx.Done():
+			if errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timed out after %s waiting for pod %s to be ready", r.timeout, podName)
+			}
+			return waitCtx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package member
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MemberSummary captures the state of a single etcd member as reported in the status of the Etcd.
+type MemberSummary struct {
+	Name    string `json:"name" yaml:"name"`
+	PodName string `json:"podName" yaml:"podName"`
+	ID      string `json:"id,omitempty" yaml:"id,omitempty"`
+	Role    string `json:"role,omitempty" yaml:"role,omitempty"`
+	Status  string `json:"status" yaml:"status"`
+	Reason  string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	// LastTransitionTime is the last time the status of the member changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime" yaml:"lastTransitionTime"`
+	// Node is the node the pod of the member is scheduled to, if the pod exists.
+	Node string `json:"node,omitempty" yaml:"node,omitempty"`
+}
+
+// Result is the result of the member list command.
+type Result struct {
+	Etcd      string `json:"etcd" yaml:"etcd"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// ClusterSize is the number of members the cluster is expected to have.
+	ClusterSize int `json:"clusterSize" yaml:"clusterSize"`
+	// Quorum is the number of ready members which is required for the cluster to be available.
+	Quorum       int             `json:"quorum" yaml:"quorum"`
+	ReadyMembers int             `json:"readyMembers" yaml:"readyMembers"`
+	Members      []MemberSummary `json:"members" yaml:"members"`
+	Kind         string          `json:"kind" yaml:"kind"`
+}
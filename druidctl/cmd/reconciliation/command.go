@@ -52,6 +52,16 @@ kubectl druid reconciliation resume test/my-etcd dev/my-etcd
 
 # Resume reconciliation for all Etcd resources
 kubectl druid reconciliation resume -A
+`
+	diffExample = `
+# Preview the changes which the next reconcile will make to the resources of an Etcd
+kubectl druid reconciliation diff my-etcd -n test
+
+# Show the live and desired values of the changed fields
+kubectl druid reconciliation diff my-etcd -n test -o wide
+
+# Print the changes as YAML
+kubectl druid reconciliation diff my-etcd -n test -o yaml
 `
 )
 
@@ -60,18 +70,21 @@ kubectl druid reconciliation resume -A
 //   - `kubectl druid reconciliation trigger <resources>` - trigger reconciliation
 //   - `kubectl druid reconciliation suspend <resources>` - suspend reconciliation
 //   - `kubectl druid reconciliation resume <resources>` - resume reconciliation
+//   - `kubectl druid reconciliation diff <resource>` - preview the changes of the next reconcile
 func NewReconciliationCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
 	reconciliationCmd := &cobra.Command{
 		Use:   "reconciliation",
 		Short: "Manage etcd resource reconciliation",
 		Long: `Manage etcd resource reconciliation.
-Use subcommands 'trigger', 'suspend', and 'resume' to control reconciliation state.`,
+Use subcommands 'trigger', 'suspend', and 'resume' to control reconciliation state,
+and 'diff' to preview the changes which the next reconciliation will make.`,
 	}
 
 	// Add subcommands
 	reconciliationCmd.AddCommand(NewTriggerCommand(cmdCtx))
 	reconciliationCmd.AddCommand(NewSuspendCommand(cmdCtx))
 	reconciliationCmd.AddCommand(NewResumeCommand(cmdCtx))
+	reconciliationCmd.AddCommand(NewDiffCommand(cmdCtx))
 
 	return reconciliationCmd
 }
@@ -243,3 +256,56 @@ func NewResumeCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
 
 	return cmd
 }
+
+// NewDiffCommand creates the 'reconciliation diff' subcommand
+func NewDiffCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newDiffOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:   "diff <etcd-resource-name> [flags]",
+		Short: "Preview the changes which the next reconciliation will make",
+		Long: `Preview the changes which the next reconciliation will make to the resources of an etcd cluster.
+The resources are rendered with the builders of etcd-druid against the current spec of the Etcd, and compared to the
+live resources. Nothing is changed in the cluster. Changes which cause a rolling update of the etcd pods are
+highlighted.
+
+The live resources and the secrets referenced by the Etcd are read with the credentials of the current kubeconfig. As
+the configuration of the running etcd-druid is not known, the images which are not set in the spec of the Etcd and
+the checksum of the managed certificates are taken over from the live StatefulSet.
+
+The ServiceAccount, Role, RoleBinding, PodDisruptionBudget, client and peer Services, ConfigMap and StatefulSet
+are previewed. Leases, managed certificates and the auth store are not previewed. Changes which etcd-druid rolls
+out in several steps, such as enabling peer TLS, are previewed as their final state.`,
+		Example: diffExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			runtime := cmdCtx.Runtime
+			ctx := &diffCmdCtx{
+				diffOptions: opts,
+				diffRuntime: newDiffRuntime(runtime),
+			}
+
+			if err := ctx.validate(); err != nil {
+				runtime.Logger.Error(runtime.IOStreams.ErrOut, "Reconciliation diff validation failed", err)
+				if herr := cmd.Help(); herr != nil {
+					runtime.Logger.Warning(runtime.IOStreams.ErrOut, "Failed to show help: ", herr.Error())
+				}
+				return err
+			}
+
+			if err := ctx.complete(); err != nil {
+				return err
+			}
+
+			if err := ctx.execute(cmdutils.CmdContext(cmd)); err != nil {
+				runtime.Logger.Error(runtime.IOStreams.ErrOut, "Reconciliation diff failed", err)
+				return err
+			}
+			return nil
+		},
+	}
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
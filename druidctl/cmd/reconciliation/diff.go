@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// previewedResource is a resource managed by etcd-druid for an Etcd, whose changes by the next reconcile are previewed.
+type previewedResource struct {
+	// component is the name of the etcd-druid component which manages the resource.
+	component string
+	kind      string
+	name      func(etcdObjMeta metav1.ObjectMeta) string
+	get       func(ctx context.Context, kube kubernetes.Interface, namespace, name string) (client.Object, error)
+	// newObject returns an empty object of the kind of the resource.
+	newObject func() client.Object
+}
+
+// previewedResources are the previewed resources, in the order in which etcd-druid syncs them. The leases, the managed
+// certificates and the auth store are not previewed.
+var previewedResources = []previewedResource{
+	{component: "ServiceAccount", kind: "ServiceAccount", name: druidv1alpha1.GetServiceAccountName,
+		get: func(ctx context.Context, kube kubernetes.Interface, namespace, name string) (client.Object, error) {
+			return kube.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		newObject: func() client.Object { return &corev1.ServiceAccount{} }},
+	{component: "Role", kind: "Role", name: druidv1alpha1.GetRoleName,
+		get: func(ctx context.Context, kube kubernetes.Interface, namespace, name string) (client.Object, error) {
+			return kube.RbacV1().Roles(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		newObject: func() client.Object { return &rbacv1.Role{} }},
+	{component: "RoleBinding", kind: "RoleBinding", name: druidv1alpha1.GetRoleBindingName,
+		get: func(ctx context.Context, kube kubernetes.Interface, namespace, name string) (client.Object, error) {
+			return kube.RbacV1().RoleBindings(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		newObject: func() client.Object { return &rbacv1.RoleBinding{} }},
+	{component: "PodDisruptionBudget", kind: "PodDisruptionBudget", name: druidv1alpha1.GetPodDisruptionBudgetName,
+		get: func(ctx context.Context, kube kubernetes.Interface, namespace, name string) (client.Object, error) {
+			return kube.PolicyV1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		newObject: func() client.Object { return &policyv1.PodDisruptionBudget{} }},
+	{component: "ClientService", kind: "Service", name: druidv1alpha1.GetClientServiceName,
+		get: func(ctx context.Context, kube kubernetes.Interface, namespace, name string) (client.Object, error) {
+			return kube.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		newObject: func() client.Object { return &corev1.Service{} }},
+	{component: "PeerService", kind: "Service", name: druidv1alpha1.GetPeerServiceName,
+		get: func(ctx context.Context, kube kubernetes.Interface, namespace, name string) (client.Object, error) {
+			return kube.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		newObject: func() client.Object { return &corev1.Service{} }},
+	{component: "ConfigMap", kind: "ConfigMap", name: druidv1alpha1.GetConfigMapName,
+		get: func(ctx context.Context, kube kubernetes.Interface, namespace, name string) (client.Object, error) {
+			return kube.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		newObject: func() client.Object { return &corev1.ConfigMap{} }},
+	{component: "StatefulSet", kind: "StatefulSet", name: druidv1alpha1.GetStatefulSetName,
+		get: func(ctx context.Context, kube kubernetes.Interface, namespace, name string) (client.Object, error) {
+			return kube.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		newObject: func() client.Object { return &appsv1.StatefulSet{} }},
+}
+
+// ResourceAction is the action which the next reconcile will perform on a resource.
+type ResourceAction string
+
+const (
+	// ResourceActionCreate indicates that the resource does not exist and will be created.
+	ResourceActionCreate ResourceAction = "Create"
+	// ResourceActionUpdate indicates that the resource will be updated.
+	ResourceActionUpdate ResourceAction = "Update"
+	// ResourceActionNone indicates that the resource is up-to-date.
+	ResourceActionNone ResourceAction = "None"
+)
+
+// ResourceDiff describes how the next reconcile will change a resource managed for an Etcd.
+type ResourceDiff struct {
+	Component string         `json:"component" yaml:"component"`
+	Kind      string         `json:"kind" yaml:"kind"`
+	Name      string         `json:"name" yaml:"name"`
+	Action    ResourceAction `json:"action" yaml:"action"`
+	Changes   []FieldChange  `json:"changes,omitempty" yaml:"changes,omitempty"`
+	// RollsPods is true if the changes cause a rolling update of the etcd pods.
+	RollsPods bool `json:"rollsPods" yaml:"rollsPods"`
+}
+
+// DiffResult contains the changes which the next reconcile will make to the resources of an Etcd.
+type DiffResult struct {
+	Etcd      cmdutils.EtcdRef `json:"etcd" yaml:"etcd"`
+	Resources []ResourceDiff   `json:"resources" yaml:"resources"`
+	// RollsPods is true if any of the changes causes a rolling update of the etcd pods.
+	RollsPods bool   `json:"rollsPods" yaml:"rollsPods"`
+	Kind      string `json:"kind" yaml:"kind"`
+}
+
+// hasChanges returns true if the next reconcile will create or update any resource.
+func (r DiffResult) hasChanges() bool {
+	for _, resource := range r.Resources {
+		if resource.Action != ResourceActionNone {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *diffCmdCtx) validate() error {
+	if err := d.ValidateResourceSelection(); err != nil {
+		return err
+	}
+	if d.AllNamespaces {
+		return fmt.Errorf("reconciliation diff operates on a single etcd resource and cannot be used with --all-namespaces/-A")
+	}
+	if len(d.ResourceArgs) != 1 {
+		return fmt.Errorf("exactly one etcd resource must be specified")
+	}
+	return nil
+}
+
+func (d *diffCmdCtx) complete() error {
+	etcdClient, err := d.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	d.etcdClient = etcdClient
+
+	genericClient, err := d.Clients.GenericClient()
+	if err != nil {
+		return fmt.Errorf("unable to create generic kube clients: %w", err)
+	}
+	d.genericClient = genericClient
+
+	d.Printer, err = d.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("failed to create formatter: %w", err)
+	}
+
+	d.etcdRef = d.BuildEtcdRefList()[0]
+	return nil
+}
+
+// execute renders the resources of the selected Etcd as etcd-druid will write them with the next reconcile, and prints
+// how they differ from the live resources.
+func (d *diffCmdCtx) execute(ctx context.Context) error {
+	etcd, err := d.etcdClient.GetEtcd(ctx, d.etcdRef.Namespace, d.etcdRef.Name)
+	if err != nil {
+		return fmt.Errorf("etcd %q not found in namespace %q: %w", d.etcdRef.Name, d.etcdRef.Namespace, err)
+	}
+	if druidv1alpha1.GetSuspendEtcdSpecReconcileAnnotationKey(etcd.ObjectMeta) != nil {
+		d.Logger.Info(d.IOStreams.ErrOut, "Spec reconciliation is suspended for etcd ", etcd.Name, ", changes are shown as they would be applied once it is resumed")
+	}
+
+	liveObjects, err := d.getLiveObjects(ctx, etcd)
+	if err != nil {
+		return err
+	}
+	secrets, err := d.getReferencedSecrets(ctx, etcd)
+	if err != nil {
+		return err
+	}
+	desiredObjects, err := renderDesiredObjects(etcd, liveObjects, secrets)
+	if err != nil {
+		return err
+	}
+	result, err := computeDiff(etcd, liveObjects, desiredObjects)
+	if err != nil {
+		return err
+	}
+
+	if d.PrintFlags.IsTableOutput() && !result.hasChanges() {
+		d.Logger.Success(d.IOStreams.Out, "No changes, the resources of etcd ", etcd.Name, " are up-to-date")
+		return nil
+	}
+	outputData, err := d.Printer.Print(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result to desired format: %w", err)
+	}
+	fmt.Fprintf(d.IOStreams.Out, "%s\n", string(outputData))
+	if result.RollsPods {
+		d.Logger.Warning(d.IOStreams.ErrOut, "The next reconcile will roll the etcd pods")
+	}
+	return nil
+}
+
+// computeDiff compares the given desired objects to the given live objects of the previewed resources of the given
+// Etcd. Both are keyed by the component of the resource.
+func computeDiff(etcd *druidv1alpha1.Etcd, liveObjects, desiredObjects map[string]client.Object) (DiffResult, error) {
+	result := DiffResult{
+		Etcd: cmdutils.EtcdRef{Name: etcd.Name, Namespace: etcd.Namespace},
+		Kind: "ReconciliationDiff",
+	}
+	for _, r := range previewedResources {
+		name := r.name(etcd.ObjectMeta)
+		desired := desiredObjects[r.component]
+		if desired == nil {
+			return result, fmt.Errorf("the %s %s was not rendered", r.kind, name)
+		}
+		resourceDiff := ResourceDiff{
+			Component: r.component,
+			Kind:      r.kind,
+			Name:      name,
+			Action:    ResourceActionNone,
+		}
+		var err error
+		if live := liveObjects[r.component]; live == nil {
+			resourceDiff.Action = ResourceActionCreate
+		} else if resourceDiff.Changes, err = diffObjects(live, desired); err != nil {
+			return result, err
+		} else if len(resourceDiff.Changes) > 0 {
+			resourceDiff.Action = ResourceActionUpdate
+			resourceDiff.RollsPods = rollsPods(r.kind, resourceDiff.Changes)
+		}
+		result.RollsPods = result.RollsPods || resourceDiff.RollsPods
+		result.Resources = append(result.Resources, resourceDiff)
+	}
+	return result, nil
+}
+
+// rollsPods returns true if the given changes of a resource of the given kind cause a rolling update of the etcd pods.
+// Changes to the configuration of etcd are reflected in the checksum annotation of the pod template, and are therefore
+// covered by the changes of the StatefulSet.
+func rollsPods(kind string, changes []FieldChange) bool {
+	if kind != "StatefulSet" {
+		return false
+	}
+	for _, change := range changes {
+		if strings.HasPrefix(change.Path, "spec.template.") {
+			return true
+		}
+	}
+	return false
+}
+
+// getLiveObjects fetches the live previewed resources of the given Etcd, keyed by their component. Resources which do
+// not exist are skipped.
+func (d *diffCmdCtx) getLiveObjects(ctx context.Context, etcd *druidv1alpha1.Etcd) (map[string]client.Object, error) {
+	liveObjects := make(map[string]client.Object, len(previewedResources))
+	for _, r := range previewedResources {
+		obj, err := r.get(ctx, d.genericClient.Kube(), etcd.Namespace, r.name(etcd.ObjectMeta))
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get live resources of etcd %s: %w", etcd.Name, err)
+		}
+		liveObjects[r.component] = obj
+	}
+	return liveObjects, nil
+}
+
+// getReferencedSecrets fetches the existing secrets referenced by the given Etcd, keyed by their name.
+func (d *diffCmdCtx) getReferencedSecrets(ctx context.Context, etcd *druidv1alpha1.Etcd) (map[string]*corev1.Secret, error) {
+	secrets := make(map[string]*corev1.Secret)
+	for _, name := range druidresources.GetReferencedSecretNames(etcd) {
+		secret, err := d.genericClient.Kube().CoreV1().Secrets(etcd.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get secret %s referenced by etcd %s: %w", name, etcd.Name, err)
+		}
+		secrets[name] = secret
+	}
+	return secrets, nil
+}
@@ -0,0 +1,333 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciliation
+
+import (
+	"strings"
+	"testing"
+
+	fake "github.com/gardener/etcd-druid/druidctl/internal/client/fake"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newDiffTestEtcd() *druidv1alpha1.Etcd {
+	return &druidv1alpha1.Etcd{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-etcd", Namespace: "default", UID: "test-uid"},
+		Spec: druidv1alpha1.EtcdSpec{
+			Replicas: 3,
+			Labels:   map[string]string{"app": "etcd"},
+			Etcd: druidv1alpha1.EtcdConfig{
+				Image:      ptr.To("etcd-wrapper:v0.1.0"),
+				ClientPort: ptr.To[int32](2379),
+				ServerPort: ptr.To[int32](2380),
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			},
+			Backup: druidv1alpha1.BackupSpec{
+				Image: ptr.To("etcd-backup-restore:v0.1.0"),
+			},
+			StorageCapacity: ptr.To(resource.MustParse("10Gi")),
+		},
+	}
+}
+
+// mustRenderDesiredObjects renders the previewed resources of the given Etcd onto the given live objects.
+func mustRenderDesiredObjects(t *testing.T, etcd *druidv1alpha1.Etcd, liveObjects map[string]client.Object, secrets map[string]*corev1.Secret) map[string]client.Object {
+	t.Helper()
+	desiredObjects, err := renderDesiredObjects(etcd, liveObjects, secrets)
+	if err != nil {
+		t.Fatalf("Failed to render desired objects: %v", err)
+	}
+	return desiredObjects
+}
+
+// toLiveObjects returns the given desired objects, as if they had been applied.
+func toLiveObjects(desiredObjects map[string]client.Object) map[string]client.Object {
+	liveObjects := make(map[string]client.Object, len(desiredObjects))
+	for component, obj := range desiredObjects {
+		live := obj.DeepCopyObject().(client.Object)
+		live.SetResourceVersion("1")
+		live.SetUID("live-uid")
+		liveObjects[component] = live
+	}
+	return liveObjects
+}
+
+func TestComputeDiffWithoutLiveResources(t *testing.T) {
+	etcd := newDiffTestEtcd()
+	result, err := computeDiff(etcd, nil, mustRenderDesiredObjects(t, etcd, nil, nil))
+	if err != nil {
+		t.Fatalf("Failed to compute diff: %v", err)
+	}
+	if len(result.Resources) != len(previewedResources) {
+		t.Fatalf("Expected %d resources, got %d", len(previewedResources), len(result.Resources))
+	}
+	for _, r := range result.Resources {
+		if r.Action != ResourceActionCreate {
+			t.Errorf("Expected %s %s to be created, got action %s", r.Kind, r.Name, r.Action)
+		}
+	}
+	if result.RollsPods {
+		t.Error("Expected creation of resources not to be reported as a pod roll")
+	}
+}
+
+func TestComputeDiffWithUpToDateResources(t *testing.T) {
+	etcd := newDiffTestEtcd()
+	liveObjects := toLiveObjects(mustRenderDesiredObjects(t, etcd, nil, nil))
+	result, err := computeDiff(etcd, liveObjects, mustRenderDesiredObjects(t, etcd, liveObjects, nil))
+	if err != nil {
+		t.Fatalf("Failed to compute diff: %v", err)
+	}
+	for _, r := range result.Resources {
+		if r.Action != ResourceActionNone {
+			t.Errorf("Expected %s %s to be up-to-date, got action %s with changes %v", r.Kind, r.Name, r.Action, r.Changes)
+		}
+	}
+	if result.hasChanges() {
+		t.Error("Expected no changes")
+	}
+}
+
+func TestComputeDiffHighlightsPodRoll(t *testing.T) {
+	etcd := newDiffTestEtcd()
+	liveObjects := toLiveObjects(mustRenderDesiredObjects(t, etcd, nil, nil))
+
+	etcd.Spec.Etcd.ClientPort = ptr.To[int32](2479)
+	etcd.Spec.Etcd.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("2Gi")
+	result, err := computeDiff(etcd, liveObjects, mustRenderDesiredObjects(t, etcd, liveObjects, nil))
+	if err != nil {
+		t.Fatalf("Failed to compute diff: %v", err)
+	}
+	if !result.RollsPods {
+		t.Error("Expected the changes to roll the pods")
+	}
+
+	resourceDiffs := make(map[string]ResourceDiff)
+	for _, r := range result.Resources {
+		resourceDiffs[r.Component] = r
+	}
+	sts := resourceDiffs["StatefulSet"]
+	if sts.Action != ResourceActionUpdate || !sts.RollsPods {
+		t.Fatalf("Expected StatefulSet update which rolls pods, got action %s, rollsPods %t", sts.Action, sts.RollsPods)
+	}
+	for _, path := range []string{
+		"spec.template.spec.containers[name=etcd].resources.requests.memory",
+		"spec.template.metadata.annotations." + druidresources.CheckSumKeyConfigMap,
+	} {
+		if !hasChange(sts.Changes, path) {
+			t.Errorf("Expected change of %s in StatefulSet, got %v", path, sts.Changes)
+		}
+	}
+	clientService := resourceDiffs["ClientService"]
+	if clientService.Action != ResourceActionUpdate || clientService.RollsPods {
+		t.Errorf("Expected client service update which does not roll pods, got action %s, rollsPods %t", clientService.Action, clientService.RollsPods)
+	}
+	if configMap := resourceDiffs["ConfigMap"]; configMap.Action != ResourceActionUpdate {
+		t.Errorf("Expected ConfigMap update, got action %s", configMap.Action)
+	}
+	if peerService := resourceDiffs["PeerService"]; peerService.Action != ResourceActionNone {
+		t.Errorf("Expected peer service to be up-to-date, got %v", peerService.Changes)
+	}
+}
+
+func TestComputeDiffRequiresAllResources(t *testing.T) {
+	etcd := newDiffTestEtcd()
+	desiredObjects := mustRenderDesiredObjects(t, etcd, nil, nil)
+	delete(desiredObjects, "StatefulSet")
+	_, err := computeDiff(etcd, nil, desiredObjects)
+	if err == nil || !strings.Contains(err.Error(), "StatefulSet test-etcd was not rendered") {
+		t.Fatalf("Expected an error for the missing StatefulSet, got %v", err)
+	}
+}
+
+func TestRenderDesiredObjectsTakesOverLiveState(t *testing.T) {
+	etcd := newDiffTestEtcd()
+	liveObjects := toLiveObjects(mustRenderDesiredObjects(t, etcd, nil, nil))
+	liveObjects["ServiceAccount"].(*corev1.ServiceAccount).AutomountServiceAccountToken = ptr.To(false)
+	liveSts := liveObjects["StatefulSet"].(*appsv1.StatefulSet)
+	liveSts.Spec.Template.Annotations[druidresources.CheckSumKeyManagedCertificates] = "managed-certificates-checksum"
+	for i, container := range liveSts.Spec.Template.Spec.Containers {
+		if container.Name == druidresources.ContainerNameEtcdBackupRestore {
+			liveSts.Spec.Template.Spec.Containers[i].Image = "etcd-backup-restore:v0.0.9"
+		}
+	}
+	etcd.Spec.Backup.Image = nil
+
+	desiredObjects := mustRenderDesiredObjects(t, etcd, liveObjects, nil)
+	if automount := desiredObjects["ServiceAccount"].(*corev1.ServiceAccount).AutomountServiceAccountToken; !ptr.Equal(automount, ptr.To(false)) {
+		t.Errorf("Expected the automount of the service account token to be taken over, got %v", automount)
+	}
+	changes, err := diffObjects(liveSts, desiredObjects["StatefulSet"])
+	if err != nil {
+		t.Fatalf("Failed to diff objects: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("Expected the images and the checksum of the managed certificates to be taken over, got %v", changes)
+	}
+}
+
+func TestRenderDesiredObjectsWithLocalProvider(t *testing.T) {
+	newEtcd := func() *druidv1alpha1.Etcd {
+		etcd := newDiffTestEtcd()
+		etcd.Spec.Backup.Store = &druidv1alpha1.StoreSpec{
+			Container: ptr.To("default.bkp"),
+			Prefix:    "test-etcd",
+			Provider:  ptr.To[druidv1alpha1.StorageProvider]("local"),
+			SecretRef: &corev1.SecretReference{Name: "etcd-backup"},
+		}
+		return etcd
+	}
+
+	t.Run("host path of backup store secret", func(t *testing.T) {
+		secrets := map[string]*corev1.Secret{
+			"etcd-backup": {
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-backup", Namespace: "default"},
+				Data:       map[string][]byte{druidresources.EtcdBackupSecretHostPath: []byte("/var/data/etcd-backup")},
+			},
+		}
+		sts := mustRenderDesiredObjects(t, newEtcd(), nil, secrets)["StatefulSet"].(*appsv1.StatefulSet)
+		var hostPath string
+		for _, volume := range sts.Spec.Template.Spec.Volumes {
+			if volume.HostPath != nil {
+				hostPath = volume.HostPath.Path
+			}
+		}
+		if hostPath != "/var/data/etcd-backup/default.bkp" {
+			t.Errorf("Expected backup volume with host path /var/data/etcd-backup/default.bkp, got %q", hostPath)
+		}
+		if _, ok := sts.Spec.Template.Annotations[druidresources.CheckSumKeyReferencedSecrets]; !ok {
+			t.Error("Expected the checksum of the referenced secrets on the pod template")
+		}
+	})
+
+	t.Run("missing backup store secret", func(t *testing.T) {
+		_, err := renderDesiredObjects(newEtcd(), nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "backup store secret etcd-backup of etcd test-etcd not found") {
+			t.Fatalf("Expected an error for the missing backup store secret, got %v", err)
+		}
+	})
+}
+
+func TestDiffObjectsIgnoresServerDefaults(t *testing.T) {
+	live := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cm", ResourceVersion: "42", UID: "uid", Labels: map[string]string{"a": "1", "b": "2"}},
+		Data:       map[string]string{"key": "old"},
+	}
+	desired := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cm", Labels: map[string]string{"a": "1"}},
+		Data:       map[string]string{"key": "new"},
+	}
+	changes, err := diffObjects(live, desired)
+	if err != nil {
+		t.Fatalf("Failed to diff objects: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %v", changes)
+	}
+	if changes[0].Path != "data.key" || changes[0].Type != FieldChanged {
+		t.Errorf("Expected data.key to be changed, got %v", changes[0])
+	}
+	if changes[1].Path != "metadata.labels.b" || changes[1].Type != FieldRemoved {
+		t.Errorf("Expected label b to be removed, got %v", changes[1])
+	}
+}
+
+func hasChange(changes []FieldChange, path string) bool {
+	for _, change := range changes {
+		if change.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDiffCommand(t *testing.T) {
+	etcd := newDiffTestEtcd()
+	var liveObjects []runtime.Object
+	for _, obj := range mustRenderDesiredObjects(t, etcd, nil, nil) {
+		liveObjects = append(liveObjects, obj)
+	}
+	tests := []struct {
+		name             string
+		k8sObjects       []runtime.Object
+		expectedOutput   []string
+		unexpectedOutput []string
+	}{
+		{
+			name:             "resources do not exist",
+			expectedOutput:   []string{"kind: ReconciliationDiff", "component: StatefulSet", "action: Create"},
+			unexpectedOutput: []string{"action: Update", "action: None"},
+		},
+		{
+			name:             "resources are up-to-date",
+			k8sObjects:       liveObjects,
+			expectedOutput:   []string{"kind: ReconciliationDiff", "component: StatefulSet", "action: None"},
+			unexpectedOutput: []string{"action: Create", "action: Update"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			helper := fake.NewTestHelper().
+				WithEtcdObjects([]runtime.Object{etcd}).
+				WithK8sObjects(tt.k8sObjects)
+			_, out, err := helper.RunCommand(t, NewDiffCommand, []string{"test-etcd"}, map[string]string{"output": "yaml"})
+			if err != nil {
+				t.Fatalf("Diff command failed: %v", err)
+			}
+			output := out.String()
+			for _, expected := range tt.expectedOutput {
+				if !strings.Contains(output, expected) {
+					t.Errorf("Expected output to contain %q, got:\n%s", expected, output)
+				}
+			}
+			for _, unexpected := range tt.unexpectedOutput {
+				if strings.Contains(output, unexpected) {
+					t.Errorf("Expected output not to contain %q, got:\n%s", unexpected, output)
+				}
+			}
+		})
+	}
+}
+
+func TestDiffCommandErrors(t *testing.T) {
+	etcd := newDiffTestEtcd()
+	tests := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{
+			name:          "more than one etcd",
+			args:          []string{"test-etcd", "other-etcd"},
+			expectedError: "exactly one etcd resource must be specified",
+		},
+		{
+			name:          "unknown etcd",
+			args:          []string{"other-etcd"},
+			expectedError: `etcd "other-etcd" not found`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			helper := fake.NewTestHelper().
+				WithEtcdObjects([]runtime.Object{etcd})
+			_, _, err := helper.RunCommand(t, NewDiffCommand, tt.args, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+				t.Fatalf("Expected error containing %q, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciliation
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FieldChangeType is the type of change of a single field.
+type FieldChangeType string
+
+const (
+	// FieldAdded indicates that the field will be added.
+	FieldAdded FieldChangeType = "Added"
+	// FieldRemoved indicates that the field will be removed.
+	FieldRemoved FieldChangeType = "Removed"
+	// FieldChanged indicates that the value of the field will be changed.
+	FieldChanged FieldChangeType = "Changed"
+)
+
+// FieldChange is the change of a single field of a resource.
+type FieldChange struct {
+	// Path is the path of the field, e.g. `spec.template.spec.containers[name=etcd].image`.
+	Path    string          `json:"path"`
+	Type    FieldChangeType `json:"type"`
+	Live    any             `json:"live,omitempty"`
+	Desired any             `json:"desired,omitempty"`
+}
+
+// ignoredMetadataFields are the metadata fields which are maintained by the API server.
+var ignoredMetadataFields = []string{"resourceVersion", "uid", "generation", "creationTimestamp", "managedFields", "selfLink"}
+
+// mapFields are the fields whose values are free-form maps, from which a removed key is always a change.
+var mapFields = []string{"labels", "annotations", "data", "binaryData", "matchLabels", "nodeSelector", "selector"}
+
+// diffObjects returns the changes which turn the live object into the desired object. The status and the fields
+// maintained by the API server are not compared. Fields which are only present in the live object are ignored if they
+// are scalars, since these are most likely defaulted by the API server.
+func diffObjects(live, desired client.Object) ([]FieldChange, error) {
+	liveContent, err := toComparableContent(live)
+	if err != nil {
+		return nil, err
+	}
+	desiredContent, err := toComparableContent(desired)
+	if err != nil {
+		return nil, err
+	}
+	var changes []FieldChange
+	diffValues("", liveContent, desiredContent, &changes)
+	return changes, nil
+}
+
+func toComparableContent(obj client.Object) (map[string]any, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %T to unstructured: %w", obj, err)
+	}
+	delete(content, "apiVersion")
+	delete(content, "kind")
+	delete(content, "status")
+	if metadata, ok := content["metadata"].(map[string]any); ok {
+		for _, field := range ignoredMetadataFields {
+			delete(metadata, field)
+		}
+	}
+	return content, nil
+}
+
+func diffValues(path string, live, desired any, changes *[]FieldChange) {
+	switch liveValue := live.(type) {
+	case map[string]any:
+		if desiredValue, ok := desired.(map[string]any); ok {
+			diffMaps(path, liveValue, desiredValue, changes)
+			return
+		}
+	case []any:
+		if desiredValue, ok := desired.([]any); ok {
+			diffLists(path, liveValue, desiredValue, changes)
+			return
+		}
+	}
+	if !reflect.DeepEqual(live, desired) {
+		*changes = append(*changes, FieldChange{Path: path, Type: FieldChanged, Live: live, Desired: desired})
+	}
+}
+
+func diffMaps(path string, live, desired map[string]any, changes *[]FieldChange) {
+	isMapField := slices.Contains(mapFields, lastPathElement(path))
+	keys := make([]string, 0, len(live)+len(desired))
+	for key := range live {
+		keys = append(keys, key)
+	}
+	for key := range desired {
+		if _, ok := live[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		fieldPath := joinPath(path, key)
+		liveValue, inLive := live[key]
+		desiredValue, inDesired := desired[key]
+		switch {
+		case inLive && inDesired:
+			diffValues(fieldPath, liveValue, desiredValue, changes)
+		case inDesired:
+			if !isEmpty(desiredValue) {
+				*changes = append(*changes, FieldChange{Path: fieldPath, Type: FieldAdded, Desired: desiredValue})
+			}
+		case isMapField:
+			*changes = append(*changes, FieldChange{Path: fieldPath, Type: FieldRemoved, Live: liveValue})
+		default:
+			// Nested structs which are only present in the live object are compared field by field, so that only
+			// non-defaulted fields within them are reported.
+			if liveMap, ok := liveValue.(map[string]any); ok {
+				diffMaps(fieldPath, liveMap, map[string]any{}, changes)
+			} else if liveList, ok := liveValue.([]any); ok && len(liveList) > 0 {
+				*changes = append(*changes, FieldChange{Path: fieldPath, Type: FieldRemoved, Live: liveValue})
+			}
+		}
+	}
+}
+
+// diffLists compares lists whose elements have a name, e.g. containers, volumes or ports, by name, and all other
+// lists by index.
+func diffLists(path string, live, desired []any, changes *[]FieldChange) {
+	liveByName, liveNames := indexByName(live)
+	desiredByName, desiredNames := indexByName(desired)
+	if liveByName == nil || desiredByName == nil {
+		for i := range max(len(live), len(desired)) {
+			elementPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(live):
+				*changes = append(*changes, FieldChange{Path: elementPath, Type: FieldAdded, Desired: desired[i]})
+			case i >= len(desired):
+				*changes = append(*changes, FieldChange{Path: elementPath, Type: FieldRemoved, Live: live[i]})
+			default:
+				diffValues(elementPath, live[i], desired[i], changes)
+			}
+		}
+		return
+	}
+	for _, name := range liveNames {
+		elementPath := fmt.Sprintf("%s[name=%s]", path, name)
+		if desiredElement, ok := desiredByName[name]; ok {
+			diffValues(elementPath, liveByName[name], desiredElement, changes)
+		} else {
+			*changes = append(*changes, FieldChange{Path: elementPath, Type: FieldRemoved, Live: liveByName[name]})
+		}
+	}
+	for _, name := range desiredNames {
+		if _, ok := liveByName[name]; !ok {
+			*changes = append(*changes, FieldChange{Path: fmt.Sprintf("%s[name=%s]", path, name), Type: FieldAdded, Desired: desiredByName[name]})
+		}
+	}
+}
+
+// indexByName returns the elements of the list by their name and the names in list order, or nil if not all elements
+// have a unique name.
+func indexByName(list []any) (map[string]any, []string) {
+	byName := make(map[string]any, len(list))
+	names := make([]string, 0, len(list))
+	for _, element := range list {
+		elementMap, ok := element.(map[string]any)
+		if !ok {
+			return nil, nil
+		}
+		name, ok := elementMap["name"].(string)
+		if !ok {
+			return nil, nil
+		}
+		if _, duplicate := byName[name]; duplicate {
+			return nil, nil
+		}
+		byName[name] = element
+		names = append(names, name)
+	}
+	return byName, names
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	}
+	return false
+}
+
+func joinPath(path, key string) string {
+	if strings.ContainsAny(key, ".[]") {
+		key = fmt.Sprintf("[%s]", key)
+		return path + key
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func lastPathElement(path string) string {
+	if idx := strings.LastIndexAny(path, ".["); idx >= 0 {
+		return strings.TrimSuffix(path[idx+1:], "]")
+	}
+	return path
+}
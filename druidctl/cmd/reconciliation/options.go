@@ -9,6 +9,7 @@ import (
 
 	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
 	"github.com/gardener/etcd-druid/druidctl/internal/client"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
 
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -108,3 +109,37 @@ func newResumeReconcileRuntime(runtime *cmdutils.RuntimeEnv) *resumeReconcileRun
 		RuntimeEnv: runtime,
 	}
 }
+
+// diffOptions holds command-specific options for the reconciliation diff command
+type diffOptions struct {
+	*cmdutils.GlobalOptions
+	PrintFlags *printer.PrintFlags
+}
+
+// diffRuntime holds runtime state for the reconciliation diff command
+type diffRuntime struct {
+	*cmdutils.RuntimeEnv
+	etcdRef       types.NamespacedName
+	etcdClient    client.EtcdClientInterface
+	genericClient client.GenericClientInterface
+	Printer       printer.Printer
+}
+
+// diffCmdCtx composes options and runtime for the reconciliation diff command
+type diffCmdCtx struct {
+	*diffOptions
+	*diffRuntime
+}
+
+func newDiffOptions(options *cmdutils.GlobalOptions) *diffOptions {
+	return &diffOptions{
+		GlobalOptions: options,
+		PrintFlags:    printer.NewPrintFlags(),
+	}
+}
+
+func newDiffRuntime(runtime *cmdutils.RuntimeEnv) *diffRuntime {
+	return &diffRuntime{
+		RuntimeEnv: runtime,
+	}
+}
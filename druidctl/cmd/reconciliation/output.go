@@ -5,11 +5,14 @@
 package reconciliation
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"sync"
 	"time"
 
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
 	"k8s.io/apimachinery/pkg/types"
@@ -154,3 +157,72 @@ func printReconcileStatus(sm *statusManager) {
 	fmt.Println("Reconciliation Status:")
 	fmt.Println(table)
 }
+
+// maxValueLength is the maximum length of a value shown in the diff table.
+const maxValueLength = 60
+
+// ToTable converts the result into a table with one row per changed field.
+func (r DiffResult) ToTable() *printer.Table {
+	t := &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "COMPONENT"},
+			{Name: "NAME"},
+			{Name: "CHANGE"},
+			{Name: "PATH"},
+			{Name: "ROLLS PODS"},
+			{Name: "LIVE", Wide: true},
+			{Name: "DESIRED", Wide: true},
+		},
+	}
+	for _, resource := range r.Resources {
+		switch resource.Action {
+		case ResourceActionNone:
+			continue
+		case ResourceActionCreate:
+			t.Rows = append(t.Rows, printer.TableRow{
+				Cells:  []string{resource.Component, resource.Name, string(resource.Action), "", "false", "", ""},
+				Object: resource,
+			})
+			continue
+		}
+		for _, change := range resource.Changes {
+			t.Rows = append(t.Rows, printer.TableRow{
+				Cells: []string{
+					resource.Component,
+					resource.Name,
+					string(change.Type),
+					change.Path,
+					fmt.Sprintf("%t", rollsPods(resource.Kind, []FieldChange{change})),
+					formatValue(change.Live),
+					formatValue(change.Desired),
+				},
+				Object: change,
+			})
+		}
+	}
+	return t
+}
+
+// formatValue formats a field value for the diff table, truncating long values.
+func formatValue(value any) string {
+	if value == nil {
+		return ""
+	}
+	var formatted string
+	switch v := value.(type) {
+	case string:
+		formatted = v
+	case map[string]any, []any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		formatted = string(data)
+	default:
+		formatted = fmt.Sprintf("%v", v)
+	}
+	if len(formatted) > maxValueLength {
+		return formatted[:maxValueLength-3] + "..."
+	}
+	return formatted
+}
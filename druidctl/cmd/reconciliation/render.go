@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciliation
+
+import (
+	"fmt"
+	"slices"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// renderDesiredObjects renders the previewed resources of the given Etcd with the builders which etcd-druid uses, keyed
+// by their component. As etcd-druid patches the live resources, every resource is rendered onto a copy of its live
+// object, if it exists. The given secrets are the existing secrets referenced by the Etcd, keyed by their name.
+//
+// The configuration and the image vector of the running etcd-druid are not known. They are therefore derived from the
+// live resources where they have an effect on them.
+func renderDesiredObjects(etcd *druidv1alpha1.Etcd, liveObjects map[string]client.Object, secrets map[string]*corev1.Secret) (map[string]client.Object, error) {
+	desiredObjects := make(map[string]client.Object, len(previewedResources))
+	checkSums := make(map[string]string)
+	for _, r := range previewedResources {
+		var obj client.Object
+		if live := liveObjects[r.component]; live != nil {
+			obj = live.DeepCopyObject().(client.Object)
+		} else {
+			obj = r.newObject()
+			obj.SetName(r.name(etcd.ObjectMeta))
+			obj.SetNamespace(etcd.Namespace)
+		}
+
+		switch o := obj.(type) {
+		case *corev1.ServiceAccount:
+			// Whether the token is mounted is configured for etcd-druid, and is therefore taken over from the live object.
+			druidresources.BuildServiceAccount(etcd, o, ptr.Deref(o.AutomountServiceAccountToken, true))
+		case *rbacv1.Role:
+			druidresources.BuildRole(etcd, o)
+		case *rbacv1.RoleBinding:
+			druidresources.BuildRoleBinding(etcd, o)
+		case *policyv1.PodDisruptionBudget:
+			druidresources.BuildPodDisruptionBudget(etcd, o)
+		case *corev1.Service:
+			if r.component == "PeerService" {
+				druidresources.BuildPeerService(etcd, o)
+			} else {
+				druidresources.BuildClientService(etcd, o)
+			}
+		case *corev1.ConfigMap:
+			if err := druidresources.BuildConfigMap(etcd, o); err != nil {
+				return nil, fmt.Errorf("failed to render the %s %s: %w", r.kind, o.Name, err)
+			}
+			checkSum, err := druidresources.ComputeConfigMapCheckSum(o)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compute the checksum of the %s %s: %w", r.kind, o.Name, err)
+			}
+			checkSums[druidresources.CheckSumKeyConfigMap] = checkSum
+		case *appsv1.StatefulSet:
+			liveSts, _ := liveObjects[r.component].(*appsv1.StatefulSet)
+			opts, err := getStatefulSetOptions(etcd, liveSts, secrets, checkSums)
+			if err != nil {
+				return nil, err
+			}
+			if err := druidresources.BuildStatefulSet(etcd, o, opts); err != nil {
+				return nil, fmt.Errorf("failed to render the %s %s: %w", r.kind, o.Name, err)
+			}
+		}
+		desiredObjects[r.component] = obj
+	}
+	return desiredObjects, nil
+}
+
+// getStatefulSetOptions returns the options to render the StatefulSet of the given Etcd.
+//   - The images are taken from the spec of the Etcd if set, and otherwise from the live StatefulSet.
+//   - The checksum of the managed certificates is taken over from the live StatefulSet, as they are not previewed.
+//   - The checksum of the referenced secrets is only computed if the live StatefulSet carries it, or does not exist,
+//     as etcd-druid does not introduce it to an existing StatefulSet by itself.
+func getStatefulSetOptions(etcd *druidv1alpha1.Etcd, liveSts *appsv1.StatefulSet, secrets map[string]*corev1.Secret, checkSums map[string]string) (druidresources.StatefulSetOptions, error) {
+	opts := druidresources.StatefulSetOptions{
+		Replicas:               etcd.Spec.Replicas,
+		EtcdImage:              ptr.Deref(etcd.Spec.Etcd.Image, getLiveContainerImage(liveSts, druidresources.ContainerNameEtcd)),
+		EtcdBackupRestoreImage: ptr.Deref(etcd.Spec.Backup.Image, getLiveContainerImage(liveSts, druidresources.ContainerNameEtcdBackupRestore)),
+		InitContainerImage:     getLiveContainerImage(liveSts, druidresources.InitContainerNameChangePermissions),
+		CheckSums:              checkSums,
+	}
+
+	provider, err := druidresources.GetBackupStoreProvider(etcd)
+	if err != nil {
+		return opts, err
+	}
+	if provider != nil && *provider == druidresources.Local {
+		var secret *corev1.Secret
+		if secretRef := etcd.Spec.Backup.Store.SecretRef; secretRef != nil {
+			if secret = secrets[secretRef.Name]; secret == nil {
+				return opts, fmt.Errorf("backup store secret %s of etcd %s not found", secretRef.Name, etcd.Name)
+			}
+		}
+		opts.LocalProviderHostPath = druidresources.GetLocalProviderHostPath(secret)
+	}
+
+	if liveSts != nil {
+		if checkSum, ok := liveSts.Spec.Template.Annotations[druidresources.CheckSumKeyManagedCertificates]; ok {
+			checkSums[druidresources.CheckSumKeyManagedCertificates] = checkSum
+		}
+	}
+	secretNames := druidresources.GetReferencedSecretNames(etcd)
+	if len(secretNames) > 0 && (liveSts == nil || metav1.HasAnnotation(liveSts.Spec.Template.ObjectMeta, druidresources.CheckSumKeyReferencedSecrets)) {
+		var referencedSecrets []*corev1.Secret
+		for _, name := range secretNames {
+			if secret, ok := secrets[name]; ok {
+				referencedSecrets = append(referencedSecrets, secret)
+			}
+		}
+		checkSums[druidresources.CheckSumKeyReferencedSecrets] = druidresources.ComputeReferencedSecretsCheckSum(referencedSecrets)
+	}
+	return opts, nil
+}
+
+// getLiveContainerImage returns the image of the container or init container with the given name of the given live
+// StatefulSet. It returns an empty string if the StatefulSet or the container does not exist.
+func getLiveContainerImage(liveSts *appsv1.StatefulSet, containerName string) string {
+	if liveSts == nil {
+		return ""
+	}
+	podSpec := liveSts.Spec.Template.Spec
+	for _, container := range slices.Concat(podSpec.InitContainers, podSpec.Containers) {
+		if container.Name == containerName {
+			return container.Image
+		}
+	}
+	return ""
+}
@@ -5,9 +5,17 @@
 package cmd
 
 import (
+	"github.com/gardener/etcd-druid/druidctl/cmd/backup"
+	"github.com/gardener/etcd-druid/druidctl/cmd/diagnose"
+	"github.com/gardener/etcd-druid/druidctl/cmd/etcdctl"
+	"github.com/gardener/etcd-druid/druidctl/cmd/lint"
 	"github.com/gardener/etcd-druid/druidctl/cmd/listresources"
+	"github.com/gardener/etcd-druid/druidctl/cmd/member"
 	"github.com/gardener/etcd-druid/druidctl/cmd/reconciliation"
 	"github.com/gardener/etcd-druid/druidctl/cmd/resourceprotection"
+	"github.com/gardener/etcd-druid/druidctl/cmd/status"
+	"github.com/gardener/etcd-druid/druidctl/cmd/task"
+	"github.com/gardener/etcd-druid/druidctl/cmd/top"
 	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
 	versioncmd "github.com/gardener/etcd-druid/druidctl/cmd/version"
 	"github.com/gardener/etcd-druid/druidctl/internal/banner"
@@ -67,6 +75,14 @@ func NewDruidCommand() *cobra.Command {
 	rootCmd.AddCommand(reconciliation.NewReconciliationCommand(cmdCtx))
 	rootCmd.AddCommand(resourceprotection.NewComponentProtectionCommand(cmdCtx))
 	rootCmd.AddCommand(listresources.NewListResourcesCommand(cmdCtx))
+	rootCmd.AddCommand(status.NewStatusCommand(cmdCtx))
+	rootCmd.AddCommand(task.NewTaskCommand(cmdCtx))
+	rootCmd.AddCommand(backup.NewBackupCommand(cmdCtx))
+	rootCmd.AddCommand(diagnose.NewDiagnoseCommand(cmdCtx))
+	rootCmd.AddCommand(etcdctl.NewEtcdctlCommand(cmdCtx))
+	rootCmd.AddCommand(member.NewMemberCommand(cmdCtx))
+	rootCmd.AddCommand(top.NewTopCommand(cmdCtx))
+	rootCmd.AddCommand(lint.NewLintCommand(cmdCtx))
 
 	return rootCmd
 }
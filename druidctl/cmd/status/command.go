@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	describeAlias = "describe"
+	detailedFlag  = "detailed"
+)
+
+var (
+	example = `
+# Show the status of an etcd resource in the default namespace
+kubectl druid status my-etcd
+
+# Show the status of an etcd resource in a specific namespace
+kubectl druid status test/my-etcd
+
+# Show the status of all etcd resources in a namespace
+kubectl druid status -n test
+
+# Show the status of all etcd resources across all namespaces
+kubectl druid status -A
+
+# Show the detailed status of an etcd resource including conditions, members, snapshots and managed resources
+kubectl druid describe test/my-etcd
+
+# Output the status in YAML format
+kubectl druid status test/my-etcd --output=yaml
+
+# Show additional columns and sort by readiness
+kubectl druid status -A --output=wide --sort-by=READY
+`
+)
+
+// NewStatusCommand creates the status command
+func NewStatusCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	statusOptions := newStatusOptions(cmdCtx.Options)
+
+	statusCmd := &cobra.Command{
+		Use:     "status <etcd-resource-name> --output=<output-format> (optional flag)",
+		Aliases: []string{describeAlias},
+		Short:   "Show a consolidated health view of etcd clusters",
+		Long: `Show a consolidated health view of etcd clusters. By default a summary table is printed. The detailed view, which is
+also printed when the command is invoked as 'describe', includes the conditions, the status and role of the members,
+the age of the snapshot leases, the last operation and errors, the compaction state and the state of the managed resources.`,
+		Args:    cobra.ArbitraryArgs,
+		Example: example,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if cmd.CalledAs() == describeAlias && !cmd.Flags().Changed(detailedFlag) {
+				statusOptions.Detailed = true
+			}
+			statusCmdCtx := &statusCmdCtx{
+				statusOptions: statusOptions,
+				statusRuntime: newStatusRuntime(cmdCtx.Runtime),
+			}
+			if err := statusCmdCtx.validate(); err != nil {
+				if herr := cmd.Help(); herr != nil {
+					cmdCtx.Runtime.Logger.Warning(cmdCtx.Runtime.IOStreams.ErrOut, "Failed to show help: ", herr.Error())
+				}
+				return err
+			}
+
+			if err := statusCmdCtx.complete(); err != nil {
+				return err
+			}
+
+			if err := statusCmdCtx.execute(cmdutils.CmdContext(cmd)); err != nil {
+				cmdCtx.Runtime.Logger.Error(cmdCtx.Runtime.IOStreams.ErrOut, "Getting the status of Etcds failed", err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	statusOptions.PrintFlags.AddFlags(statusCmd)
+	statusCmd.Flags().BoolVar(&statusOptions.Detailed, detailedFlag, false, "Print the detailed status of every etcd resource instead of a summary table. Defaults to true when invoked as 'describe'. Only applies to the table output format.")
+
+	return statusCmd
+}
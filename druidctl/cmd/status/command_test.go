@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	fake "github.com/gardener/etcd-druid/druidctl/internal/client/fake"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+// newTestObjects returns an Etcd whose third member is not ready, together with its StatefulSet, a pod and a snapshot lease.
+func newTestObjects() ([]runtime.Object, []runtime.Object) {
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").
+		WithMemberStatuses(druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusNotReady).
+		WithBackupStore("Local").
+		Build()
+	etcd.Status.Ready = ptr.To(false)
+	etcd.Status.ReadyReplicas = 2
+	etcd.Status.Conditions = []druidv1alpha1.Condition{
+		{Type: druidv1alpha1.ConditionTypeReady, Status: druidv1alpha1.ConditionTrue, Reason: "Quorate"},
+		{Type: druidv1alpha1.ConditionTypeAllMembersReady, Status: druidv1alpha1.ConditionFalse, Reason: "NotAllMembersReady"},
+		{Type: druidv1alpha1.ConditionTypeLastSnapshotCompactionSucceeded, Status: druidv1alpha1.ConditionTrue, Reason: "CompactionJobSucceeded"},
+	}
+	etcd.Status.Compaction = &druidv1alpha1.CompactionStatus{
+		History: []druidv1alpha1.CompactionJobRun{{JobName: "test-etcd-compactor", Result: druidv1alpha1.CompactionJobResultSucceeded}},
+	}
+
+	renewTime := metav1.NewMicroTime(time.Now().Add(-2 * time.Minute))
+	return []runtime.Object{etcd}, []runtime.Object{
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-etcd", Namespace: "default"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](3)},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 2, UpdatedReplicas: 3},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-etcd-0", Namespace: "default"},
+			Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "etcd", RestartCount: 1}},
+			},
+		},
+		&coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-etcd-full-snap", Namespace: "default"},
+			Spec:       coordinationv1.LeaseSpec{HolderIdentity: ptr.To("42"), RenewTime: &renewTime},
+		},
+	}
+}
+
+func TestStatusCommand(t *testing.T) {
+	etcdObjects, k8sObjects := newTestObjects()
+	_, buf, err := fake.NewTestHelper().WithEtcdObjects(etcdObjects).WithK8sObjects(k8sObjects).
+		RunCommand(t, NewStatusCommand, []string{"test-etcd"}, map[string]string{"output": "json"})
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+
+	var result Result
+	if err := json.Unmarshal([]byte(buf.String()), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, buf.String())
+	}
+	if len(result.Etcds) != 1 {
+		t.Fatalf("Expected 1 etcd in result, got %d", len(result.Etcds))
+	}
+	etcdStatus := result.Etcds[0]
+
+	if etcdStatus.Ready || etcdStatus.ReadyReplicas != 2 || etcdStatus.Replicas != 3 {
+		t.Errorf("Unexpected readiness: ready=%t, %d/%d replicas", etcdStatus.Ready, etcdStatus.ReadyReplicas, etcdStatus.Replicas)
+	}
+	if len(etcdStatus.Conditions) != 3 {
+		t.Errorf("Expected 3 conditions, got %d", len(etcdStatus.Conditions))
+	}
+	if len(etcdStatus.Members) != 3 || etcdStatus.Members[0].Role != "Leader" || etcdStatus.Members[2].Status != "NotReady" {
+		t.Errorf("Unexpected members: %+v", etcdStatus.Members)
+	}
+
+	if len(etcdStatus.SnapshotLeases) != 2 {
+		t.Fatalf("Expected 2 snapshot leases, got %d", len(etcdStatus.SnapshotLeases))
+	}
+	fullSnapshotLease, deltaSnapshotLease := etcdStatus.SnapshotLeases[0], etcdStatus.SnapshotLeases[1]
+	if !fullSnapshotLease.Found || fullSnapshotLease.Revision != "42" || fullSnapshotLease.Age == nil || *fullSnapshotLease.Age < 2*time.Minute {
+		t.Errorf("Unexpected full snapshot lease status: %+v", fullSnapshotLease)
+	}
+	if deltaSnapshotLease.Found {
+		t.Errorf("Expected delta snapshot lease not to be found, got %+v", deltaSnapshotLease)
+	}
+
+	if etcdStatus.Compaction == nil || etcdStatus.Compaction.Condition == nil || etcdStatus.Compaction.LastRun == nil {
+		t.Fatalf("Expected compaction state with condition and last run, got %+v", etcdStatus.Compaction)
+	}
+	if etcdStatus.Compaction.LastRun.JobName != "test-etcd-compactor" {
+		t.Errorf("Unexpected last compaction run: %+v", etcdStatus.Compaction.LastRun)
+	}
+
+	resourceStatuses := make(map[string]string)
+	for _, resource := range etcdStatus.Resources {
+		resourceStatuses[resource.Kind+"/"+resource.Name] = resource.Status
+	}
+	expectedResourceStatuses := map[string]string{
+		"StatefulSet/test-etcd":    "2/3 ready, 3 updated",
+		"Pod/test-etcd-0":          "Running, ready, 1 restarts",
+		"Pod/test-etcd-1":          resourceStatusNotFound,
+		"Service/test-etcd-client": resourceStatusNotFound,
+	}
+	for key, expectedStatus := range expectedResourceStatuses {
+		if resourceStatuses[key] != expectedStatus {
+			t.Errorf("Expected status %q for %s, got %q", expectedStatus, key, resourceStatuses[key])
+		}
+	}
+}
+
+func TestStatusCommandTableOutput(t *testing.T) {
+	etcdObjects, k8sObjects := newTestObjects()
+	_, buf, err := fake.NewTestHelper().WithEtcdObjects(etcdObjects).WithK8sObjects(k8sObjects).
+		RunCommand(t, NewStatusCommand, []string{"test-etcd"}, map[string]string{"output": "wide"})
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected a header and one row, got:\n%s", buf.String())
+	}
+	if fields := strings.Fields(lines[0]); fields[0] != "NAMESPACE" || fields[len(fields)-1] != "ERRORS" {
+		t.Errorf("Unexpected header: %s", lines[0])
+	}
+	for _, expected := range []string{"default", "test-etcd", "2/3", "test-etcd-0", "2m", "AllMembersReady", "Succeeded"} {
+		if !strings.Contains(lines[1], expected) {
+			t.Errorf("Expected row to contain %q, got: %s", expected, lines[1])
+		}
+	}
+}
+
+func TestStatusCommandDetailedOutput(t *testing.T) {
+	etcdObjects, k8sObjects := newTestObjects()
+	_, buf, err := fake.NewTestHelper().WithEtcdObjects(etcdObjects).WithK8sObjects(k8sObjects).
+		RunCommand(t, NewStatusCommand, []string{"test-etcd"}, map[string]string{"detailed": "true"})
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+
+	output := buf.String()
+	for _, expected := range []string{"Conditions:", "AllMembersReady", "Members:", "test-etcd-2", "Snapshots:", "test-etcd-full-snap", "Compaction:", "Resources:", "StatefulSet"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
+
+func TestStatusCommandEtcdNotFound(t *testing.T) {
+	_, _, err := fake.NewTestHelper().WithTestScenario(fake.EmptyScenario()).RunCommand(t, NewStatusCommand, []string{"non-existent"}, nil)
+	if err == nil {
+		t.Fatal("Expected command to fail for non-existent etcd")
+	}
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// statusOptions holds command-specific options for the status command
+type statusOptions struct {
+	*cmdutils.GlobalOptions
+	PrintFlags *printer.PrintFlags
+	// Detailed prints the full status of every Etcd instead of a summary table.
+	Detailed bool
+}
+
+// statusRuntime holds runtime state for the status command
+type statusRuntime struct {
+	*cmdutils.RuntimeEnv
+	etcdRefList   []types.NamespacedName
+	EtcdClient    client.EtcdClientInterface
+	GenericClient client.GenericClientInterface
+	Printer       printer.Printer
+}
+
+// statusCmdCtx composes options and runtime for the status command
+type statusCmdCtx struct {
+	*statusOptions
+	*statusRuntime
+}
+
+func newStatusOptions(options *cmdutils.GlobalOptions) *statusOptions {
+	return &statusOptions{
+		GlobalOptions: options,
+		PrintFlags:    printer.NewPrintFlags(),
+	}
+}
+
+func newStatusRuntime(runtime *cmdutils.RuntimeEnv) *statusRuntime {
+	return &statusRuntime{
+		RuntimeEnv: runtime,
+	}
+}
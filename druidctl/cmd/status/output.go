@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+)
+
+// ToTable converts the result into a summary table with one row per Etcd.
+func (r Result) ToTable() *printer.Table {
+	table := &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "NAMESPACE"},
+			{Name: "NAME"},
+			{Name: "READY"},
+			{Name: "REPLICAS"},
+			{Name: "LEADER"},
+			{Name: "LAST SNAPSHOT"},
+			{Name: "LAST OPERATION"},
+			{Name: "CONDITIONS", Wide: true},
+			{Name: "COMPACTION", Wide: true},
+			{Name: "ERRORS", Wide: true},
+		},
+	}
+	for _, etcdStatus := range r.Etcds {
+		table.Rows = append(table.Rows, printer.TableRow{
+			Cells: []string{
+				etcdStatus.Etcd.Namespace,
+				etcdStatus.Etcd.Name,
+				fmt.Sprintf("%t", etcdStatus.Ready),
+				fmt.Sprintf("%d/%d", etcdStatus.ReadyReplicas, etcdStatus.Replicas),
+				leaderName(etcdStatus.Members),
+				lastSnapshotAge(etcdStatus.SnapshotLeases),
+				lastOperationSummary(etcdStatus),
+				unhealthyConditions(etcdStatus.Conditions),
+				compactionSummary(etcdStatus.Compaction),
+				fmt.Sprintf("%d", len(etcdStatus.LastErrors)),
+			},
+			Object: etcdStatus,
+		})
+	}
+	return table
+}
+
+func leaderName(members []MemberStatus) string {
+	for _, member := range members {
+		if member.Role == "Leader" {
+			return member.Name
+		}
+	}
+	return "<none>"
+}
+
+// lastSnapshotAge returns the age of the most recent full or delta snapshot.
+func lastSnapshotAge(leases []SnapshotLeaseStatus) string {
+	if len(leases) == 0 {
+		return "N/A"
+	}
+	var latest *time.Duration
+	for _, lease := range leases {
+		if lease.Age != nil && (latest == nil || *lease.Age < *latest) {
+			latest = lease.Age
+		}
+	}
+	if latest == nil {
+		return "never"
+	}
+	return cmdutils.ShortDuration(*latest)
+}
+
+func lastOperationSummary(etcdStatus EtcdStatus) string {
+	if etcdStatus.LastOperation == nil {
+		return "<none>"
+	}
+	return fmt.Sprintf("%s/%s", etcdStatus.LastOperation.Type, etcdStatus.LastOperation.State)
+}
+
+// unhealthyConditions returns the types of all conditions which are not True, or "AllTrue" if there are none.
+func unhealthyConditions(conditions []ConditionStatus) string {
+	var unhealthy []string
+	for _, condition := range conditions {
+		if condition.Status != "True" {
+			unhealthy = append(unhealthy, condition.Type)
+		}
+	}
+	if len(unhealthy) == 0 {
+		return "AllTrue"
+	}
+	return strings.Join(unhealthy, ",")
+}
+
+func compactionSummary(compaction *CompactionState) string {
+	if compaction == nil || compaction.LastRun == nil {
+		return "<none>"
+	}
+	return string(compaction.LastRun.Result)
+}
+
+// renderStatus prints the status of each Etcd as a set of tables.
+func (s *statusCmdCtx) renderStatus(etcdStatuses []EtcdStatus) {
+	for _, etcdStatus := range etcdStatuses {
+		s.Logger.RawHeader(s.IOStreams.Out, fmt.Sprintf("Etcd [%s/%s]", etcdStatus.Etcd.Namespace, etcdStatus.Etcd.Name))
+		fmt.Fprintf(s.IOStreams.Out, "Ready: %t (%d/%d replicas ready)\n", etcdStatus.Ready, etcdStatus.ReadyReplicas, etcdStatus.Replicas)
+
+		s.renderSection("Conditions", SetupConditionsTable(etcdStatus.Conditions), len(etcdStatus.Conditions))
+		s.renderSection("Members", SetupMembersTable(etcdStatus.Members), len(etcdStatus.Members))
+		if len(etcdStatus.SnapshotLeases) > 0 {
+			s.renderSection("Snapshots", SetupSnapshotLeasesTable(etcdStatus.SnapshotLeases), len(etcdStatus.SnapshotLeases))
+		}
+		if etcdStatus.Compaction != nil {
+			s.renderSection("Compaction", SetupCompactionTable(etcdStatus.Compaction), 1)
+		}
+		if etcdStatus.LastOperation != nil {
+			lastOp := etcdStatus.LastOperation
+			fmt.Fprintf(s.IOStreams.Out, "\nLast Operation: %s %s (%s ago, runID %s)\n  %s\n", lastOp.Type, lastOp.State,
+				cmdutils.ShortDuration(time.Since(lastOp.LastUpdateTime.Time)), lastOp.RunID, lastOp.Description)
+		}
+		for _, lastErr := range etcdStatus.LastErrors {
+			fmt.Fprintf(s.IOStreams.Out, "  Error %s (%s ago): %s\n", lastErr.Code, cmdutils.ShortDuration(time.Since(lastErr.ObservedAt.Time)), lastErr.Description)
+		}
+		s.renderSection("Resources", SetupResourcesTable(etcdStatus.Resources), len(etcdStatus.Resources))
+	}
+}
+
+func (s *statusCmdCtx) renderSection(title string, t *table.Table, rowCount int) {
+	fmt.Fprintf(s.IOStreams.Out, "\n%s:\n", title)
+	if rowCount == 0 {
+		fmt.Fprintln(s.IOStreams.Out, "  <none>")
+		return
+	}
+	if _, err := fmt.Fprintln(s.IOStreams.Out, t); err != nil {
+		s.Logger.Warning(s.IOStreams.ErrOut, "Failed writing ", strings.ToLower(title), " table: ", err.Error())
+	}
+}
+
+// SetupConditionsTable constructs a lipgloss table representing the provided conditions.
+func SetupConditionsTable(conditions []ConditionStatus) *table.Table {
+	rows := make([][]string, 0, len(conditions))
+	for _, condition := range conditions {
+		rows = append(rows, []string{condition.Type, condition.Status, condition.Reason, cmdutils.ShortDuration(condition.Age), condition.Message})
+	}
+	return newTable([]string{"Type", "Status", "Reason", "Age", "Message"}, rows)
+}
+
+// SetupMembersTable constructs a lipgloss table representing the provided members.
+func SetupMembersTable(members []MemberStatus) *table.Table {
+	rows := make([][]string, 0, len(members))
+	for _, member := range members {
+		rows = append(rows, []string{member.Name, member.ID, member.Role, member.Status, member.Reason})
+	}
+	return newTable([]string{"Name", "ID", "Role", "Status", "Reason"}, rows)
+}
+
+// SetupSnapshotLeasesTable constructs a lipgloss table representing the provided snapshot leases.
+func SetupSnapshotLeasesTable(leases []SnapshotLeaseStatus) *table.Table {
+	rows := make([][]string, 0, len(leases))
+	for _, lease := range leases {
+		age := "never"
+		if !lease.Found {
+			age = resourceStatusNotFound
+		} else if lease.Age != nil {
+			age = cmdutils.ShortDuration(*lease.Age)
+		}
+		rows = append(rows, []string{lease.Type, lease.Name, lease.Revision, age})
+	}
+	return newTable([]string{"Type", "Lease", "Revision", "Last Snapshot"}, rows)
+}
+
+// SetupCompactionTable constructs a lipgloss table representing the provided compaction state.
+func SetupCompactionTable(compaction *CompactionState) *table.Table {
+	row := make([]string, 5)
+	if compaction.Condition != nil {
+		row[0] = compaction.Condition.Status
+		row[1] = compaction.Condition.Reason
+	}
+	if lastRun := compaction.LastRun; lastRun != nil {
+		row[2] = lastRun.JobName
+		row[3] = string(lastRun.Result)
+		if lastRun.CompletionTime != nil {
+			row[4] = cmdutils.ShortDuration(time.Since(lastRun.CompletionTime.Time))
+		}
+	}
+	return newTable([]string{"Succeeded", "Reason", "Last Job", "Result", "Completed"}, [][]string{row})
+}
+
+// SetupResourcesTable constructs a lipgloss table representing the provided managed resources.
+func SetupResourcesTable(resources []ResourceStatus) *table.Table {
+	rows := make([][]string, 0, len(resources))
+	for _, resource := range resources {
+		rows = append(rows, []string{resource.Kind, resource.Name, resource.Status})
+	}
+	return newTable([]string{"Kind", "Name", "Status"}, rows)
+}
+
+func newTable(headers []string, rows [][]string) *table.Table {
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		Headers(headers...).
+		Rows(rows...).
+		StyleFunc(func(_, _ int) lipgloss.Style {
+			return lipgloss.NewStyle()
+		})
+}
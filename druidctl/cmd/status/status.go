@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	resourceStatusNotFound = "NotFound"
+	resourceStatusPresent  = "Present"
+)
+
+func (s *statusCmdCtx) complete() error {
+	etcdClient, err := s.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	s.EtcdClient = etcdClient
+
+	genericClient, err := s.Clients.GenericClient()
+	if err != nil {
+		return fmt.Errorf("unable to create generic kube clients: %w", err)
+	}
+	s.GenericClient = genericClient
+
+	s.Printer, err = s.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("failed to create formatter: %w", err)
+	}
+
+	s.etcdRefList = s.BuildEtcdRefList()
+	return nil
+}
+
+func (s *statusCmdCtx) validate() error {
+	return s.ValidateResourceSelection()
+}
+
+// execute collects and prints the consolidated status of the selected etcd resources.
+func (s *statusCmdCtx) execute(ctx context.Context) error {
+	etcdList, err := cmdutils.GetEtcdList(ctx, s.EtcdClient, s.etcdRefList, s.AllNamespaces, s.GetNamespace(), s.LabelSelector)
+	if err != nil {
+		return err
+	}
+	if len(etcdList.Items) == 0 {
+		if s.AllNamespaces {
+			s.Logger.Info(s.IOStreams.Out, "No Etcd resources found across all namespaces")
+		} else {
+			s.Logger.Info(s.IOStreams.Out, "no Etcd resources found in namespace %q", s.GetNamespace())
+		}
+		return nil
+	}
+
+	result := Result{
+		Etcds: make([]EtcdStatus, 0, len(etcdList.Items)),
+		Kind:  "EtcdStatusList",
+	}
+	now := time.Now()
+	for _, etcd := range etcdList.Items {
+		result.Etcds = append(result.Etcds, s.getEtcdStatus(ctx, &etcd, now))
+	}
+	sort.Slice(result.Etcds, func(i, j int) bool {
+		if result.Etcds[i].Etcd.Namespace == result.Etcds[j].Etcd.Namespace {
+			return result.Etcds[i].Etcd.Name < result.Etcds[j].Etcd.Name
+		}
+		return result.Etcds[i].Etcd.Namespace < result.Etcds[j].Etcd.Namespace
+	})
+
+	if s.Detailed && s.PrintFlags.IsTableOutput() {
+		s.renderStatus(result.Etcds)
+		return nil
+	}
+	outputData, err := s.Printer.Print(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result to desired format: %w", err)
+	}
+	fmt.Fprintf(s.IOStreams.Out, "%s\n", string(outputData))
+	return nil
+}
+
+// getEtcdStatus consolidates the status of the given Etcd and of the resources managed for it.
+func (s *statusCmdCtx) getEtcdStatus(ctx context.Context, etcd *druidv1alpha1.Etcd, now time.Time) EtcdStatus {
+	etcdStatus := EtcdStatus{
+		Etcd:          cmdutils.EtcdRef{Name: etcd.Name, Namespace: etcd.Namespace},
+		Ready:         ptr.Deref(etcd.Status.Ready, false),
+		Replicas:      etcd.Spec.Replicas,
+		ReadyReplicas: etcd.Status.ReadyReplicas,
+		LastOperation: etcd.Status.LastOperation,
+		LastErrors:    etcd.Status.LastErrors,
+	}
+	for _, condition := range etcd.Status.Conditions {
+		etcdStatus.Conditions = append(etcdStatus.Conditions, toConditionStatus(condition, now))
+	}
+	for _, member := range etcd.Status.Members {
+		etcdStatus.Members = append(etcdStatus.Members, MemberStatus{
+			Name:   member.Name,
+			ID:     ptr.Deref(member.ID, ""),
+			Role:   string(ptr.Deref(member.Role, "")),
+			Status: string(member.Status),
+			Reason: member.Reason,
+		})
+	}
+	if etcd.IsBackupStoreEnabled() {
+		etcdStatus.SnapshotLeases = []SnapshotLeaseStatus{
+			s.getSnapshotLeaseStatus(ctx, etcd.Namespace, "Full", druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta), now),
+			s.getSnapshotLeaseStatus(ctx, etcd.Namespace, "Delta", druidv1alpha1.GetDeltaSnapshotLeaseName(etcd.ObjectMeta), now),
+		}
+		etcdStatus.Compaction = getCompactionState(etcd, now)
+	}
+	etcdStatus.Resources = s.getResourceStatuses(ctx, etcd)
+	return etcdStatus
+}
+
+func toConditionStatus(condition druidv1alpha1.Condition, now time.Time) ConditionStatus {
+	return ConditionStatus{
+		Type:    string(condition.Type),
+		Status:  string(condition.Status),
+		Reason:  condition.Reason,
+		Message: condition.Message,
+		Age:     now.Sub(condition.LastTransitionTime.Time),
+	}
+}
+
+// getSnapshotLeaseStatus returns the state of the snapshot lease with the given name. etcd-backup-restore renews the
+// lease whenever it has taken a snapshot, and records the revision of the snapshot as the holder identity of the lease.
+func (s *statusCmdCtx) getSnapshotLeaseStatus(ctx context.Context, namespace, snapshotType, leaseName string, now time.Time) SnapshotLeaseStatus {
+	leaseStatus := SnapshotLeaseStatus{Type: snapshotType, Name: leaseName}
+	lease, err := s.GenericClient.Kube().CoordinationV1().Leases(namespace).Get(ctx, leaseName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			s.Logger.Warning(s.IOStreams.ErrOut, "Failed to get lease ", leaseName, ": ", err.Error())
+		}
+		return leaseStatus
+	}
+	leaseStatus.Found = true
+	leaseStatus.Revision = ptr.Deref(lease.Spec.HolderIdentity, "")
+	if lease.Spec.RenewTime != nil {
+		leaseStatus.Age = ptr.To(now.Sub(lease.Spec.RenewTime.Time))
+	}
+	return leaseStatus
+}
+
+func getCompactionState(etcd *druidv1alpha1.Etcd, now time.Time) *CompactionState {
+	compactionState := &CompactionState{}
+	for _, condition := range etcd.Status.Conditions {
+		if condition.Type == druidv1alpha1.ConditionTypeLastSnapshotCompactionSucceeded {
+			compactionState.Condition = ptr.To(toConditionStatus(condition, now))
+		}
+	}
+	if etcd.Status.Compaction != nil && len(etcd.Status.Compaction.History) > 0 {
+		compactionState.LastRun = &etcd.Status.Compaction.History[0]
+	}
+	if compactionState.Condition == nil && compactionState.LastRun == nil {
+		return nil
+	}
+	return compactionState
+}
+
+// getResourceStatuses returns the state of the main resources which are managed by etcd-druid for the given Etcd.
+func (s *statusCmdCtx) getResourceStatuses(ctx context.Context, etcd *druidv1alpha1.Etcd) []ResourceStatus {
+	kube := s.GenericClient.Kube()
+	getOptions := metav1.GetOptions{}
+	resources := make([]ResourceStatus, 0)
+
+	stsName := druidv1alpha1.GetStatefulSetName(etcd.ObjectMeta)
+	sts, err := kube.AppsV1().StatefulSets(etcd.Namespace).Get(ctx, stsName, getOptions)
+	resources = append(resources, ResourceStatus{Kind: "StatefulSet", Name: stsName, Status: s.resourceStatus(err, "StatefulSet", stsName, func() string {
+		return fmt.Sprintf("%d/%d ready, %d updated", sts.Status.ReadyReplicas, ptr.Deref(sts.Spec.Replicas, 0), sts.Status.UpdatedReplicas)
+	})})
+
+	for _, podName := range druidv1alpha1.GetAllPodNames(etcd.ObjectMeta, etcd.Spec.Replicas) {
+		pod, err := kube.CoreV1().Pods(etcd.Namespace).Get(ctx, podName, getOptions)
+		resources = append(resources, ResourceStatus{Kind: "Pod", Name: podName, Status: s.resourceStatus(err, "Pod", podName, func() string {
+			return getPodStatus(pod)
+		})})
+	}
+
+	for _, svcName := range []string{druidv1alpha1.GetClientServiceName(etcd.ObjectMeta), druidv1alpha1.GetPeerServiceName(etcd.ObjectMeta)} {
+		_, err := kube.CoreV1().Services(etcd.Namespace).Get(ctx, svcName, getOptions)
+		resources = append(resources, ResourceStatus{Kind: "Service", Name: svcName, Status: s.resourceStatus(err, "Service", svcName, func() string { return resourceStatusPresent })})
+	}
+
+	cmName := druidv1alpha1.GetConfigMapName(etcd.ObjectMeta)
+	_, err = kube.CoreV1().ConfigMaps(etcd.Namespace).Get(ctx, cmName, getOptions)
+	resources = append(resources, ResourceStatus{Kind: "ConfigMap", Name: cmName, Status: s.resourceStatus(err, "ConfigMap", cmName, func() string { return resourceStatusPresent })})
+
+	pdbName := druidv1alpha1.GetPodDisruptionBudgetName(etcd.ObjectMeta)
+	pdb, err := kube.PolicyV1().PodDisruptionBudgets(etcd.Namespace).Get(ctx, pdbName, getOptions)
+	resources = append(resources, ResourceStatus{Kind: "PodDisruptionBudget", Name: pdbName, Status: s.resourceStatus(err, "PodDisruptionBudget", pdbName, func() string {
+		return fmt.Sprintf("%d disruptions allowed", pdb.Status.DisruptionsAllowed)
+	})})
+
+	return resources
+}
+
+// resourceStatus returns the status of a resource based on the error of getting it, or computes it with statusFn if
+// the resource has been found.
+func (s *statusCmdCtx) resourceStatus(err error, kind, name string, statusFn func() string) string {
+	if err == nil {
+		return statusFn()
+	}
+	if apierrors.IsNotFound(err) {
+		return resourceStatusNotFound
+	}
+	s.Logger.Warning(s.IOStreams.ErrOut, "Failed to get ", kind, " ", name, ": ", err.Error())
+	return "Unknown"
+}
+
+func getPodStatus(pod *corev1.Pod) string {
+	if pod.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+	ready := false
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			ready = condition.Status == corev1.ConditionTrue
+		}
+	}
+	var restarts int32
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		restarts += containerStatus.RestartCount
+	}
+	readiness := "not ready"
+	if ready {
+		readiness = "ready"
+	}
+	return fmt.Sprintf("%s, %s, %d restarts", pod.Status.Phase, readiness, restarts)
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+)
+
+// ConditionStatus captures a single condition of an Etcd.
+type ConditionStatus struct {
+	Type    string        `json:"type" yaml:"type"`
+	Status  string        `json:"status" yaml:"status"`
+	Reason  string        `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Message string        `json:"message,omitempty" yaml:"message,omitempty"`
+	Age     time.Duration `json:"age" yaml:"age"`
+}
+
+// MemberStatus captures the status of a single etcd member.
+type MemberStatus struct {
+	Name   string `json:"name" yaml:"name"`
+	ID     string `json:"id,omitempty" yaml:"id,omitempty"`
+	Role   string `json:"role,omitempty" yaml:"role,omitempty"`
+	Status string `json:"status" yaml:"status"`
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+// SnapshotLeaseStatus captures the state of a full or delta snapshot lease.
+type SnapshotLeaseStatus struct {
+	Type  string `json:"type" yaml:"type"`
+	Name  string `json:"name" yaml:"name"`
+	Found bool   `json:"found" yaml:"found"`
+	// Revision is the etcd revision of the latest snapshot as recorded in the holder identity of the lease.
+	Revision string `json:"revision,omitempty" yaml:"revision,omitempty"`
+	// Age is the time since the lease has last been renewed, i.e. since the latest snapshot has been taken.
+	Age *time.Duration `json:"age,omitempty" yaml:"age,omitempty"`
+}
+
+// CompactionState captures the state of snapshot compaction of an Etcd.
+type CompactionState struct {
+	Condition *ConditionStatus                `json:"condition,omitempty" yaml:"condition,omitempty"`
+	LastRun   *druidv1alpha1.CompactionJobRun `json:"lastRun,omitempty" yaml:"lastRun,omitempty"`
+}
+
+// ResourceStatus captures the state of a single resource managed for an Etcd.
+type ResourceStatus struct {
+	Kind   string `json:"kind" yaml:"kind"`
+	Name   string `json:"name" yaml:"name"`
+	Status string `json:"status" yaml:"status"`
+}
+
+// EtcdStatus is the consolidated health view of a single Etcd.
+type EtcdStatus struct {
+	Etcd           cmdutils.EtcdRef              `json:"etcd" yaml:"etcd"`
+	Ready          bool                          `json:"ready" yaml:"ready"`
+	Replicas       int32                         `json:"replicas" yaml:"replicas"`
+	ReadyReplicas  int32                         `json:"readyReplicas" yaml:"readyReplicas"`
+	Conditions     []ConditionStatus             `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	Members        []MemberStatus                `json:"members,omitempty" yaml:"members,omitempty"`
+	SnapshotLeases []SnapshotLeaseStatus         `json:"snapshotLeases,omitempty" yaml:"snapshotLeases,omitempty"`
+	LastOperation  *druidapicommon.LastOperation `json:"lastOperation,omitempty" yaml:"lastOperation,omitempty"`
+	LastErrors     []druidapicommon.LastError    `json:"lastErrors,omitempty" yaml:"lastErrors,omitempty"`
+	Compaction     *CompactionState              `json:"compaction,omitempty" yaml:"compaction,omitempty"`
+	Resources      []ResourceStatus              `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+// Result is the top-level aggregation of the status of Etcds.
+type Result struct {
+	Etcds []EtcdStatus `json:"etcds" yaml:"etcds"`
+	Kind  string       `json:"kind" yaml:"kind"`
+}
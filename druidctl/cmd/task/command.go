@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultWaitTimeout = 15 * time.Minute
+	defaultTTL         = time.Hour
+)
+
+var (
+	createSnapshotExample = `
+# Take an on-demand full snapshot of an etcd resource in the default namespace
+kubectl druid task create snapshot my-etcd --type full
+
+# Take a final full snapshot of an etcd resource and wait until it has been taken
+kubectl druid task create snapshot test/my-etcd --type full --final --wait
+
+# Take a delta snapshot, wait up to 2 minutes and fail the CI job if it has not succeeded
+kubectl druid task create snapshot test/my-etcd --type delta --wait --timeout 2m || exit 1
+`
+	listExample = `
+# List all EtcdOpsTasks in the default namespace
+kubectl druid task list
+
+# List the EtcdOpsTasks of an etcd resource in a specific namespace
+kubectl druid task list my-etcd -n test
+
+# List all EtcdOpsTasks across all namespaces, sorted by state
+kubectl druid task list -A --sort-by=STATE
+`
+	describeExample = `
+# Describe an EtcdOpsTask in the default namespace
+kubectl druid task describe my-etcd-full-snapshot-x7k2p
+
+# Describe an EtcdOpsTask in a specific namespace as YAML
+kubectl druid task describe test/my-etcd-full-snapshot-x7k2p -o yaml
+`
+	watchExample = `
+# Follow an EtcdOpsTask until it has completed
+kubectl druid task watch test/my-etcd-full-snapshot-x7k2p
+
+# Follow an EtcdOpsTask for at most 5 minutes
+kubectl druid task watch test/my-etcd-full-snapshot-x7k2p --timeout 5m
+`
+)
+
+const exitCodesDescription = `
+Exit codes:
+  0  all tasks have succeeded
+  1  an error occurred
+  2  a task has failed or has been rejected
+  3  timed out waiting for the tasks to complete`
+
+// NewTaskCommand creates the 'task' command with nested subcommands
+// Structure:
+//   - `kubectl druid task create snapshot <etcd-resources>` - create on-demand snapshot tasks
+//   - `kubectl druid task list [etcd-resources]` - list tasks
+//   - `kubectl druid task describe <tasks>` - show the details of tasks
+//   - `kubectl druid task watch <tasks>` - follow tasks until they have completed
+func NewTaskCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	taskCmd := &cobra.Command{
+		Use:   "task",
+		Short: "Create, watch and inspect EtcdOpsTasks",
+		Long: `Create, watch and inspect EtcdOpsTasks.
+Use subcommands 'create', 'list', 'describe' and 'watch' to manage operational tasks on etcd clusters.`,
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create EtcdOpsTasks",
+		Long:  "Create EtcdOpsTasks. Use subcommand 'snapshot' to take on-demand snapshots.",
+	}
+	createCmd.AddCommand(NewCreateSnapshotCommand(cmdCtx))
+
+	taskCmd.AddCommand(createCmd)
+	taskCmd.AddCommand(NewListCommand(cmdCtx))
+	taskCmd.AddCommand(NewDescribeCommand(cmdCtx))
+	taskCmd.AddCommand(NewWatchCommand(cmdCtx))
+
+	return taskCmd
+}
+
+// NewCreateSnapshotCommand creates the 'task create snapshot' subcommand
+func NewCreateSnapshotCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newCreateSnapshotOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:   "snapshot <etcd-resource-name> --type=<full|delta> [flags]",
+		Short: "Take on-demand snapshots of etcd clusters",
+		Long: `Take on-demand snapshots of etcd clusters by creating an EtcdOpsTask for every selected etcd resource.
+With --wait the command follows the tasks through Pending and InProgress until they have completed.
+` + exitCodesDescription,
+		Example: createSnapshotExample,
+		Args:    cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// If no args and no -A flag, show help
+			if len(opts.ResourceArgs) == 0 && !opts.AllNamespaces {
+				return cmd.Help()
+			}
+			createSnapshotCmdCtx := &createSnapshotCmdCtx{
+				createSnapshotOptions: opts,
+				taskRuntime:           newTaskRuntime(cmdCtx.Runtime),
+			}
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, createSnapshotCmdCtx, "Creating snapshot tasks failed")
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.snapshotType, "type", string(druidv1alpha1.OnDemandSnapshotTypeFull), "Type of the snapshot. One of: full, delta")
+	cmd.Flags().BoolVar(&opts.isFinal, "final", false, "Mark the full snapshot as final")
+	cmd.Flags().DurationVar(&opts.ttl, "ttl", defaultTTL, "Time after which a completed task is garbage collected")
+	cmd.Flags().BoolVarP(&opts.wait, "wait", "w", false, "Wait until the tasks have completed")
+	cmd.Flags().DurationVarP(&opts.timeout, "timeout", "t", defaultWaitTimeout, "Timeout for waiting (only valid with --wait)")
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+// NewListCommand creates the 'task list' subcommand
+func NewListCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newListOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:     "list [etcd-resource-name] [flags]",
+		Aliases: []string{"ls"},
+		Short:   "List EtcdOpsTasks",
+		Long:    "List EtcdOpsTasks. If etcd resources are given, only the tasks for these etcd resources are listed.",
+		Example: listExample,
+		Args:    cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			listCmdCtx := &listCmdCtx{
+				listOptions: opts,
+				taskRuntime: newTaskRuntime(cmdCtx.Runtime),
+			}
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, listCmdCtx, "Listing tasks failed")
+		},
+	}
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+// NewDescribeCommand creates the 'task describe' subcommand
+func NewDescribeCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newDescribeOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:     "describe <task-name> [flags]",
+		Short:   "Show the details of EtcdOpsTasks",
+		Long:    "Show the details of EtcdOpsTasks including their configuration, state, last operation and last errors.",
+		Example: describeExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			describeCmdCtx := &describeCmdCtx{
+				describeOptions: opts,
+				taskRuntime:     newTaskRuntime(cmdCtx.Runtime),
+			}
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, describeCmdCtx, "Describing tasks failed")
+		},
+	}
+
+	opts.PrintFlags.AddFlags(cmd)
+
+	return cmd
+}
+
+// NewWatchCommand creates the 'task watch' subcommand
+func NewWatchCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	opts := newWatchOptions(cmdCtx.Options)
+
+	cmd := &cobra.Command{
+		Use:   "watch <task-name> [flags]",
+		Short: "Follow EtcdOpsTasks until they have completed",
+		Long: `Follow EtcdOpsTasks through Pending and InProgress until they have reached a terminal state.
+` + exitCodesDescription,
+		Example: watchExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			watchCmdCtx := &watchCmdCtx{
+				watchOptions: opts,
+				taskRuntime:  newTaskRuntime(cmdCtx.Runtime),
+			}
+			return cmdutils.RunSubcommand(cmd, cmdCtx.Runtime, watchCmdCtx, "Watching tasks failed")
+		},
+	}
+
+	cmd.Flags().DurationVarP(&opts.timeout, "timeout", "t", 0, "Timeout for watching. Zero means no timeout")
+
+	return cmd
+}
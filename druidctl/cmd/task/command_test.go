@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	fake "github.com/gardener/etcd-druid/druidctl/internal/client/fake"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func newTestTask(name, etcdName string, state druidv1alpha1.TaskState) *druidv1alpha1.EtcdOpsTask {
+	return &druidv1alpha1.EtcdOpsTask{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Minute))},
+		Spec: druidv1alpha1.EtcdOpsTaskSpec{
+			Config:   druidv1alpha1.EtcdOpsTaskConfig{OnDemandSnapshot: &druidv1alpha1.OnDemandSnapshotConfig{Type: druidv1alpha1.OnDemandSnapshotTypeFull}},
+			EtcdName: ptr.To(etcdName),
+		},
+		Status: druidv1alpha1.EtcdOpsTaskStatus{State: ptr.To(state)},
+	}
+}
+
+func TestCreateSnapshotCommand(t *testing.T) {
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").WithBackupStore("Local").Build()
+	cmdCtx, buf, err := fake.NewTestHelper().WithEtcdObjects([]runtime.Object{etcd}).RunCommand(t, NewCreateSnapshotCommand,
+		[]string{"test-etcd"}, map[string]string{"type": "full", "final": "true", "output": "json"})
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+
+	var result Result
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, buf.String())
+	}
+	if result.Kind != resultKind || len(result.Tasks) != 1 {
+		t.Fatalf("Expected one task in result, got %+v", result)
+	}
+	if result.Tasks[0].Etcd != "test-etcd" || result.Tasks[0].Type != taskTypeOnDemandSnapshot || result.Tasks[0].Config != "full,final" {
+		t.Errorf("Unexpected task summary: %+v", result.Tasks[0])
+	}
+
+	etcdClient, err := cmdCtx.Runtime.Clients.EtcdClient()
+	if err != nil {
+		t.Fatalf("Failed to create etcd client: %v", err)
+	}
+	task, err := etcdClient.GetEtcdOpsTask(context.TODO(), "default", result.Tasks[0].Name)
+	if err != nil {
+		t.Fatalf("Expected EtcdOpsTask to have been created: %v", err)
+	}
+	if !strings.HasPrefix(task.Name, "test-etcd-full-snapshot-") {
+		t.Errorf("Unexpected task name %q", task.Name)
+	}
+	snapshotConfig := task.Spec.Config.OnDemandSnapshot
+	if snapshotConfig == nil || snapshotConfig.Type != druidv1alpha1.OnDemandSnapshotTypeFull || !ptr.Deref(snapshotConfig.IsFinal, false) {
+		t.Errorf("Unexpected snapshot config: %+v", snapshotConfig)
+	}
+	if ptr.Deref(task.Spec.TTLSecondsAfterFinished, 0) != int32(defaultTTL.Seconds()) {
+		t.Errorf("Expected TTL of %s, got %d seconds", defaultTTL, ptr.Deref(task.Spec.TTLSecondsAfterFinished, 0))
+	}
+}
+
+func TestCreateSnapshotCommandValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		etcd          *druidv1alpha1.Etcd
+		flags         map[string]string
+		expectedError string
+	}{
+		{"unknown snapshot type", fake.NewEtcdBuilder("default", "test-etcd").WithBackupStore("Local").Build(), map[string]string{"type": "incremental"}, "unsupported snapshot type"},
+		{"final delta snapshot", fake.NewEtcdBuilder("default", "test-etcd").WithBackupStore("Local").Build(), map[string]string{"type": "delta", "final": "true"}, "--final can only be specified for full snapshots"},
+		{"timeout without wait", fake.NewEtcdBuilder("default", "test-etcd").WithBackupStore("Local").Build(), map[string]string{"timeout": "1m"}, "cannot specify --timeout/-t without --wait/-w"},
+		{"etcd without backup store", fake.NewEtcdBuilder("default", "test-etcd").Build(), map[string]string{}, "has no backup store configured"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := fake.NewTestHelper().WithEtcdObjects([]runtime.Object{tt.etcd}).RunCommand(t, NewCreateSnapshotCommand, []string{"test-etcd"}, tt.flags)
+			if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+				t.Errorf("Expected error containing %q, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestCreateSnapshotCommandWaitTimeout(t *testing.T) {
+	// the fake client has no controller acting upon the task, so it never completes
+	etcd := fake.NewEtcdBuilder("default", "test-etcd").WithBackupStore("Local").Build()
+	_, _, err := fake.NewTestHelper().WithEtcdObjects([]runtime.Object{etcd}).RunCommand(t, NewCreateSnapshotCommand,
+		[]string{"test-etcd"}, map[string]string{"wait": "true", "timeout": "100ms"})
+	if err == nil {
+		t.Fatal("Expected command to time out")
+	}
+	if exitCode := cmdutils.ExitCode(err); exitCode != cmdutils.ExitCodeTimeout {
+		t.Errorf("Expected exit code %d, got %d: %v", cmdutils.ExitCodeTimeout, exitCode, err)
+	}
+}
+
+func TestWatchCommand(t *testing.T) {
+	failedTask := newTestTask("failed-task", "test-etcd", druidv1alpha1.TaskStateFailed)
+	failedTask.Status.LastErrors = []druidapicommon.LastError{{Code: "ERR_SNAPSHOT", Description: "upload failed"}}
+
+	tests := []struct {
+		name             string
+		args             []string
+		flags            map[string]string
+		expectedExitCode int
+	}{
+		{"succeeded task", []string{"succeeded-task"}, nil, 0},
+		{"failed task", []string{"succeeded-task", "failed-task"}, nil, cmdutils.ExitCodeTaskFailed},
+		{"rejected task", []string{"rejected-task"}, nil, cmdutils.ExitCodeTaskFailed},
+		{"pending task", []string{"pending-task"}, map[string]string{"timeout": "100ms"}, cmdutils.ExitCodeTimeout},
+		{"unknown task", []string{"unknown-task"}, nil, cmdutils.ExitCodeError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := []runtime.Object{
+				newTestTask("succeeded-task", "test-etcd", druidv1alpha1.TaskStateSucceeded),
+				newTestTask("rejected-task", "test-etcd", druidv1alpha1.TaskStateRejected),
+				newTestTask("pending-task", "test-etcd", druidv1alpha1.TaskStatePending),
+				failedTask,
+			}
+			_, _, err := fake.NewTestHelper().WithEtcdObjects(objects).RunCommand(t, NewWatchCommand, tt.args, tt.flags)
+			if exitCode := cmdutils.ExitCode(err); exitCode != tt.expectedExitCode {
+				t.Errorf("Expected exit code %d, got %d: %v", tt.expectedExitCode, exitCode, err)
+			}
+		})
+	}
+}
+
+func TestListCommand(t *testing.T) {
+	objects := []runtime.Object{
+		newTestTask("task-a", "etcd-main", druidv1alpha1.TaskStateSucceeded),
+		newTestTask("task-b", "etcd-events", druidv1alpha1.TaskStateInProgress),
+		newTestTask("task-c", "etcd-main", druidv1alpha1.TaskStatePending),
+	}
+
+	_, buf, err := fake.NewTestHelper().WithEtcdObjects(objects).RunCommand(t, NewListCommand, []string{"etcd-main"}, map[string]string{"output": "json"})
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	var result Result
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, buf.String())
+	}
+	if len(result.Tasks) != 2 || result.Tasks[0].Name != "task-a" || result.Tasks[1].Name != "task-c" {
+		t.Errorf("Expected tasks task-a and task-c of etcd-main, got %+v", result.Tasks)
+	}
+
+	_, buf, err = fake.NewTestHelper().WithEtcdObjects(objects).RunCommand(t, NewListCommand, nil, nil)
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "NAMESPACE") || !strings.Contains(lines[2], "InProgress") {
+		t.Errorf("Expected a table with three tasks, got:\n%s", buf.String())
+	}
+}
+
+func TestDescribeCommand(t *testing.T) {
+	task := newTestTask("task-a", "etcd-main", druidv1alpha1.TaskStateFailed)
+	task.Status.LastErrors = []druidapicommon.LastError{{Code: "ERR_SNAPSHOT", Description: "upload failed", ObservedAt: metav1.Now()}}
+
+	_, buf, err := fake.NewTestHelper().WithEtcdObjects([]runtime.Object{task}).RunCommand(t, NewDescribeCommand, []string{"task-a"}, nil)
+	if err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	for _, expected := range []string{"EtcdOpsTask [default/task-a]", "etcd-main", "OnDemandSnapshot", "Failed", "ERR_SNAPSHOT", "upload failed"} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, buf.String())
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
+)
+
+func (c *createSnapshotCmdCtx) Validate() error {
+	if err := c.ValidateResourceSelection(); err != nil {
+		return err
+	}
+	switch druidv1alpha1.OnDemandSnapshotType(c.snapshotType) {
+	case druidv1alpha1.OnDemandSnapshotTypeFull, druidv1alpha1.OnDemandSnapshotTypeDelta:
+	default:
+		return fmt.Errorf("unsupported snapshot type %q, must be one of: full, delta", c.snapshotType)
+	}
+	if c.isFinal && druidv1alpha1.OnDemandSnapshotType(c.snapshotType) != druidv1alpha1.OnDemandSnapshotTypeFull {
+		return fmt.Errorf("--final can only be specified for full snapshots")
+	}
+	if c.ttl < time.Second {
+		return fmt.Errorf("--ttl must be at least 1s")
+	}
+	// timeout is only valid if wait is set
+	if !c.wait && c.timeout != defaultWaitTimeout {
+		return fmt.Errorf("cannot specify --timeout/-t without --wait/-w")
+	}
+	return nil
+}
+
+func (c *createSnapshotCmdCtx) Complete() error {
+	etcdClient, err := c.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	c.etcdClient = etcdClient
+
+	c.Printer, err = c.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("failed to create formatter: %w", err)
+	}
+
+	c.refList = c.BuildEtcdRefList()
+	return nil
+}
+
+// Execute creates an on-demand snapshot EtcdOpsTask for every selected Etcd and optionally waits for them to complete.
+func (c *createSnapshotCmdCtx) Execute(ctx context.Context) error {
+	// Prompt for confirmation when operating on all namespaces
+	if c.AllNamespaces {
+		confirmed, err := cmdutils.ConfirmAllNamespaces(c.IOStreams.Out, c.IOStreams.In, "take snapshots of")
+		if err != nil {
+			return fmt.Errorf("confirmation failed: %w", err)
+		}
+		if !confirmed {
+			c.Logger.Info(c.IOStreams.Out, "Operation cancelled by user")
+			return nil
+		}
+	}
+
+	etcdList, err := cmdutils.GetEtcdList(ctx, c.etcdClient, c.refList, c.AllNamespaces, c.GetNamespace(), c.LabelSelector)
+	if err != nil {
+		return err
+	}
+	if len(etcdList.Items) == 0 {
+		c.Logger.Info(c.IOStreams.ErrOut, "No Etcd resources found")
+		return nil
+	}
+	// Check all Etcds upfront so that no task is created if any of them cannot be snapshotted
+	for _, etcd := range etcdList.Items {
+		if !etcd.IsBackupStoreEnabled() {
+			return fmt.Errorf("etcd %s/%s has no backup store configured, snapshots cannot be taken", etcd.Namespace, etcd.Name)
+		}
+	}
+
+	tasks := make([]druidv1alpha1.EtcdOpsTask, 0, len(etcdList.Items))
+	taskRefs := make([]types.NamespacedName, 0, len(etcdList.Items))
+	for _, etcd := range etcdList.Items {
+		task, err := c.etcdClient.CreateEtcdOpsTask(ctx, c.newSnapshotTask(&etcd))
+		if err != nil {
+			return fmt.Errorf("failed to create snapshot task for etcd %s/%s: %w", etcd.Namespace, etcd.Name, err)
+		}
+		c.Logger.Success(c.IOStreams.ErrOut, fmt.Sprintf("Created EtcdOpsTask %s/%s to take a %s snapshot of etcd %s", task.Namespace, task.Name, c.snapshotType, etcd.Name))
+		tasks = append(tasks, *task)
+		taskRefs = append(taskRefs, types.NamespacedName{Namespace: task.Namespace, Name: task.Name})
+	}
+
+	if c.wait {
+		var waitErr error
+		tasks, waitErr = c.waitForTasks(ctx, taskRefs, c.timeout)
+		if tasks != nil {
+			if err = c.printResult(tasks); err != nil {
+				return err
+			}
+		}
+		return waitErr
+	}
+	return c.printResult(tasks)
+}
+
+func (c *createSnapshotCmdCtx) newSnapshotTask(etcd *druidv1alpha1.Etcd) *druidv1alpha1.EtcdOpsTask {
+	snapshotConfig := &druidv1alpha1.OnDemandSnapshotConfig{
+		Type: druidv1alpha1.OnDemandSnapshotType(c.snapshotType),
+	}
+	if c.isFinal {
+		snapshotConfig.IsFinal = ptr.To(true)
+	}
+	return &druidv1alpha1.EtcdOpsTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-snapshot-%s", etcd.Name, c.snapshotType, utilrand.String(5)),
+			Namespace: etcd.Namespace,
+		},
+		Spec: druidv1alpha1.EtcdOpsTaskSpec{
+			Config:                  druidv1alpha1.EtcdOpsTaskConfig{OnDemandSnapshot: snapshotConfig},
+			TTLSecondsAfterFinished: ptr.To(int32(c.ttl.Seconds())),
+			EtcdName:                ptr.To(etcd.Name),
+		},
+	}
+}
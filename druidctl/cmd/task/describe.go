@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"context"
+	"fmt"
+)
+
+func (d *describeCmdCtx) Validate() error {
+	return d.ValidateResourceSelection()
+}
+
+func (d *describeCmdCtx) Complete() error {
+	etcdClient, err := d.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	d.etcdClient = etcdClient
+
+	d.Printer, err = d.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("failed to create formatter: %w", err)
+	}
+
+	d.refList = d.BuildEtcdRefList()
+	return nil
+}
+
+// Execute prints the details of the selected EtcdOpsTasks.
+func (d *describeCmdCtx) Execute(ctx context.Context) error {
+	tasks, err := d.getTasks(ctx, d.refList)
+	if err != nil {
+		return err
+	}
+	if d.PrintFlags.IsTableOutput() {
+		d.renderTaskDetails(newResult(tasks).Tasks)
+		return nil
+	}
+	return d.printResult(tasks)
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"context"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func (l *listCmdCtx) Validate() error {
+	return l.ValidateResourceSelection()
+}
+
+func (l *listCmdCtx) Complete() error {
+	etcdClient, err := l.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	l.etcdClient = etcdClient
+
+	l.Printer, err = l.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("failed to create formatter: %w", err)
+	}
+
+	// Build etcd reference list to filter the tasks by
+	l.refList = l.BuildEtcdRefList()
+	return nil
+}
+
+// Execute lists the EtcdOpsTasks, optionally only those for the selected etcd resources.
+func (l *listCmdCtx) Execute(ctx context.Context) error {
+	var tasks []druidv1alpha1.EtcdOpsTask
+	if len(l.refList) == 0 {
+		namespace := l.GetNamespace()
+		if l.AllNamespaces {
+			namespace = ""
+		}
+		taskList, err := l.etcdClient.ListEtcdOpsTasks(ctx, namespace, l.LabelSelector)
+		if err != nil {
+			return fmt.Errorf("unable to list EtcdOpsTasks: %w", err)
+		}
+		tasks = taskList.Items
+	} else {
+		// List the tasks of every namespace referenced by the etcd arguments once
+		selectedEtcds := make(map[types.NamespacedName]bool, len(l.refList))
+		namespaces := make(map[string]bool)
+		for _, ref := range l.refList {
+			selectedEtcds[ref] = true
+			namespaces[ref.Namespace] = true
+		}
+		for namespace := range namespaces {
+			taskList, err := l.etcdClient.ListEtcdOpsTasks(ctx, namespace, l.LabelSelector)
+			if err != nil {
+				return fmt.Errorf("unable to list EtcdOpsTasks in namespace %q: %w", namespace, err)
+			}
+			for _, task := range taskList.Items {
+				if selectedEtcds[types.NamespacedName{Namespace: task.Namespace, Name: ptr.Deref(task.Spec.EtcdName, "")}] {
+					tasks = append(tasks, task)
+				}
+			}
+		}
+	}
+
+	if len(tasks) == 0 && l.PrintFlags.IsTableOutput() {
+		l.Logger.Info(l.IOStreams.Out, "No EtcdOpsTasks found")
+		return nil
+	}
+	return l.printResult(tasks)
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// taskRuntime holds runtime state shared by all task subcommands
+type taskRuntime struct {
+	*cmdutils.RuntimeEnv
+	refList    []types.NamespacedName
+	etcdClient client.EtcdClientInterface
+	Printer    printer.Printer
+}
+
+func newTaskRuntime(runtime *cmdutils.RuntimeEnv) *taskRuntime {
+	return &taskRuntime{
+		RuntimeEnv: runtime,
+	}
+}
+
+// createSnapshotOptions holds command-specific options for the task create snapshot command
+type createSnapshotOptions struct {
+	*cmdutils.GlobalOptions
+	PrintFlags   *printer.PrintFlags
+	snapshotType string
+	isFinal      bool
+	ttl          time.Duration
+	wait         bool
+	timeout      time.Duration
+}
+
+// createSnapshotCmdCtx composes options and runtime for the task create snapshot command
+type createSnapshotCmdCtx struct {
+	*createSnapshotOptions
+	*taskRuntime
+}
+
+func newCreateSnapshotOptions(options *cmdutils.GlobalOptions) *createSnapshotOptions {
+	return &createSnapshotOptions{
+		GlobalOptions: options,
+		PrintFlags:    printer.NewPrintFlags(),
+	}
+}
+
+// listOptions holds command-specific options for the task list command
+type listOptions struct {
+	*cmdutils.GlobalOptions
+	PrintFlags *printer.PrintFlags
+}
+
+// listCmdCtx composes options and runtime for the task list command
+type listCmdCtx struct {
+	*listOptions
+	*taskRuntime
+}
+
+func newListOptions(options *cmdutils.GlobalOptions) *listOptions {
+	return &listOptions{
+		GlobalOptions: options,
+		PrintFlags:    printer.NewPrintFlags(),
+	}
+}
+
+// describeOptions holds command-specific options for the task describe command
+type describeOptions struct {
+	*cmdutils.GlobalOptions
+	PrintFlags *printer.PrintFlags
+}
+
+// describeCmdCtx composes options and runtime for the task describe command
+type describeCmdCtx struct {
+	*describeOptions
+	*taskRuntime
+}
+
+func newDescribeOptions(options *cmdutils.GlobalOptions) *describeOptions {
+	return &describeOptions{
+		GlobalOptions: options,
+		PrintFlags:    printer.NewPrintFlags(),
+	}
+}
+
+// watchOptions holds command-specific options for the task watch command
+type watchOptions struct {
+	*cmdutils.GlobalOptions
+	timeout time.Duration
+}
+
+// watchCmdCtx composes options and runtime for the task watch command
+type watchCmdCtx struct {
+	*watchOptions
+	*taskRuntime
+}
+
+func newWatchOptions(options *cmdutils.GlobalOptions) *watchOptions {
+	return &watchOptions{
+		GlobalOptions: options,
+	}
+}
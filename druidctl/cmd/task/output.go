@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const noneValue = "<none>"
+
+// ToTable converts the result into a table with one row per EtcdOpsTask.
+func (r Result) ToTable() *printer.Table {
+	table := &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "NAMESPACE"},
+			{Name: "NAME"},
+			{Name: "ETCD"},
+			{Name: "TYPE"},
+			{Name: "CONFIG"},
+			{Name: "STATE"},
+			{Name: "AGE"},
+			{Name: "STARTED", Wide: true},
+			{Name: "LAST OPERATION", Wide: true},
+			{Name: "ERRORS", Wide: true},
+		},
+	}
+	for _, task := range r.Tasks {
+		lastOperation := noneValue
+		if task.LastOperation != nil {
+			lastOperation = fmt.Sprintf("%s/%s", task.LastOperation.Type, task.LastOperation.State)
+		}
+		table.Rows = append(table.Rows, printer.TableRow{
+			Cells: []string{
+				task.Namespace,
+				task.Name,
+				task.Etcd,
+				valueOrNone(task.Type),
+				valueOrNone(task.Config),
+				valueOrNone(task.State),
+				cmdutils.ShortDuration(task.Age),
+				timeSince(task.StartedAt),
+				lastOperation,
+				fmt.Sprintf("%d", len(task.LastErrors)),
+			},
+			Object: task,
+		})
+	}
+	return table
+}
+
+// renderTaskDetails prints the details of every task as a list of fields.
+func (d *describeCmdCtx) renderTaskDetails(tasks []TaskSummary) {
+	for _, task := range tasks {
+		d.Logger.RawHeader(d.IOStreams.Out, fmt.Sprintf("EtcdOpsTask [%s/%s]", task.Namespace, task.Name))
+		w := tabwriter.NewWriter(d.IOStreams.Out, 0, 8, 2, ' ', 0)
+		fmt.Fprintf(w, "Etcd:\t%s\n", task.Etcd)
+		fmt.Fprintf(w, "Type:\t%s\n", valueOrNone(task.Type))
+		fmt.Fprintf(w, "Config:\t%s\n", valueOrNone(task.Config))
+		fmt.Fprintf(w, "State:\t%s\n", valueOrNone(task.State))
+		fmt.Fprintf(w, "Age:\t%s\n", cmdutils.ShortDuration(task.Age))
+		fmt.Fprintf(w, "Started:\t%s\n", timeSince(task.StartedAt))
+		fmt.Fprintf(w, "Last Transition:\t%s\n", timeSince(task.LastTransitionTime))
+		if lastOp := task.LastOperation; lastOp != nil {
+			fmt.Fprintf(w, "Last Operation:\t%s %s (%s ago): %s\n", lastOp.Type, lastOp.State,
+				cmdutils.ShortDuration(time.Since(lastOp.LastUpdateTime.Time)), lastOp.Description)
+		} else {
+			fmt.Fprintf(w, "Last Operation:\t%s\n", noneValue)
+		}
+		if len(task.LastErrors) == 0 {
+			fmt.Fprintf(w, "Last Errors:\t%s\n", noneValue)
+		} else {
+			fmt.Fprintln(w, "Last Errors:")
+			for _, lastErr := range task.LastErrors {
+				fmt.Fprintf(w, "  %s\t(%s ago): %s\n", lastErr.Code, cmdutils.ShortDuration(time.Since(lastErr.ObservedAt.Time)), lastErr.Description)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			d.Logger.Warning(d.IOStreams.ErrOut, "Failed writing task details: ", err.Error())
+		}
+	}
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return noneValue
+	}
+	return value
+}
+
+// timeSince renders the time elapsed since t, or <none> if t is not set.
+func timeSince(t *metav1.Time) string {
+	if t == nil {
+		return noneValue
+	}
+	return cmdutils.ShortDuration(time.Since(t.Time)) + " ago"
+}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	taskTypeOnDemandSnapshot = "OnDemandSnapshot"
+	resultKind               = "EtcdOpsTaskList"
+)
+
+// pollInterval is the interval in which the state of awaited tasks is checked.
+var pollInterval = 2 * time.Second
+
+// newResult converts the given tasks into a Result sorted by namespace/name.
+func newResult(tasks []druidv1alpha1.EtcdOpsTask) Result {
+	result := Result{
+		Tasks: make([]TaskSummary, 0, len(tasks)),
+		Kind:  resultKind,
+	}
+	now := time.Now()
+	for _, task := range tasks {
+		result.Tasks = append(result.Tasks, toTaskSummary(&task, now))
+	}
+	sort.Slice(result.Tasks, func(i, j int) bool {
+		if result.Tasks[i].Namespace == result.Tasks[j].Namespace {
+			return result.Tasks[i].Name < result.Tasks[j].Name
+		}
+		return result.Tasks[i].Namespace < result.Tasks[j].Namespace
+	})
+	return result
+}
+
+func toTaskSummary(task *druidv1alpha1.EtcdOpsTask, now time.Time) TaskSummary {
+	summary := TaskSummary{
+		Name:               task.Name,
+		Namespace:          task.Namespace,
+		Etcd:               ptr.Deref(task.Spec.EtcdName, ""),
+		State:              string(ptr.Deref(task.Status.State, "")),
+		Age:                now.Sub(task.CreationTimestamp.Time),
+		StartedAt:          task.Status.StartedAt,
+		LastTransitionTime: task.Status.LastTransitionTime,
+		LastOperation:      task.Status.LastOperation,
+		LastErrors:         task.Status.LastErrors,
+	}
+	if snapshotConfig := task.Spec.Config.OnDemandSnapshot; snapshotConfig != nil {
+		summary.Type = taskTypeOnDemandSnapshot
+		summary.Config = string(snapshotConfig.Type)
+		if ptr.Deref(snapshotConfig.IsFinal, false) {
+			summary.Config += ",final"
+		}
+	}
+	return summary
+}
+
+// printResult prints the given tasks with the configured printer.
+func (t *taskRuntime) printResult(tasks []druidv1alpha1.EtcdOpsTask) error {
+	outputData, err := t.Printer.Print(newResult(tasks))
+	if err != nil {
+		return fmt.Errorf("failed to marshal result to desired format: %w", err)
+	}
+	fmt.Fprintf(t.IOStreams.Out, "%s\n", string(outputData))
+	return nil
+}
+
+// getTasks fetches the EtcdOpsTasks with the given references.
+func (t *taskRuntime) getTasks(ctx context.Context, refs []types.NamespacedName) ([]druidv1alpha1.EtcdOpsTask, error) {
+	tasks := make([]druidv1alpha1.EtcdOpsTask, 0, len(refs))
+	for _, ref := range refs {
+		task, err := t.etcdClient.GetEtcdOpsTask(ctx, ref.Namespace, ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get EtcdOpsTask %s: %w", ref, err)
+		}
+		tasks = append(tasks, *task)
+	}
+	return tasks, nil
+}
+
+// waitForTasks follows the EtcdOpsTasks with the given references until all of them have completed, and reports every
+// state transition. A zero timeout waits indefinitely. The returned error carries an exit code which tells apart
+// failed or rejected tasks from a timeout.
+func (t *taskRuntime) waitForTasks(ctx context.Context, refs []types.NamespacedName, timeout time.Duration) ([]druidv1alpha1.EtcdOpsTask, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	lastStates := make(map[types.NamespacedName]druidv1alpha1.TaskState, len(refs))
+	completedTasks := make(map[types.NamespacedName]druidv1alpha1.EtcdOpsTask, len(refs))
+	for {
+		for _, ref := range refs {
+			if _, ok := completedTasks[ref]; ok {
+				continue
+			}
+			task, err := t.etcdClient.GetEtcdOpsTask(ctx, ref.Namespace, ref.Name)
+			if err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+				return nil, fmt.Errorf("failed to get EtcdOpsTask %s: %w", ref, err)
+			}
+			state := ptr.Deref(task.Status.State, "")
+			if previousState, seen := lastStates[ref]; state != "" && (!seen || previousState != state) {
+				t.Logger.Progress(t.IOStreams.ErrOut, fmt.Sprintf("EtcdOpsTask %s is %s", ref, state))
+			}
+			lastStates[ref] = state
+			if task.IsCompleted() {
+				completedTasks[ref] = *task
+			}
+		}
+		if len(completedTasks) == len(refs) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, cmdutils.NewExitError(cmdutils.ExitCodeTimeout,
+					fmt.Errorf("timed out after %s waiting for EtcdOpsTasks to complete: %s", timeout, pendingTasks(refs, completedTasks)))
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	tasks := make([]druidv1alpha1.EtcdOpsTask, 0, len(refs))
+	var unsuccessful []string
+	for _, ref := range refs {
+		task := completedTasks[ref]
+		tasks = append(tasks, task)
+		if state := ptr.Deref(task.Status.State, ""); state != druidv1alpha1.TaskStateSucceeded {
+			unsuccessful = append(unsuccessful, fmt.Sprintf("%s %s%s", ref, state, lastErrorSuffix(&task)))
+		}
+	}
+	if len(unsuccessful) > 0 {
+		return tasks, cmdutils.NewExitError(cmdutils.ExitCodeTaskFailed,
+			fmt.Errorf("EtcdOpsTasks did not succeed: %s", strings.Join(unsuccessful, "; ")))
+	}
+	return tasks, nil
+}
+
+func pendingTasks(refs []types.NamespacedName, completedTasks map[types.NamespacedName]druidv1alpha1.EtcdOpsTask) string {
+	var pending []string
+	for _, ref := range refs {
+		if _, ok := completedTasks[ref]; !ok {
+			pending = append(pending, ref.String())
+		}
+	}
+	return strings.Join(pending, ", ")
+}
+
+func lastErrorSuffix(task *druidv1alpha1.EtcdOpsTask) string {
+	if len(task.Status.LastErrors) == 0 {
+		return ""
+	}
+	lastErr := task.Status.LastErrors[len(task.Status.LastErrors)-1]
+	return fmt.Sprintf(" (%s: %s)", lastErr.Code, lastErr.Description)
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TaskSummary captures the configuration and state of a single EtcdOpsTask.
+type TaskSummary struct {
+	Name      string `json:"name" yaml:"name"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	Etcd      string `json:"etcd" yaml:"etcd"`
+	// Type is the type of the task, e.g. OnDemandSnapshot.
+	Type string `json:"type" yaml:"type"`
+	// Config is a short description of the configuration of the task, e.g. the snapshot type.
+	Config             string                        `json:"config,omitempty" yaml:"config,omitempty"`
+	State              string                        `json:"state" yaml:"state"`
+	Age                time.Duration                 `json:"age" yaml:"age"`
+	StartedAt          *metav1.Time                  `json:"startedAt,omitempty" yaml:"startedAt,omitempty"`
+	LastTransitionTime *metav1.Time                  `json:"lastTransitionTime,omitempty" yaml:"lastTransitionTime,omitempty"`
+	LastOperation      *druidapicommon.LastOperation `json:"lastOperation,omitempty" yaml:"lastOperation,omitempty"`
+	LastErrors         []druidapicommon.LastError    `json:"lastErrors,omitempty" yaml:"lastErrors,omitempty"`
+}
+
+// Result is the top-level aggregation of EtcdOpsTasks.
+type Result struct {
+	Tasks []TaskSummary `json:"tasks" yaml:"tasks"`
+	Kind  string        `json:"kind" yaml:"kind"`
+}
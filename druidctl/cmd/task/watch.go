@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"context"
+	"fmt"
+)
+
+func (w *watchCmdCtx) Validate() error {
+	if err := w.ValidateResourceSelection(); err != nil {
+		return err
+	}
+	if w.timeout < 0 {
+		return fmt.Errorf("--timeout/-t must not be negative")
+	}
+	return nil
+}
+
+func (w *watchCmdCtx) Complete() error {
+	etcdClient, err := w.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	w.etcdClient = etcdClient
+	w.refList = w.BuildEtcdRefList()
+	return nil
+}
+
+// Execute follows the selected EtcdOpsTasks until all of them have completed.
+func (w *watchCmdCtx) Execute(ctx context.Context) error {
+	if _, err := w.waitForTasks(ctx, w.refList, w.timeout); err != nil {
+		return err
+	}
+	w.Logger.Success(w.IOStreams.Out, "All EtcdOpsTasks have succeeded")
+	return nil
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package top
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const defaultInterval = 2 * time.Second
+
+var (
+	example = `
+# Show a live view of all etcd resources across all namespaces
+kubectl druid top -A
+
+# Show only the etcd resources with warnings or which are critical, refreshed every 10 seconds
+kubectl druid top -A --problems-only --interval 10s
+
+# Show a live view of the etcd resources in the test namespace with a given label
+kubectl druid top -n test -l app=my-app
+`
+)
+
+// NewTopCommand creates the top command
+func NewTopCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
+	topOptions := newTopOptions(cmdCtx.Options)
+
+	topCmd := &cobra.Command{
+		Use:     "top [etcd-resource-names...] [flags]",
+		Aliases: []string{"watch"},
+		Short:   "Show a live view of the health of etcd clusters",
+		Long: `Show a live view of the health of etcd clusters, which is refreshed until interrupted.
+For each etcd resource the ready members, the leader, the age of the latest full and delta snapshots, the last operation
+and the state of snapshot compaction are shown. The etcd resources are sorted by health, so that the critical ones are
+shown first. The view is kept up to date with watches, so it also scales to many etcd resources.`,
+		Example: example,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			topCmdCtx := &topCmdCtx{
+				topOptions: topOptions,
+				topRuntime: newTopRuntime(cmdCtx.Runtime),
+			}
+			if err := topCmdCtx.validate(); err != nil {
+				if herr := cmd.Help(); herr != nil {
+					cmdCtx.Runtime.Logger.Warning(cmdCtx.Runtime.IOStreams.ErrOut, "Failed to show help: ", herr.Error())
+				}
+				return err
+			}
+
+			if err := topCmdCtx.complete(); err != nil {
+				return err
+			}
+
+			if err := topCmdCtx.execute(cmdutils.CmdContext(cmd)); err != nil {
+				cmdCtx.Runtime.Logger.Error(cmdCtx.Runtime.IOStreams.ErrOut, "Showing the live view failed", err)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	topCmd.Flags().DurationVar(&topOptions.interval, "interval", defaultInterval, "Interval in which the view is refreshed")
+	topCmd.Flags().BoolVar(&topOptions.problemsOnly, "problems-only", false, "Only show the etcd resources with warnings or which are critical")
+
+	return topCmd
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package top
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/utils/ptr"
+)
+
+// health is the overall health of an Etcd in the view, ordered by severity.
+type health int
+
+const (
+	healthOK health = iota
+	healthWarning
+	healthCritical
+)
+
+func (h health) String() string {
+	switch h {
+	case healthCritical:
+		return "Critical"
+	case healthWarning:
+		return "Warning"
+	default:
+		return "OK"
+	}
+}
+
+// etcdRow is the state of a single Etcd as shown in the view.
+type etcdRow struct {
+	namespace    string
+	name         string
+	health       health
+	replicas     int32
+	readyMembers int
+	leader       string
+	// backupEnabled is false if no backup store is configured, in which case there are no snapshots.
+	backupEnabled bool
+	// fullSnapshotAge and deltaSnapshotAge are nil if no such snapshot has been taken yet.
+	fullSnapshotAge  *time.Duration
+	deltaSnapshotAge *time.Duration
+	lastOperation    string
+	compaction       string
+	// issues are the reasons for the health of the Etcd, most severe first.
+	issues []string
+}
+
+// leaseLister returns the lease with the given namespace and name, or nil if it does not exist.
+type leaseLister func(namespace, name string) *coordinationv1.Lease
+
+// newEtcdRow assesses the state of the given Etcd from its status and its snapshot leases.
+func newEtcdRow(etcd *druidv1alpha1.Etcd, getLease leaseLister, now time.Time) etcdRow {
+	row := etcdRow{
+		namespace:     etcd.Namespace,
+		name:          etcd.Name,
+		replicas:      etcd.Spec.Replicas,
+		leader:        "<none>",
+		backupEnabled: etcd.IsBackupStoreEnabled(),
+		lastOperation: "<none>",
+		compaction:    "<none>",
+	}
+	var critical, warnings []string
+
+	for _, member := range etcd.Status.Members {
+		if member.Status == druidv1alpha1.EtcdMemberStatusReady {
+			row.readyMembers++
+		}
+		if ptr.Deref(member.Role, "") == druidv1alpha1.EtcdRoleLeader {
+			row.leader = member.Name
+		}
+	}
+	if quorum := int(etcd.Spec.Replicas)/2 + 1; etcd.Spec.Replicas > 0 && row.readyMembers < quorum {
+		critical = append(critical, fmt.Sprintf("no quorum, %d of %d members ready", row.readyMembers, etcd.Spec.Replicas))
+	} else if row.readyMembers < int(etcd.Spec.Replicas) {
+		warnings = append(warnings, fmt.Sprintf("%d of %d members ready", row.readyMembers, etcd.Spec.Replicas))
+	}
+	if etcd.Spec.Replicas > 0 && row.leader == "<none>" {
+		critical = append(critical, "no leader")
+	}
+
+	if lastOp := etcd.Status.LastOperation; lastOp != nil {
+		row.lastOperation = fmt.Sprintf("%s/%s %s", lastOp.Type, lastOp.State, cmdutils.ShortDuration(now.Sub(lastOp.LastUpdateTime.Time)))
+		if lastOp.State == druidv1alpha1.LastOperationStateError {
+			critical = append(critical, fmt.Sprintf("%s failed", lastOp.Type))
+		}
+	}
+
+	if row.backupEnabled {
+		row.fullSnapshotAge = getSnapshotAge(getLease(etcd.Namespace, druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta)), now)
+		row.deltaSnapshotAge = getSnapshotAge(getLease(etcd.Namespace, druidv1alpha1.GetDeltaSnapshotLeaseName(etcd.ObjectMeta)), now)
+		if etcd.Spec.Replicas > 0 {
+			if fullSnapshotMaxAge, err := cmdutils.GetFullSnapshotMaxAge(etcd); err != nil {
+				warnings = append(warnings, err.Error())
+			} else if row.fullSnapshotAge == nil {
+				warnings = append(warnings, "no full snapshot")
+			} else if *row.fullSnapshotAge > fullSnapshotMaxAge {
+				warnings = append(warnings, fmt.Sprintf("full snapshot overdue since %s", cmdutils.ShortDuration(*row.fullSnapshotAge-fullSnapshotMaxAge)))
+			}
+		}
+	}
+
+	for _, condition := range etcd.Status.Conditions {
+		if condition.Type != druidv1alpha1.ConditionTypeLastSnapshotCompactionSucceeded {
+			continue
+		}
+		row.compaction = string(condition.Reason)
+		if row.compaction == "" {
+			row.compaction = string(condition.Status)
+		}
+		if condition.Status == druidv1alpha1.ConditionFalse {
+			warnings = append(warnings, "compaction failing")
+		}
+	}
+
+	row.issues = append(critical, warnings...)
+	switch {
+	case len(critical) > 0:
+		row.health = healthCritical
+	case len(warnings) > 0:
+		row.health = healthWarning
+	}
+	return row
+}
+
+func getSnapshotAge(lease *coordinationv1.Lease, now time.Time) *time.Duration {
+	if lease == nil || lease.Spec.RenewTime == nil {
+		return nil
+	}
+	return ptr.To(now.Sub(lease.Spec.RenewTime.Time))
+}
+
+// sortRows sorts the rows by descending severity of their health, and then by namespace and name.
+func sortRows(rows []etcdRow) {
+	slices.SortFunc(rows, func(a, b etcdRow) int {
+		return cmp.Or(
+			cmp.Compare(b.health, a.health),
+			cmp.Compare(a.namespace, b.namespace),
+			cmp.Compare(a.name, b.name),
+		)
+	})
+}
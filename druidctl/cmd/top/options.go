@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package top
+
+import (
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type topOptions struct {
+	*cmdutils.GlobalOptions
+	// interval is the interval in which the view is refreshed.
+	interval time.Duration
+	// problemsOnly restricts the view to the Etcds which have warnings or are critical.
+	problemsOnly bool
+}
+
+type topRuntime struct {
+	*cmdutils.RuntimeEnv
+	etcdRefList   []types.NamespacedName
+	etcdClient    client.EtcdClientInterface
+	genericClient client.GenericClientInterface
+}
+
+type topCmdCtx struct {
+	*topOptions
+	*topRuntime
+}
+
+func newTopOptions(options *cmdutils.GlobalOptions) *topOptions {
+	return &topOptions{
+		GlobalOptions: options,
+	}
+}
+
+func newTopRuntime(runtime *cmdutils.RuntimeEnv) *topRuntime {
+	return &topRuntime{
+		RuntimeEnv: runtime,
+	}
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package top
+
+import (
+	"fmt"
+	"time"
+
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+)
+
+const (
+	// clearScreen moves the cursor to the top left corner of the terminal and clears the screen.
+	clearScreen = "\x1b[H\x1b[2J"
+	// frameHeaderLines is the number of lines of a frame which are not rows of the table: the header, the summary and
+	// the borders and header of the table.
+	frameHeaderLines = 6
+)
+
+var healthStyles = map[health]lipgloss.Style{
+	healthOK:       lipgloss.NewStyle().Foreground(lipgloss.Color("10")),
+	healthWarning:  lipgloss.NewStyle().Foreground(lipgloss.Color("11")),
+	healthCritical: lipgloss.NewStyle().Foreground(lipgloss.Color("9")),
+}
+
+var tableHeaders = []string{"NAMESPACE", "NAME", "HEALTH", "READY", "LEADER", "FULL SNAPSHOT", "DELTA SNAPSHOT", "LAST OPERATION", "COMPACTION", "ISSUES"}
+
+// healthColumn is the index of the column which is colored according to the health of the row.
+const healthColumn = 2
+
+// frame is a rendering of the state of the fleet at a point in time.
+type frame struct {
+	// scope describes the selected Etcds, e.g. the namespace.
+	scope string
+	rows  []etcdRow
+	now   time.Time
+	// maxRows is the maximum number of rows to show, if it is greater than zero.
+	maxRows int
+}
+
+// render writes the frame to the output, which replaces the previous frame if the output is a terminal.
+func (t *topCmdCtx) render(f frame, isTerminal bool) {
+	out := t.IOStreams.Out
+	if isTerminal {
+		fmt.Fprint(out, clearScreen)
+	}
+	t.Logger.RawHeader(out, fmt.Sprintf("Etcds %s, refreshed every %s at %s", f.scope, t.interval, f.now.Format(time.TimeOnly)))
+	fmt.Fprintln(out, summarize(f.rows))
+
+	rows := f.rows
+	if t.problemsOnly {
+		rows = make([]etcdRow, 0, len(f.rows))
+		for _, row := range f.rows {
+			if row.health != healthOK {
+				rows = append(rows, row)
+			}
+		}
+	}
+	if len(rows) == 0 {
+		fmt.Fprintln(out, "No Etcds to show")
+		return
+	}
+	hidden := 0
+	if f.maxRows > 0 && len(rows) > f.maxRows {
+		hidden = len(rows) - f.maxRows
+		rows = rows[:f.maxRows]
+	}
+	fmt.Fprintln(out, newFleetTable(rows))
+	if hidden > 0 {
+		fmt.Fprintf(out, "... and %d more Etcds which do not fit on the screen\n", hidden)
+	}
+}
+
+// summarize returns the number of Etcds by health.
+func summarize(rows []etcdRow) string {
+	counts := make(map[health]int)
+	for _, row := range rows {
+		counts[row.health]++
+	}
+	return fmt.Sprintf("%d total, %s, %s, %s", len(rows),
+		healthStyles[healthOK].Render(fmt.Sprintf("%d OK", counts[healthOK])),
+		healthStyles[healthWarning].Render(fmt.Sprintf("%d Warning", counts[healthWarning])),
+		healthStyles[healthCritical].Render(fmt.Sprintf("%d Critical", counts[healthCritical])))
+}
+
+func newFleetTable(rows []etcdRow) *table.Table {
+	cells := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		cells = append(cells, []string{
+			row.namespace,
+			row.name,
+			row.health.String(),
+			fmt.Sprintf("%d/%d", row.readyMembers, row.replicas),
+			row.leader,
+			snapshotAge(row.backupEnabled, row.fullSnapshotAge),
+			snapshotAge(row.backupEnabled, row.deltaSnapshotAge),
+			row.lastOperation,
+			row.compaction,
+			firstIssue(row.issues),
+		})
+	}
+	return table.New().
+		Border(lipgloss.NormalBorder()).
+		Headers(tableHeaders...).
+		Rows(cells...).
+		StyleFunc(func(rowIndex, col int) lipgloss.Style {
+			if rowIndex == table.HeaderRow || col != healthColumn {
+				return lipgloss.NewStyle()
+			}
+			return healthStyles[rows[rowIndex].health]
+		})
+}
+
+func snapshotAge(backupEnabled bool, age *time.Duration) string {
+	if !backupEnabled {
+		return "N/A"
+	}
+	if age == nil {
+		return "never"
+	}
+	return cmdutils.ShortDuration(*age)
+}
+
+// firstIssue returns the most severe issue, and the number of further issues.
+func firstIssue(issues []string) string {
+	switch len(issues) {
+	case 0:
+		return ""
+	case 1:
+		return issues[0]
+	default:
+		return fmt.Sprintf("%s (+%d more)", issues[0], len(issues)-1)
+	}
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package top
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"golang.org/x/term"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/informers"
+	coordinationv1listers "k8s.io/client-go/listers/coordination/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func (t *topCmdCtx) validate() error {
+	if err := t.ValidateResourceSelection(); err != nil {
+		return err
+	}
+	if t.interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	return nil
+}
+
+func (t *topCmdCtx) complete() error {
+	etcdClient, err := t.Clients.EtcdClient()
+	if err != nil {
+		return fmt.Errorf("unable to create etcd client: %w", err)
+	}
+	t.etcdClient = etcdClient
+
+	genericClient, err := t.Clients.GenericClient()
+	if err != nil {
+		return fmt.Errorf("unable to create generic kube clients: %w", err)
+	}
+	t.genericClient = genericClient
+
+	t.etcdRefList = t.BuildEtcdRefList()
+	return nil
+}
+
+// execute shows the state of the selected Etcds until the context is cancelled. The Etcds and their snapshot leases
+// are kept up to date with informers, from whose caches the view is refreshed in the configured interval.
+func (t *topCmdCtx) execute(ctx context.Context) error {
+	namespace, scope := t.GetNamespace(), fmt.Sprintf("in namespace %s", t.GetNamespace())
+	if t.AllNamespaces {
+		namespace, scope = metav1.NamespaceAll, "in all namespaces"
+	}
+
+	// The Etcds are listed and watched through the etcd client, which may not support the watch-list semantics.
+	etcdInformer := cache.NewSharedIndexInformer(cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+		ListWithContextFunc: func(ctx context.Context, _ metav1.ListOptions) (runtime.Object, error) {
+			return t.etcdClient.ListEtcds(ctx, namespace, t.LabelSelector)
+		},
+		WatchFuncWithContext: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			opts.LabelSelector = t.LabelSelector
+			return t.etcdClient.WatchEtcds(ctx, namespace, opts)
+		},
+	}, t.etcdClient), &druidv1alpha1.Etcd{}, 0, cache.Indexers{})
+
+	// Only the snapshot leases are watched, as there are many more member and node leases which change frequently.
+	leaseInformerFactory := informers.NewSharedInformerFactoryWithOptions(t.genericClient.Kube(), 0,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = labels.SelectorFromSet(map[string]string{
+				druidv1alpha1.LabelManagedByKey: druidv1alpha1.LabelManagedByValue,
+				druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameSnapshotLease,
+			}).String()
+		}))
+	leaseInformer := leaseInformerFactory.Coordination().V1().Leases()
+	leaseLister := leaseInformer.Lister()
+	leaseSynced := leaseInformer.Informer().HasSynced
+
+	go etcdInformer.RunWithContext(ctx)
+	leaseInformerFactory.Start(ctx.Done())
+	defer leaseInformerFactory.Shutdown()
+	if !cache.WaitForCacheSync(ctx.Done(), etcdInformer.HasSynced, leaseSynced) {
+		return fmt.Errorf("failed to sync the caches of Etcds and snapshot leases: %w", context.Cause(ctx))
+	}
+
+	isTerminal, height := t.terminal()
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	for {
+		now := time.Now()
+		rows := t.collectRows(etcdInformer.GetStore().List(), leaseLister, now)
+		f := frame{scope: scope, rows: rows, now: now}
+		if isTerminal {
+			f.maxRows = max(1, height-frameHeaderLines)
+		}
+		t.render(f, isTerminal)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// collectRows assesses the state of the given Etcds which are selected, sorted by descending severity of their health.
+func (t *topCmdCtx) collectRows(objects []any, leaseLister coordinationv1listers.LeaseLister, now time.Time) []etcdRow {
+	selected := make(map[types.NamespacedName]bool, len(t.etcdRefList))
+	for _, ref := range t.etcdRefList {
+		selected[ref] = true
+	}
+	getLease := func(namespace, name string) *coordinationv1.Lease {
+		lease, err := leaseLister.Leases(namespace).Get(name)
+		if err != nil {
+			return nil
+		}
+		return lease
+	}
+
+	rows := make([]etcdRow, 0, len(objects))
+	for _, obj := range objects {
+		etcd, ok := obj.(*druidv1alpha1.Etcd)
+		if !ok {
+			continue
+		}
+		if len(selected) > 0 && !selected[types.NamespacedName{Namespace: etcd.Namespace, Name: etcd.Name}] {
+			continue
+		}
+		rows = append(rows, newEtcdRow(etcd, getLease, now))
+	}
+	sortRows(rows)
+	return rows
+}
+
+// terminal returns whether the output is a terminal, and its height.
+func (t *topCmdCtx) terminal() (bool, int) {
+	file, ok := t.IOStreams.Out.(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		return false, 0
+	}
+	_, height, err := term.GetSize(int(file.Fd()))
+	if err != nil {
+		return true, 0
+	}
+	return true, height
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package top
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	fake "github.com/gardener/etcd-druid/druidctl/internal/client/fake"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func newTestSnapshotLease(etcd *druidv1alpha1.Etcd, name string, renewTime time.Time) *coordinationv1.Lease {
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: etcd.Namespace,
+			Labels: map[string]string{
+				druidv1alpha1.LabelManagedByKey: druidv1alpha1.LabelManagedByValue,
+				druidv1alpha1.LabelPartOfKey:    etcd.Name,
+				druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameSnapshotLease,
+			},
+		},
+		Spec: coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: renewTime}},
+	}
+}
+
+func TestNewEtcdRow(t *testing.T) {
+	now := time.Now()
+	const ready, notReady = druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusNotReady
+	etcdBuilder := fake.NewEtcdBuilder("default", "test-etcd").WithBackupStore("Local")
+	tests := []struct {
+		name           string
+		etcd           *druidv1alpha1.Etcd
+		fullSnapshot   *time.Time
+		expectedHealth health
+		expectedIssue  string
+	}{
+		{"healthy", etcdBuilder.WithMemberStatuses(ready, ready, ready).Build(), ptr.To(now.Add(-time.Hour)), healthOK, ""},
+		{"member not ready", etcdBuilder.WithMemberStatuses(ready, ready, notReady).Build(), ptr.To(now.Add(-time.Hour)), healthWarning, "2 of 3 members ready"},
+		{"no quorum", etcdBuilder.WithMemberStatuses(ready, notReady, notReady).Build(), ptr.To(now.Add(-time.Hour)), healthCritical, "no quorum"},
+		{"no full snapshot", etcdBuilder.WithMemberStatuses(ready).Build(), nil, healthWarning, "no full snapshot"},
+		{"full snapshot overdue", etcdBuilder.WithMemberStatuses(ready).Build(), ptr.To(now.Add(-30 * time.Hour)), healthWarning, "full snapshot overdue since 5h"},
+		{"full snapshot overdue by schedule", func() *druidv1alpha1.Etcd {
+			etcd := etcdBuilder.WithMemberStatuses(ready).Build()
+			etcd.Spec.Backup.FullSnapshotSchedule = ptr.To("0 */6 * * *")
+			return etcd
+		}(), ptr.To(now.Add(-8 * time.Hour)), healthWarning, "full snapshot overdue since 1h"},
+		{"invalid full snapshot schedule", func() *druidv1alpha1.Etcd {
+			etcd := etcdBuilder.WithMemberStatuses(ready).Build()
+			etcd.Spec.Backup.FullSnapshotSchedule = ptr.To("invalid")
+			return etcd
+		}(), ptr.To(now.Add(-time.Hour)), healthWarning, "invalid full snapshot schedule"},
+		{"no leader", func() *druidv1alpha1.Etcd {
+			etcd := etcdBuilder.WithMemberStatuses(ready, ready, ready).Build()
+			etcd.Status.Members[0].Role = ptr.To(druidv1alpha1.EtcdRoleMember)
+			return etcd
+		}(), ptr.To(now.Add(-time.Hour)), healthCritical, "no leader"},
+		{"failed last operation", func() *druidv1alpha1.Etcd {
+			etcd := etcdBuilder.WithMemberStatuses(ready).Build()
+			etcd.Status.LastOperation = &druidapicommon.LastOperation{
+				Type:           druidv1alpha1.LastOperationTypeReconcile,
+				State:          druidv1alpha1.LastOperationStateError,
+				LastUpdateTime: metav1.NewTime(now.Add(-time.Minute)),
+			}
+			return etcd
+		}(), ptr.To(now.Add(-time.Hour)), healthCritical, "Reconcile failed"},
+		{"failing compaction", func() *druidv1alpha1.Etcd {
+			etcd := etcdBuilder.WithMemberStatuses(ready).Build()
+			etcd.Status.Conditions = []druidv1alpha1.Condition{{
+				Type:   druidv1alpha1.ConditionTypeLastSnapshotCompactionSucceeded,
+				Status: druidv1alpha1.ConditionFalse,
+				Reason: "JobFailed",
+			}}
+			return etcd
+		}(), ptr.To(now.Add(-time.Hour)), healthWarning, "compaction failing"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getLease := func(_, name string) *coordinationv1.Lease {
+				if tc.fullSnapshot == nil || name != druidv1alpha1.GetFullSnapshotLeaseName(tc.etcd.ObjectMeta) {
+					return nil
+				}
+				return newTestSnapshotLease(tc.etcd, name, *tc.fullSnapshot)
+			}
+			row := newEtcdRow(tc.etcd, getLease, now)
+			if row.health != tc.expectedHealth {
+				t.Errorf("Expected health %s, got %s with issues %v", tc.expectedHealth, row.health, row.issues)
+			}
+			if tc.expectedIssue == "" && len(row.issues) > 0 {
+				t.Errorf("Expected no issues, got %v", row.issues)
+			}
+			if tc.expectedIssue != "" && (len(row.issues) == 0 || !strings.Contains(row.issues[0], tc.expectedIssue)) {
+				t.Errorf("Expected issue %q first, got %v", tc.expectedIssue, row.issues)
+			}
+		})
+	}
+}
+
+func TestTopCommand(t *testing.T) {
+	now := time.Now()
+	const ready, notReady = druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdMemberStatusNotReady
+	healthy := fake.NewEtcdBuilder("default", "healthy-etcd").WithMemberStatuses(ready, ready, ready).WithBackupStore("Local").Build()
+	critical := fake.NewEtcdBuilder("default", "critical-etcd").WithMemberStatuses(ready, notReady, notReady).WithBackupStore("Local").Build()
+	other := fake.NewEtcdBuilder("other", "other-etcd").WithMemberStatuses(ready).WithBackupStore("Local").Build()
+	etcdObjects := []runtime.Object{healthy, critical, other}
+	k8sObjects := []runtime.Object{
+		newTestSnapshotLease(healthy, druidv1alpha1.GetFullSnapshotLeaseName(healthy.ObjectMeta), now.Add(-2*time.Hour)),
+		newTestSnapshotLease(healthy, druidv1alpha1.GetDeltaSnapshotLeaseName(healthy.ObjectMeta), now.Add(-3*time.Minute)),
+		newTestSnapshotLease(critical, druidv1alpha1.GetFullSnapshotLeaseName(critical.ObjectMeta), now.Add(-2*time.Hour)),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, out, err := fake.NewTestHelper().WithEtcdObjects(etcdObjects).WithK8sObjects(k8sObjects).WithContext(ctx).
+		RunCommand(t, NewTopCommand, nil, map[string]string{"interval": "50ms"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	output := out.String()
+	if strings.Contains(output, clearScreen) {
+		t.Errorf("Expected the screen not to be cleared if the output is not a terminal")
+	}
+	if strings.Contains(output, "other-etcd") {
+		t.Errorf("Expected only the etcds in the default namespace to be shown, got:\n%s", output)
+	}
+	frames := strings.Split(output, "Etcds in namespace default")
+	if len(frames) < 3 {
+		t.Fatalf("Expected the view to be refreshed, got:\n%s", output)
+	}
+	lastFrame := frames[len(frames)-1]
+	criticalIndex, healthyIndex := strings.Index(lastFrame, "critical-etcd"), strings.Index(lastFrame, "healthy-etcd")
+	if criticalIndex < 0 || healthyIndex < 0 || criticalIndex > healthyIndex {
+		t.Errorf("Expected the critical etcd to be shown before the healthy one, got:\n%s", lastFrame)
+	}
+	for _, expected := range []string{"2 total", "1 Critical", "no quorum, 1 of 3 members ready", "2h", "3m"} {
+		if !strings.Contains(lastFrame, expected) {
+			t.Errorf("Expected the view to contain %q, got:\n%s", expected, lastFrame)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import "errors"
+
+const (
+	// ExitCodeError is the exit code for generic errors.
+	ExitCodeError = 1
+	// ExitCodeTaskFailed is the exit code if an awaited EtcdOpsTask has failed or has been rejected.
+	ExitCodeTaskFailed = 2
+	// ExitCodeTimeout is the exit code if waiting for a condition has timed out.
+	ExitCodeTimeout = 3
+	// ExitCodeLintFailed is the exit code if linting has found errors, or warnings in strict mode.
+	ExitCodeLintFailed = 4
+)
+
+// ExitError is an error which requests the CLI to terminate with a specific exit code.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// NewExitError wraps the given error into an ExitError with the given exit code.
+func NewExitError(code int, err error) *ExitError {
+	return &ExitError{Code: code, Err: err}
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code the CLI should terminate with for the given error.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return ExitCodeError
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ResourceMeta captures details needed to operate against a resource type.
+type ResourceMeta struct {
+	GVR        schema.GroupVersionResource
+	Kind       string
+	Namespaced bool
+}
+
+// DefaultResourceTokens returns the curated set of resource types which etcd-druid manages for an Etcd.
+func DefaultResourceTokens() []string {
+	return []string{"pods", "statefulsets", "services", "configmaps", "secrets", "persistentvolumeclaims", "leases", "poddisruptionbudgets", "roles", "rolebindings", "serviceaccounts"}
+}
+
+// ResolveResourceMetas resolves the given resource type tokens (short or full names) into ResourceMetas, skipping
+// duplicates.
+func ResolveResourceMetas(mapper meta.RESTMapper, resourceTokens []string) ([]ResourceMeta, error) {
+	resourceMetas := make([]ResourceMeta, 0, len(resourceTokens))
+	seen := make(map[schema.GroupVersionResource]bool)
+
+	for _, token := range resourceTokens {
+		gvr, err := mapper.ResourceFor(schema.GroupVersionResource{Resource: token})
+		if err != nil {
+			return nil, fmt.Errorf("unknown resource '%s': %w", token, err)
+		}
+		if seen[gvr] {
+			continue
+		}
+		seen[gvr] = true
+
+		gvk, err := mapper.KindFor(gvr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get kind for '%s': %w", token, err)
+		}
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mapping for '%s': %w", token, err)
+		}
+		resourceMetas = append(resourceMetas, ResourceMeta{
+			GVR:        gvr,
+			Kind:       gvk.Kind,
+			Namespaced: mapping.Scope.Name() == meta.RESTScopeNameNamespace,
+		})
+	}
+	return resourceMetas, nil
+}
+
+// ListManagedResources lists the resources of the given type which are managed by etcd-druid for the given Etcd.
+func ListManagedResources(ctx context.Context, genericClient client.GenericClientInterface, etcd *druidv1alpha1.Etcd, resourceMeta ResourceMeta) (*unstructured.UnstructuredList, error) {
+	resourceNamespace := ""
+	if resourceMeta.Namespaced {
+		resourceNamespace = etcd.Namespace
+	}
+	labelSelector := fmt.Sprintf("%s=%s", druidv1alpha1.LabelPartOfKey, etcd.Name)
+	return genericClient.Dynamic().Resource(resourceMeta.GVR).Namespace(resourceNamespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+}
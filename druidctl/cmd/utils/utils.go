@@ -26,6 +26,38 @@ func CmdContext(cmd *cobra.Command) context.Context {
 	return ctx
 }
 
+// Subcommand is implemented by the command contexts of subcommands which are run with RunSubcommand.
+type Subcommand interface {
+	// Validate validates the options of the subcommand.
+	Validate() error
+	// Complete completes the subcommand, e.g. by creating the clients it needs.
+	Complete() error
+	// Execute executes the subcommand.
+	Execute(ctx context.Context) error
+}
+
+// RunSubcommand validates, completes and executes the given subcommand. The help of the command is shown if the
+// validation fails, and failureMessage is logged if the execution fails.
+func RunSubcommand(cmd *cobra.Command, runtime *RuntimeEnv, sub Subcommand, failureMessage string) error {
+	if err := sub.Validate(); err != nil {
+		runtime.Logger.Error(runtime.IOStreams.ErrOut, "Validation failed", err)
+		if herr := cmd.Help(); herr != nil {
+			runtime.Logger.Warning(runtime.IOStreams.ErrOut, "Failed to show help: ", herr.Error())
+		}
+		return err
+	}
+
+	if err := sub.Complete(); err != nil {
+		return err
+	}
+
+	if err := sub.Execute(CmdContext(cmd)); err != nil {
+		runtime.Logger.Error(runtime.IOStreams.ErrOut, failureMessage, err)
+		return err
+	}
+	return nil
+}
+
 // GetEtcdList returns a list of Etcd objects based on the provided references or all namespaces flag.
 // namespace is the namespace to use when etcdRefList is empty (list all in namespace).
 // labelSelector filters resources by label (e.g., "app=etcd-statefulset"). Empty string means no filtering.
@@ -78,3 +110,38 @@ func ShortDuration(d time.Duration) string {
 	days := int(d.Hours()) / 24
 	return fmt.Sprintf("%dd", days)
 }
+
+// FormatSize renders a size in bytes using binary units.
+func FormatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+const (
+	// defaultFullSnapshotInterval is the interval at which full snapshots are taken if no schedule is configured.
+	defaultFullSnapshotInterval = 24 * time.Hour
+	// fullSnapshotGracePeriod is the time by which a full snapshot may exceed its schedule before it is overdue.
+	fullSnapshotGracePeriod = time.Hour
+)
+
+// GetFullSnapshotMaxAge returns the age from which on the latest full snapshot of the given Etcd is overdue. It is the
+// interval of the full snapshot schedule of the Etcd, which is daily by default, plus a grace period. An error is
+// returned if the schedule is invalid.
+func GetFullSnapshotMaxAge(etcd *druidv1alpha1.Etcd) (time.Duration, error) {
+	interval := defaultFullSnapshotInterval
+	if schedule := etcd.Spec.Backup.FullSnapshotSchedule; schedule != nil {
+		var err error
+		if interval, err = druidv1alpha1.ComputeScheduleInterval(*schedule); err != nil {
+			return 0, fmt.Errorf("invalid full snapshot schedule %q: %w", *schedule, err)
+		}
+	}
+	return interval + fullSnapshotGracePeriod, nil
+}
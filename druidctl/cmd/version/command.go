@@ -5,14 +5,12 @@
 package version
 
 import (
-	"encoding/json"
 	"fmt"
 
 	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
 	"github.com/gardener/etcd-druid/druidctl/internal/version"
 
 	"github.com/spf13/cobra"
-	"sigs.k8s.io/yaml"
 )
 
 var (
@@ -20,6 +18,9 @@ var (
 # Print version information
 kubectl druid version
 
+# Print version information including the Go version and compiler
+kubectl druid version --output wide
+
 # Print version in JSON format
 kubectl druid version --output json
 
@@ -29,8 +30,7 @@ kubectl druid version --short`
 
 // NewVersionCommand creates the version command
 func NewVersionCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
-	opts := newVersionOptions(cmdCtx.Options, "", false)
-	runtime := newVersionRuntime(cmdCtx.Runtime)
+	opts := newVersionOptions(cmdCtx.Options, false)
 
 	cmd := &cobra.Command{
 		Use:     "version",
@@ -45,56 +45,54 @@ func NewVersionCommand(cmdCtx *cmdutils.CommandContext) *cobra.Command {
 			return nil
 		},
 		RunE: func(_ *cobra.Command, _ []string) error {
-			return runVersion(runtime, opts)
+			versionCmdCtx := &versionCmdCtx{
+				versionOptions: opts,
+				versionRuntime: newVersionRuntime(cmdCtx.Runtime),
+			}
+			if err := versionCmdCtx.complete(); err != nil {
+				return err
+			}
+			return versionCmdCtx.execute()
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.output, "output", "o", "", "Output format. One of: json|yaml")
+	opts.PrintFlags.AddFlags(cmd)
 	cmd.Flags().BoolVar(&opts.short, "short", false, "Print just the version number")
 
 	return cmd
 }
 
-func runVersion(runtime *versionRuntime, opts *versionOptions) error {
+func (v *versionCmdCtx) complete() error {
+	var err error
+	v.Printer, err = v.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("failed to create formatter: %w", err)
+	}
+	return nil
+}
+
+// execute prints the version information of druidctl.
+func (v *versionCmdCtx) execute() error {
 	versionInfo := version.Get()
 
-	if opts.short {
-		_, _ = fmt.Fprintln(runtime.IOStreams.Out, versionInfo.GitVersion)
+	if v.short {
+		_, _ = fmt.Fprintln(v.IOStreams.Out, versionInfo.GitVersion)
 		return nil
 	}
 
-	switch opts.output {
-	case "json":
-		data, err := json.MarshalIndent(versionInfo, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to marshal version info to JSON: %w", err)
-		}
-		_, _ = fmt.Fprintln(runtime.IOStreams.Out, string(data))
-		return nil
-	case "yaml":
-		data, err := yaml.Marshal(versionInfo)
-		if err != nil {
-			return fmt.Errorf("failed to marshal version info to YAML: %w", err)
-		}
-		fmt.Fprint(runtime.IOStreams.Out, string(data))
-		return nil
-	case "":
-		fmt.Fprintf(runtime.IOStreams.Out, "druidctl version: %s\n", versionInfo.GitVersion)
-		fmt.Fprintf(runtime.IOStreams.Out, "  Git commit:     %s\n", versionInfo.GitCommit)
-		fmt.Fprintf(runtime.IOStreams.Out, "  Git tree state: %s\n", versionInfo.GitTreeState)
-		fmt.Fprintf(runtime.IOStreams.Out, "  Build date:     %s\n", versionInfo.BuildDate)
-		fmt.Fprintf(runtime.IOStreams.Out, "  Go version:     %s\n", versionInfo.GoVersion)
-		fmt.Fprintf(runtime.IOStreams.Out, "  Compiler:       %s\n", versionInfo.Compiler)
-		fmt.Fprintf(runtime.IOStreams.Out, "  Platform:       %s\n", versionInfo.Platform)
+	outputData, err := v.Printer.Print(Result{Info: versionInfo})
+	if err != nil {
+		return fmt.Errorf("failed to marshal version info to desired format: %w", err)
+	}
+	fmt.Fprintf(v.IOStreams.Out, "%s\n", string(outputData))
 
+	if v.PrintFlags.IsTableOutput() {
 		if !versionInfo.IsRelease() {
-			_, _ = fmt.Fprintln(runtime.IOStreams.Out, "\n ⚠️  This is a development build and not an official release.")
+			v.Logger.Warning(v.IOStreams.Out, "This is a development build and not an official release.")
 		}
 		if versionInfo.IsDirty() {
-			_, _ = fmt.Fprintln(runtime.IOStreams.Out, " ⚠️  Built from modified source (dirty git tree).")
+			v.Logger.Warning(v.IOStreams.Out, "Built from modified source (dirty git tree).")
 		}
-		return nil
-	default:
-		return fmt.Errorf("invalid output format: %s (must be json or yaml)", opts.output)
 	}
+	return nil
 }
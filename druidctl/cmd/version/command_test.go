@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package version
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	fake "github.com/gardener/etcd-druid/druidctl/internal/client/fake"
+	"github.com/gardener/etcd-druid/druidctl/internal/version"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+func runVersionCommand(t *testing.T, flags map[string]string) string {
+	t.Helper()
+	cmdCtx := fake.NewTestHelper().CreateTestCommandContext()
+	streams, _, buf, _ := genericiooptions.NewTestIOStreams()
+	cmdCtx.Runtime.IOStreams = streams
+
+	cmd := NewVersionCommand(cmdCtx)
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("Failed to set %s flag: %v", name, err)
+		}
+	}
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("Command failed: %v", err)
+	}
+	return buf.String()
+}
+
+func TestVersionCommandTableOutput(t *testing.T) {
+	info := version.Get()
+
+	output := runVersionCommand(t, nil)
+	lines := strings.Split(output, "\n")
+	if len(lines) < 2 {
+		t.Fatalf("Expected a header and a row, got: %s", output)
+	}
+	if !strings.HasPrefix(lines[0], "VERSION") || strings.Contains(lines[0], "GO VERSION") {
+		t.Errorf("Expected the default columns in the header, got: %s", lines[0])
+	}
+	if !strings.HasPrefix(lines[1], info.GitVersion) {
+		t.Errorf("Expected the row to start with version %s, got: %s", info.GitVersion, lines[1])
+	}
+
+	output = runVersionCommand(t, map[string]string{"output": "wide", "no-headers": "true"})
+	if strings.Contains(output, "VERSION") {
+		t.Errorf("Expected no headers, got: %s", output)
+	}
+	if !strings.Contains(output, info.GoVersion) {
+		t.Errorf("Expected the wide output to contain Go version %s, got: %s", info.GoVersion, output)
+	}
+}
+
+func TestVersionCommandJSONOutput(t *testing.T) {
+	output := runVersionCommand(t, map[string]string{"output": "json"})
+
+	var info version.Info
+	if err := json.Unmarshal([]byte(output), &info); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, output)
+	}
+	if info != version.Get() {
+		t.Errorf("Expected version info %+v, got %+v", version.Get(), info)
+	}
+}
+
+func TestVersionCommandShort(t *testing.T) {
+	output := runVersionCommand(t, map[string]string{"short": "true", "output": "json"})
+	if output != version.Get().GitVersion+"\n" {
+		t.Errorf("Expected only the version number, got: %q", output)
+	}
+}
@@ -6,16 +6,18 @@ package version
 
 import (
 	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
 )
 
 type versionOptions struct {
 	*cmdutils.GlobalOptions
-	output string
-	short  bool
+	PrintFlags *printer.PrintFlags
+	short      bool
 }
 
 type versionRuntime struct {
 	*cmdutils.RuntimeEnv
+	Printer printer.Printer
 }
 
 type versionCmdCtx struct {
@@ -23,10 +25,10 @@ type versionCmdCtx struct {
 	*versionRuntime
 }
 
-func newVersionOptions(globalOpts *cmdutils.GlobalOptions, output string, short bool) *versionOptions {
+func newVersionOptions(globalOpts *cmdutils.GlobalOptions, short bool) *versionOptions {
 	return &versionOptions{
 		GlobalOptions: globalOpts,
-		output:        output,
+		PrintFlags:    printer.NewPrintFlags(),
 		short:         short,
 	}
 }
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package version
+
+import (
+	"github.com/gardener/etcd-druid/druidctl/internal/printer"
+	"github.com/gardener/etcd-druid/druidctl/internal/version"
+)
+
+// Result is the version information printed by the version command.
+type Result struct {
+	version.Info
+}
+
+// ToTable converts the result into a table with a single row.
+func (r Result) ToTable() *printer.Table {
+	return &printer.Table{
+		Columns: []printer.TableColumn{
+			{Name: "VERSION"},
+			{Name: "GIT COMMIT"},
+			{Name: "BUILD DATE"},
+			{Name: "PLATFORM"},
+			{Name: "GIT TREE STATE", Wide: true},
+			{Name: "GO VERSION", Wide: true},
+			{Name: "COMPILER", Wide: true},
+		},
+		Rows: []printer.TableRow{
+			{
+				Cells: []string{
+					r.GitVersion,
+					r.GitCommit,
+					r.BuildDate,
+					r.Platform,
+					r.GitTreeState,
+					r.GoVersion,
+					r.Compiler,
+				},
+				Object: r,
+			},
+		},
+	}
+}
@@ -10,7 +10,9 @@ import (
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	druidclientset "github.com/gardener/etcd-druid/client/clientset/versioned"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/discovery"
 	cached "k8s.io/client-go/discovery/cached/memory"
@@ -36,6 +38,24 @@ func (e *etcdClient) UpdateEtcd(ctx context.Context, etcd *druidv1alpha1.Etcd, e
 	return err
 }
 
+// DryRunEtcd creates the given Etcd resource in a server-side dry-run, or updates the existing one in a server-side
+// dry-run if it already exists. The API server validates and admits the Etcd without persisting it.
+func (e *etcdClient) DryRunEtcd(ctx context.Context, etcd *druidv1alpha1.Etcd) error {
+	dryRun := []string{metav1.DryRunAll}
+	_, err := e.client.Etcds(etcd.Namespace).Create(ctx, etcd, metav1.CreateOptions{DryRun: dryRun})
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	existingEtcd, err := e.GetEtcd(ctx, etcd.Namespace, etcd.Name)
+	if err != nil {
+		return err
+	}
+	updatedEtcd := etcd.DeepCopy()
+	updatedEtcd.ResourceVersion = existingEtcd.ResourceVersion
+	_, err = e.client.Etcds(updatedEtcd.Namespace).Update(ctx, updatedEtcd, metav1.UpdateOptions{DryRun: dryRun})
+	return err
+}
+
 // ListEtcds lists all Etcd resources in the specified namespace. If namespace is empty, it lists across all namespaces.
 // labelSelector filters resources by label (e.g., "app=etcd-statefulset,env=prod"). Empty string means no filtering.
 func (e *etcdClient) ListEtcds(ctx context.Context, namespace string, labelSelector string) (*druidv1alpha1.EtcdList, error) {
@@ -50,6 +70,28 @@ func (e *etcdClient) ListEtcds(ctx context.Context, namespace string, labelSelec
 	return etcdList, nil
 }
 
+// WatchEtcds watches the Etcd resources in the specified namespace. If namespace is empty, it watches across all
+// namespaces.
+func (e *etcdClient) WatchEtcds(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+	return e.client.Etcds(namespace).Watch(ctx, opts)
+}
+
+// CreateEtcdOpsTask creates the given EtcdOpsTask resource and returns the created object.
+func (e *etcdClient) CreateEtcdOpsTask(ctx context.Context, task *druidv1alpha1.EtcdOpsTask) (*druidv1alpha1.EtcdOpsTask, error) {
+	return e.client.EtcdOpsTasks(task.Namespace).Create(ctx, task, metav1.CreateOptions{})
+}
+
+// GetEtcdOpsTask fetches a single EtcdOpsTask resource by name and namespace.
+func (e *etcdClient) GetEtcdOpsTask(ctx context.Context, namespace, name string) (*druidv1alpha1.EtcdOpsTask, error) {
+	return e.client.EtcdOpsTasks(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListEtcdOpsTasks lists all EtcdOpsTask resources in the specified namespace. If namespace is empty, it lists across
+// all namespaces. labelSelector filters resources by label. Empty string means no filtering.
+func (e *etcdClient) ListEtcdOpsTasks(ctx context.Context, namespace string, labelSelector string) (*druidv1alpha1.EtcdOpsTaskList, error) {
+	return e.client.EtcdOpsTasks(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+}
+
 // CreateTypedClientSet creates and returns a typed Kubernetes clientset using the provided config flags.
 func CreateTypedClientSet(configFlags *genericclioptions.ConfigFlags) (*druidclientset.Clientset, error) {
 	config, err := configFlags.ToRESTConfig()
@@ -108,5 +150,6 @@ func (f *ClientFactory) CreateGenericClient() (GenericClientInterface, error) {
 		dynamic:    dynClient,
 		discovery:  discoClient,
 		restMapper: expander,
+		restConfig: config,
 	}, nil
 }
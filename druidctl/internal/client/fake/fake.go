@@ -16,16 +16,20 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
 	discoveryfake "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
 	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 )
 
 // resourceMapping defines a k8s resource type for RESTMapper registration.
@@ -80,23 +84,27 @@ func (f *TestFactory) CreateGenericClient() (client.GenericClientInterface, erro
 	return NewFakeGenericClient(f.k8sObjects), nil
 }
 
-// FakeEtcdClient implements EtcdClientInterface backed by an in-memory map.
+// FakeEtcdClient implements EtcdClientInterface backed by in-memory maps.
 type FakeEtcdClient struct {
-	etcds map[string]*druidv1alpha1.Etcd
+	etcds        map[string]*druidv1alpha1.Etcd
+	etcdOpsTasks map[string]*druidv1alpha1.EtcdOpsTask
 }
 
-// NewFakeEtcdClient constructs a FakeEtcdClient optionally seeded with Etcd objects.
+// NewFakeEtcdClient constructs a FakeEtcdClient optionally seeded with Etcd and EtcdOpsTask objects.
 func NewFakeEtcdClient(etcdObjects []runtime.Object) *FakeEtcdClient {
 	etcds := make(map[string]*druidv1alpha1.Etcd)
+	etcdOpsTasks := make(map[string]*druidv1alpha1.EtcdOpsTask)
 
 	for _, obj := range etcdObjects {
-		if etcd, ok := obj.(*druidv1alpha1.Etcd); ok {
-			key := fmt.Sprintf("%s/%s", etcd.Namespace, etcd.Name)
-			etcds[key] = etcd.DeepCopy()
+		switch o := obj.(type) {
+		case *druidv1alpha1.Etcd:
+			etcds[fmt.Sprintf("%s/%s", o.Namespace, o.Name)] = o.DeepCopy()
+		case *druidv1alpha1.EtcdOpsTask:
+			etcdOpsTasks[fmt.Sprintf("%s/%s", o.Namespace, o.Name)] = o.DeepCopy()
 		}
 	}
 
-	return &FakeEtcdClient{etcds: etcds}
+	return &FakeEtcdClient{etcds: etcds, etcdOpsTasks: etcdOpsTasks}
 }
 
 // GetEtcd retrieves a single Etcd object by namespace/name.
@@ -122,6 +130,11 @@ func (c *FakeEtcdClient) UpdateEtcd(_ context.Context, etcd *druidv1alpha1.Etcd,
 	return nil
 }
 
+// DryRunEtcd accepts every Etcd, as the fake does not validate the objects it stores.
+func (c *FakeEtcdClient) DryRunEtcd(_ context.Context, _ *druidv1alpha1.Etcd) error {
+	return nil
+}
+
 // ListEtcds lists Etcd objects optionally filtered by namespace and label selector.
 // Note: The fake implementation ignores labelSelector for simplicity in tests.
 func (c *FakeEtcdClient) ListEtcds(_ context.Context, namespace string, _ string) (*druidv1alpha1.EtcdList, error) {
@@ -134,6 +147,48 @@ func (c *FakeEtcdClient) ListEtcds(_ context.Context, namespace string, _ string
 	return etcdList, nil
 }
 
+// WatchEtcds returns a watch which does not deliver any events, as the fake does not track changes.
+func (c *FakeEtcdClient) WatchEtcds(_ context.Context, _ string, _ metav1.ListOptions) (watch.Interface, error) {
+	return watch.NewFake(), nil
+}
+
+// IsWatchListSemanticsUnSupported returns true, as the watch of the fake does not send the initial events of a
+// watch-list request. Informers therefore fall back to listing the Etcds.
+func (c *FakeEtcdClient) IsWatchListSemanticsUnSupported() bool {
+	return true
+}
+
+// CreateEtcdOpsTask stores a copy of the given EtcdOpsTask. It fails if an EtcdOpsTask with the same name exists.
+func (c *FakeEtcdClient) CreateEtcdOpsTask(_ context.Context, task *druidv1alpha1.EtcdOpsTask) (*druidv1alpha1.EtcdOpsTask, error) {
+	key := fmt.Sprintf("%s/%s", task.Namespace, task.Name)
+	if _, exists := c.etcdOpsTasks[key]; exists {
+		return nil, errors.NewAlreadyExists(schema.GroupResource{Group: "druid.gardener.cloud", Resource: "etcdopstasks"}, task.Name)
+	}
+	c.etcdOpsTasks[key] = task.DeepCopy()
+	return task.DeepCopy(), nil
+}
+
+// GetEtcdOpsTask retrieves a single EtcdOpsTask object by namespace/name.
+func (c *FakeEtcdClient) GetEtcdOpsTask(_ context.Context, namespace, name string) (*druidv1alpha1.EtcdOpsTask, error) {
+	task, exists := c.etcdOpsTasks[fmt.Sprintf("%s/%s", namespace, name)]
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{Group: "druid.gardener.cloud", Resource: "etcdopstasks"}, name)
+	}
+	return task.DeepCopy(), nil
+}
+
+// ListEtcdOpsTasks lists EtcdOpsTask objects optionally filtered by namespace.
+// Note: The fake implementation ignores labelSelector for simplicity in tests.
+func (c *FakeEtcdClient) ListEtcdOpsTasks(_ context.Context, namespace string, _ string) (*druidv1alpha1.EtcdOpsTaskList, error) {
+	taskList := &druidv1alpha1.EtcdOpsTaskList{}
+	for _, task := range c.etcdOpsTasks {
+		if namespace == "" || task.Namespace == namespace {
+			taskList.Items = append(taskList.Items, *task.DeepCopy())
+		}
+	}
+	return taskList, nil
+}
+
 // FakeGenericClient is a composite fake Kubernetes client bundle used in tests.
 type FakeGenericClient struct {
 	scheme          *runtime.Scheme
@@ -163,6 +218,9 @@ func NewFakeGenericClient(k8sObjects []runtime.Object) *FakeGenericClient {
 	if err := rbacv1.AddToScheme(scheme); err != nil {
 		panic(fmt.Sprintf("failed to add rbacv1 to scheme: %v", err))
 	}
+	if err := storagev1.AddToScheme(scheme); err != nil {
+		panic(fmt.Sprintf("failed to add storagev1 to scheme: %v", err))
+	}
 
 	// Create fake clients with pre-seeded data
 	k8sClient := kubefake.NewSimpleClientset(k8sObjects...)
@@ -207,3 +265,6 @@ func (c *FakeGenericClient) Discovery() discovery.DiscoveryInterface { return c.
 
 // RESTMapper returns a RESTMapper with common resource types registered for testing.
 func (c *FakeGenericClient) RESTMapper() meta.RESTMapper { return c.restMapper }
+
+// RESTConfig returns an empty REST config, as there is no API server to connect to in tests.
+func (c *FakeGenericClient) RESTConfig() *rest.Config { return &rest.Config{} }
@@ -105,6 +105,53 @@ func (b *TestDataBuilder) Build() ([]runtime.Object, []runtime.Object) {
 	return b.etcds, b.k8sObjects
 }
 
+// EtcdBuilder builds the Etcd objects shared by the tests of the commands
+type EtcdBuilder struct {
+	etcd *druidv1alpha1.Etcd
+}
+
+// NewEtcdBuilder creates a builder for an Etcd without members and backup store
+func NewEtcdBuilder(namespace, name string) *EtcdBuilder {
+	return &EtcdBuilder{etcd: &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}}
+}
+
+// WithMemberStatuses adds one member per given status, of which the first one is the leader, and sets the replicas accordingly
+func (b *EtcdBuilder) WithMemberStatuses(statuses ...druidv1alpha1.EtcdMemberConditionStatus) *EtcdBuilder {
+	b.etcd.Spec.Replicas = int32(len(statuses))
+	b.etcd.Status.Members = nil
+	for i, status := range statuses {
+		role := druidv1alpha1.EtcdRoleMember
+		if i == 0 {
+			role = druidv1alpha1.EtcdRoleLeader
+		}
+		b.etcd.Status.Members = append(b.etcd.Status.Members, druidv1alpha1.EtcdMemberStatus{
+			Name:   druidv1alpha1.GetOrdinalPodName(b.etcd.ObjectMeta, i),
+			Role:   &role,
+			Status: status,
+		})
+	}
+	return b
+}
+
+// WithMemberIDs sets the IDs of the members in the given order
+func (b *EtcdBuilder) WithMemberIDs(ids ...string) *EtcdBuilder {
+	for i := range min(len(ids), len(b.etcd.Status.Members)) {
+		b.etcd.Status.Members[i].ID = &ids[i]
+	}
+	return b
+}
+
+// WithBackupStore adds a backup store of the given provider, whose prefix is the name of the Etcd
+func (b *EtcdBuilder) WithBackupStore(provider druidv1alpha1.StorageProvider) *EtcdBuilder {
+	b.etcd.Spec.Backup.Store = &druidv1alpha1.StoreSpec{Provider: &provider, Prefix: b.etcd.Name}
+	return b
+}
+
+// Build returns the Etcd
+func (b *EtcdBuilder) Build() *druidv1alpha1.Etcd {
+	return b.etcd.DeepCopy()
+}
+
 // Common test scenarios
 
 // SingleEtcdWithResources creates a realistic test scenario with one Etcd and its managed resources
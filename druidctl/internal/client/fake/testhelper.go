@@ -5,9 +5,14 @@
 package fake
 
 import (
+	"bytes"
+	"context"
+	"testing"
+
 	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
 	"github.com/gardener/etcd-druid/druidctl/internal/log"
 
+	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
@@ -17,6 +22,8 @@ import (
 type TestHelper struct {
 	etcdObjects []runtime.Object
 	k8sObjects  []runtime.Object
+	stdin       string
+	ctx         context.Context
 	streams     genericiooptions.IOStreams
 }
 
@@ -45,6 +52,18 @@ func (h *TestHelper) WithK8sObjects(objects []runtime.Object) *TestHelper {
 	return h
 }
 
+// WithStdin sets the standard input of the commands run by RunCommand
+func (h *TestHelper) WithStdin(stdin string) *TestHelper {
+	h.stdin = stdin
+	return h
+}
+
+// WithContext sets the context in which the commands run by RunCommand are executed
+func (h *TestHelper) WithContext(ctx context.Context) *TestHelper {
+	h.ctx = ctx
+	return h
+}
+
 // WithTestScenario adds objects from a test scenario builder
 func (h *TestHelper) WithTestScenario(builder *TestDataBuilder) *TestHelper {
 	etcdObjs, k8sObjs := builder.Build()
@@ -74,3 +93,30 @@ func (h *TestHelper) CreateTestCommandContext() *cmdutils.CommandContext {
 		},
 	}
 }
+
+// RunCommand creates a command with the given constructor in a new test command context, sets the given flags,
+// completes the options for the given arguments and runs the command. It returns the command context and the standard
+// output of the command together with the error returned by it.
+func (h *TestHelper) RunCommand(t testing.TB, newCommand func(*cmdutils.CommandContext) *cobra.Command, args []string, flags map[string]string) (*cmdutils.CommandContext, *bytes.Buffer, error) {
+	t.Helper()
+	cmdCtx := h.CreateTestCommandContext()
+	streams, in, out, errOut := genericiooptions.NewTestIOStreams()
+	in.WriteString(h.stdin)
+	cmdCtx.Runtime.IOStreams = streams
+
+	cmd := newCommand(cmdCtx)
+	if h.ctx != nil {
+		cmd.SetContext(h.ctx)
+	}
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	for name, value := range flags {
+		if err := cmd.Flags().Set(name, value); err != nil {
+			t.Fatalf("Failed to set %s flag: %v", name, err)
+		}
+	}
+	if err := cmdCtx.Complete(cmd, args); err != nil {
+		t.Fatalf("Failed to complete options: %v", err)
+	}
+	return cmdCtx, out, cmd.RunE(cmd, args)
+}
@@ -10,10 +10,13 @@ import (
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/client/clientset/versioned/typed/core/v1alpha1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 )
 
 // Factory is the main interface for creating all types of clients needed by commands
@@ -34,11 +37,16 @@ func NewClientFactory(configFlags *genericclioptions.ConfigFlags) *ClientFactory
 	return &ClientFactory{configFlags: configFlags}
 }
 
-// EtcdClientInterface describes operations for interacting with Etcd custom resources.
+// EtcdClientInterface describes operations for interacting with Etcd and EtcdOpsTask custom resources.
 type EtcdClientInterface interface {
 	GetEtcd(ctx context.Context, namespace, name string) (*druidv1alpha1.Etcd, error)
 	UpdateEtcd(ctx context.Context, etcd *druidv1alpha1.Etcd, etcdModifier func(*druidv1alpha1.Etcd)) error
+	DryRunEtcd(ctx context.Context, etcd *druidv1alpha1.Etcd) error
 	ListEtcds(ctx context.Context, namespace string, labelSelector string) (*druidv1alpha1.EtcdList, error)
+	WatchEtcds(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error)
+	CreateEtcdOpsTask(ctx context.Context, task *druidv1alpha1.EtcdOpsTask) (*druidv1alpha1.EtcdOpsTask, error)
+	GetEtcdOpsTask(ctx context.Context, namespace, name string) (*druidv1alpha1.EtcdOpsTask, error)
+	ListEtcdOpsTasks(ctx context.Context, namespace string, labelSelector string) (*druidv1alpha1.EtcdOpsTaskList, error)
 }
 
 // etcdClient implements EtcdClientInterface using a generated typed client.
@@ -61,6 +69,8 @@ type GenericClientInterface interface {
 	Discovery() discovery.DiscoveryInterface
 	// RESTMapper returns a RESTMapper capable of resolving kinds/short names to resources.
 	RESTMapper() meta.RESTMapper
+	// RESTConfig returns the REST config the clients are created with, e.g. to set up port-forwards.
+	RESTConfig() *rest.Config
 }
 
 type genericClient struct {
@@ -68,6 +78,7 @@ type genericClient struct {
 	dynamic    dynamic.Interface
 	discovery  discovery.DiscoveryInterface
 	restMapper meta.RESTMapper
+	restConfig *rest.Config
 }
 
 func (g *genericClient) Kube() kubernetes.Interface { return g.kube }
@@ -77,3 +88,5 @@ func (g *genericClient) Dynamic() dynamic.Interface { return g.dynamic }
 func (g *genericClient) Discovery() discovery.DiscoveryInterface { return g.discovery }
 
 func (g *genericClient) RESTMapper() meta.RESTMapper { return g.restMapper }
+
+func (g *genericClient) RESTConfig() *rest.Config { return g.restConfig }
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdconn
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// defaultCADataKey is the default data key of the CA certificate in the CA secret.
+	defaultCADataKey = "ca.crt"
+	// authRootSecretUsernameKey is the data key of the root secret holding the name of the root user.
+	authRootSecretUsernameKey = "username"
+	// authRootSecretPasswordKey is the data key of the root secret holding the password of the root user.
+	authRootSecretPasswordKey = "password"
+)
+
+// Options are the user provided options for connecting to an etcd cluster.
+type Options struct {
+	// Pod is the name of the member pod to connect to. Defaults to the first ready member pod.
+	Pod string
+	// TLSServerName is the server name used to verify the server certificate of etcd. Defaults to the DNS name of the
+	// client service.
+	TLSServerName string
+	// DialTimeout is the timeout for establishing the connection to etcd.
+	DialTimeout time.Duration
+}
+
+// ResolveConfig resolves the member pod to connect to, and the credentials for the client communication of the given
+// Etcd from the secrets referenced in its spec.
+func ResolveConfig(ctx context.Context, genericClient client.GenericClientInterface, etcd *druidv1alpha1.Etcd, opts Options) (Config, error) {
+	config := Config{
+		Namespace:   etcd.Namespace,
+		Port:        ptr.Deref(etcd.Spec.Etcd.ClientPort, druidv1alpha1.DefaultPortEtcdClient),
+		DialTimeout: opts.DialTimeout,
+	}
+	pod, err := SelectPod(ctx, genericClient, etcd, opts.Pod)
+	if err != nil {
+		return config, err
+	}
+	config.PodName = pod.Name
+
+	if config.TLSConfig, err = getClientTLSConfig(ctx, genericClient, etcd, opts.TLSServerName); err != nil {
+		return config, err
+	}
+	// If auth is enabled, the client is authenticated with the common name of its client certificate, which is
+	// granted the root role by etcd-druid. Without a client certificate, the root user is used.
+	if etcd.Spec.Etcd.Auth != nil && (config.TLSConfig == nil || len(config.TLSConfig.Certificates) == 0) {
+		if config.Username, config.Password, err = getRootCredentials(ctx, genericClient, etcd); err != nil {
+			return config, err
+		}
+	}
+	return config, nil
+}
+
+// SelectPod returns the member pod with the given name, or the first ready member pod of the given Etcd if no name is
+// given.
+func SelectPod(ctx context.Context, genericClient client.GenericClientInterface, etcd *druidv1alpha1.Etcd, podName string) (*corev1.Pod, error) {
+	pods := genericClient.Kube().CoreV1().Pods(etcd.Namespace)
+	if podName != "" {
+		pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pod %s: %w", podName, err)
+		}
+		if pod.Labels[druidv1alpha1.LabelPartOfKey] != etcd.Name {
+			return nil, fmt.Errorf("pod %s is not a member of etcd %s", podName, etcd.Name)
+		}
+		return pod, nil
+	}
+	podList, err := pods.List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta)).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods of etcd %s: %w", etcd.Name, err)
+	}
+	slices.SortFunc(podList.Items, func(a, b corev1.Pod) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	for i := range podList.Items {
+		if IsPodReady(&podList.Items[i]) {
+			return &podList.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no ready member pod found for etcd %s", etcd.Name)
+}
+
+// IsPodReady returns true if the given pod is running and ready, and not being deleted.
+func IsPodReady(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// getClientTLSConfig returns the TLS configuration for clients of the given Etcd, which trusts the CA of the client
+// communication and presents the client certificate, if one is configured. It returns nil if TLS is not enabled for the
+// client communication.
+func getClientTLSConfig(ctx context.Context, genericClient client.GenericClientInterface, etcd *druidv1alpha1.Etcd, serverName string) (*tls.Config, error) {
+	tlsConfig := etcd.GetClientURLTLS()
+	if tlsConfig == nil {
+		return nil, nil
+	}
+	if serverName == "" {
+		serverName = fmt.Sprintf("%s.%s.svc", druidv1alpha1.GetClientServiceName(etcd.ObjectMeta), etcd.Namespace)
+	}
+	caSecret, err := getSecret(ctx, genericClient, etcd.Namespace, tlsConfig.TLSCASecretRef.Name, "CA")
+	if err != nil {
+		return nil, err
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caSecret.Data[ptr.Deref(tlsConfig.TLSCASecretRef.DataKey, defaultCADataKey)]) {
+		return nil, fmt.Errorf("no CA certificate found in secret %s", tlsConfig.TLSCASecretRef.Name)
+	}
+	clientTLSConfig := &tls.Config{
+		RootCAs:    caPool,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if tlsConfig.ClientTLSSecretRef.Name == "" {
+		return clientTLSConfig, nil
+	}
+	clientSecret, err := getSecret(ctx, genericClient, etcd.Namespace, tlsConfig.ClientTLSSecretRef.Name, "client TLS")
+	if err != nil {
+		return nil, err
+	}
+	clientCert, err := tls.X509KeyPair(clientSecret.Data[corev1.TLSCertKey], clientSecret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse client certificate from secret %s: %w", tlsConfig.ClientTLSSecretRef.Name, err)
+	}
+	clientTLSConfig.Certificates = []tls.Certificate{clientCert}
+	return clientTLSConfig, nil
+}
+
+// getRootCredentials returns the credentials of the etcd root user, which are managed by etcd-druid if auth is enabled.
+func getRootCredentials(ctx context.Context, genericClient client.GenericClientInterface, etcd *druidv1alpha1.Etcd) (string, string, error) {
+	secret, err := getSecret(ctx, genericClient, etcd.Namespace, druidv1alpha1.GetAuthRootSecretName(etcd.ObjectMeta), "auth root")
+	if err != nil {
+		return "", "", err
+	}
+	return string(secret.Data[authRootSecretUsernameKey]), string(secret.Data[authRootSecretPasswordKey]), nil
+}
+
+func getSecret(ctx context.Context, genericClient client.GenericClientInterface, namespace, name, purpose string) (*corev1.Secret, error) {
+	secret, err := genericClient.Kube().CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%s secret %s/%s not found", purpose, namespace, name)
+		}
+		return nil, fmt.Errorf("failed to get %s secret %s/%s: %w", purpose, namespace, name, err)
+	}
+	return secret, nil
+}
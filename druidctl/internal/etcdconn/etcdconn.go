@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdconn
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+	"github.com/gardener/etcd-druid/druidctl/internal/portforward"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+// API is the subset of the etcd client API which is used by druidctl.
+type API interface {
+	Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error)
+	Put(ctx context.Context, key, val string, opts ...clientv3.OpOption) (*clientv3.PutResponse, error)
+	Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error)
+	MemberList(ctx context.Context, opts ...clientv3.OpOption) (*clientv3.MemberListResponse, error)
+	MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error)
+	MoveLeader(ctx context.Context, transfereeID uint64) (*clientv3.MoveLeaderResponse, error)
+	Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error)
+	AlarmList(ctx context.Context) (*clientv3.AlarmResponse, error)
+	AlarmDisarm(ctx context.Context, m *clientv3.AlarmMember) (*clientv3.AlarmResponse, error)
+}
+
+// Config describes how to connect to a member of an etcd cluster.
+type Config struct {
+	Namespace string
+	// PodName is the name of the pod of the member to connect to.
+	PodName string
+	// Port is the client port of the member.
+	Port int32
+	// TLSConfig is nil if TLS is not enabled for the client communication.
+	TLSConfig   *tls.Config
+	Username    string
+	Password    string
+	DialTimeout time.Duration
+}
+
+// Connection is an open connection to a member of an etcd cluster.
+type Connection struct {
+	API API
+	// Endpoint is the local endpoint through which the member is reached.
+	Endpoint string
+	// Member is the name of the pod of the member.
+	Member  string
+	closeFn func()
+}
+
+// NewConnection creates a Connection for the given API, which calls closeFn when it is closed.
+func NewConnection(api API, endpoint, member string, closeFn func()) *Connection {
+	return &Connection{API: api, Endpoint: endpoint, Member: member, closeFn: closeFn}
+}
+
+// Close closes the connection.
+func (c *Connection) Close() {
+	if c.closeFn != nil {
+		c.closeFn()
+	}
+}
+
+// ConnectFunc establishes a connection to the etcd member described by the given config.
+type ConnectFunc func(ctx context.Context, genericClient client.GenericClientInterface, config Config, errOut io.Writer) (*Connection, error)
+
+// Connect port-forwards a local port to the client port of the etcd member pod and connects an etcd client to it.
+func Connect(ctx context.Context, genericClient client.GenericClientInterface, config Config, errOut io.Writer) (*Connection, error) {
+	portForward, err := portforward.PortForwardPod(ctx, genericClient, config.Namespace, config.PodName, config.Port, errOut)
+	if err != nil {
+		return nil, err
+	}
+	scheme := "http"
+	if config.TLSConfig != nil {
+		scheme = "https"
+	}
+	endpoint := fmt.Sprintf("%s://127.0.0.1:%d", scheme, portForward.LocalPort)
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{endpoint},
+		DialTimeout: config.DialTimeout,
+		TLS:         config.TLSConfig,
+		Username:    config.Username,
+		Password:    config.Password,
+		Logger:      zap.NewNop(),
+		Context:     ctx,
+	})
+	if err != nil {
+		portForward.Close()
+		return nil, fmt.Errorf("failed to connect to etcd member %s: %w", config.PodName, err)
+	}
+	return NewConnection(etcdClient, endpoint, config.PodName, func() {
+		_ = etcdClient.Close()
+		portForward.Close()
+	}), nil
+}
+
+// FormatID formats an etcd member ID in hexadecimal, as etcdctl does.
+func FormatID(id uint64) string {
+	return fmt.Sprintf("%x", id)
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/gardener/etcd-druid/druidctl/internal/client"
+
+	clientportforward "k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// PortForward is an active port-forward from a local port to a port of a pod.
+type PortForward struct {
+	// LocalPort is the local port which is forwarded to the pod.
+	LocalPort uint16
+	stopCh    chan struct{}
+	stopOnce  sync.Once
+}
+
+// Close stops the port-forward.
+func (p *PortForward) Close() {
+	p.stopOnce.Do(func() { close(p.stopCh) })
+}
+
+// PortForwardPod forwards a random free local port on the loopback interface to the given port of the given pod, the
+// same way as `kubectl port-forward` does. The port-forward is active until it is closed or the given context is
+// cancelled. Errors of the individual forwarded connections are written to errOut.
+func PortForwardPod(ctx context.Context, genericClient client.GenericClientInterface, namespace, podName string, podPort int32, errOut io.Writer) (*PortForward, error) {
+	restConfig := genericClient.RESTConfig()
+	transport, upgrader, err := spdy.RoundTripperFor(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create round tripper for port-forward: %w", err)
+	}
+	url := genericClient.Kube().CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(podName).
+		SubResource("portforward").
+		URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	pf := &PortForward{stopCh: make(chan struct{})}
+	readyCh := make(chan struct{})
+	forwarder, err := clientportforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf("0:%d", podPort)}, pf.stopCh, readyCh, io.Discard, errOut)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create port-forward to pod %s/%s: %w", namespace, podName, err)
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwarder.ForwardPorts()
+	}()
+	go func() {
+		<-ctx.Done()
+		pf.Close()
+	}()
+
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		return nil, fmt.Errorf("failed to port-forward to pod %s/%s: %w", namespace, podName, err)
+	case <-ctx.Done():
+		pf.Close()
+		return nil, ctx.Err()
+	}
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		pf.Close()
+		return nil, fmt.Errorf("failed to get forwarded ports: %w", err)
+	}
+	pf.LocalPort = ports[0].Local
+	return pf, nil
+}
@@ -16,6 +16,10 @@ const (
 	OutputTypeYAML OutputFormat = "yaml"
 	// OutputTypeTable represents table output format
 	OutputTypeTable OutputFormat = "table"
-	// OutputTypeNone represents no output format
+	// OutputTypeWide represents table output format including additional columns
+	OutputTypeWide OutputFormat = "wide"
+	// OutputTypeCustomColumns represents table output format with user-defined columns, e.g. custom-columns=NAME:.name
+	OutputTypeCustomColumns OutputFormat = "custom-columns"
+	// OutputTypeNone represents no explicitly chosen output format, which defaults to the table output format
 	OutputTypeNone OutputFormat = ""
 )
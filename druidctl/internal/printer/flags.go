@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package printer
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// PrintFlags holds the output related flags which are common to all commands that print a result.
+type PrintFlags struct {
+	OutputFormat string
+	NoHeaders    bool
+	SortBy       string
+}
+
+// NewPrintFlags creates PrintFlags which default to the table output format.
+func NewPrintFlags() *PrintFlags {
+	return &PrintFlags{OutputFormat: string(OutputTypeTable)}
+}
+
+// AddFlags registers the output related flags on the given command.
+func (p *PrintFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&p.OutputFormat, "output", "o", p.OutputFormat, "Output format. One of: table, wide, json, yaml, custom-columns=<header>:<json-path>[,<header>:<json-path>...]")
+	cmd.Flags().BoolVar(&p.NoHeaders, "no-headers", p.NoHeaders, "When using the table, wide or custom-columns output format, don't print headers.")
+	cmd.Flags().StringVar(&p.SortBy, "sort-by", p.SortBy, "When using the table, wide or custom-columns output format, sort the rows by the given column name or JSONPath expression (e.g. '.etcd.name').")
+}
+
+// IsTableOutput returns true if the configured output format prints a table.
+func (p *PrintFlags) IsTableOutput() bool {
+	switch OutputFormat(p.OutputFormat) {
+	case OutputTypeTable, OutputTypeWide, OutputTypeNone:
+		return true
+	default:
+		return strings.HasPrefix(p.OutputFormat, string(OutputTypeCustomColumns)+"=")
+	}
+}
+
+// ToPrinter creates the Printer for the configured output format.
+func (p *PrintFlags) ToPrinter() (Printer, error) {
+	return NewFormatter(OutputFormat(p.OutputFormat), TableOptions{NoHeaders: p.NoHeaders, SortBy: p.SortBy})
+}
@@ -7,6 +7,7 @@ package printer
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"sigs.k8s.io/yaml"
 )
@@ -46,26 +47,21 @@ func (f *YAMLFormatter) Print(data any) ([]byte, error) {
 	return yaml.Marshal(data)
 }
 
-// =======================
-// Table Formatter
-// =======================
-
-// TableFormatter formats data as a table.
-// TODO:@anveshreddy18 -> Implementation to be added.
-type TableFormatter struct{}
-
-// Format converts the given data into a table representation.
-func (f *TableFormatter) Format(_ any) ([]byte, error) {
-	// Implement table formatting logic here. Currently returns no data.
-	return nil, nil
-}
-
 // =======================
 // Formatter Factory
 // =======================
 
-// NewFormatter creates a new Formatter based on the specified output format.
-func NewFormatter(format OutputFormat) (Printer, error) {
+// NewFormatter creates a new Formatter based on the specified output format. The table options are only
+// considered by the table output formats.
+func NewFormatter(format OutputFormat, tableOptions TableOptions) (Printer, error) {
+	if spec, ok := strings.CutPrefix(string(format), string(OutputTypeCustomColumns)+"="); ok {
+		customColumns, err := ParseCustomColumns(spec)
+		if err != nil {
+			return nil, err
+		}
+		tableOptions.CustomColumns = customColumns
+		return &TableFormatter{Options: tableOptions}, nil
+	}
 	switch format {
 	case OutputTypeJSON:
 		return &JSONFormatter{Indent: true}, nil
@@ -73,8 +69,11 @@ func NewFormatter(format OutputFormat) (Printer, error) {
 		return &JSONFormatter{Indent: false}, nil
 	case OutputTypeYAML:
 		return &YAMLFormatter{}, nil
-	case OutputTypeNone:
-		return nil, nil
+	case OutputTypeTable, OutputTypeNone:
+		return &TableFormatter{Options: tableOptions}, nil
+	case OutputTypeWide:
+		tableOptions.Wide = true
+		return &TableFormatter{Options: tableOptions}, nil
 	default:
 		return nil, fmt.Errorf("unknown format: %s", string(format))
 	}
@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// noneValue is printed for custom columns whose field spec does not yield a value.
+const noneValue = "<none>"
+
+// TableColumn describes a single column of a Table.
+type TableColumn struct {
+	// Name is the header of the column.
+	Name string
+	// Wide marks the column as additional information which is only printed for the wide output format.
+	Wide bool
+}
+
+// TableRow is a single row of a Table.
+type TableRow struct {
+	// Cells contains one value per column of the table.
+	Cells []string
+	// Object is the object the row has been built from. JSONPath expressions of custom columns and of the
+	// sort-by option are evaluated against its JSON representation.
+	Object any
+}
+
+// Table is a column-aware representation of the result of a command.
+type Table struct {
+	Columns []TableColumn
+	Rows    []TableRow
+}
+
+// TableConvertible is implemented by command results which can be printed as a table.
+type TableConvertible interface {
+	// ToTable converts the result into a Table containing all columns, including the wide ones.
+	ToTable() *Table
+}
+
+// CustomColumn is a user-defined column whose values are obtained by evaluating a JSONPath expression.
+type CustomColumn struct {
+	Header    string
+	FieldSpec string
+}
+
+// TableOptions configures the TableFormatter.
+type TableOptions struct {
+	// Wide also prints the columns marked as wide.
+	Wide bool
+	// NoHeaders omits the header line.
+	NoHeaders bool
+	// SortBy sorts the rows either by the values of the column with the given name or, if it starts with '.' or '{',
+	// by the result of the given JSONPath expression.
+	SortBy string
+	// CustomColumns replaces the columns of the table, if set.
+	CustomColumns []CustomColumn
+}
+
+// =======================
+// Table Formatter
+// =======================
+
+// TableFormatter formats data as a table with aligned columns.
+type TableFormatter struct {
+	Options TableOptions
+}
+
+// Print converts the provided data into a table. The data must implement TableConvertible.
+func (f *TableFormatter) Print(data any) ([]byte, error) {
+	convertible, ok := data.(TableConvertible)
+	if !ok {
+		return nil, fmt.Errorf("table output is not supported for %T", data)
+	}
+	table := convertible.ToTable()
+
+	headers, rows, err := f.project(table)
+	if err != nil {
+		return nil, err
+	}
+	if f.Options.SortBy != "" {
+		if err = f.sortRows(table, rows); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 8, 3, ' ', 0)
+	if !f.Options.NoHeaders {
+		fmt.Fprintln(w, strings.Join(headers, "\t"))
+	}
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row.cells, "\t"))
+	}
+	if err = w.Flush(); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// projectedRow holds the cells of a row which are printed, along with the row it has been projected from.
+type projectedRow struct {
+	cells []string
+	row   TableRow
+}
+
+// project determines the headers and the cells of every row which are to be printed.
+func (f *TableFormatter) project(table *Table) ([]string, []projectedRow, error) {
+	rows := make([]projectedRow, 0, len(table.Rows))
+	if len(f.Options.CustomColumns) > 0 {
+		headers := make([]string, 0, len(f.Options.CustomColumns))
+		for _, column := range f.Options.CustomColumns {
+			headers = append(headers, column.Header)
+		}
+		for _, row := range table.Rows {
+			cells := make([]string, 0, len(f.Options.CustomColumns))
+			for _, column := range f.Options.CustomColumns {
+				value, err := evaluateJSONPath(column.FieldSpec, row.Object)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to evaluate custom column %q: %w", column.Header, err)
+				}
+				if value == "" {
+					value = noneValue
+				}
+				cells = append(cells, value)
+			}
+			rows = append(rows, projectedRow{cells: cells, row: row})
+		}
+		return headers, rows, nil
+	}
+
+	var (
+		headers       []string
+		columnIndices []int
+	)
+	for i, column := range table.Columns {
+		if column.Wide && !f.Options.Wide {
+			continue
+		}
+		headers = append(headers, column.Name)
+		columnIndices = append(columnIndices, i)
+	}
+	for _, row := range table.Rows {
+		cells := make([]string, 0, len(columnIndices))
+		for _, i := range columnIndices {
+			cells = append(cells, row.Cells[i])
+		}
+		rows = append(rows, projectedRow{cells: cells, row: row})
+	}
+	return headers, rows, nil
+}
+
+// sortRows sorts the rows in place as configured by the SortBy option.
+func (f *TableFormatter) sortRows(table *Table, rows []projectedRow) error {
+	keys := make([]string, len(rows))
+	if isJSONPath(f.Options.SortBy) {
+		for i, row := range rows {
+			key, err := evaluateJSONPath(f.Options.SortBy, row.row.Object)
+			if err != nil {
+				return fmt.Errorf("failed to evaluate sort-by expression %q: %w", f.Options.SortBy, err)
+			}
+			keys[i] = key
+		}
+	} else {
+		columnIndex := slices.IndexFunc(table.Columns, func(column TableColumn) bool {
+			return strings.EqualFold(column.Name, f.Options.SortBy)
+		})
+		if columnIndex == -1 {
+			return fmt.Errorf("cannot sort by unknown column %q", f.Options.SortBy)
+		}
+		for i, row := range rows {
+			keys[i] = row.row.Cells[columnIndex]
+		}
+	}
+
+	// sort a permutation of the rows so that the keys stay associated with their rows
+	order := make([]int, len(rows))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return lessValue(keys[order[i]], keys[order[j]])
+	})
+	sorted := make([]projectedRow, 0, len(rows))
+	for _, i := range order {
+		sorted = append(sorted, rows[i])
+	}
+	copy(rows, sorted)
+	return nil
+}
+
+// lessValue compares two values numerically if both are numbers and lexically otherwise.
+func lessValue(a, b string) bool {
+	numA, errA := strconv.ParseFloat(a, 64)
+	numB, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		return numA < numB
+	}
+	return a < b
+}
+
+// ParseCustomColumns parses a comma-separated list of <header>:<json-path> column specifications.
+func ParseCustomColumns(spec string) ([]CustomColumn, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+	parts := strings.Split(spec, ",")
+	columns := make([]CustomColumn, 0, len(parts))
+	for _, part := range parts {
+		header, fieldSpec, found := strings.Cut(part, ":")
+		if !found || header == "" || fieldSpec == "" {
+			return nil, fmt.Errorf("unexpected custom-columns spec: %q, expected <header>:<json-path-expr>", part)
+		}
+		if _, err := parseJSONPath(fieldSpec); err != nil {
+			return nil, fmt.Errorf("invalid field spec %q of custom column %q: %w", fieldSpec, header, err)
+		}
+		columns = append(columns, CustomColumn{Header: header, FieldSpec: fieldSpec})
+	}
+	return columns, nil
+}
+
+func isJSONPath(expr string) bool {
+	return strings.HasPrefix(expr, ".") || strings.HasPrefix(expr, "{")
+}
+
+// parseJSONPath parses the given expression, which may omit the surrounding braces and the leading dot.
+func parseJSONPath(expr string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(expr, "{") {
+		if !strings.HasPrefix(expr, ".") {
+			expr = "." + expr
+		}
+		expr = "{" + expr + "}"
+	}
+	parser := jsonpath.New("column").AllowMissingKeys(true)
+	if err := parser.Parse(expr); err != nil {
+		return nil, err
+	}
+	return parser, nil
+}
+
+// evaluateJSONPath evaluates the given expression against the JSON representation of obj.
+func evaluateJSONPath(expr string, obj any) (string, error) {
+	parser, err := parseJSONPath(expr)
+	if err != nil {
+		return "", err
+	}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	var data any
+	if err = json.Unmarshal(raw, &data); err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err = parser.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package printer
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type testItem struct {
+	Name     string `json:"name"`
+	Replicas int    `json:"replicas"`
+	Zone     string `json:"zone,omitempty"`
+}
+
+type testResult []testItem
+
+func (r testResult) ToTable() *Table {
+	table := &Table{Columns: []TableColumn{{Name: "NAME"}, {Name: "REPLICAS"}, {Name: "ZONE", Wide: true}}}
+	for _, item := range r {
+		table.Rows = append(table.Rows, TableRow{Cells: []string{item.Name, strconv.Itoa(item.Replicas), item.Zone}, Object: item})
+	}
+	return table
+}
+
+var testData = testResult{
+	{Name: "etcd-main", Replicas: 3, Zone: "a"},
+	{Name: "etcd-events", Replicas: 1},
+	{Name: "etcd-backup", Replicas: 5, Zone: "c"},
+}
+
+func TestTableFormatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   OutputFormat
+		options  TableOptions
+		expected []string
+	}{
+		{
+			name:     "default columns",
+			format:   OutputTypeTable,
+			expected: []string{"NAME REPLICAS", "etcd-main 3", "etcd-events 1", "etcd-backup 5"},
+		},
+		{
+			name:     "unset output format defaults to table",
+			format:   OutputTypeNone,
+			expected: []string{"NAME REPLICAS", "etcd-main 3", "etcd-events 1", "etcd-backup 5"},
+		},
+		{
+			name:     "wide columns",
+			format:   OutputTypeWide,
+			expected: []string{"NAME REPLICAS ZONE", "etcd-main 3 a", "etcd-events 1", "etcd-backup 5 c"},
+		},
+		{
+			name:     "no headers",
+			format:   OutputTypeTable,
+			options:  TableOptions{NoHeaders: true},
+			expected: []string{"etcd-main 3", "etcd-events 1", "etcd-backup 5"},
+		},
+		{
+			name:     "sort by column name",
+			format:   OutputTypeTable,
+			options:  TableOptions{SortBy: "name"},
+			expected: []string{"NAME REPLICAS", "etcd-backup 5", "etcd-events 1", "etcd-main 3"},
+		},
+		{
+			name:     "sort numerically by JSONPath expression",
+			format:   OutputTypeTable,
+			options:  TableOptions{SortBy: ".replicas"},
+			expected: []string{"NAME REPLICAS", "etcd-events 1", "etcd-main 3", "etcd-backup 5"},
+		},
+		{
+			name:     "custom columns",
+			format:   "custom-columns=ZONE:.zone,ETCD:{.name}",
+			expected: []string{"ZONE ETCD", "a etcd-main", "<none> etcd-events", "c etcd-backup"},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			formatter, err := NewFormatter(tt.format, tt.options)
+			if err != nil {
+				t.Fatalf("Failed to create formatter: %v", err)
+			}
+			output, err := formatter.Print(testData)
+			if err != nil {
+				t.Fatalf("Failed to print table: %v", err)
+			}
+			lines := strings.Split(string(output), "\n")
+			if len(lines) != len(tt.expected) {
+				t.Fatalf("Expected %d lines, got %d:\n%s", len(tt.expected), len(lines), output)
+			}
+			for i, line := range lines {
+				if got := strings.Join(strings.Fields(line), " "); got != tt.expected[i] {
+					t.Errorf("Expected line %d to be %q, got %q", i, tt.expected[i], got)
+				}
+			}
+		})
+	}
+}
+
+func TestTableFormatterErrors(t *testing.T) {
+	if _, err := NewFormatter("custom-columns=NAME", TableOptions{}); err == nil {
+		t.Error("Expected an error for a custom column without a field spec")
+	}
+	if _, err := NewFormatter("custom-columns=", TableOptions{}); err == nil {
+		t.Error("Expected an error for an empty custom columns spec")
+	}
+
+	formatter, err := NewFormatter(OutputTypeTable, TableOptions{SortBy: "UNKNOWN"})
+	if err != nil {
+		t.Fatalf("Failed to create formatter: %v", err)
+	}
+	if _, err = formatter.Print(testData); err == nil {
+		t.Error("Expected an error when sorting by an unknown column")
+	}
+	if _, err = formatter.Print(map[string]string{"name": "etcd-main"}); err == nil {
+		t.Error("Expected an error for data which cannot be converted into a table")
+	}
+}
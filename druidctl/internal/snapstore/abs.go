@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+)
+
+// defaultABSDomain is the domain of Azure Blob Storage used if the backup secret does not configure one.
+const defaultABSDomain = "blob.core.windows.net"
+
+// absStore reads the blobs of a container of Azure Blob Storage.
+type absStore struct {
+	client    *azblob.Client
+	container string
+}
+
+// newABSStore creates the client of Azure Blob Storage, which authenticates with the shared key of the storage account
+// in the backup secret.
+func newABSStore(config Config) (objectStore, error) {
+	storageAccount, err := requiredCredential(config, "storageAccount")
+	if err != nil {
+		return nil, err
+	}
+	storageKey, err := requiredCredential(config, "storageKey")
+	if err != nil {
+		return nil, err
+	}
+	emulatorEnabled, err := optionalBoolCredential(config, "emulatorEnabled")
+	if err != nil {
+		return nil, err
+	}
+	domain := defaultABSDomain
+	if d := string(config.Credentials["domain"]); d != "" {
+		domain = d
+	}
+
+	serviceURL := fmt.Sprintf("https://%s.%s", storageAccount, domain)
+	if emulatorEnabled {
+		// The emulator addresses the storage account in the path.
+		serviceURL = fmt.Sprintf("http://%s/%s", domain, storageAccount)
+	}
+	if config.EndpointOverride != "" {
+		serviceURL = config.EndpointOverride
+	}
+
+	credential, err := azblob.NewSharedKeyCredential(storageAccount, storageKey)
+	if err != nil {
+		return nil, err
+	}
+	client, err := azblob.NewClientWithSharedKeyCredential(serviceURL, credential, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &absStore{client: client, container: config.Container}, nil
+}
+
+func (s *absStore) listObjects(ctx context.Context, prefix string) ([]object, error) {
+	var objects []object
+	pager := s.client.NewListBlobsFlatPager(s.container, &azblob.ListBlobsFlatOptions{Prefix: to.Ptr(prefix)})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, blob := range page.Segment.BlobItems {
+			var size int64
+			if blob.Properties != nil && blob.Properties.ContentLength != nil {
+				size = *blob.Properties.ContentLength
+			}
+			objects = append(objects, object{key: *blob.Name, size: size})
+		}
+	}
+	return objects, nil
+}
+
+func (s *absStore) openObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.client.DownloadStream(ctx, s.container, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// gcsStore reads the objects of a bucket of Google Cloud Storage.
+type gcsStore struct {
+	bucket *storage.BucketHandle
+}
+
+// newGCSStore creates the client of Google Cloud Storage. It authenticates with the service account in the
+// serviceaccount.json key of the backup secret, unless the storage emulator is enabled.
+func newGCSStore(ctx context.Context, config Config) (objectStore, error) {
+	emulatorEnabled, err := optionalBoolCredential(config, "emulatorEnabled")
+	if err != nil {
+		return nil, err
+	}
+	var opts []option.ClientOption
+	if emulatorEnabled {
+		opts = append(opts, option.WithoutAuthentication())
+	} else {
+		serviceAccount, err := requiredCredential(config, "serviceaccount.json")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, option.WithAuthCredentialsJSON(option.ServiceAccount, []byte(serviceAccount)))
+	}
+	endpoint := string(config.Credentials["storageAPIEndpoint"])
+	if config.EndpointOverride != "" {
+		endpoint = config.EndpointOverride
+	}
+	if endpoint != "" {
+		opts = append(opts, option.WithEndpoint(endpoint))
+	}
+
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &gcsStore{bucket: client.Bucket(config.Container)}, nil
+}
+
+func (s *gcsStore) listObjects(ctx context.Context, prefix string) ([]object, error) {
+	var objects []object
+	it := s.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, object{key: attrs.Name, size: attrs.Size})
+	}
+}
+
+func (s *gcsStore) openObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.bucket.Object(key).NewReader(ctx)
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// localSnapStore reads snapshots from a directory, as written by the Local provider of etcd-backup-restore.
+type localSnapStore struct {
+	dir string
+}
+
+// NewLocalSnapStore creates a SnapStore which reads the snapshots below the given directory.
+func NewLocalSnapStore(dir string) SnapStore {
+	return &localSnapStore{dir: dir}
+}
+
+// List walks the directory and returns every file whose name is a valid snapshot name. Files which are not
+// snapshots, e.g. temporary files of snapshots which are being uploaded, are skipped.
+func (s *localSnapStore) List(ctx context.Context) ([]Snapshot, error) {
+	var snapshots []Snapshot
+	err := filepath.WalkDir(s.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		snapshot, parseErr := ParseSnapshotName(entry.Name())
+		if parseErr != nil {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		snapshot.Path = path
+		snapshot.Size = info.Size()
+		snapshots = append(snapshots, *snapshot)
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("backup directory %q does not exist", s.dir)
+		}
+		return nil, fmt.Errorf("failed to list snapshots in %q: %w", s.dir, err)
+	}
+	SortSnapshots(snapshots)
+	return snapshots, nil
+}
+
+// Open opens the file of the given snapshot.
+func (s *localSnapStore) Open(_ context.Context, snapshot Snapshot) (io.ReadCloser, error) {
+	return os.Open(snapshot.Path)
+}
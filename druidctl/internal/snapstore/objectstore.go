@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// object is an object in the container of an object store.
+type object struct {
+	key  string
+	size int64
+}
+
+// objectStore is the part of the client of an object store which is needed to read snapshots.
+type objectStore interface {
+	// listObjects returns all objects in the container whose key starts with the given prefix.
+	listObjects(ctx context.Context, prefix string) ([]object, error)
+	// openObject returns a reader for the content of the object with the given key.
+	openObject(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// objectSnapStore reads snapshots from the container of an object store, as written by etcd-backup-restore below the
+// prefix of the backup store.
+type objectSnapStore struct {
+	store  objectStore
+	prefix string
+}
+
+// List returns every object below the prefix whose name is a valid snapshot name. Other objects, e.g. the chunks of
+// snapshots which are being uploaded, are skipped.
+func (s *objectSnapStore) List(ctx context.Context) ([]Snapshot, error) {
+	prefix := s.prefix
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	objects, err := s.store.listObjects(ctx, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots below %q: %w", prefix, err)
+	}
+	var snapshots []Snapshot
+	for _, obj := range objects {
+		snapshot, err := ParseSnapshotName(path.Base(obj.key))
+		if err != nil {
+			continue
+		}
+		snapshot.Path = obj.key
+		snapshot.Size = obj.size
+		snapshots = append(snapshots, *snapshot)
+	}
+	SortSnapshots(snapshots)
+	return snapshots, nil
+}
+
+// Open opens the object of the given snapshot.
+func (s *objectSnapStore) Open(ctx context.Context, snapshot Snapshot) (io.ReadCloser, error) {
+	return s.store.openObject(ctx, snapshot.Path)
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"context"
+	"io"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+// ossStore reads the objects of a bucket of Alibaba Cloud Object Storage Service.
+type ossStore struct {
+	bucket *oss.Bucket
+}
+
+// newOSSStore creates the client of Alibaba Cloud Object Storage Service for the endpoint in the backup secret.
+func newOSSStore(config Config) (objectStore, error) {
+	endpoint, err := requiredCredential(config, "storageEndpoint")
+	if err != nil {
+		return nil, err
+	}
+	if config.EndpointOverride != "" {
+		endpoint = config.EndpointOverride
+	}
+	accessKeyID, err := requiredCredential(config, "accessKeyID")
+	if err != nil {
+		return nil, err
+	}
+	accessKeySecret, err := requiredCredential(config, "accessKeySecret")
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := oss.New(endpoint, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := client.Bucket(config.Container)
+	if err != nil {
+		return nil, err
+	}
+	return &ossStore{bucket: bucket}, nil
+}
+
+func (s *ossStore) listObjects(ctx context.Context, prefix string) ([]object, error) {
+	var objects []object
+	continuationToken := ""
+	for {
+		result, err := s.bucket.ListObjectsV2(oss.WithContext(ctx), oss.Prefix(prefix), oss.ContinuationToken(continuationToken))
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range result.Objects {
+			objects = append(objects, object{key: obj.Key, size: obj.Size})
+		}
+		if !result.IsTruncated {
+			return objects, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+func (s *ossStore) openObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.bucket.GetObject(key, oss.WithContext(ctx))
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// defaultS3CompatibleRegion is the region used for S3 compatible stores whose backup secret does not configure one.
+const defaultS3CompatibleRegion = "us-east-1"
+
+// s3Store reads the objects of a bucket of AWS S3 or of an S3 compatible store, e.g. the ECS and OCS providers.
+type s3Store struct {
+	client *s3.Client
+	bucket string
+}
+
+// s3Options are the options of an S3 client, as read from the backup secret.
+type s3Options struct {
+	accessKeyID        string
+	secretAccessKey    string
+	region             string
+	endpoint           string
+	forcePathStyle     bool
+	disableSSL         bool
+	insecureSkipVerify bool
+	trustedCACert      string
+}
+
+// newS3Store creates the client of the S3 compatible store of the given provider.
+func newS3Store(config Config) (objectStore, error) {
+	opts, err := getS3Options(config)
+	if err != nil {
+		return nil, err
+	}
+
+	awsConfig := aws.Config{
+		Region:      opts.region,
+		Credentials: credentials.NewStaticCredentialsProvider(opts.accessKeyID, opts.secretAccessKey, ""),
+	}
+	if opts.insecureSkipVerify || opts.trustedCACert != "" {
+		tlsConfig := &tls.Config{InsecureSkipVerify: opts.insecureSkipVerify} // #nosec G402 -- explicitly configured in the backup secret.
+		if opts.trustedCACert != "" {
+			certPool := x509.NewCertPool()
+			if !certPool.AppendCertsFromPEM([]byte(opts.trustedCACert)) {
+				return nil, errors.New("the trusted CA certificate of the backup secret is not a valid PEM certificate")
+			}
+			tlsConfig.RootCAs = certPool
+		}
+		awsConfig.HTTPClient = awshttp.NewBuildableClient().WithTransportOptions(func(transport *http.Transport) {
+			transport.TLSClientConfig = tlsConfig
+		})
+	}
+	client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+		if opts.endpoint != "" {
+			o.BaseEndpoint = aws.String(withScheme(opts.endpoint, opts.disableSSL))
+		}
+		o.UsePathStyle = opts.forcePathStyle
+	})
+	return &s3Store{client: client, bucket: config.Container}, nil
+}
+
+// getS3Options reads the options of the S3 client from the backup secret. AWS S3 requires the region, the ECS and OCS
+// providers require the endpoint of the store, which is always addressed in path style.
+func getS3Options(config Config) (s3Options, error) {
+	var (
+		opts s3Options
+		err  error
+	)
+	if opts.accessKeyID, err = requiredCredential(config, "accessKeyID"); err != nil {
+		return opts, err
+	}
+	if opts.secretAccessKey, err = requiredCredential(config, "secretAccessKey"); err != nil {
+		return opts, err
+	}
+	if opts.insecureSkipVerify, err = optionalBoolCredential(config, "insecureSkipVerify"); err != nil {
+		return opts, err
+	}
+
+	switch config.Provider {
+	case druidresources.S3:
+		if opts.region, err = requiredCredential(config, "region"); err != nil {
+			return opts, err
+		}
+		opts.endpoint = string(config.Credentials["endpoint"])
+		opts.trustedCACert = string(config.Credentials["trustedCaCert"])
+		if opts.forcePathStyle, err = optionalBoolCredential(config, "s3ForcePathStyle"); err != nil {
+			return opts, err
+		}
+	case druidresources.ECS:
+		if opts.endpoint, err = requiredCredential(config, "endpoint"); err != nil {
+			return opts, err
+		}
+		opts.forcePathStyle = true
+		if opts.disableSSL, err = optionalBoolCredential(config, "disableSsl"); err != nil {
+			return opts, err
+		}
+	case druidresources.OCS:
+		if opts.endpoint, err = requiredCredential(config, "endpoint"); err != nil {
+			return opts, err
+		}
+		opts.region = string(config.Credentials["region"])
+		opts.forcePathStyle = true
+		if opts.disableSSL, err = optionalBoolCredential(config, "disableSSL"); err != nil {
+			return opts, err
+		}
+	}
+	if opts.region == "" {
+		opts.region = defaultS3CompatibleRegion
+	}
+	if config.EndpointOverride != "" {
+		opts.endpoint = config.EndpointOverride
+	}
+	return opts, nil
+}
+
+// withScheme prefixes the given endpoint with https://, or http:// if SSL is disabled, unless it has a scheme.
+func withScheme(endpoint string, disableSSL bool) string {
+	if strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+	if disableSSL {
+		return "http://" + endpoint
+	}
+	return "https://" + endpoint
+}
+
+func (s *s3Store) listObjects(ctx context.Context, prefix string) ([]object, error) {
+	var objects []object
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{Bucket: aws.String(s.bucket), Prefix: aws.String(prefix)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range page.Contents {
+			objects = append(objects, object{key: aws.ToString(obj.Key), size: aws.ToInt64(obj.Size)})
+		}
+	}
+	return objects, nil
+}
+
+func (s *s3Store) openObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(s.bucket), Key: aws.String(key)})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
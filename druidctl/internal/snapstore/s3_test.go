@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+)
+
+// newTestS3Server returns a server which serves the given objects of the bucket test-bucket in path style, as an S3
+// compatible store does.
+func newTestS3Server(t *testing.T, objects map[string]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if bucket != "test-bucket" {
+			http.Error(w, "NoSuchBucket", http.StatusNotFound)
+			return
+		}
+		if key == "" && r.URL.Query().Get("list-type") == "2" {
+			prefix := r.URL.Query().Get("prefix")
+			var contents strings.Builder
+			for name, content := range objects {
+				if strings.HasPrefix(name, prefix) {
+					fmt.Fprintf(&contents, "<Contents><Key>%s</Key><Size>%d</Size></Contents>", name, len(content))
+				}
+			}
+			w.Header().Set("Content-Type", "application/xml")
+			fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>test-bucket</Name><Prefix>%s</Prefix><IsTruncated>false</IsTruncated>%s</ListBucketResult>`, prefix, contents.String())
+			return
+		}
+		content, ok := objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, content)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestS3SnapStore(t *testing.T) {
+	server := newTestS3Server(t, map[string]string{
+		"test-prefix/v2/Full-00000000-00000042-1700000000.gz":           "full",
+		"test-prefix/v2/Incr-00000043-00000050-1700000060.gz":           "delta",
+		"test-prefix/v2/Full-00000000-00000050-1700000120.gz/chunk0001": "partial upload",
+		"other-prefix/v2/Full-00000000-00000010-1700000000.gz":          "other store",
+	})
+
+	store, err := New(context.Background(), Config{
+		Provider:  druidresources.S3,
+		Container: "test-bucket",
+		Prefix:    "test-prefix",
+		Credentials: map[string][]byte{
+			"accessKeyID":      []byte("test-access-key-id"),
+			"secretAccessKey":  []byte("test-secret-access-key"),
+			"region":           []byte("eu-west-1"),
+			"endpoint":         []byte(server.URL),
+			"s3ForcePathStyle": []byte("true"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create snapstore: %v", err)
+	}
+	snapshots, err := store.List(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to list snapshots: %v", err)
+	}
+	if len(snapshots) != 2 || snapshots[0].Kind != SnapshotKindFull || snapshots[1].Kind != SnapshotKindDelta {
+		t.Fatalf("Expected a full and a delta snapshot, got %+v", snapshots)
+	}
+	if snapshots[1].Path != "test-prefix/v2/Incr-00000043-00000050-1700000060.gz" || snapshots[1].Size != int64(len("delta")) {
+		t.Errorf("Expected the key and size of the delta snapshot, got %+v", snapshots[1])
+	}
+
+	reader, err := store.Open(context.Background(), snapshots[1])
+	if err != nil {
+		t.Fatalf("Failed to open snapshot: %v", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil || string(content) != "delta" {
+		t.Errorf("Expected content %q, got %q (error: %v)", "delta", content, err)
+	}
+}
+
+func TestGetS3Options(t *testing.T) {
+	credentials := map[string][]byte{
+		"accessKeyID":     []byte("test-access-key-id"),
+		"secretAccessKey": []byte("test-secret-access-key"),
+		"endpoint":        []byte("ecs.example.com:9020"),
+		"disableSsl":      []byte("true"),
+	}
+	tests := []struct {
+		name             string
+		config           Config
+		expectedEndpoint string
+		expectedRegion   string
+		expectedError    string
+	}{
+		{"S3 requires a region", Config{Provider: druidresources.S3, Credentials: credentials}, "", "", `"region"`},
+		{"ECS store", Config{Provider: druidresources.ECS, Credentials: credentials}, "http://ecs.example.com:9020", defaultS3CompatibleRegion, ""},
+		{"endpoint override", Config{Provider: druidresources.ECS, Credentials: credentials, EndpointOverride: "https://override.example.com"}, "https://override.example.com", defaultS3CompatibleRegion, ""},
+		{"OCS requires an endpoint", Config{Provider: druidresources.OCS, Credentials: map[string][]byte{"accessKeyID": []byte("id"), "secretAccessKey": []byte("secret")}}, "", "", `"endpoint"`},
+		{"invalid boolean", Config{Provider: druidresources.ECS, Credentials: map[string][]byte{"accessKeyID": []byte("id"), "secretAccessKey": []byte("secret"), "endpoint": []byte("ecs"), "disableSsl": []byte("maybe")}}, "", "", "not a boolean"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := getS3Options(tt.config)
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if endpoint := withScheme(opts.endpoint, opts.disableSSL); endpoint != tt.expectedEndpoint || opts.region != tt.expectedRegion || !opts.forcePathStyle {
+				t.Errorf("Expected endpoint %q and region %q in path style, got %+v", tt.expectedEndpoint, tt.expectedRegion, opts)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SnapshotKindFull is the kind of full snapshots.
+	SnapshotKindFull = "Full"
+	// SnapshotKindDelta is the kind of delta (incremental) snapshots.
+	SnapshotKindDelta = "Incr"
+
+	finalSuffix = "-final"
+)
+
+// compressionSuffixes maps the file suffixes used by etcd-backup-restore to the compression policy.
+var compressionSuffixes = map[string]string{
+	".gz":   "gzip",
+	".zlib": "zlib",
+	".lz4":  "lz4",
+}
+
+// Snapshot describes a single full or delta snapshot in a backup store.
+type Snapshot struct {
+	// Name is the name of the snapshot, e.g. Full-00000000-00000042-1700000000.gz.
+	Name string `json:"name" yaml:"name"`
+	// Path is the location of the snapshot in the backup store.
+	Path          string    `json:"path" yaml:"path"`
+	Kind          string    `json:"kind" yaml:"kind"`
+	StartRevision int64     `json:"startRevision" yaml:"startRevision"`
+	LastRevision  int64     `json:"lastRevision" yaml:"lastRevision"`
+	CreatedOn     time.Time `json:"createdOn" yaml:"createdOn"`
+	// Compression is the compression policy of the snapshot, empty if it is not compressed.
+	Compression string `json:"compression,omitempty" yaml:"compression,omitempty"`
+	IsFinal     bool   `json:"isFinal,omitempty" yaml:"isFinal,omitempty"`
+	Size        int64  `json:"size" yaml:"size"`
+}
+
+// ParseSnapshotName parses a snapshot name of the form <kind>-<start-revision>-<last-revision>-<unix-timestamp>,
+// optionally followed by a compression suffix and the final suffix, as written by etcd-backup-restore.
+func ParseSnapshotName(name string) (*Snapshot, error) {
+	snapshot := &Snapshot{Name: name}
+	remainder := name
+	// the final and compression suffixes can appear in either order
+	for range 2 {
+		if trimmed, ok := strings.CutSuffix(remainder, finalSuffix); ok {
+			snapshot.IsFinal = true
+			remainder = trimmed
+		}
+		for suffix, compression := range compressionSuffixes {
+			if trimmed, ok := strings.CutSuffix(remainder, suffix); ok {
+				snapshot.Compression = compression
+				remainder = trimmed
+			}
+		}
+	}
+
+	tokens := strings.Split(remainder, "-")
+	if len(tokens) != 4 {
+		return nil, fmt.Errorf("invalid snapshot name %q: expected <kind>-<start-revision>-<last-revision>-<timestamp>", name)
+	}
+	switch tokens[0] {
+	case SnapshotKindFull, SnapshotKindDelta:
+		snapshot.Kind = tokens[0]
+	default:
+		return nil, fmt.Errorf("invalid snapshot name %q: unknown snapshot kind %q", name, tokens[0])
+	}
+	var err error
+	if snapshot.StartRevision, err = strconv.ParseInt(tokens[1], 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid snapshot name %q: invalid start revision: %w", name, err)
+	}
+	if snapshot.LastRevision, err = strconv.ParseInt(tokens[2], 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid snapshot name %q: invalid last revision: %w", name, err)
+	}
+	if snapshot.StartRevision > snapshot.LastRevision {
+		return nil, fmt.Errorf("invalid snapshot name %q: start revision is greater than last revision", name)
+	}
+	createdOn, err := strconv.ParseInt(tokens[3], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot name %q: invalid timestamp: %w", name, err)
+	}
+	snapshot.CreatedOn = time.Unix(createdOn, 0).UTC()
+	return snapshot, nil
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+)
+
+func TestParseSnapshotName(t *testing.T) {
+	tests := []struct {
+		name        string
+		expected    *Snapshot
+		expectError bool
+	}{
+		{
+			name:     "Full-00000000-00000042-1700000000",
+			expected: &Snapshot{Kind: SnapshotKindFull, StartRevision: 0, LastRevision: 42, CreatedOn: time.Unix(1700000000, 0).UTC()},
+		},
+		{
+			name:     "Incr-00000043-00000050-1700000060.gz",
+			expected: &Snapshot{Kind: SnapshotKindDelta, StartRevision: 43, LastRevision: 50, CreatedOn: time.Unix(1700000060, 0).UTC(), Compression: "gzip"},
+		},
+		{
+			name:     "Full-00000000-00000050-1700000120.gz-final",
+			expected: &Snapshot{Kind: SnapshotKindFull, LastRevision: 50, CreatedOn: time.Unix(1700000120, 0).UTC(), Compression: "gzip", IsFinal: true},
+		},
+		{
+			name:     "Full-00000000-00000050-1700000120-final.lz4",
+			expected: &Snapshot{Kind: SnapshotKindFull, LastRevision: 50, CreatedOn: time.Unix(1700000120, 0).UTC(), Compression: "lz4", IsFinal: true},
+		},
+		{name: "Backup-00000000-00000042-1700000000", expectError: true},
+		{name: "Full-00000000-00000042", expectError: true},
+		{name: "Full-00000050-00000042-1700000000", expectError: true},
+		{name: "Full-00000000-00000042-1700000000.tmp", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot, err := ParseSnapshotName(tt.name)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected an error, got %+v", snapshot)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to parse snapshot name: %v", err)
+			}
+			tt.expected.Name = tt.name
+			if *snapshot != *tt.expected {
+				t.Errorf("Expected %+v, got %+v", *tt.expected, *snapshot)
+			}
+		})
+	}
+}
+
+func TestLocalSnapStore(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"v2/Incr-00000043-00000050-1700000060.gz":  "delta",
+		"v2/Full-00000000-00000042-1700000000.gz":  "full",
+		"v2/Full-00000000-00000042-1700000000.tmp": "partial upload",
+		"v2/README": "not a snapshot",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	store, err := New(context.Background(), Config{Provider: druidresources.Local, Location: dir})
+	if err != nil {
+		t.Fatalf("Failed to create snapstore: %v", err)
+	}
+	snapshots, err := store.List(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to list snapshots: %v", err)
+	}
+	if len(snapshots) != 2 || snapshots[0].Kind != SnapshotKindFull || snapshots[1].Kind != SnapshotKindDelta {
+		t.Fatalf("Expected a full and a delta snapshot, got %+v", snapshots)
+	}
+	if snapshots[0].Size != int64(len("full")) {
+		t.Errorf("Expected size %d, got %d", len("full"), snapshots[0].Size)
+	}
+
+	reader, err := store.Open(context.Background(), snapshots[1])
+	if err != nil {
+		t.Fatalf("Failed to open snapshot: %v", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil || string(content) != "delta" {
+		t.Errorf("Expected content %q, got %q (error: %v)", "delta", content, err)
+	}
+
+	if _, err = NewLocalSnapStore(filepath.Join(dir, "missing")).List(context.Background()); err == nil {
+		t.Error("Expected an error for a missing directory")
+	}
+	if _, err = New(context.Background(), Config{Provider: "Unknown"}); err == nil {
+		t.Error("Expected an error for an unsupported provider")
+	}
+}
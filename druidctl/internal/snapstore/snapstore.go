@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+)
+
+// SnapStore gives read access to the snapshots in a backup store.
+type SnapStore interface {
+	// List returns all snapshots in the store sorted by their last revision.
+	List(ctx context.Context) ([]Snapshot, error)
+	// Open returns a reader for the content of the given snapshot. The caller must close it.
+	Open(ctx context.Context, snapshot Snapshot) (io.ReadCloser, error)
+}
+
+// Config configures access to a backup store.
+type Config struct {
+	// Provider is the normalized name of the storage provider, e.g. S3 or Local.
+	Provider string
+	// Container is the bucket or container of the backup store.
+	Container string
+	// Prefix is the prefix of the snapshots in the container.
+	Prefix string
+	// Location is the directory of the snapshots for the Local provider.
+	Location string
+	// EndpointOverride overrides the endpoint of the object store, if set.
+	EndpointOverride string
+	// Credentials is the data of the backup secret, in the format in which etcd-backup-restore reads it.
+	Credentials map[string][]byte
+}
+
+// New creates a SnapStore for the given configuration.
+func New(ctx context.Context, config Config) (SnapStore, error) {
+	var (
+		store objectStore
+		err   error
+	)
+	switch config.Provider {
+	case druidresources.Local:
+		return NewLocalSnapStore(config.Location), nil
+	case druidresources.S3, druidresources.ECS, druidresources.OCS:
+		store, err = newS3Store(config)
+	case druidresources.GCS:
+		store, err = newGCSStore(ctx, config)
+	case druidresources.ABS:
+		store, err = newABSStore(config)
+	case druidresources.Swift:
+		store, err = newSwiftStore(ctx, config)
+	case druidresources.OSS:
+		store, err = newOSSStore(config)
+	default:
+		return nil, fmt.Errorf("storage provider %q is not supported by druidctl", config.Provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the client of the %s backup store: %w", config.Provider, err)
+	}
+	return &objectSnapStore{store: store, prefix: config.Prefix}, nil
+}
+
+// SortSnapshots sorts the snapshots by their last revision, full snapshots first for equal revisions.
+func SortSnapshots(snapshots []Snapshot) {
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if snapshots[i].LastRevision != snapshots[j].LastRevision {
+			return snapshots[i].LastRevision < snapshots[j].LastRevision
+		}
+		if snapshots[i].Kind != snapshots[j].Kind {
+			return snapshots[i].Kind == SnapshotKindFull
+		}
+		return snapshots[i].CreatedOn.Before(snapshots[j].CreatedOn)
+	})
+}
+
+// requiredCredential returns the value of the given key of the backup secret, or an error if it is not set.
+func requiredCredential(config Config, key string) (string, error) {
+	value := string(config.Credentials[key])
+	if value == "" {
+		return "", fmt.Errorf("the backup secret does not contain the key %q", key)
+	}
+	return value, nil
+}
+
+// optionalBoolCredential returns the boolean value of the given key of the backup secret, false if it is not set.
+func optionalBoolCredential(config Config, key string) (bool, error) {
+	value, ok := config.Credentials[key]
+	if !ok {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(string(value))
+	if err != nil {
+		return false, fmt.Errorf("the key %q of the backup secret is not a boolean: %w", key, err)
+	}
+	return b, nil
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapstore
+
+import (
+	"context"
+	"io"
+
+	"github.com/ncw/swift/v2"
+)
+
+// swiftStore reads the objects of a container of OpenStack Swift.
+type swiftStore struct {
+	conn      *swift.Connection
+	container string
+}
+
+// newSwiftStore creates and authenticates the connection to OpenStack Swift. The backup secret either contains the
+// credentials of a user or an application credential.
+func newSwiftStore(ctx context.Context, config Config) (objectStore, error) {
+	authURL, err := requiredCredential(config, "authURL")
+	if err != nil {
+		return nil, err
+	}
+	conn := &swift.Connection{
+		AuthUrl:                     authURL,
+		Domain:                      string(config.Credentials["domainName"]),
+		Tenant:                      string(config.Credentials["tenantName"]),
+		Region:                      string(config.Credentials["region"]),
+		UserName:                    string(config.Credentials["username"]),
+		ApiKey:                      string(config.Credentials["password"]),
+		ApplicationCredentialId:     string(config.Credentials["applicationCredentialID"]),
+		ApplicationCredentialName:   string(config.Credentials["applicationCredentialName"]),
+		ApplicationCredentialSecret: string(config.Credentials["applicationCredentialSecret"]),
+	}
+	if conn.ApplicationCredentialSecret == "" {
+		if _, err := requiredCredential(config, "username"); err != nil {
+			return nil, err
+		}
+		if _, err := requiredCredential(config, "password"); err != nil {
+			return nil, err
+		}
+	}
+	if err := conn.Authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return &swiftStore{conn: conn, container: config.Container}, nil
+}
+
+// listObjects lists the objects below the prefix. Snapshots uploaded in segments are stored as manifests, which are
+// listed without size, so their size is looked up.
+func (s *swiftStore) listObjects(ctx context.Context, prefix string) ([]object, error) {
+	swiftObjects, err := s.conn.ObjectsAll(ctx, s.container, &swift.ObjectsOpts{Prefix: prefix})
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]object, 0, len(swiftObjects))
+	for _, obj := range swiftObjects {
+		size := obj.Bytes
+		if size == 0 {
+			info, _, err := s.conn.Object(ctx, s.container, obj.Name)
+			if err != nil {
+				return nil, err
+			}
+			size = info.Bytes
+		}
+		objects = append(objects, object{key: obj.Name, size: size})
+	}
+	return objects, nil
+}
+
+func (s *swiftStore) openObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, _, err := s.conn.ObjectOpen(ctx, s.container, key, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
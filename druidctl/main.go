@@ -8,10 +8,11 @@ import (
 	"os"
 
 	"github.com/gardener/etcd-druid/druidctl/cmd"
+	cmdutils "github.com/gardener/etcd-druid/druidctl/cmd/utils"
 )
 
 func main() {
 	if err := cmd.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(cmdutils.ExitCode(err))
 	}
 }
@@ -9,16 +9,13 @@ import (
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
-	"github.com/gardener/etcd-druid/internal/utils"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -72,7 +69,7 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 	objectKey := getObjectKey(etcd.ObjectMeta)
 	svc := emptyClientService(objectKey)
 	result, err := controllerutil.CreateOrPatch(ctx, r.client, svc, func() error {
-		buildResource(etcd, svc)
+		druidresources.BuildClientService(etcd, svc)
 		return nil
 	})
 	if err != nil {
@@ -106,19 +103,6 @@ func (r _resource) TriggerDelete(ctx component.OperatorContext, etcdObjMeta meta
 	return nil
 }
 
-func buildResource(etcd *druidv1alpha1.Etcd, svc *corev1.Service) {
-	svc.Labels = getLabels(etcd)
-	svc.Annotations = getAnnotations(etcd)
-	svc.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
-	svc.Spec.Type = corev1.ServiceTypeClusterIP
-	svc.Spec.SessionAffinity = corev1.ServiceAffinityNone
-	// Client service should only target StatefulSet pods. Default labels are going to be present on anything that is managed by etcd-druid and started for an etcd cluster.
-	// Therefore, only using default labels as label selector can cause issues as we have already seen in https://github.com/gardener/etcd-druid/issues/914
-	svc.Spec.Selector = utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), map[string]string{druidv1alpha1.LabelComponentKey: common.ComponentNameStatefulSet})
-	svc.Spec.Ports = getPorts(etcd)
-	svc.Spec.TrafficDistribution = getTrafficDistribution(etcd)
-}
-
 func getObjectKey(obj metav1.ObjectMeta) client.ObjectKey {
 	return client.ObjectKey{
 		Name:      druidv1alpha1.GetClientServiceName(obj),
@@ -126,33 +110,6 @@ func getObjectKey(obj metav1.ObjectMeta) client.ObjectKey {
 	}
 }
 
-func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
-	clientSvcLabels := map[string]string{
-		druidv1alpha1.LabelAppNameKey:   druidv1alpha1.GetClientServiceName(etcd.ObjectMeta),
-		druidv1alpha1.LabelComponentKey: common.ComponentNameClientService,
-	}
-	// Add any client service labels as defined in the etcd resource
-	specClientSvcLabels := map[string]string{}
-	if etcd.Spec.Etcd.ClientService != nil && etcd.Spec.Etcd.ClientService.Labels != nil {
-		specClientSvcLabels = etcd.Spec.Etcd.ClientService.Labels
-	}
-	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), clientSvcLabels, specClientSvcLabels)
-}
-
-func getAnnotations(etcd *druidv1alpha1.Etcd) map[string]string {
-	if etcd.Spec.Etcd.ClientService != nil {
-		return etcd.Spec.Etcd.ClientService.Annotations
-	}
-	return nil
-}
-
-func getTrafficDistribution(etcd *druidv1alpha1.Etcd) *string {
-	if etcd.Spec.Etcd.ClientService != nil {
-		return etcd.Spec.Etcd.ClientService.TrafficDistribution
-	}
-	return nil
-}
-
 func emptyClientService(objectKey client.ObjectKey) *corev1.Service {
 	return &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
@@ -161,31 +118,3 @@ func emptyClientService(objectKey client.ObjectKey) *corev1.Service {
 		},
 	}
 }
-
-func getPorts(etcd *druidv1alpha1.Etcd) []corev1.ServicePort {
-	backupPort := ptr.Deref(etcd.Spec.Backup.Port, common.DefaultPortEtcdBackupRestore)
-	clientPort := ptr.Deref(etcd.Spec.Etcd.ClientPort, common.DefaultPortEtcdClient)
-	peerPort := ptr.Deref(etcd.Spec.Etcd.ServerPort, common.DefaultPortEtcdPeer)
-
-	return []corev1.ServicePort{
-		{
-			Name:       "client",
-			Protocol:   corev1.ProtocolTCP,
-			Port:       clientPort,
-			TargetPort: intstr.FromInt(int(clientPort)),
-		},
-		// TODO: Remove the "server" port in a future release
-		{
-			Name:       "server",
-			Protocol:   corev1.ProtocolTCP,
-			Port:       peerPort,
-			TargetPort: intstr.FromInt(int(peerPort)),
-		},
-		{
-			Name:       "backuprestore",
-			Protocol:   corev1.ProtocolTCP,
-			Port:       backupPort,
-			TargetPort: intstr.FromInt(int(backupPort)),
-		},
-	}
-}
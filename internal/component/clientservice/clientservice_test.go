@@ -9,7 +9,7 @@ import (
 	"testing"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
 	"github.com/gardener/etcd-druid/internal/utils"
@@ -283,9 +283,9 @@ func buildEtcd(clientPort, peerPort, backupPort, wrapperPort *int32, trafficDist
 }
 
 func matchClientService(g *WithT, etcd *druidv1alpha1.Etcd, actualSvc corev1.Service) {
-	clientPort := ptr.Deref(etcd.Spec.Etcd.ClientPort, common.DefaultPortEtcdClient)
-	backupPort := ptr.Deref(etcd.Spec.Backup.Port, common.DefaultPortEtcdBackupRestore)
-	peerPort := ptr.Deref(etcd.Spec.Etcd.ServerPort, common.DefaultPortEtcdPeer)
+	clientPort := ptr.Deref(etcd.Spec.Etcd.ClientPort, druidv1alpha1.DefaultPortEtcdClient)
+	backupPort := ptr.Deref(etcd.Spec.Backup.Port, druidv1alpha1.DefaultPortEtcdBackupRestore)
+	peerPort := ptr.Deref(etcd.Spec.Etcd.ServerPort, druidv1alpha1.DefaultPortEtcdPeer)
 	etcdObjMeta := etcd.ObjectMeta
 	expectedLabels := druidv1alpha1.GetDefaultLabels(etcdObjMeta)
 	var expectedAnnotations map[string]string
@@ -295,7 +295,7 @@ func matchClientService(g *WithT, etcd *druidv1alpha1.Etcd, actualSvc corev1.Ser
 		expectedLabels = utils.MergeMaps(etcd.Spec.Etcd.ClientService.Labels, druidv1alpha1.GetDefaultLabels(etcdObjMeta))
 		expectedTrafficDistribution = etcd.Spec.Etcd.ClientService.TrafficDistribution
 	}
-	expectedLabelSelector := utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcdObjMeta), map[string]string{druidv1alpha1.LabelComponentKey: common.ComponentNameStatefulSet})
+	expectedLabelSelector := utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcdObjMeta), map[string]string{druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameStatefulSet})
 
 	g.Expect(actualSvc).To(MatchFields(IgnoreExtras, Fields{
 		"ObjectMeta": MatchFields(IgnoreExtras, Fields{
@@ -336,7 +336,7 @@ func matchClientService(g *WithT, etcd *druidv1alpha1.Etcd, actualSvc corev1.Ser
 
 func newClientService(etcd *druidv1alpha1.Etcd) *corev1.Service {
 	svc := emptyClientService(getObjectKey(etcd.ObjectMeta))
-	buildResource(etcd, svc)
+	druidresources.BuildClientService(etcd, svc)
 	return svc
 }
 
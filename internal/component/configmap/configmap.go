@@ -5,22 +5,19 @@
 package configmap
 
 import (
-	"encoding/json"
 	"fmt"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
-	"github.com/gardener/etcd-druid/internal/utils"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -73,7 +70,7 @@ func (r _resource) PreSync(_ component.OperatorContext, _ *druidv1alpha1.Etcd) e
 func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) error {
 	cm := emptyConfigMap(getObjectKey(etcd.ObjectMeta))
 	result, err := controllerutil.CreateOrPatch(ctx, r.client, cm, func() error {
-		return buildResource(etcd, cm)
+		return druidresources.BuildConfigMap(etcd, cm)
 	})
 	if err != nil {
 		return druiderr.WrapError(err,
@@ -81,14 +78,14 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 			component.OperationSync,
 			fmt.Sprintf("Error during create or update of configmap for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
 	}
-	checkSum, err := computeCheckSum(cm)
+	checkSum, err := druidresources.ComputeConfigMapCheckSum(cm)
 	if err != nil {
 		return druiderr.WrapError(err,
 			ErrSyncConfigMap,
 			component.OperationSync,
 			fmt.Sprintf("Error when computing CheckSum for configmap for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
 	}
-	ctx.Data[common.CheckSumKeyConfigMap] = checkSum
+	ctx.Data[druidresources.CheckSumKeyConfigMap] = checkSum
 	ctx.Logger.Info("synced", "component", "configmap", "name", cm.Name, "result", result)
 	return nil
 }
@@ -113,29 +110,6 @@ func (r _resource) TriggerDelete(ctx component.OperatorContext, etcdObjMeta meta
 	return nil
 }
 
-func buildResource(etcd *druidv1alpha1.Etcd, cm *corev1.ConfigMap) error {
-	cfg := createEtcdConfig(etcd)
-	cfgYaml, err := yaml.Marshal(cfg)
-	if err != nil {
-		return err
-	}
-	cm.Name = druidv1alpha1.GetConfigMapName(etcd.ObjectMeta)
-	cm.Namespace = etcd.Namespace
-	cm.Labels = getLabels(etcd)
-	cm.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
-	cm.Data = map[string]string{common.EtcdConfigFileName: string(cfgYaml)}
-
-	return nil
-}
-
-func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
-	cmLabels := map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameConfigMap,
-		druidv1alpha1.LabelAppNameKey:   druidv1alpha1.GetConfigMapName(etcd.ObjectMeta),
-	}
-	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), cmLabels)
-}
-
 func getObjectKey(obj metav1.ObjectMeta) client.ObjectKey {
 	return client.ObjectKey{
 		Name:      druidv1alpha1.GetConfigMapName(obj),
@@ -151,11 +125,3 @@ func emptyConfigMap(objectKey client.ObjectKey) *corev1.ConfigMap {
 		},
 	}
 }
-
-func computeCheckSum(cm *corev1.ConfigMap) (string, error) {
-	jsonData, err := json.Marshal(cm.Data)
-	if err != nil {
-		return "", err
-	}
-	return utils.ComputeSHA256Hex(jsonData), nil
-}
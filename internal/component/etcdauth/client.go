@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdauth
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Permission types as defined by the etcd auth API.
+const (
+	permTypeRead      = "READ"
+	permTypeWrite     = "WRITE"
+	permTypeReadWrite = "READWRITE"
+)
+
+// errMessageAuthNotEnabled is the message of the error returned by etcd when authenticating while auth is not enabled.
+const errMessageAuthNotEnabled = "authentication is not enabled"
+
+// permission is a permission on a range of keys, as defined by the etcd auth API.
+type permission struct {
+	permType string
+	key      string
+	rangeEnd string
+}
+
+// authClient manages the auth store of an etcd cluster.
+type authClient interface {
+	// Authenticate authenticates the client as the given user for all subsequent requests. It returns false if auth is
+	// not enabled in etcd, in which case no authentication is required.
+	Authenticate(ctx context.Context, name, password string) (bool, error)
+	// AuthEnable enables auth in etcd.
+	AuthEnable(ctx context.Context) error
+	// UserList returns the names of all users.
+	UserList(ctx context.Context) ([]string, error)
+	// UserGet returns the names of the roles granted to the given user.
+	UserGet(ctx context.Context, name string) ([]string, error)
+	// UserAdd adds a user. If the password is empty, the user can only authenticate with a client certificate.
+	UserAdd(ctx context.Context, name, password string) error
+	// UserChangePassword changes the password of a user.
+	UserChangePassword(ctx context.Context, name, password string) error
+	// UserDelete deletes a user.
+	UserDelete(ctx context.Context, name string) error
+	// UserGrantRole grants a role to a user.
+	UserGrantRole(ctx context.Context, name, role string) error
+	// UserRevokeRole revokes a role from a user.
+	UserRevokeRole(ctx context.Context, name, role string) error
+	// RoleList returns the names of all roles.
+	RoleList(ctx context.Context) ([]string, error)
+	// RoleGet returns the permissions granted by the given role.
+	RoleGet(ctx context.Context, name string) ([]permission, error)
+	// RoleAdd adds a role.
+	RoleAdd(ctx context.Context, name string) error
+	// RoleDelete deletes a role.
+	RoleDelete(ctx context.Context, name string) error
+	// RoleGrantPermission grants a permission to a role.
+	RoleGrantPermission(ctx context.Context, name string, perm permission) error
+	// RoleRevokePermission revokes a permission from a role.
+	RoleRevokePermission(ctx context.Context, name string, perm permission) error
+	// Close closes the idle connections of the client.
+	Close()
+}
+
+// newAuthClientFn creates an authClient for the etcd cluster reachable at the given endpoint.
+type newAuthClientFn func(endpoint string, tlsConfig *tls.Config) authClient
+
+// gatewayClient is an authClient which uses the gRPC gateway of etcd.
+type gatewayClient struct {
+	httpClient *http.Client
+	endpoint   string
+	token      string
+}
+
+func newGatewayClient(endpoint string, tlsConfig *tls.Config) authClient {
+	return &gatewayClient{
+		httpClient: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			Timeout:   10 * time.Second,
+		},
+		endpoint: endpoint,
+	}
+}
+
+func (c *gatewayClient) Close() {
+	c.httpClient.CloseIdleConnections()
+}
+
+// permissionJSON is the JSON representation of a permission in the gRPC gateway of etcd. Keys are base64 encoded, and
+// the READ permission type is omitted as it is the default value.
+type permissionJSON struct {
+	PermType string `json:"permType,omitempty"`
+	Key      []byte `json:"key,omitempty"`
+	RangeEnd []byte `json:"range_end,omitempty"`
+}
+
+func (c *gatewayClient) Authenticate(ctx context.Context, name, password string) (bool, error) {
+	var resp struct {
+		Token string `json:"token"`
+	}
+	if err := c.post(ctx, "/v3/auth/authenticate", map[string]any{"name": name, "password": password}, &resp); err != nil {
+		if strings.Contains(err.Error(), errMessageAuthNotEnabled) {
+			return false, nil
+		}
+		return false, err
+	}
+	c.token = resp.Token
+	return true, nil
+}
+
+func (c *gatewayClient) AuthEnable(ctx context.Context) error {
+	return c.post(ctx, "/v3/auth/enable", map[string]any{}, nil)
+}
+
+func (c *gatewayClient) UserList(ctx context.Context) ([]string, error) {
+	var resp struct {
+		Users []string `json:"users"`
+	}
+	err := c.post(ctx, "/v3/auth/user/list", map[string]any{}, &resp)
+	return resp.Users, err
+}
+
+func (c *gatewayClient) UserGet(ctx context.Context, name string) ([]string, error) {
+	var resp struct {
+		Roles []string `json:"roles"`
+	}
+	err := c.post(ctx, "/v3/auth/user/get", map[string]any{"name": name}, &resp)
+	return resp.Roles, err
+}
+
+func (c *gatewayClient) UserAdd(ctx context.Context, name, password string) error {
+	return c.post(ctx, "/v3/auth/user/add", map[string]any{"name": name, "password": password, "options": map[string]any{"no_password": password == ""}}, nil)
+}
+
+func (c *gatewayClient) UserChangePassword(ctx context.Context, name, password string) error {
+	return c.post(ctx, "/v3/auth/user/changepw", map[string]any{"name": name, "password": password}, nil)
+}
+
+func (c *gatewayClient) UserDelete(ctx context.Context, name string) error {
+	return c.post(ctx, "/v3/auth/user/delete", map[string]any{"name": name}, nil)
+}
+
+func (c *gatewayClient) UserGrantRole(ctx context.Context, name, role string) error {
+	return c.post(ctx, "/v3/auth/user/grant", map[string]any{"user": name, "role": role}, nil)
+}
+
+func (c *gatewayClient) UserRevokeRole(ctx context.Context, name, role string) error {
+	return c.post(ctx, "/v3/auth/user/revoke", map[string]any{"name": name, "role": role}, nil)
+}
+
+func (c *gatewayClient) RoleList(ctx context.Context) ([]string, error) {
+	var resp struct {
+		Roles []string `json:"roles"`
+	}
+	err := c.post(ctx, "/v3/auth/role/list", map[string]any{}, &resp)
+	return resp.Roles, err
+}
+
+func (c *gatewayClient) RoleGet(ctx context.Context, name string) ([]permission, error) {
+	var resp struct {
+		Perm []permissionJSON `json:"perm"`
+	}
+	if err := c.post(ctx, "/v3/auth/role/get", map[string]any{"role": name}, &resp); err != nil {
+		return nil, err
+	}
+	perms := make([]permission, 0, len(resp.Perm))
+	for _, p := range resp.Perm {
+		permType := p.PermType
+		if permType == "" {
+			permType = permTypeRead
+		}
+		perms = append(perms, permission{permType: permType, key: string(p.Key), rangeEnd: string(p.RangeEnd)})
+	}
+	return perms, nil
+}
+
+func (c *gatewayClient) RoleAdd(ctx context.Context, name string) error {
+	return c.post(ctx, "/v3/auth/role/add", map[string]any{"name": name}, nil)
+}
+
+func (c *gatewayClient) RoleDelete(ctx context.Context, name string) error {
+	return c.post(ctx, "/v3/auth/role/delete", map[string]any{"role": name}, nil)
+}
+
+func (c *gatewayClient) RoleGrantPermission(ctx context.Context, name string, perm permission) error {
+	return c.post(ctx, "/v3/auth/role/grant", map[string]any{"name": name, "perm": permissionJSON{PermType: perm.permType, Key: []byte(perm.key), RangeEnd: []byte(perm.rangeEnd)}}, nil)
+}
+
+func (c *gatewayClient) RoleRevokePermission(ctx context.Context, name string, perm permission) error {
+	return c.post(ctx, "/v3/auth/role/revoke", map[string]any{"role": name, "key": []byte(perm.key), "range_end": []byte(perm.rangeEnd)}, nil)
+}
+
+// post sends the given request to the given path of the gRPC gateway and decodes the response into resp, if not nil.
+func (c *gatewayClient) post(ctx context.Context, path string, req any, resp any) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		httpReq.Header.Set("Authorization", c.token)
+	}
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	data, err := io.ReadAll(io.LimitReader(httpResp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Error   string `json:"error"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(data, &errResp)
+		message := errResp.Message
+		if message == "" {
+			message = errResp.Error
+		}
+		if message == "" {
+			message = string(data)
+		}
+		return fmt.Errorf("request to %s failed with status %d: %s", path, httpResp.StatusCode, message)
+	}
+	if resp == nil {
+		return nil
+	}
+	return json.Unmarshal(data, resp)
+}
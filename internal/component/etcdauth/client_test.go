@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGatewayClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should detect that auth is not enabled", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"etcdserver: authentication is not enabled","code":9,"message":"etcdserver: authentication is not enabled"}`))
+		}))
+		defer server.Close()
+
+		enabled, err := newGatewayClient(server.URL, nil).Authenticate(context.Background(), rootUser, "password")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(enabled).To(BeFalse())
+	})
+
+	t.Run("should send the token of an authenticated user and decode permissions", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		var requests []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.URL.Path)
+			switch r.URL.Path {
+			case "/v3/auth/authenticate":
+				_, _ = w.Write([]byte(`{"token":"abc"}`))
+			case "/v3/auth/role/get":
+				if r.Header.Get("Authorization") != "abc" {
+					w.WriteHeader(http.StatusUnauthorized)
+					_, _ = w.Write([]byte(`{"message":"etcdserver: user name is empty"}`))
+					return
+				}
+				var req map[string]string
+				_ = json.NewDecoder(r.Body).Decode(&req)
+				g.Expect(req).To(HaveKeyWithValue("role", "app-a"))
+				_, _ = w.Write([]byte(`{"perm":[{"key":"L2Ev","range_end":"L2Ew"},{"permType":"READWRITE","key":"L2Iv","range_end":"L2Iw"}]}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		c := newGatewayClient(server.URL, nil)
+		enabled, err := c.Authenticate(context.Background(), rootUser, "password")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(enabled).To(BeTrue())
+		perms, err := c.RoleGet(context.Background(), "app-a")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(perms).To(Equal([]permission{
+			{permType: permTypeRead, key: "/a/", rangeEnd: "/a0"},
+			{permType: permTypeReadWrite, key: "/b/", rangeEnd: "/b0"},
+		}))
+		g.Expect(requests).To(Equal([]string{"/v3/auth/authenticate", "/v3/auth/role/get"}))
+	})
+
+	t.Run("should return err with the message of a failed request", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"etcdserver: role name already exists"}`))
+		}))
+		defer server.Close()
+
+		err := newGatewayClient(server.URL, nil).RoleAdd(context.Background(), "app-a")
+		g.Expect(err).To(MatchError(ContainSubstring("role name already exists")))
+	})
+}
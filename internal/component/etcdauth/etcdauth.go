@@ -0,0 +1,525 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdauth
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	"github.com/gardener/etcd-druid/internal/utils"
+	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ErrGetEtcdAuth indicates an error in getting the secret holding the credentials of the etcd root user.
+	ErrGetEtcdAuth druidapicommon.ErrorCode = "ERR_GET_ETCD_AUTH"
+	// ErrSyncEtcdAuth indicates an error in syncing the auth store of etcd.
+	ErrSyncEtcdAuth druidapicommon.ErrorCode = "ERR_SYNC_ETCD_AUTH"
+	// ErrDeleteEtcdAuth indicates an error in deleting the secret holding the credentials of the etcd root user.
+	ErrDeleteEtcdAuth druidapicommon.ErrorCode = "ERR_DELETE_ETCD_AUTH"
+)
+
+const (
+	// rootUser is the name of the etcd user and role with full access, which is required to enable auth in etcd.
+	rootUser = "root"
+	// dataKeyUsername is the data key of the root secret holding the name of the root user.
+	dataKeyUsername = "username"
+	// dataKeyPassword is the data key of the root secret holding the password of the root user.
+	dataKeyPassword = "password"
+	// dataKeyManagedUsers is the data key of the root secret holding the names of the users created by etcd-druid.
+	dataKeyManagedUsers = "managedUsers"
+	// dataKeyManagedRoles is the data key of the root secret holding the names of the roles created by etcd-druid.
+	dataKeyManagedRoles = "managedRoles"
+)
+
+type _resource struct {
+	client        client.Client
+	newAuthClient newAuthClientFn
+}
+
+// New returns a new etcd auth component operator.
+func New(client client.Client) component.Operator {
+	return &_resource{
+		client:        client,
+		newAuthClient: newGatewayClient,
+	}
+}
+
+// GetExistingResourceNames returns the name of the existing secret holding the credentials of the etcd root user for the given Etcd.
+func (r _resource) GetExistingResourceNames(ctx component.OperatorContext, etcdObjMeta metav1.ObjectMeta) ([]string, error) {
+	resourceNames := make([]string, 0, 1)
+	objectKey := getObjectKey(etcdObjMeta)
+	objMeta := &metav1.PartialObjectMetadata{}
+	objMeta.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	if err := r.client.Get(ctx, objectKey, objMeta); err != nil {
+		if errors.IsNotFound(err) {
+			return resourceNames, nil
+		}
+		return resourceNames, druiderr.WrapError(err,
+			ErrGetEtcdAuth,
+			component.OperationGetExistingResourceNames,
+			fmt.Sprintf("Error getting etcd auth root secret: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcdObjMeta)))
+	}
+	if metav1.IsControlledBy(objMeta, &etcdObjMeta) {
+		resourceNames = append(resourceNames, objMeta.Name)
+	}
+	return resourceNames, nil
+}
+
+// PreSync is a no-op for the etcd auth component.
+func (r _resource) PreSync(_ component.OperatorContext, _ *druidv1alpha1.Etcd) error { return nil }
+
+// Sync reconciles the users and roles defined in the given Etcd into the auth store of etcd, and enables auth.
+//
+// The names of the users and roles created by etcd-druid are recorded in the root secret, and only these are removed
+// once they are no longer defined in the Etcd. Users and roles created by other means are left untouched. Besides the
+// defined users, the auth store contains the root user, which etcd-druid authenticates as with the password stored in
+// the root secret, and the user named after the common name of the client certificate used by etcd-backup-restore,
+// both with the root role. Auth is only enabled once all users and roles exist. The users and roles are compared with
+// the live auth store on every sync, so that changes made directly in etcd, or lost by restoring an older backup, are
+// reverted.
+func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) error {
+	if etcd.Spec.Etcd.Auth == nil {
+		return nil
+	}
+	rootSecret, err := r.ensureRootSecret(ctx, etcd)
+	if err != nil {
+		return err
+	}
+	tlsConfig, clientUser, err := r.getTLSConfig(ctx, etcd)
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error getting client TLS configuration for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if slices.ContainsFunc(etcd.Spec.Etcd.Auth.Users, func(u druidv1alpha1.EtcdAuthUser) bool { return u.Name == clientUser }) {
+		return druiderr.New(
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("User: %s is the common name of the client certificate of etcd-backup-restore and cannot be defined in etcd.spec.etcd.auth.users for etcd: %v", clientUser, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if etcd.Spec.Replicas == 0 {
+		return nil
+	}
+	desired := computeDesiredAuthState(etcd.Spec.Etcd.Auth, clientUser)
+
+	sts, err := kubernetes.GetStatefulSet(ctx, r.client, etcd)
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error getting StatefulSet for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if sts == nil {
+		return druiderr.New(
+			druiderr.ErrRequeueAfter,
+			component.OperationSync,
+			fmt.Sprintf("StatefulSet has not yet been created for etcd: %v, requeuing reconcile request", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if ready, reason := kubernetes.IsStatefulSetReady(etcd.Spec.Replicas, sts); !ready {
+		return druiderr.New(
+			druiderr.ErrRequeueAfter,
+			component.OperationSync,
+			fmt.Sprintf("StatefulSet is not yet ready for etcd: %v, requeuing reconcile request: %s", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta), reason))
+	}
+
+	managed, err := getManagedAuthState(rootSecret)
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error reading the users and roles created by etcd-druid from etcd auth root secret for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	// Users and roles are recorded before they are created, so that they are removed later on even if the reconciliation
+	// of the auth store fails midway.
+	if err = r.recordManagedAuthState(ctx, rootSecret, union(managed.users, desired.userNames()), union(managed.roles, desired.roleNames())); err != nil {
+		return err
+	}
+
+	authClient := r.newAuthClient(getEndpoint(etcd), tlsConfig)
+	defer authClient.Close()
+	if err = reconcileAuthStore(ctx, authClient, desired, managed, string(rootSecret.Data[dataKeyPassword])); err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error reconciling auth store of etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if err = r.recordManagedAuthState(ctx, rootSecret, desired.userNames(), desired.roleNames()); err != nil {
+		return err
+	}
+	ctx.Logger.Info("synced", "component", "etcd-auth", "users", len(desired.users), "roles", len(desired.roles))
+	return nil
+}
+
+// TriggerDelete triggers the deletion of the secret holding the credentials of the etcd root user for the given Etcd.
+func (r _resource) TriggerDelete(ctx component.OperatorContext, etcdObjMeta metav1.ObjectMeta) error {
+	ctx.Logger.Info("Triggering deletion of etcd auth root secret")
+	objectKey := getObjectKey(etcdObjMeta)
+	if err := client.IgnoreNotFound(r.client.Delete(ctx, emptySecret(objectKey))); err != nil {
+		return druiderr.WrapError(err,
+			ErrDeleteEtcdAuth,
+			component.OperationTriggerDelete,
+			fmt.Sprintf("Failed to delete etcd auth root secret: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcdObjMeta)))
+	}
+	ctx.Logger.Info("deleted", "component", "etcd-auth")
+	return nil
+}
+
+// ensureRootSecret returns the secret holding the credentials of the etcd root user, creating it with a random password
+// if it does not exist yet. The password is never changed once it has been created, as it may already be in use.
+func (r _resource) ensureRootSecret(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) (*corev1.Secret, error) {
+	objectKey := getObjectKey(etcd.ObjectMeta)
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, objectKey, secret); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, druiderr.WrapError(err,
+				ErrGetEtcdAuth,
+				component.OperationSync,
+				fmt.Sprintf("Error getting etcd auth root secret: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+		}
+		password, err := generatePassword()
+		if err != nil {
+			return nil, druiderr.WrapError(err,
+				ErrSyncEtcdAuth,
+				component.OperationSync,
+				fmt.Sprintf("Error generating password of etcd root user for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+		}
+		secret = emptySecret(objectKey)
+		secret.Labels = getLabels(etcd)
+		secret.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{
+			dataKeyUsername: []byte(rootUser),
+			dataKeyPassword: []byte(password),
+		}
+		if err = r.client.Create(ctx, secret); err != nil {
+			return nil, druiderr.WrapError(err,
+				ErrSyncEtcdAuth,
+				component.OperationSync,
+				fmt.Sprintf("Error creating etcd auth root secret: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+		}
+		return secret, nil
+	}
+	if !metav1.IsControlledBy(secret, etcd) {
+		return nil, druiderr.New(
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Secret: %v already exists and is not controlled by etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if len(secret.Data[dataKeyPassword]) == 0 {
+		return nil, druiderr.New(
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Secret: %v does not contain the password of the etcd root user for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	return secret, nil
+}
+
+// recordManagedAuthState records the names of the users and roles created by etcd-druid in the root secret.
+func (r _resource) recordManagedAuthState(ctx component.OperatorContext, rootSecret *corev1.Secret, users, roles []string) error {
+	usersData, err := json.Marshal(users)
+	if err != nil {
+		return druiderr.WrapError(err, ErrSyncEtcdAuth, component.OperationSync, "Error encoding the names of the users created by etcd-druid")
+	}
+	rolesData, err := json.Marshal(roles)
+	if err != nil {
+		return druiderr.WrapError(err, ErrSyncEtcdAuth, component.OperationSync, "Error encoding the names of the roles created by etcd-druid")
+	}
+	if string(rootSecret.Data[dataKeyManagedUsers]) == string(usersData) && string(rootSecret.Data[dataKeyManagedRoles]) == string(rolesData) {
+		return nil
+	}
+	patch := client.MergeFrom(rootSecret.DeepCopy())
+	rootSecret.Data[dataKeyManagedUsers] = usersData
+	rootSecret.Data[dataKeyManagedRoles] = rolesData
+	if err = r.client.Patch(ctx, rootSecret, patch); err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncEtcdAuth,
+			component.OperationSync,
+			fmt.Sprintf("Error recording the users and roles created by etcd-druid in etcd auth root secret: %v", client.ObjectKeyFromObject(rootSecret)))
+	}
+	return nil
+}
+
+// getManagedAuthState returns the names of the users and roles created by etcd-druid, as recorded in the root secret.
+func getManagedAuthState(rootSecret *corev1.Secret) (*managedAuthState, error) {
+	managed := &managedAuthState{}
+	if data, ok := rootSecret.Data[dataKeyManagedUsers]; ok {
+		if err := json.Unmarshal(data, &managed.users); err != nil {
+			return nil, err
+		}
+	}
+	if data, ok := rootSecret.Data[dataKeyManagedRoles]; ok {
+		if err := json.Unmarshal(data, &managed.roles); err != nil {
+			return nil, err
+		}
+	}
+	return managed, nil
+}
+
+// union returns the sorted union of the given names.
+func union(a, b []string) []string {
+	return slices.Compact(slices.Sorted(slices.Values(append(slices.Clone(a), b...))))
+}
+
+// getTLSConfig returns the TLS configuration for the client communication with etcd, along with the common name of the
+// client certificate, which etcd uses as the user name of the clients authenticating with it.
+func (r _resource) getTLSConfig(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) (*tls.Config, string, error) {
+	tlsConfig, err := kubernetes.GetEtcdClientTLSConfig(ctx, r.client, etcd)
+	if err != nil {
+		return nil, "", err
+	}
+	if tlsConfig == nil {
+		return nil, "", fmt.Errorf("TLS is not configured for client communication")
+	}
+	if len(tlsConfig.Certificates) == 0 {
+		return nil, "", fmt.Errorf("no client certificate is configured for client communication")
+	}
+	return tlsConfig, tlsConfig.Certificates[0].Leaf.Subject.CommonName, nil
+}
+
+// authState holds the users and roles of the auth store of etcd.
+type authState struct {
+	// roles maps the names of the roles to their permissions.
+	roles map[string][]permission
+	// users maps the names of the users to the names of their roles.
+	users map[string][]string
+}
+
+// userNames returns the sorted names of the users.
+func (s *authState) userNames() []string {
+	return slices.Sorted(maps.Keys(s.users))
+}
+
+// roleNames returns the sorted names of the roles.
+func (s *authState) roleNames() []string {
+	return slices.Sorted(maps.Keys(s.roles))
+}
+
+// managedAuthState holds the names of the users and roles of the auth store of etcd which have been created by
+// etcd-druid, and which are therefore removed once they are no longer desired.
+type managedAuthState struct {
+	users []string
+	roles []string
+}
+
+// computeDesiredAuthState computes the users and roles of the auth store of etcd from the given spec.
+func computeDesiredAuthState(auth *druidv1alpha1.EtcdAuth, clientUser string) *authState {
+	state := &authState{
+		roles: make(map[string][]permission, len(auth.Roles)),
+		users: make(map[string][]string, len(auth.Users)+2),
+	}
+	for _, role := range auth.Roles {
+		perms := make([]permission, 0, len(role.Permissions))
+		for _, p := range role.Permissions {
+			perms = append(perms, toPermission(p))
+		}
+		state.roles[role.Name] = perms
+	}
+	for _, user := range auth.Users {
+		state.users[user.Name] = slices.Sorted(slices.Values(user.Roles))
+	}
+	state.users[rootUser] = []string{rootUser}
+	if clientUser != "" {
+		state.users[clientUser] = []string{rootUser}
+	}
+	return state
+}
+
+// toPermission converts the given permission on a key prefix to the permission on the range of keys with that prefix.
+func toPermission(p druidv1alpha1.EtcdAuthPermission) permission {
+	permType := permTypeReadWrite
+	switch p.Type {
+	case druidv1alpha1.EtcdAuthPermissionTypeRead:
+		permType = permTypeRead
+	case druidv1alpha1.EtcdAuthPermissionTypeWrite:
+		permType = permTypeWrite
+	}
+	key, rangeEnd := getPrefixRange(p.KeyPrefix)
+	return permission{permType: permType, key: key, rangeEnd: rangeEnd}
+}
+
+// getPrefixRange returns the range of all keys with the given prefix, as defined by the etcd API. The range of an empty
+// prefix contains all keys.
+func getPrefixRange(prefix string) (string, string) {
+	if prefix == "" {
+		return "\x00", "\x00"
+	}
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return prefix, string(end[:i+1])
+		}
+	}
+	// the prefix only consists of 0xff bytes, so the range contains all keys greater than or equal to it
+	return prefix, "\x00"
+}
+
+// reconcileAuthStore reconciles the auth store of etcd to the desired users and roles. Roles are reconciled before
+// users, so that all roles exist before they are granted. If auth is not enabled yet, the password of the root user is
+// set before auth is enabled, so that etcd-druid can authenticate afterwards. Only users and roles which are managed by
+// etcd-druid are removed if they are not desired.
+func reconcileAuthStore(ctx component.OperatorContext, c authClient, desired *authState, managed *managedAuthState, rootPassword string) error {
+	authEnabled, err := c.Authenticate(ctx, rootUser, rootPassword)
+	if err != nil {
+		return fmt.Errorf("failed to authenticate as user %s: %w", rootUser, err)
+	}
+	if err = reconcileRoles(ctx, c, desired.roles, managed.roles); err != nil {
+		return err
+	}
+	if err = reconcileUsers(ctx, c, desired.users, managed.users, rootPassword, authEnabled); err != nil {
+		return err
+	}
+	if !authEnabled {
+		ctx.Logger.Info("Enabling auth in etcd")
+		if err = c.AuthEnable(ctx); err != nil {
+			return fmt.Errorf("failed to enable auth: %w", err)
+		}
+	}
+	return nil
+}
+
+func reconcileRoles(ctx component.OperatorContext, c authClient, desired map[string][]permission, managed []string) error {
+	existing, err := c.RoleList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list roles: %w", err)
+	}
+	for _, name := range slices.Sorted(maps.Keys(desired)) {
+		var current []permission
+		if slices.Contains(existing, name) {
+			if current, err = c.RoleGet(ctx, name); err != nil {
+				return fmt.Errorf("failed to get role %s: %w", name, err)
+			}
+		} else if err = c.RoleAdd(ctx, name); err != nil {
+			return fmt.Errorf("failed to add role %s: %w", name, err)
+		}
+		for _, perm := range current {
+			if !slices.Contains(desired[name], perm) {
+				if err = c.RoleRevokePermission(ctx, name, perm); err != nil {
+					return fmt.Errorf("failed to revoke permission on key %q from role %s: %w", perm.key, name, err)
+				}
+			}
+		}
+		for _, perm := range desired[name] {
+			if !slices.Contains(current, perm) {
+				if err = c.RoleGrantPermission(ctx, name, perm); err != nil {
+					return fmt.Errorf("failed to grant permission on key %q to role %s: %w", perm.key, name, err)
+				}
+			}
+		}
+	}
+	for _, name := range existing {
+		if _, ok := desired[name]; !ok && name != rootUser && slices.Contains(managed, name) {
+			ctx.Logger.Info("Deleting etcd role", "role", name)
+			if err = c.RoleDelete(ctx, name); err != nil {
+				return fmt.Errorf("failed to delete role %s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func reconcileUsers(ctx component.OperatorContext, c authClient, desired map[string][]string, managed []string, rootPassword string, authEnabled bool) error {
+	existing, err := c.UserList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+	for _, name := range slices.Sorted(maps.Keys(desired)) {
+		password := ""
+		if name == rootUser {
+			password = rootPassword
+		}
+		var current []string
+		if slices.Contains(existing, name) {
+			if current, err = c.UserGet(ctx, name); err != nil {
+				return fmt.Errorf("failed to get user %s: %w", name, err)
+			}
+			// the root user might have been created with a different password before auth was enabled
+			if name == rootUser && !authEnabled {
+				if err = c.UserChangePassword(ctx, name, password); err != nil {
+					return fmt.Errorf("failed to change password of user %s: %w", name, err)
+				}
+			}
+		} else if err = c.UserAdd(ctx, name, password); err != nil {
+			return fmt.Errorf("failed to add user %s: %w", name, err)
+		}
+		for _, role := range current {
+			if !slices.Contains(desired[name], role) {
+				if err = c.UserRevokeRole(ctx, name, role); err != nil {
+					return fmt.Errorf("failed to revoke role %s from user %s: %w", role, name, err)
+				}
+			}
+		}
+		for _, role := range desired[name] {
+			if !slices.Contains(current, role) {
+				if err = c.UserGrantRole(ctx, name, role); err != nil {
+					return fmt.Errorf("failed to grant role %s to user %s: %w", role, name, err)
+				}
+			}
+		}
+	}
+	for _, name := range existing {
+		if _, ok := desired[name]; !ok && slices.Contains(managed, name) {
+			ctx.Logger.Info("Deleting etcd user", "user", name)
+			if err = c.UserDelete(ctx, name); err != nil {
+				return fmt.Errorf("failed to delete user %s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// getEndpoint returns the endpoint of the gRPC gateway of etcd, which is served on the client port.
+func getEndpoint(etcd *druidv1alpha1.Etcd) string {
+	clientPort := druidv1alpha1.DefaultPortEtcdClient
+	if etcd.Spec.Etcd.ClientPort != nil {
+		clientPort = *etcd.Spec.Etcd.ClientPort
+	}
+	return fmt.Sprintf("https://%s.%s.svc:%d", druidv1alpha1.GetClientServiceName(etcd.ObjectMeta), etcd.Namespace, clientPort)
+}
+
+func generatePassword() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
+	secretLabels := map[string]string{
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameEtcdAuth,
+		druidv1alpha1.LabelAppNameKey:   druidv1alpha1.GetAuthRootSecretName(etcd.ObjectMeta),
+	}
+	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), secretLabels)
+}
+
+func getObjectKey(etcdObjMeta metav1.ObjectMeta) client.ObjectKey {
+	return client.ObjectKey{Name: druidv1alpha1.GetAuthRootSecretName(etcdObjMeta), Namespace: etcdObjMeta.Namespace}
+}
+
+func emptySecret(objectKey client.ObjectKey) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objectKey.Name,
+			Namespace: objectKey.Namespace,
+		},
+	}
+}
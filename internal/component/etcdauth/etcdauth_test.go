@@ -0,0 +1,662 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+const testClientUser = "etcd-client"
+
+// ------------------------ GetExistingResourceNames ------------------------
+func TestGetExistingResourceNames(t *testing.T) {
+	etcd := newEtcdWithAuth()
+	testCases := []struct {
+		name                string
+		secretExists        bool
+		getErr              *apierrors.StatusError
+		expectedErr         *druiderr.DruidError
+		expectedSecretNames []string
+	}{
+		{
+			name:                "should return empty slice when the root secret does not exist",
+			expectedSecretNames: []string{},
+		},
+		{
+			name:                "should return the name of the existing root secret",
+			secretExists:        true,
+			expectedSecretNames: []string{druidv1alpha1.GetAuthRootSecretName(etcd.ObjectMeta)},
+		},
+		{
+			name:         "should return err when client get fails",
+			secretExists: true,
+			getErr:       testutils.TestAPIInternalErr,
+			expectedErr: &druiderr.DruidError{
+				Code:      ErrGetEtcdAuth,
+				Cause:     testutils.TestAPIInternalErr,
+				Operation: component.OperationGetExistingResourceNames,
+			},
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			var existingObjects []client.Object
+			if tc.secretExists {
+				existingObjects = append(existingObjects, newRootSecret(etcd, "secret"))
+			}
+			cl := testutils.CreateTestFakeClientForObjects(tc.getErr, nil, nil, nil, existingObjects, getObjectKey(etcd.ObjectMeta))
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			secretNames, err := New(cl).GetExistingResourceNames(opCtx, etcd.ObjectMeta)
+			if tc.expectedErr != nil {
+				testutils.CheckDruidError(g, tc.expectedErr, err)
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(secretNames).To(Equal(tc.expectedSecretNames))
+			}
+		})
+	}
+}
+
+// ------------------------------ Sync -------------------------------
+func TestSync(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should be a no-op when auth is not configured", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithClientTLS().Build()
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil)
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		g.Expect(New(cl).Sync(opCtx, etcd)).To(Succeed())
+		secrets := &corev1.SecretList{}
+		g.Expect(cl.List(context.Background(), secrets)).To(Succeed())
+		g.Expect(secrets.Items).To(BeEmpty())
+	})
+
+	t.Run("should create the root secret and requeue while the StatefulSet is not ready", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := newEtcdWithAuth()
+		sts := testutils.CreateStatefulSet(etcd.Name, etcd.Namespace, etcd.UID, etcd.Spec.Replicas)
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, append(newClientTLSSecrets(t), sts))
+		fake := newFakeAuthClient()
+		operator := newTestOperator(cl, fake)
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+
+		err := operator.Sync(opCtx, etcd)
+		g.Expect(druiderr.AsDruidError(err)).ToNot(BeNil())
+		g.Expect(druiderr.AsDruidError(err).Code).To(Equal(druidapicommon.ErrorCode(druiderr.ErrRequeueAfter)))
+		g.Expect(fake.calls).To(BeZero())
+
+		rootSecret := getRootSecret(g, cl, etcd)
+		g.Expect(rootSecret.OwnerReferences).To(ConsistOf(druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)))
+		g.Expect(rootSecret.Labels).To(HaveKeyWithValue(druidv1alpha1.LabelComponentKey, druidv1alpha1.ComponentNameEtcdAuth))
+		g.Expect(rootSecret.Data).To(HaveKeyWithValue(dataKeyUsername, []byte(rootUser)))
+		g.Expect(rootSecret.Data[dataKeyPassword]).ToNot(BeEmpty())
+	})
+
+	t.Run("should only modify the auth store when it deviates from the desired state", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := newEtcdWithAuth()
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, append(newClientTLSSecrets(t), newReadyStatefulSet(etcd)))
+		fake := newFakeAuthClient()
+		operator := newTestOperator(cl, fake)
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+
+		g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+		rootSecret := getRootSecret(g, cl, etcd)
+		g.Expect(fake.authEnabled).To(BeTrue())
+		g.Expect(fake.passwords).To(HaveKeyWithValue(rootUser, string(rootSecret.Data[dataKeyPassword])))
+		expectedUsers := map[string][]string{
+			rootUser:       {rootUser},
+			testClientUser: {rootUser},
+			"app-a":        {"app-a"},
+		}
+		g.Expect(fake.users).To(Equal(expectedUsers))
+		g.Expect(fake.roles).To(Equal(map[string][]permission{
+			"app-a": {{permType: permTypeReadWrite, key: "/app-a/", rangeEnd: "/app-a0"}},
+		}))
+
+		writes := fake.writes
+		g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+		g.Expect(fake.writes).To(Equal(writes))
+
+		// the user might have been removed directly in etcd or by restoring an older backup
+		delete(fake.users, "app-a")
+		g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+		g.Expect(fake.users).To(Equal(expectedUsers))
+
+		etcd.Spec.Etcd.Auth.Roles[0].Permissions[0].Type = druidv1alpha1.EtcdAuthPermissionTypeRead
+		g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+		g.Expect(fake.roles).To(Equal(map[string][]permission{
+			"app-a": {{permType: permTypeRead, key: "/app-a/", rangeEnd: "/app-a0"}},
+		}))
+	})
+
+	t.Run("should only remove users and roles created by etcd-druid", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := newEtcdWithAuth()
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, append(newClientTLSSecrets(t), newReadyStatefulSet(etcd)))
+		fake := newFakeAuthClient()
+		// the user and role might have been created directly in etcd, e.g. via etcdctl
+		fake.users["other"] = []string{"other"}
+		fake.roles["other"] = nil
+		operator := newTestOperator(cl, fake)
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+
+		g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+		g.Expect(fake.users).To(HaveKey("app-a"))
+		g.Expect(fake.roles).To(HaveKey("app-a"))
+		rootSecret := getRootSecret(g, cl, etcd)
+		g.Expect(string(rootSecret.Data[dataKeyManagedUsers])).To(Equal(`["app-a","etcd-client","root"]`))
+		g.Expect(string(rootSecret.Data[dataKeyManagedRoles])).To(Equal(`["app-a"]`))
+
+		etcd.Spec.Etcd.Auth.Users = nil
+		etcd.Spec.Etcd.Auth.Roles = nil
+		g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+		g.Expect(fake.users).To(Equal(map[string][]string{
+			rootUser:       {rootUser},
+			testClientUser: {rootUser},
+			"other":        {"other"},
+		}))
+		g.Expect(fake.roles).To(Equal(map[string][]permission{"other": nil}))
+		g.Expect(fake.closed).To(BeTrue())
+		rootSecret = getRootSecret(g, cl, etcd)
+		g.Expect(string(rootSecret.Data[dataKeyManagedUsers])).To(Equal(`["etcd-client","root"]`))
+		g.Expect(string(rootSecret.Data[dataKeyManagedRoles])).To(Equal(`null`))
+	})
+
+	t.Run("should return err when a user is named after the client certificate of etcd-backup-restore", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := newEtcdWithAuth()
+		etcd.Spec.Etcd.Auth.Users = append(etcd.Spec.Etcd.Auth.Users, druidv1alpha1.EtcdAuthUser{Name: testClientUser})
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, append(newClientTLSSecrets(t), newReadyStatefulSet(etcd)))
+		fake := newFakeAuthClient()
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		err := newTestOperator(cl, fake).Sync(opCtx, etcd)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(druiderr.AsDruidError(err).Code).To(Equal(ErrSyncEtcdAuth))
+		g.Expect(fake.calls).To(BeZero())
+	})
+
+	t.Run("should return err when the root secret is not controlled by the etcd", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := newEtcdWithAuth()
+		foreignSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: druidv1alpha1.GetAuthRootSecretName(etcd.ObjectMeta), Namespace: etcd.Namespace}}
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{foreignSecret}, client.ObjectKeyFromObject(foreignSecret))
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		err := New(cl).Sync(opCtx, etcd)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(druiderr.AsDruidError(err).Code).To(Equal(ErrSyncEtcdAuth))
+	})
+
+	t.Run("should return err when client create fails", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := newEtcdWithAuth()
+		cl := testutils.CreateTestFakeClientForObjects(nil, testutils.TestAPIInternalErr, nil, nil, nil, getObjectKey(etcd.ObjectMeta))
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		testutils.CheckDruidError(g, &druiderr.DruidError{
+			Code:      ErrSyncEtcdAuth,
+			Cause:     testutils.TestAPIInternalErr,
+			Operation: component.OperationSync,
+		}, New(cl).Sync(opCtx, etcd))
+	})
+}
+
+func TestReconcileAuthStore(t *testing.T) {
+	desired := &authState{
+		roles: map[string][]permission{
+			"app-a": {{permType: permTypeRead, key: "/a/", rangeEnd: "/a0"}},
+			"app-b": {{permType: permTypeReadWrite, key: "/b/", rangeEnd: "/b0"}},
+		},
+		users: map[string][]string{
+			rootUser:       {rootUser},
+			testClientUser: {rootUser},
+			"app-a":        {"app-a"},
+			"app-b":        {"app-a", "app-b"},
+		},
+	}
+	testCases := []struct {
+		name          string
+		existing      *fakeAuthClient
+		managed       *managedAuthState
+		expectedUsers map[string][]string
+		expectedRoles map[string][]permission
+		expectErr     bool
+	}{
+		{
+			name:     "should create all users and roles and enable auth",
+			existing: newFakeAuthClient(),
+		},
+		{
+			name: "should correct users and roles, remove stale ones and reset the root password before enabling auth",
+			existing: &fakeAuthClient{
+				users:     map[string][]string{rootUser: {rootUser}, "app-a": {"stale"}, "stale": nil},
+				passwords: map[string]string{rootUser: "previous"},
+				roles: map[string][]permission{
+					"app-a": {{permType: permTypeWrite, key: "/a/", rangeEnd: "/a0"}, {permType: permTypeRead, key: "/c/", rangeEnd: "/c0"}},
+					"stale": nil,
+				},
+			},
+			managed: &managedAuthState{users: []string{"stale"}, roles: []string{"stale"}},
+		},
+		{
+			name: "should not remove users and roles which have not been created by etcd-druid",
+			existing: &fakeAuthClient{
+				users:     map[string][]string{rootUser: {rootUser}, "other": {"other"}},
+				passwords: map[string]string{},
+				roles:     map[string][]permission{"other": nil},
+			},
+			expectedUsers: map[string][]string{
+				rootUser:       {rootUser},
+				testClientUser: {rootUser},
+				"app-a":        {"app-a"},
+				"app-b":        {"app-a", "app-b"},
+				"other":        {"other"},
+			},
+			expectedRoles: map[string][]permission{
+				"app-a": {{permType: permTypeRead, key: "/a/", rangeEnd: "/a0"}},
+				"app-b": {{permType: permTypeReadWrite, key: "/b/", rangeEnd: "/b0"}},
+				"other": nil,
+			},
+		},
+		{
+			name: "should authenticate as root if auth is enabled",
+			existing: &fakeAuthClient{
+				authEnabled: true,
+				users:       map[string][]string{rootUser: {rootUser}, testClientUser: {rootUser}},
+				passwords:   map[string]string{rootUser: "password"},
+				roles:       map[string][]permission{},
+			},
+		},
+		{
+			name: "should return err if the root password does not match",
+			existing: &fakeAuthClient{
+				authEnabled: true,
+				users:       map[string][]string{rootUser: {rootUser}},
+				passwords:   map[string]string{rootUser: "other"},
+				roles:       map[string][]permission{},
+			},
+			expectErr: true,
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			managed := tc.managed
+			if managed == nil {
+				managed = &managedAuthState{}
+			}
+			err := reconcileAuthStore(opCtx, tc.existing, desired, managed, "password")
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tc.existing.authEnabled).To(BeTrue())
+			g.Expect(tc.existing.passwords).To(HaveKeyWithValue(rootUser, "password"))
+			expectedUsers, expectedRoles := desired.users, desired.roles
+			if tc.expectedUsers != nil {
+				expectedUsers, expectedRoles = tc.expectedUsers, tc.expectedRoles
+			}
+			g.Expect(tc.existing.users).To(Equal(expectedUsers))
+			g.Expect(tc.existing.roles).To(Equal(expectedRoles))
+		})
+	}
+}
+
+func TestGetPrefixRange(t *testing.T) {
+	testCases := []struct {
+		prefix           string
+		expectedKey      string
+		expectedRangeEnd string
+	}{
+		{prefix: "", expectedKey: "\x00", expectedRangeEnd: "\x00"},
+		{prefix: "/registry/", expectedKey: "/registry/", expectedRangeEnd: "/registry0"},
+		{prefix: "a\xff", expectedKey: "a\xff", expectedRangeEnd: "b"},
+		{prefix: "\xff\xff", expectedKey: "\xff\xff", expectedRangeEnd: "\x00"},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("prefix %q", tc.prefix), func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			key, rangeEnd := getPrefixRange(tc.prefix)
+			g.Expect(key).To(Equal(tc.expectedKey))
+			g.Expect(rangeEnd).To(Equal(tc.expectedRangeEnd))
+		})
+	}
+}
+
+// ----------------------------- TriggerDelete -------------------------------
+func TestTriggerDelete(t *testing.T) {
+	etcd := newEtcdWithAuth()
+	testCases := []struct {
+		name         string
+		secretExists bool
+		deleteErr    *apierrors.StatusError
+		expectedErr  *druiderr.DruidError
+	}{
+		{
+			name: "no-op when the root secret does not exist",
+		},
+		{
+			name:         "successfully delete the existing root secret",
+			secretExists: true,
+		},
+		{
+			name:         "returns err when client delete fails",
+			secretExists: true,
+			deleteErr:    testutils.TestAPIInternalErr,
+			expectedErr: &druiderr.DruidError{
+				Code:      ErrDeleteEtcdAuth,
+				Cause:     testutils.TestAPIInternalErr,
+				Operation: component.OperationTriggerDelete,
+			},
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			var existingObjects []client.Object
+			if tc.secretExists {
+				existingObjects = append(existingObjects, newRootSecret(etcd, "secret"))
+			}
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, tc.deleteErr, existingObjects, getObjectKey(etcd.ObjectMeta))
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			err := New(cl).TriggerDelete(opCtx, etcd.ObjectMeta)
+			if tc.expectedErr != nil {
+				testutils.CheckDruidError(g, tc.expectedErr, err)
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(cl.Get(context.Background(), getObjectKey(etcd.ObjectMeta), &corev1.Secret{})).To(testutils.BeNotFoundError())
+			}
+		})
+	}
+}
+
+// ---------------------------- Helper Functions -----------------------------
+
+func newEtcdWithAuth() *druidv1alpha1.Etcd {
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithClientTLS().Build()
+	etcd.Spec.Etcd.Auth = &druidv1alpha1.EtcdAuth{
+		Roles: []druidv1alpha1.EtcdAuthRole{{
+			Name:        "app-a",
+			Permissions: []druidv1alpha1.EtcdAuthPermission{{KeyPrefix: "/app-a/", Type: druidv1alpha1.EtcdAuthPermissionTypeReadWrite}},
+		}},
+		Users: []druidv1alpha1.EtcdAuthUser{{Name: "app-a", Roles: []string{"app-a"}}},
+	}
+	return etcd
+}
+
+func newTestOperator(cl client.Client, fake *fakeAuthClient) component.Operator {
+	return &_resource{
+		client:        cl,
+		newAuthClient: func(_ string, _ *tls.Config) authClient { return fake },
+	}
+}
+
+func newRootSecret(etcd *druidv1alpha1.Etcd, password string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            druidv1alpha1.GetAuthRootSecretName(etcd.ObjectMeta),
+			Namespace:       etcd.Namespace,
+			OwnerReferences: []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)},
+		},
+		Data: map[string][]byte{dataKeyUsername: []byte(rootUser), dataKeyPassword: []byte(password)},
+	}
+}
+
+func newReadyStatefulSet(etcd *druidv1alpha1.Etcd) client.Object {
+	sts := testutils.CreateStatefulSet(etcd.Name, etcd.Namespace, etcd.UID, etcd.Spec.Replicas)
+	sts.Status.ReadyReplicas = etcd.Spec.Replicas
+	sts.Status.CurrentReplicas = etcd.Spec.Replicas
+	sts.Status.UpdatedReplicas = etcd.Spec.Replicas
+	return sts
+}
+
+func getRootSecret(g *WithT, cl client.Client, etcd *druidv1alpha1.Etcd) *corev1.Secret {
+	secret := &corev1.Secret{}
+	g.Expect(cl.Get(context.Background(), getObjectKey(etcd.ObjectMeta), secret)).To(Succeed())
+	return secret
+}
+
+// newClientTLSSecrets returns the CA and client certificate secrets referenced by the client TLS configuration of the
+// test etcd. The client certificate is issued for testClientUser.
+func newClientTLSSecrets(t *testing.T) []client.Object {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: testClientUser},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, clientTemplate, caTemplate, &clientKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientKeyDER, err := x509.MarshalECPrivateKey(clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []client.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: testutils.ClientTLSCASecretName, Namespace: testutils.TestNamespace},
+			Data:       map[string][]byte{"ca.crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: testutils.ClientTLSClientCertSecretName, Namespace: testutils.TestNamespace},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientDER}),
+				corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: clientKeyDER}),
+			},
+		},
+	}
+}
+
+// fakeAuthClient is an in-memory authClient which mimics the auth store of etcd.
+type fakeAuthClient struct {
+	authEnabled bool
+	users       map[string][]string
+	passwords   map[string]string
+	roles       map[string][]permission
+	// calls counts all requests, writes only the requests modifying the auth store.
+	calls  int
+	writes int
+	closed bool
+}
+
+func newFakeAuthClient() *fakeAuthClient {
+	return &fakeAuthClient{
+		users:     map[string][]string{},
+		passwords: map[string]string{},
+		roles:     map[string][]permission{},
+	}
+}
+
+func (f *fakeAuthClient) Authenticate(_ context.Context, name, password string) (bool, error) {
+	f.calls++
+	if !f.authEnabled {
+		return false, nil
+	}
+	if _, ok := f.users[name]; !ok || f.passwords[name] != password {
+		return false, fmt.Errorf("authentication failed, invalid user ID or password")
+	}
+	return true, nil
+}
+
+func (f *fakeAuthClient) AuthEnable(_ context.Context) error {
+	f.calls++
+	f.writes++
+	if !slices.Contains(f.users[rootUser], rootUser) {
+		return fmt.Errorf("root user does not have root role")
+	}
+	f.authEnabled = true
+	return nil
+}
+
+func (f *fakeAuthClient) UserList(_ context.Context) ([]string, error) {
+	f.calls++
+	return slices.Sorted(maps.Keys(f.users)), nil
+}
+
+func (f *fakeAuthClient) UserGet(_ context.Context, name string) ([]string, error) {
+	f.calls++
+	return slices.Clone(f.users[name]), nil
+}
+
+func (f *fakeAuthClient) UserAdd(_ context.Context, name, password string) error {
+	f.calls++
+	f.writes++
+	f.users[name] = nil
+	if password != "" {
+		f.passwords[name] = password
+	}
+	return nil
+}
+
+func (f *fakeAuthClient) UserChangePassword(_ context.Context, name, password string) error {
+	f.calls++
+	f.writes++
+	f.passwords[name] = password
+	return nil
+}
+
+func (f *fakeAuthClient) UserDelete(_ context.Context, name string) error {
+	f.calls++
+	f.writes++
+	delete(f.users, name)
+	delete(f.passwords, name)
+	return nil
+}
+
+func (f *fakeAuthClient) UserGrantRole(_ context.Context, name, role string) error {
+	f.calls++
+	f.writes++
+	if _, ok := f.roles[role]; !ok && role != rootUser {
+		return fmt.Errorf("role name not found")
+	}
+	f.users[name] = slices.Sorted(slices.Values(append(f.users[name], role)))
+	return nil
+}
+
+func (f *fakeAuthClient) UserRevokeRole(_ context.Context, name, role string) error {
+	f.calls++
+	f.writes++
+	f.users[name] = slices.DeleteFunc(f.users[name], func(r string) bool { return r == role })
+	return nil
+}
+
+func (f *fakeAuthClient) RoleList(_ context.Context) ([]string, error) {
+	f.calls++
+	return slices.Sorted(maps.Keys(f.roles)), nil
+}
+
+func (f *fakeAuthClient) RoleGet(_ context.Context, name string) ([]permission, error) {
+	f.calls++
+	return slices.Clone(f.roles[name]), nil
+}
+
+func (f *fakeAuthClient) RoleAdd(_ context.Context, name string) error {
+	f.calls++
+	f.writes++
+	f.roles[name] = []permission{}
+	return nil
+}
+
+func (f *fakeAuthClient) RoleDelete(_ context.Context, name string) error {
+	f.calls++
+	f.writes++
+	delete(f.roles, name)
+	for user, roles := range f.users {
+		f.users[user] = slices.DeleteFunc(roles, func(r string) bool { return r == name })
+	}
+	return nil
+}
+
+func (f *fakeAuthClient) RoleGrantPermission(_ context.Context, name string, perm permission) error {
+	f.calls++
+	f.writes++
+	f.roles[name] = append(f.roles[name], perm)
+	return nil
+}
+
+func (f *fakeAuthClient) RoleRevokePermission(_ context.Context, name string, perm permission) error {
+	f.calls++
+	f.writes++
+	f.roles[name] = slices.DeleteFunc(f.roles[name], func(p permission) bool { return p == perm })
+	return nil
+}
+
+func (f *fakeAuthClient) Close() {
+	f.closed = true
+}
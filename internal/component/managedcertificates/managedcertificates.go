@@ -0,0 +1,492 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedcertificates
+
+import (
+	"crypto/x509"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+	"github.com/gardener/etcd-druid/internal/common"
+	"github.com/gardener/etcd-druid/internal/component"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	"github.com/gardener/etcd-druid/internal/utils"
+	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// ErrGetManagedCertificates indicates an error in getting the secrets holding the managed certificates.
+	ErrGetManagedCertificates druidapicommon.ErrorCode = "ERR_GET_MANAGED_CERTIFICATES"
+	// ErrSyncManagedCertificates indicates an error in syncing the secrets holding the managed certificates.
+	ErrSyncManagedCertificates druidapicommon.ErrorCode = "ERR_SYNC_MANAGED_CERTIFICATES"
+	// ErrDeleteManagedCertificates indicates an error in deleting the secrets holding the managed certificates.
+	ErrDeleteManagedCertificates druidapicommon.ErrorCode = "ERR_DELETE_MANAGED_CERTIFICATES"
+)
+
+const (
+	// dataKeySigningCACert is the data key of the signing CA secret holding the certificate of the CA which currently signs the certificates.
+	dataKeySigningCACert = "ca.crt"
+	// dataKeySigningCAKey is the data key of the signing CA secret holding the private key of the CA which currently signs the certificates.
+	dataKeySigningCAKey = "ca.key"
+	// dataKeyNextCACert is the data key of the signing CA secret holding the certificate of the CA which replaces the
+	// signing CA once it is trusted by all etcd members.
+	dataKeyNextCACert = "next-ca.crt"
+	// dataKeyNextCAKey is the data key of the signing CA secret holding the private key of the CA which replaces the
+	// signing CA once it is trusted by all etcd members.
+	dataKeyNextCAKey = "next-ca.key"
+
+	defaultCAValidity          = 10 * 365 * 24 * time.Hour
+	defaultCertificateValidity = 90 * 24 * time.Hour
+)
+
+type _resource struct {
+	client client.Client
+	now    func() time.Time
+}
+
+// New returns a new managed certificates component operator.
+func New(client client.Client) component.Operator {
+	return &_resource{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// GetExistingResourceNames returns the names of the existing secrets holding the managed certificates, including the
+// client certificates of users, for the given Etcd.
+func (r _resource) GetExistingResourceNames(ctx component.OperatorContext, etcdObjMeta metav1.ObjectMeta) ([]string, error) {
+	resourceNames := make([]string, 0, 5)
+	for _, objectKey := range getObjectKeys(etcdObjMeta) {
+		objMeta := &metav1.PartialObjectMetadata{}
+		objMeta.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+		if err := r.client.Get(ctx, objectKey, objMeta); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return resourceNames, druiderr.WrapError(err,
+				ErrGetManagedCertificates,
+				component.OperationGetExistingResourceNames,
+				fmt.Sprintf("Error getting managed certificates secret: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcdObjMeta)))
+		}
+		if metav1.IsControlledBy(objMeta, &etcdObjMeta) {
+			resourceNames = append(resourceNames, objMeta.Name)
+		}
+	}
+	userSecrets, err := r.listUserCertificateSecrets(ctx, etcdObjMeta, component.OperationGetExistingResourceNames)
+	if err != nil {
+		return resourceNames, err
+	}
+	return append(resourceNames, slices.Sorted(maps.Keys(userSecrets))...), nil
+}
+
+// PreSync is a no-op for the managed certificates component.
+func (r _resource) PreSync(_ component.OperatorContext, _ *druidv1alpha1.Etcd) error { return nil }
+
+// Sync generates, renews and rotates the certificates managed by etcd-druid for the given Etcd.
+//
+// The CA is rotated without disrupting the communication between the etcd members and their clients, by first adding
+// the next CA to the trusted CA bundle. Only once the bundle has been rolled out to all members, the next CA replaces
+// the signing CA and the certificates are reissued. The previous CA is removed from the bundle once the reissued
+// certificates have been rolled out as well. The StatefulSet component is informed about every change via the
+// checksum of the certificates, and about an ongoing rotation via the ManagedCertificatesCARotationInProgressKey.
+func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) error {
+	if !etcd.IsManagedCertificatesEnabled() {
+		return nil
+	}
+	secrets, err := r.getSecrets(ctx, etcd)
+	if err != nil {
+		return err
+	}
+	current, err := parseCertificateState(etcd, secrets)
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncManagedCertificates,
+			component.OperationSync,
+			fmt.Sprintf("Error parsing managed certificates for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	rolledOut, err := r.isRolledOut(ctx, etcd, current.checksum())
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncManagedCertificates,
+			component.OperationSync,
+			fmt.Sprintf("Error checking roll out of managed certificates for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	desired, err := computeDesiredCertificateState(etcd, current, rolledOut, r.now())
+	if err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncManagedCertificates,
+			component.OperationSync,
+			fmt.Sprintf("Error generating managed certificates for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
+	if err = r.syncSecrets(ctx, etcd, desired); err != nil {
+		return err
+	}
+	if err = r.syncUserCertificates(ctx, etcd, desired); err != nil {
+		return err
+	}
+
+	ctx.Data[druidresources.CheckSumKeyManagedCertificates] = desired.checksum()
+	if desired.isCARotationInProgress() {
+		ctx.Data[common.ManagedCertificatesCARotationInProgressKey] = "true"
+	}
+	ctx.Logger.Info("synced", "component", "managed-certificates", "caRotationInProgress", desired.isCARotationInProgress())
+	return nil
+}
+
+// TriggerDelete triggers the deletion of the secrets holding the managed certificates for the given Etcd.
+func (r _resource) TriggerDelete(ctx component.OperatorContext, etcdObjMeta metav1.ObjectMeta) error {
+	ctx.Logger.Info("Triggering deletion of managed certificates secrets")
+	for _, objectKey := range getObjectKeys(etcdObjMeta) {
+		if err := client.IgnoreNotFound(r.client.Delete(ctx, emptySecret(objectKey))); err != nil {
+			return druiderr.WrapError(err,
+				ErrDeleteManagedCertificates,
+				component.OperationTriggerDelete,
+				fmt.Sprintf("Failed to delete managed certificates secret: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcdObjMeta)))
+		}
+	}
+	userSecrets, err := r.listUserCertificateSecrets(ctx, etcdObjMeta, component.OperationTriggerDelete)
+	if err != nil {
+		return err
+	}
+	for _, secret := range userSecrets {
+		if err = client.IgnoreNotFound(r.client.Delete(ctx, secret)); err != nil {
+			return druiderr.WrapError(err,
+				ErrDeleteManagedCertificates,
+				component.OperationTriggerDelete,
+				fmt.Sprintf("Failed to delete user client certificate secret: %v for etcd: %v", secret.Name, druidv1alpha1.GetNamespaceName(etcdObjMeta)))
+		}
+	}
+	ctx.Logger.Info("deleted", "component", "managed-certificates")
+	return nil
+}
+
+// getSecrets returns the existing secrets holding the managed certificates, keyed by their name.
+func (r _resource) getSecrets(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) (map[string]*corev1.Secret, error) {
+	secrets := make(map[string]*corev1.Secret)
+	for _, objectKey := range getObjectKeys(etcd.ObjectMeta) {
+		secret := &corev1.Secret{}
+		if err := r.client.Get(ctx, objectKey, secret); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, druiderr.WrapError(err,
+				ErrGetManagedCertificates,
+				component.OperationSync,
+				fmt.Sprintf("Error getting managed certificates secret: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+		}
+		if !metav1.IsControlledBy(secret, etcd) {
+			return nil, druiderr.New(
+				ErrSyncManagedCertificates,
+				component.OperationSync,
+				fmt.Sprintf("Secret: %v already exists and is not controlled by etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+		}
+		secrets[objectKey.Name] = secret
+	}
+	return secrets, nil
+}
+
+// isRolledOut checks if the certificates with the given checksum are in use by all members of the etcd cluster.
+func (r _resource) isRolledOut(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, checksum string) (bool, error) {
+	sts, err := kubernetes.GetStatefulSet(ctx, r.client, etcd)
+	if err != nil {
+		return false, err
+	}
+	if sts == nil || ptr.Deref(sts.Spec.Replicas, 0) == 0 {
+		return true, nil
+	}
+	if sts.Spec.Template.Annotations[druidresources.CheckSumKeyManagedCertificates] != checksum {
+		return false, nil
+	}
+	ready, _ := kubernetes.IsStatefulSetReady(ptr.Deref(sts.Spec.Replicas, 0), sts)
+	return ready, nil
+}
+
+// syncSecrets writes the given certificates to the secrets. The signing CA secret is written first, so that the private
+// keys of newly generated CAs are never lost.
+func (r _resource) syncSecrets(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, state *certificateState) error {
+	signingCAData := map[string][]byte{
+		dataKeySigningCACert: state.signingCA.certPEM,
+		dataKeySigningCAKey:  state.signingCA.keyPEM,
+	}
+	if state.nextCA != nil {
+		signingCAData[dataKeyNextCACert] = state.nextCA.certPEM
+		signingCAData[dataKeyNextCAKey] = state.nextCA.keyPEM
+	}
+	secrets := []secretData{
+		{druidv1alpha1.GetManagedSigningCASecretName(etcd.ObjectMeta), corev1.SecretTypeOpaque, signingCAData},
+		{druidv1alpha1.GetManagedCASecretName(etcd.ObjectMeta), corev1.SecretTypeOpaque, map[string][]byte{
+			druidv1alpha1.ManagedCertificatesCABundleDataKey: encodeCertificates(state.caBundle),
+		}},
+	}
+	for _, name := range leafSecretNames(etcd.ObjectMeta) {
+		leaf := state.leaves[name]
+		secrets = append(secrets, secretData{name, corev1.SecretTypeTLS, map[string][]byte{
+			corev1.TLSCertKey:       leaf.certPEM,
+			corev1.TLSPrivateKeyKey: leaf.keyPEM,
+		}})
+	}
+
+	for _, s := range secrets {
+		secret := emptySecret(client.ObjectKey{Name: s.name, Namespace: etcd.Namespace})
+		opResult, err := controllerutil.CreateOrPatch(ctx, r.client, secret, func() error {
+			secret.Labels = getLabels(etcd, s.name)
+			secret.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+			secret.Type = s.secretType
+			secret.Data = s.data
+			return nil
+		})
+		if err != nil {
+			return druiderr.WrapError(err,
+				ErrSyncManagedCertificates,
+				component.OperationSync,
+				fmt.Sprintf("Error during create or update of managed certificates secret: %v for etcd: %v", s.name, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+		}
+		ctx.Logger.V(1).Info("synced managed certificates secret", "name", s.name, "result", opResult)
+	}
+	return nil
+}
+
+// secretData is the desired content of a secret holding managed certificates.
+type secretData struct {
+	name       string
+	secretType corev1.SecretType
+	data       map[string][]byte
+}
+
+// certificateState holds the managed CAs and certificates of an Etcd.
+type certificateState struct {
+	signingCA *keyPair
+	nextCA    *keyPair
+	caBundle  []*x509.Certificate
+	leaves    map[string]*keyPair
+}
+
+// checksum computes the checksum of the certificates which are mounted into the etcd pods.
+func (s *certificateState) checksum() string {
+	var data []byte
+	data = append(data, encodeCertificates(s.caBundle)...)
+	for _, name := range slices.Sorted(maps.Keys(s.leaves)) {
+		data = append(data, s.leaves[name].certPEM...)
+	}
+	return utils.ComputeSHA256Hex(data)
+}
+
+// isCARotationInProgress checks if the CA bundle is about to change, which requires further roll outs.
+func (s *certificateState) isCARotationInProgress() bool {
+	return s.nextCA != nil || len(s.caBundle) > 1
+}
+
+func parseCertificateState(etcd *druidv1alpha1.Etcd, secrets map[string]*corev1.Secret) (*certificateState, error) {
+	state := &certificateState{leaves: make(map[string]*keyPair)}
+	if secret, ok := secrets[druidv1alpha1.GetManagedSigningCASecretName(etcd.ObjectMeta)]; ok {
+		var err error
+		if len(secret.Data[dataKeySigningCACert]) > 0 {
+			if state.signingCA, err = parseKeyPair(secret.Data[dataKeySigningCACert], secret.Data[dataKeySigningCAKey]); err != nil {
+				return nil, fmt.Errorf("failed to parse signing CA: %w", err)
+			}
+		}
+		if len(secret.Data[dataKeyNextCACert]) > 0 {
+			if state.nextCA, err = parseKeyPair(secret.Data[dataKeyNextCACert], secret.Data[dataKeyNextCAKey]); err != nil {
+				return nil, fmt.Errorf("failed to parse next CA: %w", err)
+			}
+		}
+	}
+	if secret, ok := secrets[druidv1alpha1.GetManagedCASecretName(etcd.ObjectMeta)]; ok {
+		var err error
+		if state.caBundle, err = parseCertificates(secret.Data[druidv1alpha1.ManagedCertificatesCABundleDataKey]); err != nil {
+			return nil, fmt.Errorf("failed to parse CA bundle: %w", err)
+		}
+	}
+	for _, name := range leafSecretNames(etcd.ObjectMeta) {
+		secret, ok := secrets[name]
+		if !ok {
+			continue
+		}
+		// certificates which cannot be parsed are reissued
+		if leaf, err := parseKeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]); err == nil {
+			state.leaves[name] = leaf
+		}
+	}
+	return state, nil
+}
+
+// computeDesiredCertificateState advances the given certificate state by at most one step of the CA rotation, and
+// reissues all certificates which are missing, due for renewal or do not match the Etcd anymore.
+func computeDesiredCertificateState(etcd *druidv1alpha1.Etcd, current *certificateState, rolledOut bool, now time.Time) (*certificateState, error) {
+	caValidity, certificateValidity, caRenewBefore, certificateRenewBefore := getDurations(etcd.Spec.ManagedCertificates)
+	desired := &certificateState{
+		signingCA: current.signingCA,
+		nextCA:    current.nextCA,
+		leaves:    make(map[string]*keyPair),
+	}
+
+	var err error
+	if desired.signingCA == nil {
+		if desired.signingCA, err = generateCA(fmt.Sprintf("%s-ca", etcd.Name), caValidity, now); err != nil {
+			return nil, err
+		}
+	}
+	if desired.nextCA == nil && isRenewalDue(desired.signingCA.cert, caRenewBefore, now) {
+		if desired.nextCA, err = generateCA(fmt.Sprintf("%s-ca", etcd.Name), caValidity, now); err != nil {
+			return nil, err
+		}
+	}
+	// the next CA may only sign certificates once all members trust it
+	if desired.nextCA != nil && rolledOut && containsCertificate(current.caBundle, desired.nextCA.cert) {
+		desired.signingCA, desired.nextCA = desired.nextCA, nil
+	}
+
+	reissued := false
+	for name, config := range getCertificateConfigs(etcd) {
+		leaf := current.leaves[name]
+		if leaf == nil || !matchesConfig(leaf.cert, desired.signingCA.cert, config) || isLeafRenewalDue(leaf.cert, desired, certificateRenewBefore, now) {
+			if leaf, err = generateCertificate(desired.signingCA, config, certificateValidity, now); err != nil {
+				return nil, err
+			}
+			reissued = true
+		}
+		desired.leaves[name] = leaf
+	}
+
+	desired.caBundle = []*x509.Certificate{desired.signingCA.cert}
+	if desired.nextCA != nil {
+		desired.caBundle = append(desired.caBundle, desired.nextCA.cert)
+	}
+	// previous CAs are trusted until all members use certificates signed by the signing CA
+	if !rolledOut || desired.nextCA != nil || reissued {
+		for _, cert := range current.caBundle {
+			if !containsCertificate(desired.caBundle, cert) && now.Before(cert.NotAfter) {
+				desired.caBundle = append(desired.caBundle, cert)
+			}
+		}
+	}
+	return desired, nil
+}
+
+// isLeafRenewalDue checks if the given certificate, which matches the signing CA of the desired state, is due for renewal.
+// Certificates are not renewed while a next CA is pending, as they are reissued anyway once it replaces the signing CA,
+// nor once they are valid until the signing CA expires, as a reissued certificate would not be valid any longer. Otherwise
+// certificates whose validity is limited by the signing CA would be reissued on every reconciliation, and the changing
+// checksum would prevent the roll out of the CA bundle which the rotation of the signing CA waits for.
+func isLeafRenewalDue(cert *x509.Certificate, desired *certificateState, renewBefore time.Duration, now time.Time) bool {
+	if desired.nextCA != nil || !cert.NotAfter.Before(desired.signingCA.cert.NotAfter) {
+		return false
+	}
+	return isRenewalDue(cert, renewBefore, now)
+}
+
+// getCertificateConfigs returns the configs of the certificates signed by the managed CA, keyed by the secret names.
+func getCertificateConfigs(etcd *druidv1alpha1.Etcd) map[string]certificateConfig {
+	peerServiceDNSNames := getServiceDNSNames(fmt.Sprintf("*.%s", druidv1alpha1.GetPeerServiceName(etcd.ObjectMeta)), etcd.Namespace)
+	serverDNSNames := append([]string{
+		fmt.Sprintf("%s-local", etcd.Name),
+		"localhost",
+	}, getServiceDNSNames(druidv1alpha1.GetClientServiceName(etcd.ObjectMeta), etcd.Namespace)...)
+	serverDNSNames = append(serverDNSNames, peerServiceDNSNames...)
+	serverAndClientAuth := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	return map[string]certificateConfig{
+		druidv1alpha1.GetManagedServerTLSSecretName(etcd.ObjectMeta): {
+			commonName:  fmt.Sprintf("%s-server", etcd.Name),
+			dnsNames:    serverDNSNames,
+			ipAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+			extKeyUsage: serverAndClientAuth,
+		},
+		druidv1alpha1.GetManagedPeerTLSSecretName(etcd.ObjectMeta): {
+			commonName:  fmt.Sprintf("%s-peer", etcd.Name),
+			dnsNames:    peerServiceDNSNames,
+			extKeyUsage: serverAndClientAuth,
+		},
+		druidv1alpha1.GetManagedClientTLSSecretName(etcd.ObjectMeta): {
+			commonName:  fmt.Sprintf("%s-client", etcd.Name),
+			extKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		},
+	}
+}
+
+func getServiceDNSNames(serviceName, namespace string) []string {
+	return []string{
+		serviceName,
+		fmt.Sprintf("%s.%s", serviceName, namespace),
+		fmt.Sprintf("%s.%s.svc", serviceName, namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", serviceName, namespace),
+	}
+}
+
+// getDurations returns the validity and renewBefore durations of the CA and the certificates, applying the defaults.
+// A renewBefore which is not shorter than the respective validity falls back to the default, as it would cause a
+// rotation on every reconciliation.
+func getDurations(spec *druidv1alpha1.ManagedCertificatesSpec) (caValidity, certificateValidity, caRenewBefore, certificateRenewBefore time.Duration) {
+	caValidity, certificateValidity = defaultCAValidity, defaultCertificateValidity
+	if spec.CAValidity != nil {
+		caValidity = spec.CAValidity.Duration
+	}
+	if spec.CertificateValidity != nil {
+		certificateValidity = spec.CertificateValidity.Duration
+	}
+	caRenewBefore, certificateRenewBefore = caValidity/3, certificateValidity/3
+	if spec.RenewBefore != nil {
+		if spec.RenewBefore.Duration < caValidity {
+			caRenewBefore = spec.RenewBefore.Duration
+		}
+		if spec.RenewBefore.Duration < certificateValidity {
+			certificateRenewBefore = spec.RenewBefore.Duration
+		}
+	}
+	return
+}
+
+func containsCertificate(certs []*x509.Certificate, cert *x509.Certificate) bool {
+	return slices.ContainsFunc(certs, cert.Equal)
+}
+
+func leafSecretNames(etcdObjMeta metav1.ObjectMeta) []string {
+	return []string{
+		druidv1alpha1.GetManagedServerTLSSecretName(etcdObjMeta),
+		druidv1alpha1.GetManagedPeerTLSSecretName(etcdObjMeta),
+		druidv1alpha1.GetManagedClientTLSSecretName(etcdObjMeta),
+	}
+}
+
+func getLabels(etcd *druidv1alpha1.Etcd, secretName string) map[string]string {
+	secretLabels := map[string]string{
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameManagedCertificates,
+		druidv1alpha1.LabelAppNameKey:   secretName,
+	}
+	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), secretLabels)
+}
+
+func getObjectKeys(etcdObjMeta metav1.ObjectMeta) []client.ObjectKey {
+	names := append([]string{
+		druidv1alpha1.GetManagedSigningCASecretName(etcdObjMeta),
+		druidv1alpha1.GetManagedCASecretName(etcdObjMeta),
+	}, leafSecretNames(etcdObjMeta)...)
+	objectKeys := make([]client.ObjectKey, 0, len(names))
+	for _, name := range names {
+		objectKeys = append(objectKeys, client.ObjectKey{Name: name, Namespace: etcdObjMeta.Namespace})
+	}
+	return objectKeys
+}
+
+func emptySecret(objectKey client.ObjectKey) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objectKey.Name,
+			Namespace: objectKey.Namespace,
+		},
+	}
+}
@@ -0,0 +1,465 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedcertificates
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
+	"github.com/gardener/etcd-druid/internal/common"
+	"github.com/gardener/etcd-druid/internal/component"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// ------------------------ GetExistingResourceNames ------------------------
+func TestGetExistingResourceNames(t *testing.T) {
+	etcd := newEtcdWithManagedCertificates()
+	testCases := []struct {
+		name                string
+		secretsExist        bool
+		getErr              *apierrors.StatusError
+		expectedErr         *druiderr.DruidError
+		expectedSecretNames []string
+	}{
+		{
+			name:                "should return empty slice when no secrets exist",
+			expectedSecretNames: []string{},
+		},
+		{
+			name:         "should return the names of all existing secrets",
+			secretsExist: true,
+			expectedSecretNames: []string{
+				druidv1alpha1.GetManagedSigningCASecretName(etcd.ObjectMeta),
+				druidv1alpha1.GetManagedCASecretName(etcd.ObjectMeta),
+				druidv1alpha1.GetManagedServerTLSSecretName(etcd.ObjectMeta),
+				druidv1alpha1.GetManagedPeerTLSSecretName(etcd.ObjectMeta),
+				druidv1alpha1.GetManagedClientTLSSecretName(etcd.ObjectMeta),
+			},
+		},
+		{
+			name:         "should return err when client get fails",
+			secretsExist: true,
+			getErr:       testutils.TestAPIInternalErr,
+			expectedErr: &druiderr.DruidError{
+				Code:      ErrGetManagedCertificates,
+				Cause:     testutils.TestAPIInternalErr,
+				Operation: component.OperationGetExistingResourceNames,
+			},
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			var existingObjects []client.Object
+			if tc.secretsExist {
+				for _, objectKey := range getObjectKeys(etcd.ObjectMeta) {
+					existingObjects = append(existingObjects, newOwnedSecret(etcd, objectKey))
+				}
+			}
+			cl := testutils.CreateTestFakeClientForObjects(tc.getErr, nil, nil, nil, existingObjects, getObjectKeys(etcd.ObjectMeta)...)
+			operator := New(cl)
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			secretNames, err := operator.GetExistingResourceNames(opCtx, etcd.ObjectMeta)
+			if tc.expectedErr != nil {
+				testutils.CheckDruidError(g, tc.expectedErr, err)
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(secretNames).To(ConsistOf(tc.expectedSecretNames))
+		})
+	}
+}
+
+// ----------------------------------- Sync -----------------------------------
+func TestSync(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should be a no-op when managed certificates are disabled", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil)
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		g.Expect(New(cl).Sync(opCtx, etcd)).To(Succeed())
+		g.Expect(opCtx.Data).ToNot(HaveKey(druidresources.CheckSumKeyManagedCertificates))
+		secrets := &corev1.SecretList{}
+		g.Expect(cl.List(context.Background(), secrets)).To(Succeed())
+		g.Expect(secrets.Items).To(BeEmpty())
+	})
+
+	t.Run("should create the CA and certificates and keep them on subsequent syncs", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := newEtcdWithManagedCertificates()
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil)
+		operator := New(cl)
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+		checksum := opCtx.Data[druidresources.CheckSumKeyManagedCertificates]
+		g.Expect(checksum).ToNot(BeEmpty())
+		g.Expect(opCtx.Data).ToNot(HaveKey(common.ManagedCertificatesCARotationInProgressKey))
+
+		caBundle := getSecret(g, cl, druidv1alpha1.GetManagedCASecretName(etcd.ObjectMeta))
+		g.Expect(caBundle.OwnerReferences).To(ConsistOf(druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)))
+		g.Expect(caBundle.Labels).To(HaveKeyWithValue(druidv1alpha1.LabelComponentKey, druidv1alpha1.ComponentNameManagedCertificates))
+		caCerts, err := parseCertificates(caBundle.Data[druidv1alpha1.ManagedCertificatesCABundleDataKey])
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(caCerts).To(HaveLen(1))
+		roots := x509.NewCertPool()
+		roots.AddCert(caCerts[0])
+
+		signingCA := getSecret(g, cl, druidv1alpha1.GetManagedSigningCASecretName(etcd.ObjectMeta))
+		g.Expect(signingCA.Data).To(HaveKey(dataKeySigningCAKey))
+		g.Expect(signingCA.Data).ToNot(HaveKey(dataKeyNextCACert))
+
+		server := getLeafCertificate(g, cl, druidv1alpha1.GetManagedServerTLSSecretName(etcd.ObjectMeta))
+		_, err = server.Verify(x509.VerifyOptions{Roots: roots, DNSName: "etcd-test-0.etcd-test-peer.test-ns.svc", KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}})
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = server.Verify(x509.VerifyOptions{Roots: roots, DNSName: "etcd-test-client.test-ns.svc.cluster.local", KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}})
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = server.Verify(x509.VerifyOptions{Roots: roots, DNSName: "etcd-test-local", KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}})
+		g.Expect(err).ToNot(HaveOccurred())
+		peer := getLeafCertificate(g, cl, druidv1alpha1.GetManagedPeerTLSSecretName(etcd.ObjectMeta))
+		_, err = peer.Verify(x509.VerifyOptions{Roots: roots, DNSName: "etcd-test-1.etcd-test-peer.test-ns.svc.cluster.local", KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}})
+		g.Expect(err).ToNot(HaveOccurred())
+		clientCert := getLeafCertificate(g, cl, druidv1alpha1.GetManagedClientTLSSecretName(etcd.ObjectMeta))
+		_, err = clientCert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		opCtx = component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+		g.Expect(opCtx.Data).To(HaveKeyWithValue(druidresources.CheckSumKeyManagedCertificates, checksum))
+	})
+
+	t.Run("should issue client certificates for users and delete them once no longer requested", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := newEtcdWithManagedCertificates()
+		etcd.Spec.Etcd.Auth = &druidv1alpha1.EtcdAuth{
+			Users: []druidv1alpha1.EtcdAuthUser{
+				{Name: "app-a", IssueClientCertificate: ptr.To(true)},
+				{Name: "app-b"},
+			},
+		}
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, nil)
+		operator := New(cl)
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+		checksum := opCtx.Data[druidresources.CheckSumKeyManagedCertificates]
+
+		userSecretName := druidv1alpha1.GetUserClientTLSSecretName(etcd.ObjectMeta, "app-a")
+		userSecret := getSecret(g, cl, userSecretName)
+		g.Expect(userSecret.Labels).To(HaveKeyWithValue(druidv1alpha1.LabelComponentKey, druidv1alpha1.ComponentNameUserClientCertificate))
+		roots := x509.NewCertPool()
+		g.Expect(roots.AppendCertsFromPEM(userSecret.Data[dataKeyUserCACert])).To(BeTrue())
+		userCert := getLeafCertificate(g, cl, userSecretName)
+		g.Expect(userCert.Subject.CommonName).To(Equal("app-a"))
+		_, err := userCert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(cl.Get(context.Background(), client.ObjectKey{Name: druidv1alpha1.GetUserClientTLSSecretName(etcd.ObjectMeta, "app-b"), Namespace: etcd.Namespace}, &corev1.Secret{})).To(testutils.BeNotFoundError())
+
+		names, err := operator.GetExistingResourceNames(opCtx, etcd.ObjectMeta)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(names).To(ContainElement(userSecretName))
+
+		etcd.Spec.Etcd.Auth.Users[0].IssueClientCertificate = nil
+		opCtx = component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		g.Expect(operator.Sync(opCtx, etcd)).To(Succeed())
+		g.Expect(opCtx.Data).To(HaveKeyWithValue(druidresources.CheckSumKeyManagedCertificates, checksum))
+		g.Expect(cl.Get(context.Background(), client.ObjectKey{Name: userSecretName, Namespace: etcd.Namespace}, &corev1.Secret{})).To(testutils.BeNotFoundError())
+	})
+
+	t.Run("should return err when a secret is not controlled by the etcd", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := newEtcdWithManagedCertificates()
+		foreignSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: druidv1alpha1.GetManagedCASecretName(etcd.ObjectMeta), Namespace: etcd.Namespace}}
+		cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{foreignSecret}, client.ObjectKeyFromObject(foreignSecret))
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		err := New(cl).Sync(opCtx, etcd)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(druiderr.AsDruidError(err).Code).To(Equal(ErrSyncManagedCertificates))
+	})
+
+	t.Run("should return err when client create fails", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+		etcd := newEtcdWithManagedCertificates()
+		cl := testutils.CreateTestFakeClientForObjects(nil, testutils.TestAPIInternalErr, nil, nil, nil, getObjectKeys(etcd.ObjectMeta)...)
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		testutils.CheckDruidError(g, &druiderr.DruidError{
+			Code:      ErrSyncManagedCertificates,
+			Cause:     testutils.TestAPIInternalErr,
+			Operation: component.OperationSync,
+		}, New(cl).Sync(opCtx, etcd))
+	})
+}
+
+func TestComputeDesiredCertificateStateRotatesCA(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	etcd := newEtcdWithManagedCertificates()
+	etcd.Spec.ManagedCertificates.CAValidity = &metav1.Duration{Duration: 300 * time.Hour}
+	etcd.Spec.ManagedCertificates.CertificateValidity = &metav1.Duration{Duration: 30 * time.Hour}
+	now := time.Now()
+
+	initial, err := computeDesiredCertificateState(etcd, &certificateState{}, true, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(initial.caBundle).To(HaveLen(1))
+	g.Expect(initial.isCARotationInProgress()).To(BeFalse())
+
+	// the CA is due for renewal, the next CA is only added to the bundle
+	now = now.Add(250 * time.Hour)
+	withNextCA, err := computeDesiredCertificateState(etcd, initial, true, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(withNextCA.nextCA).ToNot(BeNil())
+	g.Expect(withNextCA.signingCA.cert.Equal(initial.signingCA.cert)).To(BeTrue())
+	g.Expect(withNextCA.caBundle).To(HaveLen(2))
+	g.Expect(withNextCA.isCARotationInProgress()).To(BeTrue())
+
+	// the next CA is not promoted as long as the bundle has not been rolled out
+	notRolledOut, err := computeDesiredCertificateState(etcd, withNextCA, false, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(notRolledOut.signingCA.cert.Equal(initial.signingCA.cert)).To(BeTrue())
+	g.Expect(notRolledOut.checksum()).To(Equal(withNextCA.checksum()))
+
+	// the next CA is promoted and the certificates are reissued, the previous CA is still trusted
+	promoted, err := computeDesiredCertificateState(etcd, withNextCA, true, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(promoted.nextCA).To(BeNil())
+	g.Expect(promoted.signingCA.cert.Equal(withNextCA.nextCA.cert)).To(BeTrue())
+	g.Expect(promoted.caBundle).To(HaveLen(2))
+	g.Expect(promoted.caBundle).To(ContainElement(initial.signingCA.cert))
+	for name, leaf := range promoted.leaves {
+		g.Expect(leaf.cert.CheckSignatureFrom(promoted.signingCA.cert)).To(Succeed(), name)
+	}
+	g.Expect(promoted.isCARotationInProgress()).To(BeTrue())
+
+	// the previous CA is removed once the reissued certificates have been rolled out
+	completed, err := computeDesiredCertificateState(etcd, promoted, true, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(completed.caBundle).To(HaveLen(1))
+	g.Expect(completed.caBundle[0].Equal(promoted.signingCA.cert)).To(BeTrue())
+	g.Expect(completed.isCARotationInProgress()).To(BeFalse())
+}
+
+func TestComputeDesiredCertificateStateConvergesDuringCARenewal(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	etcd := newEtcdWithManagedCertificates()
+	etcd.Spec.ManagedCertificates.CAValidity = &metav1.Duration{Duration: 300 * time.Hour}
+	etcd.Spec.ManagedCertificates.CertificateValidity = &metav1.Duration{Duration: 30 * time.Hour}
+	etcd.Spec.ManagedCertificates.RenewBefore = &metav1.Duration{Duration: 20 * time.Hour}
+	now := time.Now()
+
+	initial, err := computeDesiredCertificateState(etcd, &certificateState{}, true, now)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// the certificates are reissued shortly before the CA is due, their validity is limited by the CA
+	now = now.Add(275 * time.Hour)
+	reissued, err := computeDesiredCertificateState(etcd, initial, true, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reissued.nextCA).To(BeNil())
+	for name, leaf := range reissued.leaves {
+		g.Expect(leaf.cert.Equal(initial.leaves[name].cert)).To(BeFalse(), name)
+		g.Expect(leaf.cert.NotAfter).To(Equal(initial.signingCA.cert.NotAfter), name)
+	}
+
+	// the certificates valid until the CA expires are not reissued again, although they are due for renewal
+	stable, err := computeDesiredCertificateState(etcd, reissued, true, now.Add(time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(stable.checksum()).To(Equal(reissued.checksum()))
+
+	// the CA is due, the next CA is added to the bundle while the certificates are kept
+	now = now.Add(5 * time.Hour)
+	withNextCA, err := computeDesiredCertificateState(etcd, stable, true, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(withNextCA.nextCA).ToNot(BeNil())
+	for name, leaf := range withNextCA.leaves {
+		g.Expect(leaf.cert.Equal(stable.leaves[name].cert)).To(BeTrue(), name)
+	}
+
+	// the checksum settles while the bundle is rolled out, so that the next CA is eventually promoted
+	notRolledOut, err := computeDesiredCertificateState(etcd, withNextCA, false, now.Add(time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(notRolledOut.checksum()).To(Equal(withNextCA.checksum()))
+	promoted, err := computeDesiredCertificateState(etcd, notRolledOut, true, now.Add(2*time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(promoted.nextCA).To(BeNil())
+	g.Expect(promoted.signingCA.cert.Equal(withNextCA.nextCA.cert)).To(BeTrue())
+	for name, leaf := range promoted.leaves {
+		g.Expect(leaf.cert.CheckSignatureFrom(promoted.signingCA.cert)).To(Succeed(), name)
+		g.Expect(leaf.cert.NotAfter.Sub(now)).To(BeNumerically(">", 29*time.Hour), name)
+	}
+
+	// the reissued certificates are not renewed again once they have been rolled out
+	completed, err := computeDesiredCertificateState(etcd, promoted, true, now.Add(3*time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(completed.isCARotationInProgress()).To(BeFalse())
+	for name, leaf := range completed.leaves {
+		g.Expect(leaf.cert.Equal(promoted.leaves[name].cert)).To(BeTrue(), name)
+	}
+}
+
+func TestComputeDesiredCertificateStateReissuesCertificates(t *testing.T) {
+	etcd := newEtcdWithManagedCertificates()
+	etcd.Spec.ManagedCertificates.CertificateValidity = &metav1.Duration{Duration: 30 * time.Hour}
+	now := time.Now()
+	initial, err := computeDesiredCertificateState(etcd, &certificateState{}, true, now)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	testCases := []struct {
+		name            string
+		mutateEtcd      func(etcd *druidv1alpha1.Etcd)
+		elapsed         time.Duration
+		expectReissued  []string
+		expectUnchanged []string
+	}{
+		{
+			name:            "should keep certificates which are not due for renewal",
+			elapsed:         time.Hour,
+			expectUnchanged: leafSecretNames(etcd.ObjectMeta),
+		},
+		{
+			name:           "should reissue certificates which are due for renewal",
+			elapsed:        25 * time.Hour,
+			expectReissued: leafSecretNames(etcd.ObjectMeta),
+		},
+		{
+			name: "should reissue certificates whose DNS names do not match the etcd anymore",
+			mutateEtcd: func(etcd *druidv1alpha1.Etcd) {
+				etcd.Namespace = "other-ns"
+			},
+			expectReissued:  []string{druidv1alpha1.GetManagedServerTLSSecretName(etcd.ObjectMeta), druidv1alpha1.GetManagedPeerTLSSecretName(etcd.ObjectMeta)},
+			expectUnchanged: []string{druidv1alpha1.GetManagedClientTLSSecretName(etcd.ObjectMeta)},
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := etcd.DeepCopy()
+			if tc.mutateEtcd != nil {
+				tc.mutateEtcd(etcd)
+			}
+			desired, err := computeDesiredCertificateState(etcd, initial, true, now.Add(tc.elapsed))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(desired.signingCA.cert.Equal(initial.signingCA.cert)).To(BeTrue())
+			g.Expect(desired.caBundle).To(HaveLen(1))
+			for _, name := range tc.expectReissued {
+				g.Expect(desired.leaves[name].cert.Equal(initial.leaves[name].cert)).To(BeFalse(), name)
+			}
+			for _, name := range tc.expectUnchanged {
+				g.Expect(desired.leaves[name].cert.Equal(initial.leaves[name].cert)).To(BeTrue(), name)
+			}
+		})
+	}
+}
+
+// ----------------------------- TriggerDelete -------------------------------
+func TestTriggerDelete(t *testing.T) {
+	testCases := []struct {
+		name         string
+		secretsExist bool
+		deleteErr    *apierrors.StatusError
+		expectedErr  *druiderr.DruidError
+	}{
+		{
+			name: "no-op when secrets do not exist",
+		},
+		{
+			name:         "successfully delete existing secrets",
+			secretsExist: true,
+		},
+		{
+			name:         "return error when client delete fails",
+			secretsExist: true,
+			deleteErr:    testutils.TestAPIInternalErr,
+			expectedErr: &druiderr.DruidError{
+				Code:      ErrDeleteManagedCertificates,
+				Cause:     testutils.TestAPIInternalErr,
+				Operation: component.OperationTriggerDelete,
+			},
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := newEtcdWithManagedCertificates()
+			var existingObjects []client.Object
+			if tc.secretsExist {
+				for _, objectKey := range getObjectKeys(etcd.ObjectMeta) {
+					existingObjects = append(existingObjects, newOwnedSecret(etcd, objectKey))
+				}
+			}
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, tc.deleteErr, existingObjects, getObjectKeys(etcd.ObjectMeta)...)
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			err := New(cl).TriggerDelete(opCtx, etcd.ObjectMeta)
+			if tc.expectedErr != nil {
+				testutils.CheckDruidError(g, tc.expectedErr, err)
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			secrets := &corev1.SecretList{}
+			g.Expect(cl.List(context.Background(), secrets)).To(Succeed())
+			g.Expect(secrets.Items).To(BeEmpty())
+		})
+	}
+}
+
+// ---------------------------- Helper Functions -----------------------------
+
+func newEtcdWithManagedCertificates() *druidv1alpha1.Etcd {
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+	etcd.Spec.ManagedCertificates = &druidv1alpha1.ManagedCertificatesSpec{}
+	return etcd
+}
+
+func newOwnedSecret(etcd *druidv1alpha1.Etcd, objectKey client.ObjectKey) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            objectKey.Name,
+			Namespace:       objectKey.Namespace,
+			OwnerReferences: []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)},
+		},
+	}
+}
+
+func getSecret(g *WithT, cl client.Client, name string) *corev1.Secret {
+	secret := &corev1.Secret{}
+	g.Expect(cl.Get(context.Background(), client.ObjectKey{Name: name, Namespace: testutils.TestNamespace}, secret)).To(Succeed())
+	return secret
+}
+
+func getLeafCertificate(g *WithT, cl client.Client, name string) *x509.Certificate {
+	secret := getSecret(g, cl, name)
+	g.Expect(secret.Type).To(Equal(corev1.SecretTypeTLS))
+	leaf, err := parseKeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	g.Expect(err).ToNot(HaveOccurred())
+	return leaf.cert
+}
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedcertificates
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"slices"
+	"time"
+)
+
+const (
+	pemTypeCertificate  = "CERTIFICATE"
+	pemTypeECPrivateKey = "EC PRIVATE KEY"
+	// certificateBackdate is subtracted from the current time for the start of the validity of a certificate, to
+	// tolerate clock skew between the nodes of the cluster.
+	certificateBackdate = 5 * time.Minute
+)
+
+// keyPair is a certificate along with its private key.
+type keyPair struct {
+	cert    *x509.Certificate
+	key     crypto.Signer
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// certificateConfig defines the subject and usages of a certificate which is signed by the managed CA.
+type certificateConfig struct {
+	commonName  string
+	dnsNames    []string
+	ipAddresses []net.IP
+	extKeyUsage []x509.ExtKeyUsage
+}
+
+// generateCA generates a new self-signed CA.
+func generateCA(commonName string, validity time.Duration, now time.Time) (*keyPair, error) {
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-certificateBackdate),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	return createKeyPair(template, nil)
+}
+
+// generateCertificate generates a new certificate for the given config which is signed by the given CA.
+func generateCertificate(ca *keyPair, config certificateConfig, validity time.Duration, now time.Time) (*keyPair, error) {
+	template := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: config.commonName},
+		DNSNames:    config.dnsNames,
+		IPAddresses: config.ipAddresses,
+		NotBefore:   now.Add(-certificateBackdate),
+		NotAfter:    now.Add(validity),
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: config.extKeyUsage,
+	}
+	if ca.cert.NotAfter.Before(template.NotAfter) {
+		template.NotAfter = ca.cert.NotAfter
+	}
+	return createKeyPair(template, ca)
+}
+
+func createKeyPair(template *x509.Certificate, ca *keyPair) (*keyPair, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	template.SerialNumber = serialNumber
+
+	parent, signer := template, crypto.Signer(key)
+	if ca != nil {
+		parent, signer = ca.cert, ca.key
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse created certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal private key: %w", err)
+	}
+	return &keyPair{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: certDER}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: pemTypeECPrivateKey, Bytes: keyDER}),
+	}, nil
+}
+
+// parseKeyPair parses a PEM encoded certificate and EC private key.
+func parseKeyPair(certPEM, keyPEM []byte) (*keyPair, error) {
+	certs, err := parseCertificates(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+	block, _ := pem.Decode(keyPEM)
+	if block == nil || block.Type != pemTypeECPrivateKey {
+		return nil, errors.New("no EC private key found")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	return &keyPair{cert: certs[0], key: key, certPEM: certPEM, keyPEM: keyPEM}, nil
+}
+
+// parseCertificates parses all PEM encoded certificates contained in the given data.
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != pemTypeCertificate {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// encodeCertificates PEM encodes the given certificates into a single bundle.
+func encodeCertificates(certs []*x509.Certificate) []byte {
+	var buf bytes.Buffer
+	for _, cert := range certs {
+		_ = pem.Encode(&buf, &pem.Block{Type: pemTypeCertificate, Bytes: cert.Raw})
+	}
+	return buf.Bytes()
+}
+
+// isRenewalDue checks if the given certificate is about to expire within the given renewBefore duration.
+func isRenewalDue(cert *x509.Certificate, renewBefore time.Duration, now time.Time) bool {
+	return !now.Before(cert.NotAfter.Add(-renewBefore))
+}
+
+// matchesConfig checks if the given certificate has been issued by the given CA for the given config.
+func matchesConfig(cert *x509.Certificate, ca *x509.Certificate, config certificateConfig) bool {
+	if cert.CheckSignatureFrom(ca) != nil {
+		return false
+	}
+	if cert.Subject.CommonName != config.commonName ||
+		!slices.Equal(cert.DNSNames, config.dnsNames) ||
+		!slices.Equal(cert.ExtKeyUsage, config.extKeyUsage) {
+		return false
+	}
+	return slices.EqualFunc(cert.IPAddresses, config.ipAddresses, func(a, b net.IP) bool { return a.Equal(b) })
+}
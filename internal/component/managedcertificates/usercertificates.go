@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedcertificates
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	"github.com/gardener/etcd-druid/internal/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// dataKeyUserCACert is the data key of a user client certificate secret holding the bundle of trusted CAs, so that
+// the user can verify the server certificate of etcd.
+const dataKeyUserCACert = "ca.crt"
+
+// syncUserCertificates issues client certificates signed by the signing CA for all users of the Etcd which request one,
+// reissues them if they are due for renewal or not signed by the signing CA, and deletes the secrets of all other users.
+// The certificates are not mounted into the etcd pods and therefore do not contribute to the checksum of the certificates.
+func (r _resource) syncUserCertificates(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, state *certificateState) error {
+	existing, err := r.listUserCertificateSecrets(ctx, etcd.ObjectMeta, component.OperationSync)
+	if err != nil {
+		return err
+	}
+	_, certificateValidity, _, certificateRenewBefore := getDurations(etcd.Spec.ManagedCertificates)
+	now := r.now()
+	desired := make(map[string]struct{})
+	for _, user := range getUsersWithClientCertificate(etcd) {
+		name := druidv1alpha1.GetUserClientTLSSecretName(etcd.ObjectMeta, user)
+		desired[name] = struct{}{}
+		config := certificateConfig{
+			commonName:  user,
+			extKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+
+		var leaf *keyPair
+		if secret, ok := existing[name]; ok {
+			// certificates which cannot be parsed are reissued
+			leaf, _ = parseKeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		}
+		if leaf == nil || !matchesConfig(leaf.cert, state.signingCA.cert, config) || isLeafRenewalDue(leaf.cert, state, certificateRenewBefore, now) {
+			if leaf, err = generateCertificate(state.signingCA, config, certificateValidity, now); err != nil {
+				return druiderr.WrapError(err,
+					ErrSyncManagedCertificates,
+					component.OperationSync,
+					fmt.Sprintf("Error generating client certificate of user: %v for etcd: %v", user, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+			}
+		}
+
+		secret := emptySecret(client.ObjectKey{Name: name, Namespace: etcd.Namespace})
+		opResult, err := controllerutil.CreateOrPatch(ctx, r.client, secret, func() error {
+			secret.Labels = getUserCertificateLabels(etcd, name)
+			secret.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+			secret.Type = corev1.SecretTypeTLS
+			secret.Data = map[string][]byte{
+				corev1.TLSCertKey:       leaf.certPEM,
+				corev1.TLSPrivateKeyKey: leaf.keyPEM,
+				dataKeyUserCACert:       encodeCertificates(state.caBundle),
+			}
+			return nil
+		})
+		if err != nil {
+			return druiderr.WrapError(err,
+				ErrSyncManagedCertificates,
+				component.OperationSync,
+				fmt.Sprintf("Error during create or update of user client certificate secret: %v for etcd: %v", name, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+		}
+		ctx.Logger.V(1).Info("synced user client certificate secret", "name", name, "result", opResult)
+	}
+
+	for name, secret := range existing {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		if err = client.IgnoreNotFound(r.client.Delete(ctx, secret)); err != nil {
+			return druiderr.WrapError(err,
+				ErrSyncManagedCertificates,
+				component.OperationSync,
+				fmt.Sprintf("Error deleting user client certificate secret: %v for etcd: %v", name, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+		}
+		ctx.Logger.Info("deleted user client certificate secret", "name", name)
+	}
+	return nil
+}
+
+// listUserCertificateSecrets returns the existing secrets holding the client certificates of users of the Etcd, keyed
+// by their name.
+func (r _resource) listUserCertificateSecrets(ctx component.OperatorContext, etcdObjMeta metav1.ObjectMeta, operation string) (map[string]*corev1.Secret, error) {
+	secretList := &corev1.SecretList{}
+	if err := r.client.List(ctx,
+		secretList,
+		client.InNamespace(etcdObjMeta.Namespace),
+		client.MatchingLabels(getSelectorLabelsForAllUserCertificates(etcdObjMeta)),
+	); err != nil {
+		return nil, druiderr.WrapError(err,
+			ErrGetManagedCertificates,
+			operation,
+			fmt.Sprintf("Error listing user client certificate secrets for etcd: %v", druidv1alpha1.GetNamespaceName(etcdObjMeta)))
+	}
+	secrets := make(map[string]*corev1.Secret, len(secretList.Items))
+	for i := range secretList.Items {
+		if metav1.IsControlledBy(&secretList.Items[i], &etcdObjMeta) {
+			secrets[secretList.Items[i].Name] = &secretList.Items[i]
+		}
+	}
+	return secrets, nil
+}
+
+// getUsersWithClientCertificate returns the names of the users of the Etcd for which a client certificate is issued.
+func getUsersWithClientCertificate(etcd *druidv1alpha1.Etcd) []string {
+	if etcd.Spec.Etcd.Auth == nil {
+		return nil
+	}
+	var users []string
+	for _, user := range etcd.Spec.Etcd.Auth.Users {
+		if ptr.Deref(user.IssueClientCertificate, false) {
+			users = append(users, user.Name)
+		}
+	}
+	return users
+}
+
+func getSelectorLabelsForAllUserCertificates(etcdObjMeta metav1.ObjectMeta) map[string]string {
+	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcdObjMeta), map[string]string{
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameUserClientCertificate,
+	})
+}
+
+func getUserCertificateLabels(etcd *druidv1alpha1.Etcd, secretName string) map[string]string {
+	secretLabels := map[string]string{
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameUserClientCertificate,
+		druidv1alpha1.LabelAppNameKey:   secretName,
+	}
+	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), secretLabels)
+}
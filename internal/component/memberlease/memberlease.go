@@ -9,7 +9,6 @@ import (
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
 	"github.com/gardener/etcd-druid/internal/utils"
@@ -138,14 +137,14 @@ func getObjectKeys(etcd *druidv1alpha1.Etcd) []client.ObjectKey {
 
 func getSelectorLabelsForAllMemberLeases(etcdObjMeta metav1.ObjectMeta) map[string]string {
 	leaseMatchingLabels := map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameMemberLease,
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameMemberLease,
 	}
 	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcdObjMeta), leaseMatchingLabels)
 }
 
 func getLabels(etcd *druidv1alpha1.Etcd, leaseName string) map[string]string {
 	leaseLabels := map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameMemberLease,
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameMemberLease,
 		druidv1alpha1.LabelAppNameKey:   leaseName,
 	}
 	return utils.MergeMaps(leaseLabels, druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta))
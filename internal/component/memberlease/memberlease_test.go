@@ -11,7 +11,6 @@ import (
 	"testing"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
 	"github.com/gardener/etcd-druid/internal/utils"
@@ -252,7 +251,7 @@ func TestTriggerDelete(t *testing.T) {
 				}
 			}
 			for _, nonTargetLeaseName := range nonTargetLeaseNames {
-				existingObjects = append(existingObjects, testutils.CreateLease(nonTargetLeaseName, nonTargetEtcd.Namespace, nonTargetEtcd.Name, nonTargetEtcd.UID, common.ComponentNameMemberLease))
+				existingObjects = append(existingObjects, testutils.CreateLease(nonTargetLeaseName, nonTargetEtcd.Namespace, nonTargetEtcd.Name, nonTargetEtcd.UID, druidv1alpha1.ComponentNameMemberLease))
 			}
 			cl := testutils.CreateTestFakeClientForAllObjectsInNamespace(tc.deleteAllOfErr, nil, etcd.Namespace, getSelectorLabelsForAllMemberLeases(etcd.ObjectMeta), existingObjects...)
 			// ***************** Setup component operator and test *****************
@@ -279,7 +278,7 @@ func getLatestMemberLeases(g *WithT, cl client.Client, etcd *druidv1alpha1.Etcd)
 		cl,
 		etcd,
 		utils.MergeMaps(map[string]string{
-			druidv1alpha1.LabelComponentKey: common.ComponentNameMemberLease,
+			druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameMemberLease,
 		}, druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta)))
 }
 
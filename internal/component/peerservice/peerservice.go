@@ -9,16 +9,13 @@ import (
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
-	"github.com/gardener/etcd-druid/internal/utils"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -72,7 +69,7 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 	objectKey := getObjectKey(etcd.ObjectMeta)
 	svc := emptyPeerService(objectKey)
 	result, err := controllerutil.CreateOrPatch(ctx, r.client, svc, func() error {
-		buildResource(etcd, svc)
+		druidresources.BuildPeerService(etcd, svc)
 		return nil
 	})
 	if err != nil {
@@ -106,27 +103,6 @@ func (r _resource) TriggerDelete(ctx component.OperatorContext, etcdObjMeta meta
 	return nil
 }
 
-func buildResource(etcd *druidv1alpha1.Etcd, svc *corev1.Service) {
-	svc.Labels = getLabels(etcd)
-	svc.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
-	svc.Spec.Type = corev1.ServiceTypeClusterIP
-	svc.Spec.ClusterIP = corev1.ClusterIPNone
-	svc.Spec.SessionAffinity = corev1.ServiceAffinityNone
-	// Peer service should only target StatefulSet pods. Default labels are going to be present on anything that is managed by etcd-druid and started for an etcd cluster.
-	// Therefore, only using default labels as label selector can cause issues as we have already seen in https://github.com/gardener/etcd-druid/issues/914
-	svc.Spec.Selector = utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), map[string]string{druidv1alpha1.LabelComponentKey: common.ComponentNameStatefulSet})
-	svc.Spec.PublishNotReadyAddresses = true
-	svc.Spec.Ports = getPorts(etcd)
-}
-
-func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
-	svcLabels := map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNamePeerService,
-		druidv1alpha1.LabelAppNameKey:   druidv1alpha1.GetPeerServiceName(etcd.ObjectMeta),
-	}
-	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), svcLabels)
-}
-
 func getObjectKey(obj metav1.ObjectMeta) client.ObjectKey {
 	return client.ObjectKey{Name: druidv1alpha1.GetPeerServiceName(obj), Namespace: obj.Namespace}
 }
@@ -139,15 +115,3 @@ func emptyPeerService(objectKey client.ObjectKey) *corev1.Service {
 		},
 	}
 }
-
-func getPorts(etcd *druidv1alpha1.Etcd) []corev1.ServicePort {
-	peerPort := ptr.Deref(etcd.Spec.Etcd.ServerPort, common.DefaultPortEtcdPeer)
-	return []corev1.ServicePort{
-		{
-			Name:       "peer",
-			Protocol:   corev1.ProtocolTCP,
-			Port:       peerPort,
-			TargetPort: intstr.FromInt(int(peerPort)),
-		},
-	}
-}
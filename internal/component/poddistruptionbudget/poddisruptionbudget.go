@@ -9,16 +9,13 @@ import (
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
-	"github.com/gardener/etcd-druid/internal/utils"
 
 	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -32,12 +29,6 @@ const (
 	ErrDeletePodDisruptionBudget druidapicommon.ErrorCode = "ERR_DELETE_POD_DISRUPTION_BUDGET"
 )
 
-const (
-	// annotationAllowUnhealthyPodEviction is an annotation that can be set on the Etcd resource, to allow unhealthy pod eviction.
-	// WARNING: this annotation may be removed at any point of time, and is NOT meant to be generally used.
-	annotationAllowUnhealthyPodEviction = "resources.druid.gardener.cloud/allow-unhealthy-pod-eviction"
-)
-
 type _resource struct {
 	client client.Client
 }
@@ -78,7 +69,7 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 	objectKey := getObjectKey(etcd.ObjectMeta)
 	pdb := emptyPodDisruptionBudget(objectKey)
 	result, err := controllerutil.CreateOrPatch(ctx, r.client, pdb, func() error {
-		buildResource(etcd, pdb)
+		druidresources.BuildPodDisruptionBudget(etcd, pdb)
 		return nil
 	})
 	if err != nil {
@@ -106,31 +97,6 @@ func (r _resource) TriggerDelete(ctx component.OperatorContext, etcdObjMeta meta
 	return nil
 }
 
-func buildResource(etcd *druidv1alpha1.Etcd, pdb *policyv1.PodDisruptionBudget) {
-	pdb.Labels = getLabels(etcd)
-	pdb.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
-	pdb.Spec.MinAvailable = &intstr.IntOrString{
-		IntVal: computePDBMinAvailable(int(etcd.Spec.Replicas)),
-		Type:   intstr.Int,
-	}
-	pdb.Spec.Selector = &metav1.LabelSelector{
-		MatchLabels: druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta),
-	}
-	if metav1.HasAnnotation(etcd.ObjectMeta, annotationAllowUnhealthyPodEviction) {
-		pdb.Spec.UnhealthyPodEvictionPolicy = ptr.To(policyv1.AlwaysAllow)
-	} else {
-		pdb.Spec.UnhealthyPodEvictionPolicy = nil
-	}
-}
-
-func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
-	pdbLabels := map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNamePodDisruptionBudget,
-		druidv1alpha1.LabelAppNameKey:   etcd.Name,
-	}
-	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), pdbLabels)
-}
-
 func getObjectKey(obj metav1.ObjectMeta) client.ObjectKey {
 	return client.ObjectKey{Name: druidv1alpha1.GetPodDisruptionBudgetName(obj), Namespace: obj.Namespace}
 }
@@ -143,11 +109,3 @@ func emptyPodDisruptionBudget(objectKey client.ObjectKey) *policyv1.PodDisruptio
 		},
 	}
 }
-
-func computePDBMinAvailable(etcdReplicas int) int32 {
-	// do not enable PDB for single node cluster
-	if etcdReplicas <= 1 {
-		return 0
-	}
-	return int32(etcdReplicas/2 + 1) // #nosec G115 -- etcdReplicas will never cross the size of int32, so conversion is safe.
-}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
 	testutils "github.com/gardener/etcd-druid/test/utils"
@@ -248,7 +249,7 @@ func TestTriggerDelete(t *testing.T) {
 
 func newPodDisruptionBudget(etcd *druidv1alpha1.Etcd) *policyv1.PodDisruptionBudget {
 	pdb := emptyPodDisruptionBudget(getObjectKey(etcd.ObjectMeta))
-	buildResource(etcd, pdb)
+	druidresources.BuildPodDisruptionBudget(etcd, pdb)
 	return pdb
 }
 
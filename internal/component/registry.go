@@ -39,6 +39,10 @@ const (
 	ClientServiceKind Kind = "ClientService"
 	// PodDisruptionBudgetKind indicates that the kind of component is a PodDisruptionBudget.
 	PodDisruptionBudgetKind Kind = "PodDisruptionBudget"
+	// ManagedCertificatesKind indicates that the kind of component is a set of Secrets holding certificates managed by etcd-druid.
+	ManagedCertificatesKind Kind = "ManagedCertificates"
+	// EtcdAuthKind indicates that the kind of component is the auth store of etcd, which holds its users and roles.
+	EtcdAuthKind Kind = "EtcdAuth"
 )
 
 type registry struct {
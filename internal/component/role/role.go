@@ -6,14 +6,12 @@ package role
 
 import (
 	"fmt"
-	"strings"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
-	"github.com/gardener/etcd-druid/internal/utils"
 
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -71,7 +69,7 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 	objectKey := getObjectKey(etcd.ObjectMeta)
 	role := emptyRole(objectKey)
 	result, err := controllerutil.CreateOrPatch(ctx, r.client, role, func() error {
-		buildResource(etcd, role)
+		druidresources.BuildRole(etcd, role)
 		return nil
 	})
 	if err != nil {
@@ -116,33 +114,3 @@ func emptyRole(objectKey client.ObjectKey) *rbacv1.Role {
 		},
 	}
 }
-
-func buildResource(etcd *druidv1alpha1.Etcd, role *rbacv1.Role) {
-	role.Labels = getLabels(etcd)
-	role.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
-	role.Rules = []rbacv1.PolicyRule{
-		{
-			APIGroups: []string{"coordination.k8s.io"},
-			Resources: []string{"leases"},
-			Verbs:     []string{"get", "list", "patch", "update", "watch"},
-		},
-		{
-			APIGroups: []string{"apps"},
-			Resources: []string{"statefulsets"},
-			Verbs:     []string{"get", "list", "patch", "update", "watch"},
-		},
-		{
-			APIGroups: []string{""},
-			Resources: []string{"pods"},
-			Verbs:     []string{"get", "list", "watch"},
-		},
-	}
-}
-
-func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
-	roleLabels := map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameRole,
-		druidv1alpha1.LabelAppNameKey:   strings.ReplaceAll(druidv1alpha1.GetRoleName(etcd.ObjectMeta), ":", "-"), // role name contains `:` which is not an allowed character as a label value.
-	}
-	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), roleLabels)
-}
@@ -6,14 +6,12 @@ package rolebinding
 
 import (
 	"fmt"
-	"strings"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
-	"github.com/gardener/etcd-druid/internal/utils"
 
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -71,7 +69,7 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 	objectKey := getObjectKey(etcd.ObjectMeta)
 	rb := emptyRoleBinding(objectKey)
 	result, err := controllerutil.CreateOrPatch(ctx, r.client, rb, func() error {
-		buildResource(etcd, rb)
+		druidresources.BuildRoleBinding(etcd, rb)
 		return nil
 	})
 	if err != nil {
@@ -116,28 +114,3 @@ func emptyRoleBinding(objKey client.ObjectKey) *rbacv1.RoleBinding {
 		},
 	}
 }
-
-func buildResource(etcd *druidv1alpha1.Etcd, rb *rbacv1.RoleBinding) {
-	rb.Labels = getLabels(etcd)
-	rb.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
-	rb.RoleRef = rbacv1.RoleRef{
-		APIGroup: "rbac.authorization.k8s.io",
-		Kind:     "Role",
-		Name:     druidv1alpha1.GetRoleName(etcd.ObjectMeta),
-	}
-	rb.Subjects = []rbacv1.Subject{
-		{
-			Kind:      "ServiceAccount",
-			Name:      druidv1alpha1.GetServiceAccountName(etcd.ObjectMeta),
-			Namespace: etcd.Namespace,
-		},
-	}
-}
-
-func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
-	roleLabels := map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameRoleBinding,
-		druidv1alpha1.LabelAppNameKey:   strings.ReplaceAll(druidv1alpha1.GetRoleBindingName(etcd.ObjectMeta), ":", "-"), // role-binding name contains `:` which is not an allowed character as a label value.
-	}
-	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), roleLabels)
-}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
 	testutils "github.com/gardener/etcd-druid/test/utils"
@@ -187,7 +188,7 @@ func TestTriggerDelete(t *testing.T) {
 
 func newRoleBinding(etcd *druidv1alpha1.Etcd) *rbacv1.RoleBinding {
 	rb := emptyRoleBinding(getObjectKey(etcd.ObjectMeta))
-	buildResource(etcd, rb)
+	druidresources.BuildRoleBinding(etcd, rb)
 	return rb
 }
 
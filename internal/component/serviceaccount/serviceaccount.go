@@ -9,15 +9,13 @@ import (
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
-	"github.com/gardener/etcd-druid/internal/utils"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -73,7 +71,7 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 	objectKey := getObjectKey(etcd.ObjectMeta)
 	sa := emptyServiceAccount(objectKey)
 	opResult, err := controllerutil.CreateOrPatch(ctx, r.client, sa, func() error {
-		buildResource(etcd, sa, !r.disableAutoMount)
+		druidresources.BuildServiceAccount(etcd, sa, !r.disableAutoMount)
 		return nil
 	})
 	if err != nil {
@@ -105,20 +103,6 @@ func (r _resource) TriggerDelete(ctx component.OperatorContext, etcdObjMeta meta
 	return nil
 }
 
-func buildResource(etcd *druidv1alpha1.Etcd, sa *corev1.ServiceAccount, autoMountServiceAccountToken bool) {
-	sa.Labels = getLabels(etcd)
-	sa.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
-	sa.AutomountServiceAccountToken = ptr.To(autoMountServiceAccountToken)
-}
-
-func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
-	roleLabels := map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameServiceAccount,
-		druidv1alpha1.LabelAppNameKey:   druidv1alpha1.GetServiceAccountName(etcd.ObjectMeta),
-	}
-	return utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), roleLabels)
-}
-
 func getObjectKey(obj metav1.ObjectMeta) client.ObjectKey {
 	return client.ObjectKey{Name: druidv1alpha1.GetServiceAccountName(obj), Namespace: obj.Namespace}
 }
@@ -10,7 +10,6 @@ import (
 	"testing"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
 	"github.com/gardener/etcd-druid/internal/utils"
@@ -273,7 +272,7 @@ func buildLease(etcd *druidv1alpha1.Etcd, leaseName string) *coordinationv1.Leas
 			Name:      leaseName,
 			Namespace: etcd.Namespace,
 			Labels: utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), map[string]string{
-				druidv1alpha1.LabelComponentKey: common.ComponentNameSnapshotLease,
+				druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameSnapshotLease,
 				druidv1alpha1.LabelAppNameKey:   leaseName,
 			}),
 			OwnerReferences: []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)},
@@ -283,7 +282,7 @@ func buildLease(etcd *druidv1alpha1.Etcd, leaseName string) *coordinationv1.Leas
 
 func matchLease(leaseName string, etcd *druidv1alpha1.Etcd) gomegatypes.GomegaMatcher {
 	expectedLabels := utils.MergeMaps(druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta), map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameSnapshotLease,
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameSnapshotLease,
 		druidv1alpha1.LabelAppNameKey:   leaseName,
 	})
 	return MatchFields(IgnoreExtras, Fields{
@@ -300,7 +299,7 @@ func getLatestSnapshotLeases(cl client.Client, etcd *druidv1alpha1.Etcd) ([]coor
 	return doGetLatestLeases(cl,
 		etcd,
 		utils.MergeMaps(map[string]string{
-			druidv1alpha1.LabelComponentKey: common.ComponentNameSnapshotLease,
+			druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameSnapshotLease,
 		}, druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta)))
 }
 
@@ -13,9 +13,11 @@ import (
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
 	"github.com/gardener/etcd-druid/internal/utils/imagevector"
 	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
@@ -119,7 +121,7 @@ func (r _resource) PreSync(ctx component.OperatorContext, etcd *druidv1alpha1.Et
 
 	var existingWrapperImageFromSts string
 	for _, c := range existingSts.Spec.Template.Spec.Containers {
-		if c.Name == common.ContainerNameEtcd {
+		if c.Name == druidresources.ContainerNameEtcd {
 			existingWrapperImageFromSts = c.Image
 			break
 		}
@@ -234,12 +236,18 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 			component.OperationSync,
 			fmt.Sprintf("Error getting StatefulSet: %v for etcd: %v", objectKey, druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
 	}
+	if err = r.computeReferencedSecretsCheckSum(ctx, etcd, existingSTS); err != nil {
+		return druiderr.WrapError(err,
+			ErrSyncStatefulSet,
+			component.OperationSync,
+			fmt.Sprintf("Error computing CheckSum of referenced secrets for etcd: %v", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta)))
+	}
 	// There is no StatefulSet present. Create one.
 	if existingSTS == nil {
 		// Check etcd observed generation to determine if the etcd cluster is new or not.
 		if etcd.Status.ObservedGeneration == nil {
 			r.logger.Info("ObservedGeneration has not yet been set, triggering the creation of StatefulSet assuming a new etcd cluster")
-			return r.createOrPatch(ctx, etcd)
+			return r.createOrPatchAndCheckManagedCARotation(ctx, etcd)
 		}
 		// If Etcd resource has previously being reconciled successfully (indicated by a non-nil etcd.Status.ObservedGeneration)
 		// then check if the STS is missing due to it being orphan deleted in the previous reconcile run. If so, recreate the STS.
@@ -259,7 +267,7 @@ func (r _resource) Sync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd)
 		}
 	}
 
-	return r.createOrPatch(ctx, etcd)
+	return r.createOrPatchAndCheckManagedCARotation(ctx, etcd)
 }
 
 // TriggerDelete triggers the deletion of the statefulset for the given Etcd.
@@ -371,11 +379,11 @@ func (r _resource) getExistingStatefulSet(ctx component.OperatorContext, etcdObj
 func (r _resource) createOrPatchWithReplicas(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, sts *appsv1.StatefulSet, replicas int32, skipSetOrUpdateForbiddenFields bool) error {
 	stsClone := sts.DeepCopy()
 	mutatingFn := func() error {
-		if builder, err := newStsBuilder(r.client, ctx.Logger, etcd, replicas, r.imageVector, skipSetOrUpdateForbiddenFields, stsClone); err != nil {
+		opts, err := r.getStatefulSetOptions(ctx, etcd, replicas, skipSetOrUpdateForbiddenFields)
+		if err != nil {
 			return err
-		} else {
-			return builder.Build(ctx)
 		}
+		return druidresources.BuildStatefulSet(etcd, stsClone, opts)
 	}
 
 	opResult, err := controllerutil.CreateOrPatch(ctx, r.client, stsClone, mutatingFn)
@@ -386,6 +394,34 @@ func (r _resource) createOrPatchWithReplicas(ctx component.OperatorContext, etcd
 	return nil
 }
 
+// getStatefulSetOptions computes the options which are required to build the StatefulSet for the passed in etcd.
+func (r _resource) getStatefulSetOptions(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, replicas int32, skipSetOrUpdateForbiddenFields bool) (druidresources.StatefulSetOptions, error) {
+	etcdImage, etcdBackupRestoreImage, initContainerImage, err := utils.GetEtcdImages(etcd, r.imageVector)
+	if err != nil {
+		return druidresources.StatefulSetOptions{}, err
+	}
+	provider, err := druidresources.GetBackupStoreProvider(etcd)
+	if err != nil {
+		return druidresources.StatefulSetOptions{}, err
+	}
+	var localProviderHostPath string
+	if provider != nil && *provider == druidresources.Local {
+		localProviderHostPath, err = druidstore.GetHostMountPathFromSecretRef(ctx, r.client, ctx.Logger, etcd.Spec.Backup.Store, etcd.GetNamespace())
+		if err != nil {
+			return druidresources.StatefulSetOptions{}, fmt.Errorf("error getting host mount path for etcd: %v Err: %w", druidv1alpha1.GetNamespaceName(etcd.ObjectMeta), err)
+		}
+	}
+	return druidresources.StatefulSetOptions{
+		Replicas:                       replicas,
+		EtcdImage:                      etcdImage,
+		EtcdBackupRestoreImage:         etcdBackupRestoreImage,
+		InitContainerImage:             initContainerImage,
+		LocalProviderHostPath:          localProviderHostPath,
+		CheckSums:                      ctx.Data,
+		SkipSetOrUpdateForbiddenFields: skipSetOrUpdateForbiddenFields,
+	}, nil
+}
+
 // createOrPatch updates StatefulSet taking changes from passed in etcd component.
 func (r _resource) createOrPatch(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) error {
 	sts := emptyStatefulSet(etcd.ObjectMeta)
@@ -398,6 +434,22 @@ func (r _resource) createOrPatch(ctx component.OperatorContext, etcd *druidv1alp
 	return nil
 }
 
+// createOrPatchAndCheckManagedCARotation creates or patches the StatefulSet and requeues while a rotation of the managed
+// CA is in progress, as the rotation is rolled out in multiple steps, each of which needs a reconciliation after the
+// previous step has been rolled out to all members.
+func (r _resource) createOrPatchAndCheckManagedCARotation(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) error {
+	if err := r.createOrPatch(ctx, etcd); err != nil {
+		return err
+	}
+	if _, ok := ctx.Data[common.ManagedCertificatesCARotationInProgressKey]; ok && etcd.Spec.Replicas > 0 {
+		return druiderr.New(
+			druiderr.ErrRequeueAfter,
+			component.OperationSync,
+			fmt.Sprintf("Rotation of managed CA is in progress for etcd: %v, requeuing reconcile request", client.ObjectKeyFromObject(etcd)))
+	}
+	return nil
+}
+
 func (r _resource) handleTLSChanges(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, existingSts *appsv1.StatefulSet) error {
 	// There are no replicas and there is no need to handle any TLS changes. Once replicas are increased then new pods will automatically have the TLS changes.
 	if etcd.Spec.Replicas == 0 {
@@ -405,6 +457,26 @@ func (r _resource) handleTLSChanges(ctx component.OperatorContext, etcd *druidv1
 		return nil
 	}
 
+	// Changed managed certificates and changed content of referenced secrets are rolled out with a rolling update of the
+	// StatefulSet, which must not be started if it could restart a healthy member while that causes a loss of quorum.
+	// Unready members do not block the rolling update by themselves, as the changed secrets might be the cause of them
+	// being unready.
+	if !areSecretCheckSumsInSync(ctx, existingSts) {
+		quorumSafe, err := r.canRollOutWithoutLossOfQuorum(ctx, existingSts)
+		if err != nil {
+			return druiderr.WrapError(err,
+				ErrSyncStatefulSet,
+				component.OperationSync,
+				fmt.Sprintf("Error checking the readiness of the members for StatefulSet: %v, etcd: %v", client.ObjectKeyFromObject(existingSts), client.ObjectKeyFromObject(etcd)))
+		}
+		if !quorumSafe {
+			return druiderr.New(
+				druiderr.ErrRequeueAfter,
+				component.OperationSync,
+				fmt.Sprintf("Rolling out changed certificates or secrets could cause a loss of quorum. It is not safe to patch STS. Replicas: %d, ReadyReplicas: %d", *existingSts.Spec.Replicas, existingSts.Status.ReadyReplicas))
+		}
+	}
+
 	isSTSTLSConfigInSync := isStatefulSetTLSConfigInSync(etcd, existingSts)
 	if !isSTSTLSConfigInSync {
 		// check if the etcd cluster is in a state where it can handle TLS changes.
@@ -449,6 +521,92 @@ func (r _resource) handleTLSChanges(ctx component.OperatorContext, etcd *druidv1
 	}
 }
 
+// areSecretCheckSumsInSync checks if the checksums of the managed certificates and of the referenced secrets computed
+// in this reconciliation match the ones of the pod template of the existing StatefulSet.
+func areSecretCheckSumsInSync(ctx component.OperatorContext, existingSts *appsv1.StatefulSet) bool {
+	for _, checkSumKey := range []string{druidresources.CheckSumKeyManagedCertificates, druidresources.CheckSumKeyReferencedSecrets} {
+		if checkSum, ok := ctx.Data[checkSumKey]; ok && existingSts.Spec.Template.Annotations[checkSumKey] != checkSum {
+			return false
+		}
+	}
+	return true
+}
+
+// computeReferencedSecretsCheckSum computes the checksum of the content of all secrets referenced by the Etcd and
+// stores it in the operator context, from where it is placed as an annotation on the pod template. Any change to the
+// content of these secrets thereby results in a rolling update of the StatefulSet. Secrets which do not exist (yet)
+// are skipped, since the pods cannot start without them anyway.
+// The checksum is not introduced to the pod template of an existing StatefulSet which does not carry it yet, unless the
+// pod template is rolled out anyway or the RollOutReferencedSecretsCheckSum feature is enabled, so that upgrading
+// etcd-druid does not by itself roll the pods of every etcd cluster.
+func (r _resource) computeReferencedSecretsCheckSum(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, existingSts *appsv1.StatefulSet) error {
+	secretNames := druidresources.GetReferencedSecretNames(etcd)
+	if len(secretNames) == 0 {
+		return nil
+	}
+	if existingSts != nil && !metav1.HasAnnotation(existingSts.Spec.Template.ObjectMeta, druidresources.CheckSumKeyReferencedSecrets) &&
+		!druidconfigv1alpha1.DefaultFeatureGates.IsEnabled(druidconfigv1alpha1.RollOutReferencedSecretsCheckSum) && !isPodTemplateRolledOut(ctx, etcd, existingSts) {
+		r.logger.Info("Skipping checksum of referenced secrets until the next rollout of the pod template, as the existing StatefulSet does not carry it yet and the feature is disabled", "feature", druidconfigv1alpha1.RollOutReferencedSecretsCheckSum)
+		return nil
+	}
+	var secrets []*corev1.Secret
+	for _, secretName := range secretNames {
+		secret := &corev1.Secret{}
+		if err := r.client.Get(ctx, client.ObjectKey{Name: secretName, Namespace: etcd.Namespace}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		secrets = append(secrets, secret)
+	}
+	ctx.Data[druidresources.CheckSumKeyReferencedSecrets] = druidresources.ComputeReferencedSecretsCheckSum(secrets)
+	return nil
+}
+
+// isPodTemplateRolledOut checks if the pod template of the existing StatefulSet is changed in this reconciliation for
+// reasons other than the checksum of the referenced secrets, i.e. if the pods are rolled anyway.
+func isPodTemplateRolledOut(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, existingSts *appsv1.StatefulSet) bool {
+	for _, checkSumKey := range []string{druidresources.CheckSumKeyConfigMap, druidresources.CheckSumKeyManagedCertificates} {
+		if existingSts.Spec.Template.Annotations[checkSumKey] != ctx.Data[checkSumKey] {
+			return true
+		}
+	}
+	return !isStatefulSetTLSConfigInSync(etcd, existingSts)
+}
+
+// canRollOutWithoutLossOfQuorum checks if a rolling update of the given StatefulSet keeps a quorum of ready members.
+// The rolling update restarts one pod at a time in descending order of the ordinals, and only proceeds with the next
+// pod once the restarted pod is ready again. Unready pods with an ordinal higher than the one of any ready pod are
+// therefore restarted first, and have to become ready before a ready pod is restarted. Only the ready pods with a lower
+// ordinal, minus the restarted one, are left at that point.
+func (r _resource) canRollOutWithoutLossOfQuorum(ctx component.OperatorContext, sts *appsv1.StatefulSet) (bool, error) {
+	if !shouldRequeueForMultiNodeEtcdIfPodsNotReady(sts) {
+		return true, nil
+	}
+	podList := &corev1.PodList{}
+	if err := r.client.List(ctx, podList, client.InNamespace(sts.Namespace), client.MatchingLabels(sts.Spec.Selector.MatchLabels)); err != nil {
+		return false, err
+	}
+	replicas := int(*sts.Spec.Replicas)
+	readyPods := make(map[string]bool, len(podList.Items))
+	for _, pod := range podList.Items {
+		readyPods[pod.Name] = kubernetes.HasPodReadyConditionTrue(&pod)
+	}
+	numReadyMembers, highestReadyOrdinal := 0, -1
+	for ordinal := range replicas {
+		if readyPods[fmt.Sprintf("%s-%d", sts.Name, ordinal)] {
+			numReadyMembers++
+			highestReadyOrdinal = ordinal
+		}
+	}
+	if highestReadyOrdinal < 0 {
+		return true, nil
+	}
+	numUnreadyMembersRolledOutFirst := replicas - 1 - highestReadyOrdinal
+	return numReadyMembers-1+numUnreadyMembersRolledOutFirst >= replicas/2+1, nil
+}
+
 func shouldRequeueForMultiNodeEtcdIfPodsNotReady(sts *appsv1.StatefulSet) bool {
 	return sts.Spec.Replicas != nil &&
 		*sts.Spec.Replicas > 1 &&
@@ -457,18 +615,18 @@ func shouldRequeueForMultiNodeEtcdIfPodsNotReady(sts *appsv1.StatefulSet) bool {
 }
 
 func (r _resource) hasTLSEnablementForPeerURLReflectedOnSTS(etcd *druidv1alpha1.Etcd, existingSts *appsv1.StatefulSet) bool {
-	newEtcdWrapperPeerTLSVolMounts := getEtcdContainerPeerVolumeMounts(etcd)
+	newEtcdWrapperPeerTLSVolMounts := druidresources.GetEtcdContainerPeerVolumeMounts(etcd)
 	containerPeerTLSVolMounts := kubernetes.GetEtcdContainerPeerTLSVolumeMounts(existingSts)
 	r.logger.Info("Checking if peer URL TLS enablement is reflected on StatefulSet", "len(newEtcdWrapperPeerTLSVolMounts)", len(newEtcdWrapperPeerTLSVolMounts), "len(containerPeerTLSVolMounts)", len(containerPeerTLSVolMounts))
 	return len(containerPeerTLSVolMounts) == len(newEtcdWrapperPeerTLSVolMounts)
 }
 
 func isStatefulSetTLSConfigInSync(etcd *druidv1alpha1.Etcd, existingSts *appsv1.StatefulSet) bool {
-	newEtcdbrTLSVolMounts := getBackupRestoreContainerSecretVolumeMounts(etcd)
-	newEtcdWrapperTLSVolMounts := getEtcdContainerSecretVolumeMounts(etcd)
+	newEtcdbrTLSVolMounts := druidresources.GetBackupRestoreContainerSecretVolumeMounts(etcd)
+	newEtcdWrapperTLSVolMounts := druidresources.GetEtcdContainerSecretVolumeMounts(etcd)
 	containerTLSVolMounts := kubernetes.GetStatefulSetContainerTLSVolumeMounts(existingSts)
-	return !hasTLSVolumeMountsChanged(containerTLSVolMounts[common.ContainerNameEtcd], newEtcdWrapperTLSVolMounts) &&
-		!hasTLSVolumeMountsChanged(containerTLSVolMounts[common.ContainerNameEtcdBackupRestore], newEtcdbrTLSVolMounts)
+	return !hasTLSVolumeMountsChanged(containerTLSVolMounts[druidresources.ContainerNameEtcd], newEtcdWrapperTLSVolMounts) &&
+		!hasTLSVolumeMountsChanged(containerTLSVolMounts[druidresources.ContainerNameEtcdBackupRestore], newEtcdbrTLSVolMounts)
 }
 
 func hasTLSVolumeMountsChanged(existingVolMounts, newVolMounts []corev1.VolumeMount) bool {
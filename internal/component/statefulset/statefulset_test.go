@@ -7,16 +7,17 @@ package statefulset
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/client/kubernetes"
 	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/component"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
-	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
 	testutils "github.com/gardener/etcd-druid/test/utils"
 
@@ -366,11 +367,11 @@ func TestSyncWhenNoSTSExists(t *testing.T) {
 			etcdImage, etcdBRImage, initContainerImage, err := utils.GetEtcdImages(etcd, iv)
 			g.Expect(err).ToNot(HaveOccurred())
 			g.Expect(tc.expectedReplicas).ToNot(BeNil())
-			stsMatcher := NewStatefulSetMatcher(g, cl, etcd, *tc.expectedReplicas, initContainerImage, etcdImage, etcdBRImage, ptr.To(druidstore.Local), tc.expectNoServiceAccount, tc.expectNoService)
+			stsMatcher := NewStatefulSetMatcher(g, cl, etcd, *tc.expectedReplicas, initContainerImage, etcdImage, etcdBRImage, ptr.To(druidresources.Local), tc.expectNoServiceAccount, tc.expectNoService)
 			operator := New(cl, iv)
 			// *************** Test and assert ***************
 			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
-			opCtx.Data[common.CheckSumKeyConfigMap] = testutils.TestConfigMapCheckSum
+			opCtx.Data[druidresources.CheckSumKeyConfigMap] = testutils.TestConfigMapCheckSum
 			syncErr := operator.Sync(opCtx, etcd)
 			latestSTS, getErr := getLatestStatefulSet(cl, etcd)
 			if tc.expectedErr != nil {
@@ -386,6 +387,211 @@ func TestSyncWhenNoSTSExists(t *testing.T) {
 	}
 }
 
+func TestSyncWithManagedCertificates(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		replicas             int32
+		caRotationInProgress bool
+		expectRequeue        bool
+	}{
+		{
+			name:     "creates sts with the managed certificates checksum annotation",
+			replicas: 3,
+		},
+		{
+			name:                 "requeues while a rotation of the managed CA is in progress",
+			replicas:             3,
+			caRotationInProgress: true,
+			expectRequeue:        true,
+		},
+		{
+			name:                 "does not requeue for a rotation of the managed CA when replicas are 0",
+			replicas:             0,
+			caRotationInProgress: true,
+		},
+	}
+
+	t.Parallel()
+	iv := testutils.CreateImageVector(true, true)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(tc.replicas).Build()
+			etcd.Spec.ManagedCertificates = &druidv1alpha1.ManagedCertificatesSpec{}
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{buildBackupSecret()}, getObjectKey(etcd.ObjectMeta))
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+			opCtx.Data[druidresources.CheckSumKeyConfigMap] = testutils.TestConfigMapCheckSum
+			opCtx.Data[druidresources.CheckSumKeyManagedCertificates] = "managed-certificates-checksum"
+			if tc.caRotationInProgress {
+				opCtx.Data[common.ManagedCertificatesCARotationInProgressKey] = "true"
+			}
+			syncErr := New(cl, iv).Sync(opCtx, etcd)
+			if tc.expectRequeue {
+				g.Expect(druiderr.AsDruidError(syncErr)).ToNot(BeNil())
+				g.Expect(druiderr.AsDruidError(syncErr).Code).To(BeEquivalentTo(druiderr.ErrRequeueAfter))
+			} else {
+				g.Expect(syncErr).ToNot(HaveOccurred())
+			}
+			latestSTS, err := getLatestStatefulSet(cl, etcd)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(latestSTS.Spec.Template.Annotations).To(HaveKeyWithValue(druidresources.CheckSumKeyManagedCertificates, "managed-certificates-checksum"))
+			g.Expect(latestSTS.Spec.Template.Annotations).To(HaveKeyWithValue(druidresources.CheckSumKeyConfigMap, testutils.TestConfigMapCheckSum))
+		})
+	}
+}
+
+func TestSyncWithChangedReferencedSecrets(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		readyPodOrdinals      []int
+		expectRequeue         bool
+		expectChecksumChanged bool
+	}{
+		{
+			name:                  "rolls out changed content of the backup store secret",
+			readyPodOrdinals:      []int{0, 1, 2},
+			expectChecksumChanged: true,
+		},
+		{
+			name:                  "rolls out changed content of the backup store secret if the unready member is restarted first",
+			readyPodOrdinals:      []int{0, 1},
+			expectChecksumChanged: true,
+		},
+		{
+			name:             "requeues for changed content of the backup store secret if restarting a ready member loses quorum",
+			readyPodOrdinals: []int{1, 2},
+			expectRequeue:    true,
+		},
+	}
+
+	t.Parallel()
+	iv := testutils.CreateImageVector(true, true)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).Build()
+			backupSecret := buildBackupSecret()
+			existingObjects := []client.Object{backupSecret}
+			for _, leaseName := range druidv1alpha1.GetMemberLeaseNames(etcd) {
+				existingObjects = append(existingObjects, testutils.CreateLease(leaseName, etcd.Namespace, etcd.Name, etcd.UID, druidv1alpha1.ComponentNameMemberLease))
+			}
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects, getObjectKey(etcd.ObjectMeta))
+			newOperatorContext := func() component.OperatorContext {
+				opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+				opCtx.Data[druidresources.CheckSumKeyConfigMap] = testutils.TestConfigMapCheckSum
+				return opCtx
+			}
+			g.Expect(New(cl, iv).Sync(newOperatorContext(), etcd)).To(Succeed())
+			sts, err := getLatestStatefulSet(cl, etcd)
+			g.Expect(err).ToNot(HaveOccurred())
+			initialCheckSum := sts.Spec.Template.Annotations[druidresources.CheckSumKeyReferencedSecrets]
+			g.Expect(initialCheckSum).ToNot(BeEmpty())
+
+			for ordinal := range 3 {
+				pod := buildStatefulSetPod(sts, ordinal, slices.Contains(tc.readyPodOrdinals, ordinal))
+				g.Expect(cl.Create(context.Background(), pod)).To(Succeed())
+			}
+			// #nosec G115 -- the number of ready pods is at most 3.
+			sts.Status.ReadyReplicas = int32(len(tc.readyPodOrdinals))
+			g.Expect(cl.Status().Update(context.Background(), sts)).To(Succeed())
+			backupSecret.Data["bucketName"] = []byte("rotated")
+			g.Expect(cl.Update(context.Background(), backupSecret)).To(Succeed())
+
+			syncErr := New(cl, iv).Sync(newOperatorContext(), etcd)
+			if tc.expectRequeue {
+				g.Expect(druiderr.AsDruidError(syncErr)).ToNot(BeNil())
+				g.Expect(druiderr.AsDruidError(syncErr).Code).To(BeEquivalentTo(druiderr.ErrRequeueAfter))
+			} else {
+				g.Expect(syncErr).ToNot(HaveOccurred())
+			}
+			sts, err = getLatestStatefulSet(cl, etcd)
+			g.Expect(err).ToNot(HaveOccurred())
+			if tc.expectChecksumChanged {
+				g.Expect(sts.Spec.Template.Annotations[druidresources.CheckSumKeyReferencedSecrets]).ToNot(Equal(initialCheckSum))
+			} else {
+				g.Expect(sts.Spec.Template.Annotations[druidresources.CheckSumKeyReferencedSecrets]).To(Equal(initialCheckSum))
+			}
+		})
+	}
+}
+
+func TestSyncAddsReferencedSecretsCheckSumWithNextRollout(t *testing.T) {
+	g := NewWithT(t)
+	iv := testutils.CreateImageVector(true, true)
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).Build()
+	existingObjects := []client.Object{buildBackupSecret()}
+	for _, leaseName := range druidv1alpha1.GetMemberLeaseNames(etcd) {
+		existingObjects = append(existingObjects, testutils.CreateLease(leaseName, etcd.Namespace, etcd.Name, etcd.UID, druidv1alpha1.ComponentNameMemberLease))
+	}
+	cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects, getObjectKey(etcd.ObjectMeta))
+	newOperatorContext := func(configMapCheckSum string) component.OperatorContext {
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		opCtx.Data[druidresources.CheckSumKeyConfigMap] = configMapCheckSum
+		return opCtx
+	}
+	g.Expect(New(cl, iv).Sync(newOperatorContext(testutils.TestConfigMapCheckSum), etcd)).To(Succeed())
+
+	// simulate a StatefulSet created by a previous version of etcd-druid, which did not compute the checksum
+	sts, err := getLatestStatefulSet(cl, etcd)
+	g.Expect(err).ToNot(HaveOccurred())
+	delete(sts.Spec.Template.Annotations, druidresources.CheckSumKeyReferencedSecrets)
+	g.Expect(cl.Update(context.Background(), sts)).To(Succeed())
+	sts.Status.ReadyReplicas = 3
+	g.Expect(cl.Status().Update(context.Background(), sts)).To(Succeed())
+
+	etcd.Generation = 2
+	etcd.Status.ObservedGeneration = ptr.To[int64](1)
+	g.Expect(New(cl, iv).Sync(newOperatorContext(testutils.TestConfigMapCheckSum), etcd)).To(Succeed())
+	sts, err = getLatestStatefulSet(cl, etcd)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(sts.Spec.Template.Annotations).ToNot(HaveKey(druidresources.CheckSumKeyReferencedSecrets))
+
+	// a changed configuration rolls the pods anyway
+	g.Expect(New(cl, iv).Sync(newOperatorContext("changed-configmap-checksum"), etcd)).To(Succeed())
+	sts, err = getLatestStatefulSet(cl, etcd)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(sts.Spec.Template.Annotations).To(HaveKeyWithValue(druidresources.CheckSumKeyConfigMap, "changed-configmap-checksum"))
+	g.Expect(sts.Spec.Template.Annotations).To(HaveKey(druidresources.CheckSumKeyReferencedSecrets))
+}
+
+func TestSyncAddsReferencedSecretsCheckSumWithFeatureGate(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(druidconfigv1alpha1.DefaultFeatureGates.SetEnabledFeaturesFromMap(map[string]bool{druidconfigv1alpha1.RollOutReferencedSecretsCheckSum: true})).To(Succeed())
+	t.Cleanup(func() {
+		g.Expect(druidconfigv1alpha1.DefaultFeatureGates.SetEnabledFeaturesFromMap(map[string]bool{druidconfigv1alpha1.RollOutReferencedSecretsCheckSum: false})).To(Succeed())
+	})
+	iv := testutils.CreateImageVector(true, true)
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).Build()
+	existingObjects := []client.Object{buildBackupSecret()}
+	for _, leaseName := range druidv1alpha1.GetMemberLeaseNames(etcd) {
+		existingObjects = append(existingObjects, testutils.CreateLease(leaseName, etcd.Namespace, etcd.Name, etcd.UID, druidv1alpha1.ComponentNameMemberLease))
+	}
+	cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects, getObjectKey(etcd.ObjectMeta))
+	newOperatorContext := func() component.OperatorContext {
+		opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+		opCtx.Data[druidresources.CheckSumKeyConfigMap] = testutils.TestConfigMapCheckSum
+		return opCtx
+	}
+	g.Expect(New(cl, iv).Sync(newOperatorContext(), etcd)).To(Succeed())
+
+	// simulate a StatefulSet created by a previous version of etcd-druid, which did not compute the checksum
+	sts, err := getLatestStatefulSet(cl, etcd)
+	g.Expect(err).ToNot(HaveOccurred())
+	delete(sts.Spec.Template.Annotations, druidresources.CheckSumKeyReferencedSecrets)
+	g.Expect(cl.Update(context.Background(), sts)).To(Succeed())
+	sts.Status.ReadyReplicas = 3
+	g.Expect(cl.Status().Update(context.Background(), sts)).To(Succeed())
+
+	etcd.Generation = 2
+	etcd.Status.ObservedGeneration = ptr.To[int64](1)
+	g.Expect(New(cl, iv).Sync(newOperatorContext(), etcd)).To(Succeed())
+	sts, err = getLatestStatefulSet(cl, etcd)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(sts.Spec.Template.Annotations).To(HaveKey(druidresources.CheckSumKeyReferencedSecrets))
+}
+
 // ----------------------------- TriggerDelete -------------------------------
 // ---------------------------- Helper Functions -----------------------------
 
@@ -408,6 +614,23 @@ func buildBackupSecret() *corev1.Secret {
 	}
 }
 
+func buildStatefulSetPod(sts *appsv1.StatefulSet, ordinal int, ready bool) *corev1.Pod {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", sts.Name, ordinal),
+			Namespace: sts.Namespace,
+			Labels:    sts.Spec.Selector.MatchLabels,
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}},
+		},
+	}
+}
+
 func buildPreSyncTask(prefix string, index int, state *druidv1alpha1.TaskState) *druidv1alpha1.EtcdOpsTask {
 	taskName := fmt.Sprintf("%s%d", prefix, index)
 	builder := testutils.EtcdOpsTaskBuilderWithDefaults(taskName, testutils.TestNamespace).
@@ -438,7 +661,7 @@ func buildStatefulSetWithImage(objMeta metav1.ObjectMeta, replicas int32, image
 				Spec: corev1.PodSpec{
 					Containers: []corev1.Container{
 						{
-							Name:  common.ContainerNameEtcd,
+							Name:  druidresources.ContainerNameEtcd,
 							Image: image,
 						},
 					},
@@ -9,7 +9,7 @@ import (
 	"fmt"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
 	testutils "github.com/gardener/etcd-druid/test/utils"
@@ -27,6 +27,13 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 )
 
+const (
+	serverPortName           = "server"
+	clientPortName           = "client"
+	etcdWrapperReadyEndpoint = "/readyz"
+	etcdConfigFileMountPath  = "/var/etcd/config/"
+)
+
 var (
 	defaultTestContainerResources = corev1.ResourceRequirements{
 		Requests: corev1.ResourceList{
@@ -174,24 +181,24 @@ func (s StatefulSetMatcher) matchPodHostAliases() gomegatypes.GomegaMatcher {
 
 func (s StatefulSetMatcher) matchPodInitContainers() gomegatypes.GomegaMatcher {
 	initContainerMatcherElements := make(map[string]gomegatypes.GomegaMatcher)
-	if s.etcd.IsBackupStoreEnabled() && s.provider != nil && *s.provider == druidstore.Local && !ptr.Deref(s.etcd.Spec.RunAsRoot, false) {
+	if s.etcd.IsBackupStoreEnabled() && s.provider != nil && *s.provider == druidresources.Local && !ptr.Deref(s.etcd.Spec.RunAsRoot, false) {
 		changeBackupBucketPermissionsMatcher := MatchFields(IgnoreExtras, Fields{
-			"Name":            Equal(common.InitContainerNameChangeBackupBucketPermissions),
+			"Name":            Equal(druidresources.InitContainerNameChangeBackupBucketPermissions),
 			"Image":           Equal(s.initContainerImage),
 			"ImagePullPolicy": Equal(corev1.PullIfNotPresent),
 			"Command":         HaveExactElements("chown", "-R", "65532:65532", fmt.Sprintf("/home/nonroot/%s", *s.etcd.Spec.Backup.Store.Container)),
 			"VolumeMounts":    ConsistOf(s.getEtcdBackupVolumeMountMatcher()),
 			"SecurityContext": matchPodInitContainerSecurityContext(),
 		})
-		initContainerMatcherElements[common.InitContainerNameChangeBackupBucketPermissions] = changeBackupBucketPermissionsMatcher
+		initContainerMatcherElements[druidresources.InitContainerNameChangeBackupBucketPermissions] = changeBackupBucketPermissionsMatcher
 	}
 	return MatchAllElements(containerIdentifier, initContainerMatcherElements)
 }
 
 func (s StatefulSetMatcher) matchContainers() gomegatypes.GomegaMatcher {
 	return MatchAllElements(containerIdentifier, Elements{
-		common.ContainerNameEtcd:              s.matchEtcdContainer(),
-		common.ContainerNameEtcdBackupRestore: s.matchBackupRestoreContainer(),
+		druidresources.ContainerNameEtcd:              s.matchEtcdContainer(),
+		druidresources.ContainerNameEtcdBackupRestore: s.matchBackupRestoreContainer(),
 	})
 }
 
@@ -257,7 +264,7 @@ func (s StatefulSetMatcher) matchBackupRestoreContainer() gomegatypes.GomegaMatc
 }
 
 func (s StatefulSetMatcher) matchEtcdContainerReadinessHandler() gomegatypes.GomegaMatcher {
-	scheme := utils.IfConditionOr(s.etcd.Spec.Backup.TLS == nil, corev1.URISchemeHTTP, corev1.URISchemeHTTPS)
+	scheme := utils.IfConditionOr(s.etcd.GetBackupTLS() == nil, corev1.URISchemeHTTP, corev1.URISchemeHTTPS)
 	return MatchFields(IgnoreExtras|IgnoreMissing, Fields{
 		"HTTPGet": PointTo(MatchFields(IgnoreExtras|IgnoreMissing, Fields{
 			"Path": Equal(etcdWrapperReadyEndpoint),
@@ -276,15 +283,15 @@ func (s StatefulSetMatcher) matchEtcdContainerCmdArgs() gomegatypes.GomegaMatche
 	cmdArgs = append(cmdArgs, fmt.Sprintf("--backup-restore-host-port=%s-local:%d", s.etcd.Name, s.backupPort))
 	cmdArgs = append(cmdArgs, fmt.Sprintf("--etcd-server-name=%s-local", s.etcd.Name))
 	// backup-restore tls specific configuration
-	if s.etcd.Spec.Backup.TLS == nil {
+	if s.etcd.GetBackupTLS() == nil {
 		cmdArgs = append(cmdArgs, "--backup-restore-tls-enabled=false")
 	} else {
-		dataKey := ptr.Deref(s.etcd.Spec.Backup.TLS.TLSCASecretRef.DataKey, "ca.crt")
+		dataKey := ptr.Deref(s.etcd.GetBackupTLS().TLSCASecretRef.DataKey, "ca.crt")
 		cmdArgs = append(cmdArgs, "--backup-restore-tls-enabled=true")
 		cmdArgs = append(cmdArgs, fmt.Sprintf("--backup-restore-ca-cert-bundle-path=/var/etcdbr/ssl/ca/%s", dataKey))
 	}
 	// etcd client url tls specific configuration
-	if s.etcd.Spec.Etcd.ClientUrlTLS != nil {
+	if s.etcd.GetClientURLTLS() != nil {
 		cmdArgs = append(cmdArgs, "--etcd-client-cert-path=/var/etcd/ssl/client/tls.crt")
 		cmdArgs = append(cmdArgs, "--etcd-client-key-path=/var/etcd/ssl/client/tls.key")
 	}
@@ -295,13 +302,13 @@ func (s StatefulSetMatcher) matchEtcdContainerCmdArgs() gomegatypes.GomegaMatche
 
 func (s StatefulSetMatcher) matchEtcdDataVolMount() gomegatypes.GomegaMatcher {
 	volumeClaimTemplateName := ptr.Deref(s.etcd.Spec.VolumeClaimTemplate, s.etcd.Name)
-	return matchVolMount(volumeClaimTemplateName, common.VolumeMountPathEtcdData)
+	return matchVolMount(volumeClaimTemplateName, druidresources.VolumeMountPathEtcdData)
 }
 
 func (s StatefulSetMatcher) matchBackupRestoreContainerVolMounts() gomegatypes.GomegaMatcher {
 	volMountMatchers := make([]gomegatypes.GomegaMatcher, 0, 6)
 	volMountMatchers = append(volMountMatchers, s.matchEtcdDataVolMount())
-	volMountMatchers = append(volMountMatchers, matchVolMount(common.VolumeNameEtcdConfig, etcdConfigFileMountPath))
+	volMountMatchers = append(volMountMatchers, matchVolMount(druidresources.VolumeNameEtcdConfig, etcdConfigFileMountPath))
 	volMountMatchers = append(volMountMatchers, s.getBackupRestoreSecretVolMountMatchers()...)
 	if s.etcd.IsBackupStoreEnabled() {
 		etcdBackupVolMountMatcher := s.getEtcdBackupVolumeMountMatcher()
@@ -314,41 +321,41 @@ func (s StatefulSetMatcher) matchBackupRestoreContainerVolMounts() gomegatypes.G
 
 func (s StatefulSetMatcher) getBackupRestoreSecretVolMountMatchers() []gomegatypes.GomegaMatcher {
 	secretVolMountMatchers := make([]gomegatypes.GomegaMatcher, 0, 3)
-	if s.etcd.Spec.Backup.TLS != nil {
-		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(common.VolumeNameBackupRestoreCA, common.VolumeMountPathBackupRestoreCA))
-		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(common.VolumeNameBackupRestoreServerTLS, common.VolumeMountPathBackupRestoreServerTLS))
-		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(common.VolumeNameBackupRestoreClientTLS, common.VolumeMountPathBackupRestoreClientTLS))
+	if s.etcd.GetBackupTLS() != nil {
+		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(druidresources.VolumeNameBackupRestoreCA, druidresources.VolumeMountPathBackupRestoreCA))
+		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(druidresources.VolumeNameBackupRestoreServerTLS, druidresources.VolumeMountPathBackupRestoreServerTLS))
+		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(druidresources.VolumeNameBackupRestoreClientTLS, druidresources.VolumeMountPathBackupRestoreClientTLS))
 	}
 	return secretVolMountMatchers
 }
 
 func (s StatefulSetMatcher) getEtcdSecretVolMountsMatchers() []gomegatypes.GomegaMatcher {
 	secretVolMountMatchers := make([]gomegatypes.GomegaMatcher, 0, 5)
-	if s.etcd.Spec.Etcd.ClientUrlTLS != nil {
-		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(common.VolumeNameEtcdCA, common.VolumeMountPathEtcdCA))
-		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(common.VolumeNameEtcdServerTLS, common.VolumeMountPathEtcdServerTLS))
-		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(common.VolumeNameEtcdClientTLS, common.VolumeMountPathEtcdClientTLS))
+	if s.etcd.GetClientURLTLS() != nil {
+		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(druidresources.VolumeNameEtcdCA, druidresources.VolumeMountPathEtcdCA))
+		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(druidresources.VolumeNameEtcdServerTLS, druidresources.VolumeMountPathEtcdServerTLS))
+		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(druidresources.VolumeNameEtcdClientTLS, druidresources.VolumeMountPathEtcdClientTLS))
 	}
-	if s.etcd.Spec.Etcd.PeerUrlTLS != nil {
-		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(common.VolumeNameEtcdPeerCA, common.VolumeMountPathEtcdPeerCA))
-		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(common.VolumeNameEtcdPeerServerTLS, common.VolumeMountPathEtcdPeerServerTLS))
+	if s.etcd.GetPeerURLTLS() != nil {
+		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(druidresources.VolumeNameEtcdPeerCA, druidresources.VolumeMountPathEtcdPeerCA))
+		secretVolMountMatchers = append(secretVolMountMatchers, matchVolMount(druidresources.VolumeNameEtcdPeerServerTLS, druidresources.VolumeMountPathEtcdPeerServerTLS))
 	}
 	return secretVolMountMatchers
 }
 
 func (s StatefulSetMatcher) getEtcdBackupVolumeMountMatcher() gomegatypes.GomegaMatcher {
 	switch *s.provider {
-	case druidstore.Local:
+	case druidresources.Local:
 		if s.etcd.Spec.Backup.Store.Container != nil {
 			if ptr.Deref(s.etcd.Spec.RunAsRoot, false) {
-				return matchVolMount(common.VolumeNameLocalBackup, fmt.Sprintf("/root/%s", *s.etcd.Spec.Backup.Store.Container))
+				return matchVolMount(druidresources.VolumeNameLocalBackup, fmt.Sprintf("/root/%s", *s.etcd.Spec.Backup.Store.Container))
 			}
-			return matchVolMount(common.VolumeNameLocalBackup, fmt.Sprintf("/home/nonroot/%s", *s.etcd.Spec.Backup.Store.Container))
+			return matchVolMount(druidresources.VolumeNameLocalBackup, fmt.Sprintf("/home/nonroot/%s", *s.etcd.Spec.Backup.Store.Container))
 		}
-	case druidstore.GCS:
-		return matchVolMount(common.VolumeNameProviderBackupSecret, common.VolumeMountPathGCSBackupSecret)
-	case druidstore.S3, druidstore.ABS, druidstore.OSS, druidstore.Swift, druidstore.OCS:
-		return matchVolMount(common.VolumeNameProviderBackupSecret, common.VolumeMountPathNonGCSProviderBackupSecret)
+	case druidresources.GCS:
+		return matchVolMount(druidresources.VolumeNameProviderBackupSecret, druidresources.VolumeMountPathGCSBackupSecret)
+	case druidresources.S3, druidresources.ABS, druidresources.OSS, druidresources.Swift, druidresources.OCS:
+		return matchVolMount(druidresources.VolumeNameProviderBackupSecret, druidresources.VolumeMountPathNonGCSProviderBackupSecret)
 	}
 	return nil
 }
@@ -379,17 +386,17 @@ func (s StatefulSetMatcher) matchEtcdPodSecurityContext() gomegatypes.GomegaMatc
 func (s StatefulSetMatcher) matchPodVolumes() gomegatypes.GomegaMatcher {
 	volMatchers := make([]gomegatypes.GomegaMatcher, 0, 7)
 	etcdConfigFileVolMountMatcher := MatchFields(IgnoreExtras, Fields{
-		"Name": Equal(common.VolumeNameEtcdConfig),
+		"Name": Equal(druidresources.VolumeNameEtcdConfig),
 		"VolumeSource": MatchFields(IgnoreExtras|IgnoreMissing, Fields{
 			"ConfigMap": PointTo(MatchFields(IgnoreExtras, Fields{
 				"LocalObjectReference": MatchFields(IgnoreExtras, Fields{
 					"Name": Equal(druidv1alpha1.GetConfigMapName(s.etcd.ObjectMeta)),
 				}),
 				"Items": HaveExactElements(MatchFields(IgnoreExtras|IgnoreMissing, Fields{
-					"Key":  Equal(common.EtcdConfigFileName),
-					"Path": Equal(common.EtcdConfigFileName),
+					"Key":  Equal(druidresources.EtcdConfigFileName),
+					"Path": Equal(druidresources.EtcdConfigFileName),
 				})),
-				"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+				"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 			})),
 		}),
 	})
@@ -409,83 +416,83 @@ func (s StatefulSetMatcher) matchPodVolumes() gomegatypes.GomegaMatcher {
 
 func (s StatefulSetMatcher) getPodSecurityVolumeMatchers() []gomegatypes.GomegaMatcher {
 	volMatchers := make([]gomegatypes.GomegaMatcher, 0, 5)
-	if s.etcd.Spec.Etcd.ClientUrlTLS != nil {
+	if s.etcd.GetClientURLTLS() != nil {
 		volMatchers = append(volMatchers, MatchFields(IgnoreExtras, Fields{
-			"Name": Equal(common.VolumeNameEtcdCA),
+			"Name": Equal(druidresources.VolumeNameEtcdCA),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
-					"SecretName":  Equal(s.etcd.Spec.Etcd.ClientUrlTLS.TLSCASecretRef.Name),
-					"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+					"SecretName":  Equal(s.etcd.GetClientURLTLS().TLSCASecretRef.Name),
+					"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		}))
 		volMatchers = append(volMatchers, MatchFields(IgnoreExtras, Fields{
-			"Name": Equal(common.VolumeNameEtcdServerTLS),
+			"Name": Equal(druidresources.VolumeNameEtcdServerTLS),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
-					"SecretName":  Equal(s.etcd.Spec.Etcd.ClientUrlTLS.ServerTLSSecretRef.Name),
-					"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+					"SecretName":  Equal(s.etcd.GetClientURLTLS().ServerTLSSecretRef.Name),
+					"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		}))
 		volMatchers = append(volMatchers, MatchFields(IgnoreExtras, Fields{
-			"Name": Equal(common.VolumeNameEtcdClientTLS),
+			"Name": Equal(druidresources.VolumeNameEtcdClientTLS),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
-					"SecretName":  Equal(s.etcd.Spec.Etcd.ClientUrlTLS.ClientTLSSecretRef.Name),
-					"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+					"SecretName":  Equal(s.etcd.GetClientURLTLS().ClientTLSSecretRef.Name),
+					"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		}))
 	}
-	if s.etcd.Spec.Etcd.PeerUrlTLS != nil {
+	if s.etcd.GetPeerURLTLS() != nil {
 		volMatchers = append(volMatchers, MatchFields(IgnoreExtras, Fields{
-			"Name": Equal(common.VolumeNameEtcdPeerCA),
+			"Name": Equal(druidresources.VolumeNameEtcdPeerCA),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
-					"SecretName":  Equal(s.etcd.Spec.Etcd.PeerUrlTLS.TLSCASecretRef.Name),
-					"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+					"SecretName":  Equal(s.etcd.GetPeerURLTLS().TLSCASecretRef.Name),
+					"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		}))
 
 		volMatchers = append(volMatchers, MatchFields(IgnoreExtras, Fields{
-			"Name": Equal(common.VolumeNameEtcdPeerServerTLS),
+			"Name": Equal(druidresources.VolumeNameEtcdPeerServerTLS),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
-					"SecretName":  Equal(s.etcd.Spec.Etcd.PeerUrlTLS.ServerTLSSecretRef.Name),
-					"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+					"SecretName":  Equal(s.etcd.GetPeerURLTLS().ServerTLSSecretRef.Name),
+					"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		}))
 	}
-	if s.etcd.Spec.Backup.TLS != nil {
+	if s.etcd.GetBackupTLS() != nil {
 		volMatchers = append(volMatchers, MatchFields(IgnoreExtras, Fields{
-			"Name": Equal(common.VolumeNameBackupRestoreCA),
+			"Name": Equal(druidresources.VolumeNameBackupRestoreCA),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
-					"SecretName":  Equal(s.etcd.Spec.Backup.TLS.TLSCASecretRef.Name),
-					"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+					"SecretName":  Equal(s.etcd.GetBackupTLS().TLSCASecretRef.Name),
+					"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		}))
 
 		volMatchers = append(volMatchers, MatchFields(IgnoreExtras, Fields{
-			"Name": Equal(common.VolumeNameBackupRestoreServerTLS),
+			"Name": Equal(druidresources.VolumeNameBackupRestoreServerTLS),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
-					"SecretName":  Equal(s.etcd.Spec.Backup.TLS.ServerTLSSecretRef.Name),
-					"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+					"SecretName":  Equal(s.etcd.GetBackupTLS().ServerTLSSecretRef.Name),
+					"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		}))
 
 		volMatchers = append(volMatchers, MatchFields(IgnoreExtras, Fields{
-			"Name": Equal(common.VolumeNameBackupRestoreClientTLS),
+			"Name": Equal(druidresources.VolumeNameBackupRestoreClientTLS),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
-					"SecretName":  Equal(s.etcd.Spec.Backup.TLS.ClientTLSSecretRef.Name),
-					"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+					"SecretName":  Equal(s.etcd.GetBackupTLS().ClientTLSSecretRef.Name),
+					"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		}))
@@ -498,11 +505,11 @@ func (s StatefulSetMatcher) getBackupVolumeMatcher() gomegatypes.GomegaMatcher {
 		return nil
 	}
 	switch *s.provider {
-	case druidstore.Local:
+	case druidresources.Local:
 		hostPath, err := druidstore.GetHostMountPathFromSecretRef(context.Background(), s.cl, logr.Discard(), s.etcd.Spec.Backup.Store, s.etcd.Namespace)
 		s.g.Expect(err).ToNot(HaveOccurred())
 		return MatchFields(IgnoreExtras, Fields{
-			"Name": Equal(common.VolumeNameLocalBackup),
+			"Name": Equal(druidresources.VolumeNameLocalBackup),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"HostPath": PointTo(MatchFields(IgnoreExtras, Fields{
 					"Path": Equal(fmt.Sprintf("%s/%s", hostPath, ptr.Deref(s.etcd.Spec.Backup.Store.Container, ""))),
@@ -511,14 +518,14 @@ func (s StatefulSetMatcher) getBackupVolumeMatcher() gomegatypes.GomegaMatcher {
 			}),
 		})
 
-	case druidstore.GCS, druidstore.S3, druidstore.OSS, druidstore.ABS, druidstore.Swift, druidstore.OCS:
+	case druidresources.GCS, druidresources.S3, druidresources.OSS, druidresources.ABS, druidresources.Swift, druidresources.OCS:
 		s.g.Expect(s.etcd.Spec.Backup.Store.SecretRef).ToNot(BeNil())
 		return MatchFields(IgnoreExtras, Fields{
-			"Name": Equal(common.VolumeNameProviderBackupSecret),
+			"Name": Equal(druidresources.VolumeNameProviderBackupSecret),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
 					"SecretName":  Equal(s.etcd.Spec.Backup.Store.SecretRef.Name),
-					"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+					"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		})
@@ -543,7 +550,7 @@ func matchPodInitContainerSecurityContext() gomegatypes.GomegaMatcher {
 
 func getStatefulSetLabels(etcdName string) map[string]string {
 	return map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameStatefulSet,
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameStatefulSet,
 		druidv1alpha1.LabelAppNameKey:   etcdName,
 		druidv1alpha1.LabelManagedByKey: druidv1alpha1.LabelManagedByValue,
 		druidv1alpha1.LabelPartOfKey:    etcdName,
@@ -6,6 +6,8 @@ package component
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 
@@ -53,6 +55,31 @@ func (o *OperatorContext) SetLogger(logger logr.Logger) {
 	o.Logger = logger
 }
 
+// dataKeyChangedComponents is the key of the Data of an OperatorContext which holds the comma-separated kinds of the
+// components whose resources have been changed in a reconciliation run.
+const dataKeyChangedComponents = "changedComponents"
+
+// RecordChangedComponent records that resources of the component with the given kind have been changed in the
+// reconciliation run.
+func (o *OperatorContext) RecordChangedComponent(kind Kind) {
+	changedComponents := o.GetChangedComponents()
+	if slices.Contains(changedComponents, string(kind)) {
+		return
+	}
+	if o.Data == nil {
+		o.Data = make(map[string]string)
+	}
+	o.Data[dataKeyChangedComponents] = strings.Join(append(changedComponents, string(kind)), ",")
+}
+
+// GetChangedComponents returns the kinds of the components whose resources have been changed in the reconciliation run.
+func (o *OperatorContext) GetChangedComponents() []string {
+	if changedComponents := o.Data[dataKeyChangedComponents]; changedComponents != "" {
+		return strings.Split(changedComponents, ",")
+	}
+	return nil
+}
+
 // Operator manages one or more resources of a specific Kind which are provisioned for an etcd cluster.
 type Operator interface {
 	// GetExistingResourceNames gets all resources that currently exist that this Operator manages.
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compaction
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// retainedCompactionPodLabelKey is set on the pod of a failed compaction job that is retained for inspection once
+	// the job itself has been deleted, so that the retention of the pod does not block subsequent compaction jobs.
+	retainedCompactionPodLabelKey = "druid.gardener.cloud/retained-compaction-pod"
+	// retainedUntilAnnotationKey is set on a retained compaction pod and holds the time, in RFC3339 format, after which it is deleted.
+	retainedUntilAnnotationKey = "druid.gardener.cloud/retained-until"
+	// legacyJobNameLabelKey and legacyControllerUIDLabelKey are the legacy labels set by the job controller on the pods of a job.
+	legacyJobNameLabelKey       = "job-name"
+	legacyControllerUIDLabelKey = "controller-uid"
+)
+
+// newCompactionJobRun constructs the history entry for the given completed compaction job.
+func (r *Reconciler) newCompactionJobRun(ctx context.Context, logger logr.Logger, job *batchv1.Job, etcd *druidv1alpha1.Etcd, jobCompletionState int, jobFailureReason, message string) druidv1alpha1.CompactionJobRun {
+	jobRun := druidv1alpha1.CompactionJobRun{
+		JobName:        job.Name,
+		StartTime:      job.Status.StartTime,
+		CompletionTime: getJobCompletionTime(job),
+		Result:         druidv1alpha1.CompactionJobResultFailed,
+		Reason:         computeSnapshotCompactionJobReason(jobCompletionState, jobFailureReason),
+		Message:        message,
+	}
+	if containers := job.Spec.Template.Spec.Containers; len(containers) > 0 {
+		if memoryRequests, ok := containers[0].Resources.Requests[v1.ResourceMemory]; ok {
+			jobRun.MemoryRequests = &memoryRequests
+		}
+	}
+	if jobCompletionState != jobSucceeded {
+		pod, err := getPodForJob(ctx, r.Client, &job.ObjectMeta)
+		if err != nil {
+			logger.Error(err, "Could not determine whether the compaction job was OOMKilled", "jobName", job.Name)
+			return jobRun
+		}
+		jobRun.OOMKilled = pod != nil && isPodOOMKilled(pod)
+		return jobRun
+	}
+	jobRun.Result = druidv1alpha1.CompactionJobResultSucceeded
+	// The compaction job renews the full snapshot lease with the revision of the compacted snapshot it has uploaded.
+	snapshotRevision, err := r.getFullSnapshotRevision(ctx, etcd)
+	if err != nil {
+		logger.Error(err, "Could not determine revision of the compacted snapshot", "jobName", job.Name)
+		return jobRun
+	}
+	jobRun.SnapshotRevision = snapshotRevision
+	return jobRun
+}
+
+// getFullSnapshotRevision returns the revision recorded in the full snapshot lease, or nil if none has been recorded yet.
+func (r *Reconciler) getFullSnapshotRevision(ctx context.Context, etcd *druidv1alpha1.Etcd) (*int64, error) {
+	fullLease := &coordinationv1.Lease{}
+	fullSnapshotLeaseName := druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta)
+	if err := r.Get(ctx, client.ObjectKey{Namespace: etcd.Namespace, Name: fullSnapshotLeaseName}, fullLease); err != nil {
+		return nil, fmt.Errorf("couldn't fetch full snapshot lease %s: %w", fullSnapshotLeaseName, err)
+	}
+	if fullLease.Spec.HolderIdentity == nil {
+		return nil, nil
+	}
+	revision, err := strconv.ParseInt(*fullLease.Spec.HolderIdentity, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse holder identity %q of full snapshot lease %s: %w", *fullLease.Spec.HolderIdentity, fullSnapshotLeaseName, err)
+	}
+	return &revision, nil
+}
+
+// recordCompactionJobRun records the given job run in the compaction status of the Etcd. The memory requests of a job run
+// which was OOMKilled are recorded regardless of the history, and are cleared by the next successful job run. The job run
+// is added to the history, keeping at most historyLimit entries. If historyLimit is 0, the history is removed.
+func recordCompactionJobRun(etcd *druidv1alpha1.Etcd, jobRun druidv1alpha1.CompactionJobRun, historyLimit int) {
+	if etcd.Status.Compaction == nil {
+		etcd.Status.Compaction = &druidv1alpha1.CompactionStatus{}
+	}
+	switch {
+	case jobRun.OOMKilled && jobRun.MemoryRequests != nil:
+		etcd.Status.Compaction.OOMKilledMemoryRequests = jobRun.MemoryRequests
+	case jobRun.Result == druidv1alpha1.CompactionJobResultSucceeded:
+		etcd.Status.Compaction.OOMKilledMemoryRequests = nil
+	}
+	addCompactionJobRunToHistory(etcd, jobRun, historyLimit)
+	if etcd.Status.Compaction.OOMKilledMemoryRequests == nil && len(etcd.Status.Compaction.History) == 0 && etcd.Status.Compaction.DeltaSnapshotsSizeBaseline == nil {
+		etcd.Status.Compaction = nil
+	}
+}
+
+// addCompactionJobRunToHistory prepends the given job run to the compaction history of the Etcd, keeping at most historyLimit entries.
+// A job run which is already part of the history is not added again. If historyLimit is 0, the history is removed.
+func addCompactionJobRunToHistory(etcd *druidv1alpha1.Etcd, jobRun druidv1alpha1.CompactionJobRun, historyLimit int) {
+	if historyLimit <= 0 {
+		etcd.Status.Compaction.History = nil
+		return
+	}
+	history := make([]druidv1alpha1.CompactionJobRun, 0, len(etcd.Status.Compaction.History)+1)
+	history = append(history, jobRun)
+	for _, existingRun := range etcd.Status.Compaction.History {
+		if existingRun.JobName == jobRun.JobName && existingRun.StartTime.Equal(jobRun.StartTime) {
+			continue
+		}
+		history = append(history, existingRun)
+	}
+	if len(history) > historyLimit {
+		history = history[:historyLimit]
+	}
+	etcd.Status.Compaction.History = history
+}
+
+// getJobCompletionTime returns the time at which the given job has either completed successfully or failed.
+func getJobCompletionTime(job *batchv1.Job) *metav1.Time {
+	if job.Status.CompletionTime != nil {
+		return job.Status.CompletionTime
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == v1.ConditionTrue {
+			return &condition.LastTransitionTime
+		}
+	}
+	return nil
+}
+
+// retainFailedCompactionJobPod detaches the pod of the given failed compaction job from the job and hands its ownership
+// over to the Etcd, so that the pod can be inspected for the configured retention duration while the job is deleted.
+func (r *Reconciler) retainFailedCompactionJobPod(ctx context.Context, job *batchv1.Job, etcd *druidv1alpha1.Etcd) error {
+	pod, err := getPodForJob(ctx, r.Client, &job.ObjectMeta)
+	if err != nil {
+		return err
+	}
+	if pod == nil {
+		return nil
+	}
+	failedAt := job.CreationTimestamp.Time
+	if completionTime := getJobCompletionTime(job); completionTime != nil {
+		failedAt = completionTime.Time
+	}
+	patch := client.MergeFrom(pod.DeepCopy())
+	for _, key := range []string{batchv1.JobNameLabel, batchv1.ControllerUidLabel, legacyJobNameLabelKey, legacyControllerUIDLabelKey} {
+		delete(pod.Labels, key)
+	}
+	metav1.SetMetaDataLabel(&pod.ObjectMeta, retainedCompactionPodLabelKey, "true")
+	metav1.SetMetaDataAnnotation(&pod.ObjectMeta, retainedUntilAnnotationKey, failedAt.Add(r.config.FailedJobRetentionDuration.Duration).UTC().Format(time.RFC3339))
+	pod.OwnerReferences = []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)}
+	controllerutil.RemoveFinalizer(pod, batchv1.JobTrackingFinalizer)
+	if err := r.Patch(ctx, pod, patch); err != nil {
+		return fmt.Errorf("error while retaining pod %s of failed compaction job %s: %w", pod.Name, job.Name, err)
+	}
+	return nil
+}
+
+// listRetainedCompactionPods lists the retained pods of failed compaction jobs of the given Etcd.
+func (r *Reconciler) listRetainedCompactionPods(ctx context.Context, etcd *druidv1alpha1.Etcd) ([]v1.Pod, error) {
+	podList := &v1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(etcd.Namespace), client.MatchingLabels{
+		retainedCompactionPodLabelKey: "true",
+		druidv1alpha1.LabelPartOfKey:  etcd.Name,
+	}); err != nil {
+		return nil, fmt.Errorf("error while listing retained compaction pods: %w", err)
+	}
+	return podList.Items, nil
+}
+
+// deleteExpiredRetainedCompactionPods deletes the retained pods of failed compaction jobs of the given Etcd whose retention
+// has elapsed. It returns the duration after which the next of the remaining retained pods expires, or zero if there is none.
+func (r *Reconciler) deleteExpiredRetainedCompactionPods(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd, now time.Time) (time.Duration, error) {
+	pods, err := r.listRetainedCompactionPods(ctx, etcd)
+	if err != nil {
+		return 0, err
+	}
+	var nextExpiry time.Duration
+	for _, pod := range pods {
+		retainedUntil, err := time.Parse(time.RFC3339, pod.Annotations[retainedUntilAnnotationKey])
+		if err == nil && retainedUntil.After(now) {
+			if remaining := retainedUntil.Sub(now); nextExpiry == 0 || remaining < nextExpiry {
+				nextExpiry = remaining
+			}
+			continue
+		}
+		logger.Info("Deleting retained pod of failed compaction job as its retention has elapsed", "podName", pod.Name)
+		if err := client.IgnoreNotFound(r.Delete(ctx, &pod)); err != nil {
+			return 0, fmt.Errorf("error while deleting retained compaction pod %s: %w", pod.Name, err)
+		}
+	}
+	return nextExpiry, nil
+}
@@ -0,0 +1,311 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compaction
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRecordCompactionJobRun(t *testing.T) {
+	newJobRun := func(i int) druidv1alpha1.CompactionJobRun {
+		return druidv1alpha1.CompactionJobRun{
+			JobName:   fmt.Sprintf("job-%d", i),
+			StartTime: &metav1.Time{Time: time.Date(2025, 1, 1, i, 0, 0, 0, time.UTC)},
+			Result:    druidv1alpha1.CompactionJobResultSucceeded,
+		}
+	}
+	newOOMKilledJobRun := func(i int, memoryRequests string) druidv1alpha1.CompactionJobRun {
+		jobRun := newJobRun(i)
+		jobRun.Result = druidv1alpha1.CompactionJobResultFailed
+		jobRun.OOMKilled = true
+		jobRun.MemoryRequests = ptr.To(resource.MustParse(memoryRequests))
+		return jobRun
+	}
+	newFailedJobRun := func(i int) druidv1alpha1.CompactionJobRun {
+		jobRun := newJobRun(i)
+		jobRun.Result = druidv1alpha1.CompactionJobResultFailed
+		return jobRun
+	}
+	tests := []struct {
+		name                            string
+		existingHistory                 []druidv1alpha1.CompactionJobRun
+		existingOOMKilledMemoryRequests *resource.Quantity
+		jobRun                          druidv1alpha1.CompactionJobRun
+		historyLimit                    int
+		expectedHistory                 []druidv1alpha1.CompactionJobRun
+		expectedOOMKilledMemoryRequests *resource.Quantity
+	}{
+		{
+			name:            "empty history",
+			jobRun:          newJobRun(1),
+			historyLimit:    3,
+			expectedHistory: []druidv1alpha1.CompactionJobRun{newJobRun(1)},
+		},
+		{
+			name:            "job run is prepended",
+			existingHistory: []druidv1alpha1.CompactionJobRun{newJobRun(2), newJobRun(1)},
+			jobRun:          newJobRun(3),
+			historyLimit:    3,
+			expectedHistory: []druidv1alpha1.CompactionJobRun{newJobRun(3), newJobRun(2), newJobRun(1)},
+		},
+		{
+			name:            "history is trimmed to the limit",
+			existingHistory: []druidv1alpha1.CompactionJobRun{newJobRun(3), newJobRun(2), newJobRun(1)},
+			jobRun:          newJobRun(4),
+			historyLimit:    3,
+			expectedHistory: []druidv1alpha1.CompactionJobRun{newJobRun(4), newJobRun(3), newJobRun(2)},
+		},
+		{
+			name:            "already recorded job run is replaced",
+			existingHistory: []druidv1alpha1.CompactionJobRun{newJobRun(2), newJobRun(1)},
+			jobRun:          newJobRun(2),
+			historyLimit:    3,
+			expectedHistory: []druidv1alpha1.CompactionJobRun{newJobRun(2), newJobRun(1)},
+		},
+		{
+			name:            "history is removed if it is disabled",
+			existingHistory: []druidv1alpha1.CompactionJobRun{newJobRun(2), newJobRun(1)},
+			jobRun:          newJobRun(3),
+			historyLimit:    0,
+		},
+		{
+			name:                            "memory requests of an OOMKilled job run are recorded if the history is disabled",
+			jobRun:                          newOOMKilledJobRun(1, "4Gi"),
+			historyLimit:                    0,
+			expectedOOMKilledMemoryRequests: ptr.To(resource.MustParse("4Gi")),
+		},
+		{
+			name:                            "memory requests of an OOMKilled job run replace those of a previous one",
+			existingHistory:                 []druidv1alpha1.CompactionJobRun{newOOMKilledJobRun(1, "4Gi")},
+			existingOOMKilledMemoryRequests: ptr.To(resource.MustParse("4Gi")),
+			jobRun:                          newOOMKilledJobRun(2, "6Gi"),
+			historyLimit:                    3,
+			expectedHistory:                 []druidv1alpha1.CompactionJobRun{newOOMKilledJobRun(2, "6Gi"), newOOMKilledJobRun(1, "4Gi")},
+			expectedOOMKilledMemoryRequests: ptr.To(resource.MustParse("6Gi")),
+		},
+		{
+			name:                            "memory requests of an OOMKilled job run are kept after a job run failing for another reason",
+			existingOOMKilledMemoryRequests: ptr.To(resource.MustParse("4Gi")),
+			jobRun:                          newFailedJobRun(2),
+			historyLimit:                    0,
+			expectedOOMKilledMemoryRequests: ptr.To(resource.MustParse("4Gi")),
+		},
+		{
+			name:                            "memory requests of an OOMKilled job run are cleared by a successful job run",
+			existingOOMKilledMemoryRequests: ptr.To(resource.MustParse("4Gi")),
+			jobRun:                          newJobRun(2),
+			historyLimit:                    0,
+		},
+	}
+
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			if test.existingHistory != nil || test.existingOOMKilledMemoryRequests != nil {
+				etcd.Status.Compaction = &druidv1alpha1.CompactionStatus{
+					History:                 test.existingHistory,
+					OOMKilledMemoryRequests: test.existingOOMKilledMemoryRequests,
+				}
+			}
+			recordCompactionJobRun(etcd, test.jobRun, test.historyLimit)
+			if test.expectedHistory == nil && test.expectedOOMKilledMemoryRequests == nil {
+				g.Expect(etcd.Status.Compaction).To(BeNil())
+				return
+			}
+			g.Expect(etcd.Status.Compaction).ToNot(BeNil())
+			g.Expect(etcd.Status.Compaction.History).To(Equal(test.expectedHistory))
+			g.Expect(etcd.Status.Compaction.OOMKilledMemoryRequests).To(Equal(test.expectedOOMKilledMemoryRequests))
+		})
+	}
+}
+
+func TestNewCompactionJobRun(t *testing.T) {
+	startTime := metav1.NewTime(time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC))
+	completionTime := metav1.NewTime(time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name               string
+		jobStatus          batchv1.JobStatus
+		jobCompletionState int
+		jobFailureReason   string
+		leaseHolder        *string
+		expectedJobRun     druidv1alpha1.CompactionJobRun
+	}{
+		{
+			name:               "successful job records the compacted snapshot revision",
+			jobStatus:          batchv1.JobStatus{StartTime: &startTime, CompletionTime: &completionTime},
+			jobCompletionState: jobSucceeded,
+			leaseHolder:        ptr.To("42"),
+			expectedJobRun: druidv1alpha1.CompactionJobRun{
+				JobName:          "test-job",
+				StartTime:        &startTime,
+				CompletionTime:   &completionTime,
+				Result:           druidv1alpha1.CompactionJobResultSucceeded,
+				Reason:           druidv1alpha1.PodSuccessReasonNone,
+				Message:          "message",
+				SnapshotRevision: ptr.To[int64](42),
+			},
+		},
+		{
+			name: "failed job records the failure reason and time",
+			jobStatus: batchv1.JobStatus{
+				StartTime:  &startTime,
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, LastTransitionTime: completionTime}},
+			},
+			jobCompletionState: jobFailed,
+			jobFailureReason:   druidv1alpha1.JobFailureReasonDeadlineExceeded,
+			leaseHolder:        ptr.To("42"),
+			expectedJobRun: druidv1alpha1.CompactionJobRun{
+				JobName:        "test-job",
+				StartTime:      &startTime,
+				CompletionTime: &completionTime,
+				Result:         druidv1alpha1.CompactionJobResultFailed,
+				Reason:         druidv1alpha1.JobFailureReasonDeadlineExceeded,
+				Message:        "message",
+			},
+		},
+		{
+			name:               "successful job with invalid lease holder identity records no revision",
+			jobStatus:          batchv1.JobStatus{StartTime: &startTime, CompletionTime: &completionTime},
+			jobCompletionState: jobSucceeded,
+			leaseHolder:        ptr.To("invalid"),
+			expectedJobRun: druidv1alpha1.CompactionJobRun{
+				JobName:        "test-job",
+				StartTime:      &startTime,
+				CompletionTime: &completionTime,
+				Result:         druidv1alpha1.CompactionJobResultSucceeded,
+				Reason:         druidv1alpha1.PodSuccessReasonNone,
+				Message:        "message",
+			},
+		},
+	}
+
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			lease := &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta),
+					Namespace: etcd.Namespace,
+				},
+				Spec: coordinationv1.LeaseSpec{HolderIdentity: test.leaseHolder},
+			}
+			r := &Reconciler{Client: testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{lease})}
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: etcd.Namespace},
+				Status:     test.jobStatus,
+			}
+			jobRun := r.newCompactionJobRun(context.Background(), logr.Discard(), job, etcd, test.jobCompletionState, test.jobFailureReason, "message")
+			g.Expect(jobRun).To(Equal(test.expectedJobRun))
+		})
+	}
+}
+
+func TestRetainFailedCompactionJobPod(t *testing.T) {
+	g := NewWithT(t)
+	failedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: druidv1alpha1.GetCompactionJobName(etcd.ObjectMeta), Namespace: etcd.Namespace},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(failedAt)}},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name + "-abcde",
+			Namespace: etcd.Namespace,
+			Labels: map[string]string{
+				batchv1.JobNameLabel:         job.Name,
+				batchv1.ControllerUidLabel:   "uid",
+				legacyJobNameLabelKey:        job.Name,
+				legacyControllerUIDLabelKey:  "uid",
+				druidv1alpha1.LabelPartOfKey: etcd.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "Job", Name: job.Name, UID: "uid"}},
+			Finalizers:      []string{batchv1.JobTrackingFinalizer},
+		},
+	}
+	cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{pod})
+	r := &Reconciler{
+		Client: cl,
+		config: druidconfigv1alpha1.CompactionControllerConfiguration{FailedJobRetentionDuration: &metav1.Duration{Duration: time.Hour}},
+	}
+	g.Expect(r.retainFailedCompactionJobPod(context.Background(), job, etcd)).To(Succeed())
+
+	retainedPod := &corev1.Pod{}
+	g.Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(pod), retainedPod)).To(Succeed())
+	g.Expect(retainedPod.Labels).To(Equal(map[string]string{
+		druidv1alpha1.LabelPartOfKey:  etcd.Name,
+		retainedCompactionPodLabelKey: "true",
+	}))
+	g.Expect(retainedPod.Annotations).To(HaveKeyWithValue(retainedUntilAnnotationKey, failedAt.Add(time.Hour).Format(time.RFC3339)))
+	g.Expect(retainedPod.OwnerReferences).To(ConsistOf(druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)))
+	g.Expect(retainedPod.Finalizers).To(BeEmpty())
+
+	// the retained pod is no longer considered to be the pod of a subsequent compaction job with the same name
+	jobPod, err := getPodForJob(context.Background(), cl, &job.ObjectMeta)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(jobPod).To(BeNil())
+}
+
+func TestDeleteExpiredRetainedCompactionPods(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+	retainedPod := func(name string, retainedUntil string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   etcd.Namespace,
+				Labels:      map[string]string{retainedCompactionPodLabelKey: "true", druidv1alpha1.LabelPartOfKey: etcd.Name},
+				Annotations: map[string]string{retainedUntilAnnotationKey: retainedUntil},
+			},
+		}
+	}
+	expiredPod := retainedPod("expired", now.Add(-time.Minute).Format(time.RFC3339))
+	invalidPod := retainedPod("invalid", "invalid")
+	laterPod := retainedPod("later", now.Add(time.Hour).Format(time.RFC3339))
+	soonerPod := retainedPod("sooner", now.Add(15*time.Minute).Format(time.RFC3339))
+	otherEtcdPod := retainedPod("other-etcd", now.Add(-time.Minute).Format(time.RFC3339))
+	otherEtcdPod.Labels[druidv1alpha1.LabelPartOfKey] = "other-etcd"
+
+	cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, []client.Object{expiredPod, invalidPod, laterPod, soonerPod, otherEtcdPod})
+	r := &Reconciler{Client: cl}
+	nextExpiry, err := r.deleteExpiredRetainedCompactionPods(context.Background(), logr.Discard(), etcd, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(nextExpiry).To(Equal(15 * time.Minute))
+
+	podList := &corev1.PodList{}
+	g.Expect(cl.List(context.Background(), podList)).To(Succeed())
+	var podNames []string
+	for _, pod := range podList.Items {
+		podNames = append(podNames, pod.Name)
+	}
+	g.Expect(podNames).To(ConsistOf("later", "sooner", "other-etcd"))
+}
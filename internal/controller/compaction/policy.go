@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// concurrentJobsLimitRequeueInterval is the interval after which a reconciliation is retried if a compaction job
+	// could not be created because the maximum number of concurrently running compaction jobs has been reached.
+	concurrentJobsLimitRequeueInterval = 1 * time.Minute
+)
+
+// compactionPolicy captures the effective thresholds and scheduling constraints for the snapshot compaction of an Etcd.
+type compactionPolicy struct {
+	// eventsThreshold is the number of accumulated delta revisions after which a compaction job is triggered.
+	eventsThreshold int64
+	// triggerFullSnapshotThreshold is the number of accumulated delta revisions after which a full snapshot is triggered instead of a compaction job.
+	triggerFullSnapshotThreshold int64
+	// maxFullSnapshotAge is the maximum age of the latest full snapshot after which a compaction job is triggered.
+	maxFullSnapshotAge *time.Duration
+	// deltaSnapshotsTotalSizeThreshold is the estimated total size of the delta snapshots after which a compaction job is triggered.
+	deltaSnapshotsTotalSizeThreshold *resource.Quantity
+	// allowedHours is the daily window during which compaction is allowed to start.
+	allowedHours *druidv1alpha1.CompactionAllowedHours
+}
+
+// getCompactionPolicy computes the compaction policy for the given Etcd. Values set in the Etcd spec take precedence
+// over the ones configured for the compaction controller.
+func (r *Reconciler) getCompactionPolicy(etcd *druidv1alpha1.Etcd) compactionPolicy {
+	policy := compactionPolicy{
+		eventsThreshold:              r.config.EventsThreshold,
+		triggerFullSnapshotThreshold: r.config.TriggerFullSnapshotThreshold,
+	}
+	compactionSpec := etcd.Spec.Backup.SnapshotCompaction
+	if compactionSpec == nil {
+		return policy
+	}
+	if compactionSpec.EventsThreshold != nil {
+		policy.eventsThreshold = *compactionSpec.EventsThreshold
+	}
+	if compactionSpec.TriggerFullSnapshotThreshold != nil {
+		policy.triggerFullSnapshotThreshold = *compactionSpec.TriggerFullSnapshotThreshold
+	}
+	if compactionSpec.MaxFullSnapshotAge != nil {
+		policy.maxFullSnapshotAge = &compactionSpec.MaxFullSnapshotAge.Duration
+	}
+	policy.deltaSnapshotsTotalSizeThreshold = compactionSpec.DeltaSnapshotsTotalSizeThreshold
+	policy.allowedHours = compactionSpec.AllowedHours
+	return policy
+}
+
+// timeUntilAllowedHours returns zero if compaction is allowed to start at the given time, otherwise it returns the
+// duration after which the allowed hours window opens.
+func timeUntilAllowedHours(allowedHours *druidv1alpha1.CompactionAllowedHours, now time.Time) time.Duration {
+	if allowedHours == nil || allowedHours.Start == allowedHours.End {
+		return 0
+	}
+	now = now.UTC()
+	hour := int32(now.Hour())
+	var inWindow bool
+	if allowedHours.Start < allowedHours.End {
+		inWindow = hour >= allowedHours.Start && hour < allowedHours.End
+	} else {
+		// window spans midnight
+		inWindow = hour >= allowedHours.Start || hour < allowedHours.End
+	}
+	if inWindow {
+		return 0
+	}
+	windowStart := time.Date(now.Year(), now.Month(), now.Day(), int(allowedHours.Start), 0, 0, 0, time.UTC)
+	if !windowStart.After(now) {
+		windowStart = windowStart.Add(24 * time.Hour)
+	}
+	return windowStart.Sub(now)
+}
+
+// getFullSnapshotAge returns the time elapsed since the full snapshot lease was last renewed by etcd-backup-restore.
+// It returns nil if the renew time has not been set yet.
+func (r *Reconciler) getFullSnapshotAge(ctx context.Context, etcd *druidv1alpha1.Etcd, now time.Time) (*time.Duration, error) {
+	fullLease := &coordinationv1.Lease{}
+	fullSnapshotLeaseName := druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta)
+	if err := r.Get(ctx, client.ObjectKey{Namespace: etcd.Namespace, Name: fullSnapshotLeaseName}, fullLease); err != nil {
+		return nil, fmt.Errorf("couldn't fetch full snapshot lease %s: %w", fullSnapshotLeaseName, err)
+	}
+	if fullLease.Spec.RenewTime == nil {
+		return nil, nil
+	}
+	age := now.Sub(fullLease.Spec.RenewTime.Time)
+	return &age, nil
+}
+
+// getDeltaSnapshotsTotalSize estimates the total size of the delta snapshots taken since the latest full snapshot by the
+// growth of the largest database size reported by the etcd members since then, which the delta snapshots hold at least.
+// The database size is recorded as baseline in the compaction status of the Etcd whenever a new full snapshot is observed,
+// and whenever the database has shrunk below the baseline, e.g. after a defragmentation. It returns nil if no estimate
+// can be made yet, i.e. if no member has reported its database size or no full snapshot revision has been recorded.
+func (r *Reconciler) getDeltaSnapshotsTotalSize(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd) (*resource.Quantity, error) {
+	dbSize := getLargestMemberDBSize(etcd)
+	if dbSize == 0 {
+		return nil, nil
+	}
+	fullSnapshotRevision, err := r.getFullSnapshotRevision(ctx, etcd)
+	if err != nil || fullSnapshotRevision == nil {
+		return nil, err
+	}
+
+	var baseline *druidv1alpha1.DeltaSnapshotsSizeBaseline
+	if etcd.Status.Compaction != nil {
+		baseline = etcd.Status.Compaction.DeltaSnapshotsSizeBaseline
+	}
+	if baseline != nil && baseline.FullSnapshotRevision == *fullSnapshotRevision && baseline.DBSize.Value() <= dbSize {
+		return resource.NewQuantity(dbSize-baseline.DBSize.Value(), resource.BinarySI), nil
+	}
+
+	logger.Info("Recording database size as baseline for the total size of the delta snapshots", "fullSnapshotRevision", *fullSnapshotRevision, "dbSize", dbSize)
+	patch := client.MergeFrom(etcd.DeepCopy())
+	if etcd.Status.Compaction == nil {
+		etcd.Status.Compaction = &druidv1alpha1.CompactionStatus{}
+	}
+	etcd.Status.Compaction.DeltaSnapshotsSizeBaseline = &druidv1alpha1.DeltaSnapshotsSizeBaseline{
+		FullSnapshotRevision: *fullSnapshotRevision,
+		DBSize:               *resource.NewQuantity(dbSize, resource.BinarySI),
+	}
+	if err := r.Status().Patch(ctx, etcd, patch); err != nil {
+		return nil, fmt.Errorf("error while recording baseline for the total size of the delta snapshots: %w", err)
+	}
+	return resource.NewQuantity(0, resource.BinarySI), nil
+}
+
+// countActiveCompactionJobs returns the number of compaction jobs, across all namespaces, which have not yet completed.
+// The jobs are listed from the API server rather than from the cache, as jobs created by a preceding reconciliation
+// might not yet be reflected in the cache.
+func (r *Reconciler) countActiveCompactionJobs(ctx context.Context) (int, error) {
+	jobList := &batchv1.JobList{}
+	if err := r.apiReader.List(ctx, jobList, client.MatchingLabels{druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameSnapshotCompactionJob}); err != nil {
+		return 0, fmt.Errorf("error while listing compaction jobs: %w", err)
+	}
+	var count int
+	for _, job := range jobList.Items {
+		if job.DeletionTimestamp.IsZero() && !isJobFinished(&job) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// isJobFinished checks if the given job has either completed successfully or failed.
+func isJobFinished(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) && condition.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
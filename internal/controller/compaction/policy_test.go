@@ -0,0 +1,388 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compaction
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTimeUntilAllowedHours(t *testing.T) {
+	tests := []struct {
+		name         string
+		allowedHours *druidv1alpha1.CompactionAllowedHours
+		now          time.Time
+		expected     time.Duration
+	}{
+		{
+			name:         "no allowed hours configured",
+			allowedHours: nil,
+			now:          time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+			expected:     0,
+		},
+		{
+			name:         "within window on the same day",
+			allowedHours: &druidv1alpha1.CompactionAllowedHours{Start: 1, End: 5},
+			now:          time.Date(2025, 1, 1, 3, 30, 0, 0, time.UTC),
+			expected:     0,
+		},
+		{
+			name:         "before window on the same day",
+			allowedHours: &druidv1alpha1.CompactionAllowedHours{Start: 1, End: 5},
+			now:          time.Date(2025, 1, 1, 0, 30, 0, 0, time.UTC),
+			expected:     30 * time.Minute,
+		},
+		{
+			name:         "after window on the same day",
+			allowedHours: &druidv1alpha1.CompactionAllowedHours{Start: 1, End: 5},
+			now:          time.Date(2025, 1, 1, 5, 0, 0, 0, time.UTC),
+			expected:     20 * time.Hour,
+		},
+		{
+			name:         "within window spanning midnight before midnight",
+			allowedHours: &druidv1alpha1.CompactionAllowedHours{Start: 22, End: 4},
+			now:          time.Date(2025, 1, 1, 23, 0, 0, 0, time.UTC),
+			expected:     0,
+		},
+		{
+			name:         "within window spanning midnight after midnight",
+			allowedHours: &druidv1alpha1.CompactionAllowedHours{Start: 22, End: 4},
+			now:          time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC),
+			expected:     0,
+		},
+		{
+			name:         "outside window spanning midnight",
+			allowedHours: &druidv1alpha1.CompactionAllowedHours{Start: 22, End: 4},
+			now:          time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+			expected:     10 * time.Hour,
+		},
+		{
+			name:         "non-UTC time is converted to UTC",
+			allowedHours: &druidv1alpha1.CompactionAllowedHours{Start: 1, End: 5},
+			now:          time.Date(2025, 1, 1, 5, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+			expected:     0,
+		},
+	}
+
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(timeUntilAllowedHours(test.allowedHours, test.now)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestGetCompactionPolicy(t *testing.T) {
+	config := druidconfigv1alpha1.CompactionControllerConfiguration{
+		EventsThreshold:              100,
+		TriggerFullSnapshotThreshold: 300,
+	}
+	tests := []struct {
+		name           string
+		compactionSpec *druidv1alpha1.SnapshotCompactionSpec
+		expectedPolicy compactionPolicy
+	}{
+		{
+			name:           "no compaction spec set",
+			compactionSpec: nil,
+			expectedPolicy: compactionPolicy{eventsThreshold: 100, triggerFullSnapshotThreshold: 300},
+		},
+		{
+			name: "compaction spec overrides controller configuration",
+			compactionSpec: &druidv1alpha1.SnapshotCompactionSpec{
+				EventsThreshold:                  ptr.To[int64](10),
+				TriggerFullSnapshotThreshold:     ptr.To[int64](30),
+				MaxFullSnapshotAge:               &metav1.Duration{Duration: time.Hour},
+				DeltaSnapshotsTotalSizeThreshold: ptr.To(resource.MustParse("1Gi")),
+				AllowedHours:                     &druidv1alpha1.CompactionAllowedHours{Start: 1, End: 5},
+			},
+			expectedPolicy: compactionPolicy{
+				eventsThreshold:                  10,
+				triggerFullSnapshotThreshold:     30,
+				maxFullSnapshotAge:               ptr.To(time.Hour),
+				deltaSnapshotsTotalSizeThreshold: ptr.To(resource.MustParse("1Gi")),
+				allowedHours:                     &druidv1alpha1.CompactionAllowedHours{Start: 1, End: 5},
+			},
+		},
+	}
+
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			etcd.Spec.Backup.SnapshotCompaction = test.compactionSpec
+			r := &Reconciler{config: config}
+			g.Expect(r.getCompactionPolicy(etcd)).To(Equal(test.expectedPolicy))
+		})
+	}
+}
+
+func TestIsCompactionRequired(t *testing.T) {
+	tests := []struct {
+		name                     string
+		accumulatedRevisions     int64
+		maxFullSnapshotAge       *time.Duration
+		fullSnapshotRenewTime    *time.Time
+		expectedRequired         bool
+		expectedRequeueAtMost    time.Duration
+		expectErr                bool
+		skipFullSnapshotLease    bool
+		expectedRequeueIsNonZero bool
+		sizeThreshold            *resource.Quantity
+		sizeBaseline             *druidv1alpha1.DeltaSnapshotsSizeBaseline
+		memberDBSize             *resource.Quantity
+	}{
+		{
+			name:                 "events threshold reached",
+			accumulatedRevisions: 100,
+			expectedRequired:     true,
+		},
+		{
+			name:                 "events threshold not reached and no max full snapshot age",
+			accumulatedRevisions: 10,
+			expectedRequired:     false,
+		},
+		{
+			name:                  "no delta revisions although full snapshot is older than max age",
+			accumulatedRevisions:  0,
+			maxFullSnapshotAge:    ptr.To(time.Hour),
+			fullSnapshotRenewTime: ptr.To(time.Now().Add(-2 * time.Hour)),
+			expectedRequired:      false,
+		},
+		{
+			name:                  "full snapshot is older than max age",
+			accumulatedRevisions:  10,
+			maxFullSnapshotAge:    ptr.To(time.Hour),
+			fullSnapshotRenewTime: ptr.To(time.Now().Add(-2 * time.Hour)),
+			expectedRequired:      true,
+		},
+		{
+			name:                     "full snapshot is younger than max age",
+			accumulatedRevisions:     10,
+			maxFullSnapshotAge:       ptr.To(time.Hour),
+			fullSnapshotRenewTime:    ptr.To(time.Now().Add(-30 * time.Minute)),
+			expectedRequired:         false,
+			expectedRequeueIsNonZero: true,
+			expectedRequeueAtMost:    30 * time.Minute,
+		},
+		{
+			name:                 "full snapshot lease has no renew time",
+			accumulatedRevisions: 10,
+			maxFullSnapshotAge:   ptr.To(time.Hour),
+			expectedRequired:     false,
+		},
+		{
+			name:                 "estimated total size of the delta snapshots reached its threshold",
+			accumulatedRevisions: 10,
+			sizeThreshold:        ptr.To(resource.MustParse("1Gi")),
+			sizeBaseline:         &druidv1alpha1.DeltaSnapshotsSizeBaseline{FullSnapshotRevision: 42, DBSize: resource.MustParse("2Gi")},
+			memberDBSize:         ptr.To(resource.MustParse("3Gi")),
+			expectedRequired:     true,
+		},
+		{
+			name:                 "estimated total size of the delta snapshots below its threshold",
+			accumulatedRevisions: 10,
+			sizeThreshold:        ptr.To(resource.MustParse("1Gi")),
+			sizeBaseline:         &druidv1alpha1.DeltaSnapshotsSizeBaseline{FullSnapshotRevision: 42, DBSize: resource.MustParse("2Gi")},
+			memberDBSize:         ptr.To(resource.MustParse("2.5Gi")),
+			expectedRequired:     false,
+		},
+		{
+			name:                  "full snapshot lease is missing",
+			accumulatedRevisions:  10,
+			maxFullSnapshotAge:    ptr.To(time.Hour),
+			skipFullSnapshotLease: true,
+			expectErr:             true,
+		},
+	}
+
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			var existingObjects []client.Object
+			if !test.skipFullSnapshotLease {
+				lease := &coordinationv1.Lease{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta),
+						Namespace: etcd.Namespace,
+					},
+				}
+				if test.fullSnapshotRenewTime != nil {
+					lease.Spec.RenewTime = &metav1.MicroTime{Time: *test.fullSnapshotRenewTime}
+				}
+				lease.Spec.HolderIdentity = ptr.To("42")
+				existingObjects = append(existingObjects, lease)
+			}
+			if test.sizeBaseline != nil {
+				etcd.Status.Compaction = &druidv1alpha1.CompactionStatus{DeltaSnapshotsSizeBaseline: test.sizeBaseline}
+			}
+			if test.memberDBSize != nil {
+				etcd.Status.Members = []druidv1alpha1.EtcdMemberStatus{{Name: "etcd-0", DBSize: test.memberDBSize}}
+			}
+			r := &Reconciler{Client: testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects)}
+			policy := compactionPolicy{
+				eventsThreshold:                  100,
+				triggerFullSnapshotThreshold:     300,
+				maxFullSnapshotAge:               test.maxFullSnapshotAge,
+				deltaSnapshotsTotalSizeThreshold: test.sizeThreshold,
+			}
+			required, requeueAfter, err := r.isCompactionRequired(context.Background(), logr.Discard(), etcd, test.accumulatedRevisions, policy)
+			if test.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(required).To(Equal(test.expectedRequired))
+			if test.expectedRequeueIsNonZero {
+				g.Expect(requeueAfter).To(BeNumerically(">", 0))
+				g.Expect(requeueAfter).To(BeNumerically("<=", test.expectedRequeueAtMost))
+			} else {
+				g.Expect(requeueAfter).To(BeZero())
+			}
+		})
+	}
+}
+
+func TestGetDeltaSnapshotsTotalSize(t *testing.T) {
+	tests := []struct {
+		name             string
+		memberDBSizes    []string
+		baseline         *druidv1alpha1.DeltaSnapshotsSizeBaseline
+		expectedSize     *resource.Quantity
+		expectedBaseline *druidv1alpha1.DeltaSnapshotsSizeBaseline
+	}{
+		{
+			name:         "no member has reported its database size",
+			expectedSize: nil,
+		},
+		{
+			name:             "no baseline has been recorded yet",
+			memberDBSizes:    []string{"2Gi", "3Gi"},
+			expectedSize:     resource.NewQuantity(0, resource.BinarySI),
+			expectedBaseline: &druidv1alpha1.DeltaSnapshotsSizeBaseline{FullSnapshotRevision: 42, DBSize: resource.MustParse("3Gi")},
+		},
+		{
+			name:             "database has grown since the baseline of the latest full snapshot",
+			memberDBSizes:    []string{"2Gi", "3Gi"},
+			baseline:         &druidv1alpha1.DeltaSnapshotsSizeBaseline{FullSnapshotRevision: 42, DBSize: resource.MustParse("1Gi")},
+			expectedSize:     resource.NewQuantity(2*1024*1024*1024, resource.BinarySI),
+			expectedBaseline: &druidv1alpha1.DeltaSnapshotsSizeBaseline{FullSnapshotRevision: 42, DBSize: resource.MustParse("1Gi")},
+		},
+		{
+			name:             "baseline has been recorded for a previous full snapshot",
+			memberDBSizes:    []string{"3Gi"},
+			baseline:         &druidv1alpha1.DeltaSnapshotsSizeBaseline{FullSnapshotRevision: 10, DBSize: resource.MustParse("1Gi")},
+			expectedSize:     resource.NewQuantity(0, resource.BinarySI),
+			expectedBaseline: &druidv1alpha1.DeltaSnapshotsSizeBaseline{FullSnapshotRevision: 42, DBSize: resource.MustParse("3Gi")},
+		},
+		{
+			name:             "database has shrunk below the baseline",
+			memberDBSizes:    []string{"1Gi"},
+			baseline:         &druidv1alpha1.DeltaSnapshotsSizeBaseline{FullSnapshotRevision: 42, DBSize: resource.MustParse("3Gi")},
+			expectedSize:     resource.NewQuantity(0, resource.BinarySI),
+			expectedBaseline: &druidv1alpha1.DeltaSnapshotsSizeBaseline{FullSnapshotRevision: 42, DBSize: resource.MustParse("1Gi")},
+		},
+	}
+
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			for i, dbSize := range test.memberDBSizes {
+				etcd.Status.Members = append(etcd.Status.Members, druidv1alpha1.EtcdMemberStatus{
+					Name:   fmt.Sprintf("etcd-%d", i),
+					DBSize: ptr.To(resource.MustParse(dbSize)),
+				})
+			}
+			if test.baseline != nil {
+				etcd.Status.Compaction = &druidv1alpha1.CompactionStatus{DeltaSnapshotsSizeBaseline: test.baseline}
+			}
+			lease := &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta),
+					Namespace: etcd.Namespace,
+				},
+				Spec: coordinationv1.LeaseSpec{HolderIdentity: ptr.To("42")},
+			}
+			cl := testutils.NewTestClientBuilder().WithScheme(kubernetes.Scheme).WithObjects(etcd, lease).WithStatusSubresource(etcd).Build()
+			r := &Reconciler{Client: cl}
+
+			size, err := r.getDeltaSnapshotsTotalSize(context.Background(), logr.Discard(), etcd)
+			g.Expect(err).ToNot(HaveOccurred())
+			if test.expectedSize == nil {
+				g.Expect(size).To(BeNil())
+			} else {
+				g.Expect(size).ToNot(BeNil())
+				g.Expect(size.Cmp(*test.expectedSize)).To(BeZero())
+			}
+
+			latestEtcd := &druidv1alpha1.Etcd{}
+			g.Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(etcd), latestEtcd)).To(Succeed())
+			if test.expectedBaseline == nil {
+				g.Expect(latestEtcd.Status.Compaction).To(BeNil())
+				return
+			}
+			g.Expect(latestEtcd.Status.Compaction).ToNot(BeNil())
+			baseline := latestEtcd.Status.Compaction.DeltaSnapshotsSizeBaseline
+			g.Expect(baseline).ToNot(BeNil())
+			g.Expect(baseline.FullSnapshotRevision).To(Equal(test.expectedBaseline.FullSnapshotRevision))
+			g.Expect(baseline.DBSize.Cmp(test.expectedBaseline.DBSize)).To(BeZero())
+		})
+	}
+}
+
+func TestCountActiveCompactionJobs(t *testing.T) {
+	newJob := func(name, namespace string, conditions ...batchv1.JobCondition) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameSnapshotCompactionJob},
+			},
+			Status: batchv1.JobStatus{Conditions: conditions},
+		}
+	}
+	existingObjects := []client.Object{
+		newJob("etcd-1-compactor", "ns-1"),
+		newJob("etcd-2-compactor", "ns-2"),
+		newJob("etcd-3-compactor", "ns-3", batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}),
+		newJob("etcd-4-compactor", "ns-4", batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}),
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "unrelated-job", Namespace: "ns-1"}},
+	}
+
+	g := NewWithT(t)
+	cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects)
+	r := &Reconciler{Client: cl, apiReader: cl}
+	count, err := r.countActiveCompactionJobs(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(count).To(Equal(2))
+}
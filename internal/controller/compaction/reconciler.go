@@ -9,17 +9,17 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/images"
 	druidmetrics "github.com/gardener/etcd-druid/internal/metrics"
 	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
 	"github.com/gardener/etcd-druid/internal/utils/imagevector"
-	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
 
 	"github.com/go-logr/logr"
 	"github.com/prometheus/client_golang/prometheus"
@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -68,10 +69,15 @@ var (
 // Reconciler reconciles compaction jobs for Etcd resources.
 type Reconciler struct {
 	client.Client
+	apiReader        client.Reader
 	config           druidconfigv1alpha1.CompactionControllerConfiguration
 	imageVector      imagevector.ImageVector
 	logger           logr.Logger
+	recorder         record.EventRecorder
 	EtcdbrHTTPClient httpClientInterface
+	// jobCreationMu serialises counting the active compaction jobs and creating a new one across concurrent
+	// reconciliations, so that the maximum number of concurrently running compaction jobs is not exceeded.
+	jobCreationMu sync.Mutex
 }
 
 // NewReconciler creates a new reconciler for Compaction
@@ -88,16 +94,18 @@ func NewReconciler(mgr manager.Manager, config druidconfigv1alpha1.CompactionCon
 func NewReconcilerWithImageVector(mgr manager.Manager, config druidconfigv1alpha1.CompactionControllerConfiguration, imageVector imagevector.ImageVector) *Reconciler {
 	return &Reconciler{
 		Client:      mgr.GetClient(),
+		apiReader:   mgr.GetAPIReader(),
 		config:      config,
 		imageVector: imageVector,
 		logger:      log.Log.WithName("compaction-lease-controller"),
+		recorder:    mgr.GetEventRecorderFor(controllerName),
 	}
 }
 
 // +kubebuilder:rbac:groups=druid.gardener.cloud,resources=etcds,verbs=get;list;watch
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;create;list;watch;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=pods,verbs=list;watch;delete;get
+// +kubebuilder:rbac:groups="",resources=pods,verbs=list;watch;delete;get;patch
 
 // Reconcile reconciles the compaction job.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -120,7 +128,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	logger := r.logger.WithValues("etcdNamespace", etcd.Namespace, "etcdName", etcd.Name)
 
-	return r.doReconcile(ctx, logger, etcd)
+	nextRetainedPodExpiry, err := r.deleteExpiredRetainedCompactionPods(ctx, logger, etcd, time.Now())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	result, err := r.doReconcile(ctx, logger, etcd)
+	if err == nil && nextRetainedPodExpiry > 0 && (result.RequeueAfter == 0 || nextRetainedPodExpiry < result.RequeueAfter) {
+		result.RequeueAfter = nextRetainedPodExpiry
+	}
+	return result, err
 }
 
 func (r *Reconciler) doReconcile(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd) (ctrl.Result, error) {
@@ -158,6 +174,13 @@ func (r *Reconciler) doReconcile(ctx context.Context, logger logr.Logger, etcd *
 			logger.Error(err, "Error while updating metrics and/or status for completed job", "jobName", job.Name)
 			return ctrl.Result{}, fmt.Errorf("error while updating metrics and status for completed job: %w", err)
 		}
+		// The pod of a failed job is retained for inspection, while the job itself is deleted so that it does not block subsequent compaction jobs.
+		if jobCompletionState, _ := getJobCompletionStateAndReason(job); jobCompletionState == jobFailed && r.config.FailedJobRetentionDuration != nil {
+			logger.Info("Retaining pod of failed compaction job for inspection", "jobName", job.Name, "retentionDuration", r.config.FailedJobRetentionDuration.Duration)
+			if err := r.retainFailedCompactionJobPod(ctx, job, etcd); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
 
 		// Delete the completed compaction job
 		logger.Info("Deleting the completed compaction job", "jobName", compactionJobName)
@@ -291,6 +314,17 @@ func (r *Reconciler) waitForEtcdStatusConditionUpdate(ctx context.Context, logge
 }
 
 func (r *Reconciler) delete(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd) (ctrl.Result, error) {
+	retainedPods, err := r.listRetainedCompactionPods(ctx, etcd)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	for _, pod := range retainedPods {
+		logger.Info("Deleting retained pod of failed compaction job", "podName", pod.Name)
+		if err := client.IgnoreNotFound(r.Delete(ctx, &pod)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error while deleting retained compaction pod %s: %w", pod.Name, err)
+		}
+	}
+
 	job := &batchv1.Job{}
 	if err := r.Get(ctx, types.NamespacedName{Name: druidv1alpha1.GetCompactionJobName(etcd.ObjectMeta), Namespace: etcd.Namespace}, job); err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -349,29 +383,25 @@ func (r *Reconciler) getDeltaRevisionsSinceFullSnapshot(ctx context.Context, log
 	return delta - full, nil
 }
 
-// triggerFullSnapshotOrCreateCompactionJob triggers a full snapshot or creates a compaction job based on the accumulated revisions and the last compaction job status.
+// triggerFullSnapshotOrCreateCompactionJob triggers a full snapshot or creates a compaction job based on the accumulated revisions, the age of the
+// latest full snapshot, the last compaction job status and the scheduling constraints configured for the compaction.
 func (r *Reconciler) triggerFullSnapshotOrCreateCompactionJob(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd, accumulatedEtcdRevisions int64) (ctrl.Result, error) {
-	eventsThreshold := r.config.EventsThreshold
-	triggerFullSnapshotThreshold := r.config.TriggerFullSnapshotThreshold
-	if compactionSpec := etcd.Spec.Backup.SnapshotCompaction; compactionSpec != nil {
-		if compactionSpec.EventsThreshold != nil {
-			eventsThreshold = *compactionSpec.EventsThreshold
-		}
-		if compactionSpec.TriggerFullSnapshotThreshold != nil {
-			triggerFullSnapshotThreshold = *compactionSpec.TriggerFullSnapshotThreshold
-		}
-	}
+	policy := r.getCompactionPolicy(etcd)
+	logger.Info("Compaction thresholds", "eventsThreshold", policy.eventsThreshold, "triggerFullSnapshotThreshold", policy.triggerFullSnapshotThreshold, "maxFullSnapshotAge", policy.maxFullSnapshotAge, "deltaSnapshotsTotalSizeThreshold", policy.deltaSnapshotsTotalSizeThreshold)
 
-	logger.Info("Compaction thresholds", "eventsThreshold", eventsThreshold, "triggerFullSnapshotThreshold", triggerFullSnapshotThreshold)
+	if waitDuration := timeUntilAllowedHours(policy.allowedHours, time.Now()); waitDuration > 0 {
+		logger.Info("Compaction is not allowed to start outside the allowed hours, requeuing", "allowedHoursStart", policy.allowedHours.Start, "allowedHoursEnd", policy.allowedHours.End, "requeueAfter", waitDuration)
+		return ctrl.Result{RequeueAfter: waitDuration}, nil
+	}
 
 	// Trigger full snapshot if the delta revisions over the last full snapshot are more than the configured upper threshold
 	// or if the last job completion reason is DeadlineExceeded or last full snapshot failed.
 	// This is to ensure that we avoid spinning up compaction jobs even when we know that the probability of it getting succeeded is very low due to the large number of revisions.
 	// This avoids unnecessary resource consumption and delays in the compaction process
-	if isLastCompactionConditionDeadlineExceededOrFullSnapshotFailure(etcd) || accumulatedEtcdRevisions >= triggerFullSnapshotThreshold {
-		return r.triggerFullSnapshotAndUpdateStatus(ctx, logger, etcd, accumulatedEtcdRevisions, triggerFullSnapshotThreshold)
+	if isLastCompactionConditionDeadlineExceededOrFullSnapshotFailure(etcd) || accumulatedEtcdRevisions >= policy.triggerFullSnapshotThreshold {
+		return r.triggerFullSnapshotAndUpdateStatus(ctx, logger, etcd, accumulatedEtcdRevisions, policy.triggerFullSnapshotThreshold)
 	}
-	return r.checkAndTriggerCompactionJob(ctx, logger, etcd, accumulatedEtcdRevisions, eventsThreshold)
+	return r.checkAndTriggerCompactionJob(ctx, logger, etcd, accumulatedEtcdRevisions, policy)
 }
 
 // triggerFullSnapshotAndUpdateStatus triggers a full snapshot and updates the etcd status condition LastSnapshotCompactionSucceeded.
@@ -422,28 +452,81 @@ func (r *Reconciler) triggerFullSnapshotAndUpdateStatus(ctx context.Context, log
 	return ctrl.Result{}, nil
 }
 
-// checkAndTriggerCompactionJob creates compaction job only when number of accumulated revisions over the last full snapshot is more than the configured events threshold.
-func (r *Reconciler) checkAndTriggerCompactionJob(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd, accumulatedEtcdRevisions, eventsThreshold int64) (ctrl.Result, error) {
-	var err error
-	job := &batchv1.Job{}
-	compactionJobName := druidv1alpha1.GetCompactionJobName(etcd.ObjectMeta)
-	if accumulatedEtcdRevisions >= eventsThreshold {
-		logger.Info("Creating etcd compaction job", "jobName", compactionJobName)
-		job, err = r.createCompactionJob(ctx, logger, etcd)
+// checkAndTriggerCompactionJob creates compaction job only when number of accumulated revisions over the last full snapshot is more than the configured
+// events threshold, when the estimated total size of the delta snapshots has reached the configured threshold, or when the latest full snapshot is older
+// than the configured maximum age. Job creation is deferred if the maximum number of concurrently running compaction jobs has been reached.
+func (r *Reconciler) checkAndTriggerCompactionJob(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd, accumulatedEtcdRevisions int64, policy compactionPolicy) (ctrl.Result, error) {
+	compactionRequired, requeueAfter, err := r.isCompactionRequired(ctx, logger, etcd, accumulatedEtcdRevisions, policy)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !compactionRequired {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if r.config.MaxConcurrentJobs != nil {
+		r.jobCreationMu.Lock()
+		defer r.jobCreationMu.Unlock()
+		activeJobs, err := r.countActiveCompactionJobs(ctx)
 		if err != nil {
-			logger.Error(err, "Error while creating compaction job", "jobName", compactionJobName)
-			return ctrl.Result{}, fmt.Errorf("error during compaction job creation: %w", err)
+			return ctrl.Result{}, err
+		}
+		if activeJobs >= *r.config.MaxConcurrentJobs {
+			logger.Info("Maximum number of concurrent compaction jobs reached, deferring compaction job creation", "activeJobs", activeJobs, "maxConcurrentJobs", *r.config.MaxConcurrentJobs)
+			return ctrl.Result{RequeueAfter: concurrentJobsLimitRequeueInterval}, nil
 		}
-		metricJobsCurrent.With(prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace}).Set(1)
 	}
 
-	if isJobPresent(job) {
-		logger.Info("Current compaction job status", "jobName", job.Name, "succeeded", job.Status.Succeeded)
+	compactionJobName := druidv1alpha1.GetCompactionJobName(etcd.ObjectMeta)
+	logger.Info("Creating etcd compaction job", "jobName", compactionJobName)
+	job, err := r.createCompactionJob(ctx, logger, etcd, accumulatedEtcdRevisions)
+	if err != nil {
+		logger.Error(err, "Error while creating compaction job", "jobName", compactionJobName)
+		return ctrl.Result{}, fmt.Errorf("error during compaction job creation: %w", err)
 	}
+	metricJobsCurrent.With(prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace}).Set(1)
+	logger.Info("Current compaction job status", "jobName", job.Name, "succeeded", job.Status.Succeeded)
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) createCompactionJob(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd) (*batchv1.Job, error) {
+// isCompactionRequired checks whether a compaction job needs to be created for the given Etcd. If compaction is not yet required only because the
+// latest full snapshot has not yet reached its maximum age, the duration after which it will reach it is returned so that the reconciliation can be requeued.
+func (r *Reconciler) isCompactionRequired(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd, accumulatedEtcdRevisions int64, policy compactionPolicy) (bool, time.Duration, error) {
+	if accumulatedEtcdRevisions >= policy.eventsThreshold {
+		return true, 0, nil
+	}
+	// There is nothing to compact if there are no delta revisions on top of the latest full snapshot.
+	if accumulatedEtcdRevisions <= 0 {
+		return false, 0, nil
+	}
+	if policy.deltaSnapshotsTotalSizeThreshold != nil {
+		deltaSnapshotsTotalSize, err := r.getDeltaSnapshotsTotalSize(ctx, logger, etcd)
+		if err != nil {
+			return false, 0, fmt.Errorf("error while estimating total size of the delta snapshots: %w", err)
+		}
+		if deltaSnapshotsTotalSize != nil && deltaSnapshotsTotalSize.Cmp(*policy.deltaSnapshotsTotalSizeThreshold) >= 0 {
+			logger.Info("Estimated total size of the delta snapshots has reached its threshold", "deltaSnapshotsTotalSize", deltaSnapshotsTotalSize.String(), "deltaSnapshotsTotalSizeThreshold", policy.deltaSnapshotsTotalSizeThreshold.String())
+			return true, 0, nil
+		}
+	}
+	if policy.maxFullSnapshotAge == nil {
+		return false, 0, nil
+	}
+	fullSnapshotAge, err := r.getFullSnapshotAge(ctx, etcd, time.Now())
+	if err != nil {
+		return false, 0, fmt.Errorf("error while getting age of the latest full snapshot: %w", err)
+	}
+	if fullSnapshotAge == nil {
+		return false, 0, nil
+	}
+	if *fullSnapshotAge >= *policy.maxFullSnapshotAge {
+		logger.Info("Latest full snapshot has exceeded its maximum age", "fullSnapshotAge", *fullSnapshotAge, "maxFullSnapshotAge", *policy.maxFullSnapshotAge)
+		return true, 0, nil
+	}
+	return false, *policy.maxFullSnapshotAge - *fullSnapshotAge, nil
+}
+
+func (r *Reconciler) createCompactionJob(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd, accumulatedEtcdRevisions int64) (*batchv1.Job, error) {
 	activeDeadlineSeconds := r.config.ActiveDeadlineDuration.Seconds()
 
 	_, etcdBackupImage, _, err := utils.GetEtcdImages(etcd, r.imageVector)
@@ -451,23 +534,6 @@ func (r *Reconciler) createCompactionJob(ctx context.Context, logger logr.Logger
 		return nil, fmt.Errorf("couldn't fetch etcd backup image: %w", err)
 	}
 
-	var cpuRequests resource.Quantity
-	var memoryRequests resource.Quantity
-	etcdBackupSpec := etcd.Spec.Backup
-	if etcdBackupSpec.SnapshotCompaction != nil && etcdBackupSpec.SnapshotCompaction.Resources != nil {
-		cpuRequests = *etcdBackupSpec.SnapshotCompaction.Resources.Requests.Cpu()
-		if cpuRequests.IsZero() {
-			cpuRequests = defaultCompactionJobCPURequests
-		}
-		memoryRequests = *etcdBackupSpec.SnapshotCompaction.Resources.Requests.Memory()
-		if memoryRequests.IsZero() {
-			memoryRequests = defaultCompactionJobMemoryRequests
-		}
-	} else {
-		cpuRequests = defaultCompactionJobCPURequests
-		memoryRequests = defaultCompactionJobMemoryRequests
-	}
-
 	// TerminationGracePeriodSeconds is set to 60 seconds to allow sufficient time for inspecting
 	// the pod's status in case of disruptions. This includes checking statuses such as DisruptionTarget
 	// to determine if the pod was subjected to disruptions, such as preemptions & evictions.
@@ -508,12 +574,7 @@ func (r *Reconciler) createCompactionJob(ctx context.Context, logger logr.Logger
 						Image:           etcdBackupImage,
 						ImagePullPolicy: v1.PullIfNotPresent,
 						Args:            getCompactionJobArgs(etcd, r.config.MetricsScrapeWaitDuration.Duration.String()),
-						Resources: v1.ResourceRequirements{
-							Requests: v1.ResourceList{
-								v1.ResourceCPU:    cpuRequests,
-								v1.ResourceMemory: memoryRequests,
-							},
-						},
+						Resources:       r.getCompactionJobResources(logger, etcd, accumulatedEtcdRevisions),
 						SecurityContext: &v1.SecurityContext{
 							AllowPrivilegeEscalation: ptr.To(false),
 						},
@@ -532,14 +593,14 @@ func (r *Reconciler) createCompactionJob(ctx context.Context, logger logr.Logger
 		job.Spec.Template.Spec.Containers[0].VolumeMounts = vms
 	}
 
-	env, err := utils.GetBackupRestoreContainerEnvVars(etcd.Spec.Backup.Store)
+	env, err := druidresources.GetBackupRestoreContainerEnvVars(etcd.Spec.Backup.Store)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating compaction job in %v for %v : unable to get backup-restore container environment variables : %w",
 			etcd.Namespace,
 			etcd.Name,
 			err)
 	}
-	providerEnv, err := druidstore.GetProviderEnvVars(etcd.Spec.Backup.Store)
+	providerEnv, err := druidresources.GetProviderEnvVars(etcd.Spec.Backup.Store)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating compaction job in %v for %v : unable to get provider-specific environment variables : %w",
 			etcd.Namespace,
@@ -600,6 +661,8 @@ func (r *Reconciler) updateMetricsAndStatusForCompletedJob(ctx context.Context,
 		latestCondition.Message += ", Compaction will be retried"
 	}
 
+	jobRun := r.newCompactionJobRun(ctx, logger, job, etcd, jobCompletionState, jobFailureReason, latestCondition.Message)
+
 	logger.Info("Updating etcd status condition for compaction job",
 		"conditionType", latestCondition.Type, "status", latestCondition.Status,
 		"reason", latestCondition.Reason, "message", latestCondition.Message)
@@ -608,6 +671,7 @@ func (r *Reconciler) updateMetricsAndStatusForCompletedJob(ctx context.Context,
 	if err := r.Get(ctx, types.NamespacedName{Namespace: etcd.Namespace, Name: etcd.Name}, latestEtcd); err != nil {
 		return fmt.Errorf("error while fetching etcd %s/%s: %w", etcd.Namespace, etcd.Name, err)
 	}
+	recordCompactionJobRun(latestEtcd, jobRun, ptr.Deref(r.config.HistoryLimit, 0))
 	if err := r.updateCompactionJobEtcdStatusCondition(ctx, latestEtcd, latestCondition); err != nil {
 		logger.Error(err, "Error while updating etcd status condition for compaction job", "jobName", job.Name)
 		return fmt.Errorf("error while updating etcd status condition for compaction job: %w", err)
@@ -623,8 +687,10 @@ func (r *Reconciler) updateMetricsAndStatusForCompletedJob(ctx context.Context,
 	// in a reconciliation loop, which leads to the metrics being updated multiple times for the same job.
 	if jobCompletionState == jobSucceeded {
 		recordSuccessfulJobMetrics(job)
+		r.recorder.Event(latestEtcd, v1.EventTypeNormal, druidv1alpha1.EventReasonCompactionSucceeded, latestCondition.Message)
 	} else {
 		recordFailureJobMetrics(jobFailureReasonMetricLabelValue, jobDurationSeconds, job)
+		r.recorder.Event(latestEtcd, v1.EventTypeWarning, druidv1alpha1.EventReasonCompactionFailed, latestCondition.Message)
 	}
 	return nil
 }
@@ -714,7 +780,7 @@ func getPodFailureReasonAndLastTransitionTime(pod *v1.Pod) (string, time.Time) {
 func getLabels(etcd *druidv1alpha1.Etcd) map[string]string {
 	jobLabels := map[string]string{
 		druidv1alpha1.LabelAppNameKey:                   druidv1alpha1.GetCompactionJobName(etcd.ObjectMeta),
-		druidv1alpha1.LabelComponentKey:                 common.ComponentNameSnapshotCompactionJob,
+		druidv1alpha1.LabelComponentKey:                 druidv1alpha1.ComponentNameSnapshotCompactionJob,
 		"networking.gardener.cloud/to-dns":              "allowed",
 		"networking.gardener.cloud/to-private-networks": "allowed",
 		"networking.gardener.cloud/to-public-networks":  "allowed",
@@ -726,29 +792,29 @@ func getCompactionJobVolumeMounts(etcd *druidv1alpha1.Etcd) ([]v1.VolumeMount, e
 	vms := []v1.VolumeMount{
 		{
 			Name:      "etcd-workspace-dir",
-			MountPath: common.VolumeMountPathEtcdData,
+			MountPath: druidresources.VolumeMountPathEtcdData,
 		},
 	}
 
-	provider, err := druidstore.StorageProviderFromInfraProvider(etcd.Spec.Backup.Store.Provider)
+	provider, err := druidresources.StorageProviderFromInfraProvider(etcd.Spec.Backup.Store.Provider)
 	if err != nil {
 		return vms, fmt.Errorf("storage provider is not recognized while fetching volume mounts")
 	}
 	switch provider {
-	case druidstore.Local:
+	case druidresources.Local:
 		vms = append(vms, v1.VolumeMount{
 			Name:      "host-storage",
-			MountPath: kubernetes.MountPathLocalStore(etcd, &provider),
+			MountPath: druidresources.MountPathLocalStore(etcd, &provider),
 		})
-	case druidstore.GCS:
+	case druidresources.GCS:
 		vms = append(vms, v1.VolumeMount{
-			Name:      common.VolumeNameProviderBackupSecret,
-			MountPath: common.VolumeMountPathGCSBackupSecret,
+			Name:      druidresources.VolumeNameProviderBackupSecret,
+			MountPath: druidresources.VolumeMountPathGCSBackupSecret,
 		})
-	case druidstore.S3, druidstore.ABS, druidstore.OSS, druidstore.Swift, druidstore.OCS:
+	case druidresources.S3, druidresources.ABS, druidresources.OSS, druidresources.Swift, druidresources.OCS:
 		vms = append(vms, v1.VolumeMount{
-			Name:      common.VolumeNameProviderBackupSecret,
-			MountPath: common.VolumeMountPathNonGCSProviderBackupSecret,
+			Name:      druidresources.VolumeNameProviderBackupSecret,
+			MountPath: druidresources.VolumeMountPathNonGCSProviderBackupSecret,
 		})
 	}
 
@@ -766,7 +832,7 @@ func getCompactionJobVolumes(ctx context.Context, cl client.Client, logger logr.
 	}
 
 	storeValues := etcd.Spec.Backup.Store
-	provider, err := druidstore.StorageProviderFromInfraProvider(storeValues.Provider)
+	provider, err := druidresources.StorageProviderFromInfraProvider(storeValues.Provider)
 	if err != nil {
 		return vs, fmt.Errorf("could not recognize storage provider while fetching volumes")
 	}
@@ -787,13 +853,13 @@ func getCompactionJobVolumes(ctx context.Context, cl client.Client, logger logr.
 				},
 			},
 		})
-	case druidstore.GCS, druidstore.S3, druidstore.OSS, druidstore.ABS, druidstore.Swift, druidstore.OCS:
+	case druidresources.GCS, druidresources.S3, druidresources.OSS, druidresources.ABS, druidresources.Swift, druidresources.OCS:
 		if storeValues.SecretRef == nil {
 			return vs, fmt.Errorf("could not configure secretRef for backup store %v", provider)
 		}
 
 		vs = append(vs, v1.Volume{
-			Name: common.VolumeNameProviderBackupSecret,
+			Name: druidresources.VolumeNameProviderBackupSecret,
 			VolumeSource: v1.VolumeSource{
 				Secret: &v1.SecretVolumeSource{
 					SecretName: storeValues.SecretRef.Name,
@@ -815,11 +881,7 @@ func getCompactionJobArgs(etcd *druidv1alpha1.Etcd, metricsScrapeWaitDuration st
 	command = append(command, fmt.Sprintf("--full-snapshot-lease-name=%s", druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta)))
 	command = append(command, fmt.Sprintf("--delta-snapshot-lease-name=%s", druidv1alpha1.GetDeltaSnapshotLeaseName(etcd.ObjectMeta)))
 
-	var quota int64 = DefaultETCDQuota
-	if etcd.Spec.Etcd.Quota != nil {
-		quota = etcd.Spec.Etcd.Quota.Value()
-	}
-	command = append(command, fmt.Sprintf("--embedded-etcd-quota-bytes=%d", quota))
+	command = append(command, fmt.Sprintf("--embedded-etcd-quota-bytes=%d", getEtcdQuota(etcd)))
 
 	if etcd.Spec.Etcd.EtcdDefragTimeout != nil {
 		command = append(command, fmt.Sprintf("--etcd-defrag-timeout=%s", etcd.Spec.Etcd.EtcdDefragTimeout.Duration.String()))
@@ -831,7 +893,7 @@ func getCompactionJobArgs(etcd *druidv1alpha1.Etcd, metricsScrapeWaitDuration st
 	}
 	storeValues := etcd.Spec.Backup.Store
 	if storeValues != nil {
-		provider, err := druidstore.StorageProviderFromInfraProvider(etcd.Spec.Backup.Store.Provider)
+		provider, err := druidresources.StorageProviderFromInfraProvider(etcd.Spec.Backup.Store.Provider)
 		if err == nil {
 			command = append(command, fmt.Sprintf("--storage-provider=%s", provider))
 		}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compaction
+
+import (
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// reasonOOMKilled is the reason set on a terminated container which has been killed due to running out of memory.
+	reasonOOMKilled = "OOMKilled"
+)
+
+// getCompactionJobResources computes the resource requirements for the compaction job of the given Etcd.
+// If adaptive resources are configured, the memory requests are sized from the size of the database restored by the
+// compaction job and the number of accumulated delta events, and are increased if the previous compaction job was OOMKilled.
+func (r *Reconciler) getCompactionJobResources(logger logr.Logger, etcd *druidv1alpha1.Etcd, accumulatedEtcdRevisions int64) v1.ResourceRequirements {
+	cpuRequests := defaultCompactionJobCPURequests
+	memoryRequests := defaultCompactionJobMemoryRequests
+	if compactionSpec := etcd.Spec.Backup.SnapshotCompaction; compactionSpec != nil && compactionSpec.Resources != nil {
+		if cpu := compactionSpec.Resources.Requests.Cpu(); !cpu.IsZero() {
+			cpuRequests = *cpu
+		}
+		if memory := compactionSpec.Resources.Requests.Memory(); !memory.IsZero() {
+			memoryRequests = *memory
+		}
+	}
+	resources := v1.ResourceRequirements{
+		Requests: v1.ResourceList{
+			v1.ResourceCPU:    cpuRequests,
+			v1.ResourceMemory: memoryRequests,
+		},
+	}
+
+	adaptiveResourcesConfig := r.config.AdaptiveResources
+	if adaptiveResourcesConfig == nil {
+		return resources
+	}
+	memory := getDatabaseSize(etcd)*int64(adaptiveResourcesConfig.DatabaseSizeMemoryPercent)/100 +
+		accumulatedEtcdRevisions*adaptiveResourcesConfig.MemoryPerDeltaEvent.Value()
+	if etcd.Status.Compaction != nil && etcd.Status.Compaction.OOMKilledMemoryRequests != nil {
+		oomKilledMemory := etcd.Status.Compaction.OOMKilledMemoryRequests
+		oomRetryMemory := oomKilledMemory.Value() * int64(adaptiveResourcesConfig.OOMRetryMemoryPercent) / 100
+		logger.Info("Previous compaction job was OOMKilled, increasing memory requests",
+			"previousMemoryRequests", oomKilledMemory.String(), "oomRetryMemoryRequests", oomRetryMemory)
+		memory = max(memory, oomRetryMemory)
+	}
+	if maxMemory := adaptiveResourcesConfig.MaxMemory; maxMemory != nil && memory > maxMemory.Value() {
+		computedMemory := resource.NewQuantity(memory, resource.BinarySI)
+		logger.Info("Memory requests computed for the compaction job exceed the maximum memory, capping them", "computedMemoryRequests", computedMemory.String(), "maxMemory", maxMemory.String())
+		r.recorder.Eventf(etcd, v1.EventTypeWarning, druidv1alpha1.EventReasonCompactionJobMemoryCapped,
+			"Memory requests of %s computed for the compaction job have been capped at the maximum memory of %s", computedMemory.String(), maxMemory.String())
+		memory = maxMemory.Value()
+	}
+	memory = max(memory, memoryRequests.Value())
+
+	resources.Requests[v1.ResourceMemory] = *resource.NewQuantity(memory, resource.BinarySI)
+	resources.Limits = v1.ResourceList{
+		v1.ResourceMemory: *resource.NewQuantity(memory*int64(adaptiveResourcesConfig.MemoryLimitPercent)/100, resource.BinarySI),
+	}
+	return resources
+}
+
+// getDatabaseSize returns the largest database size reported by the members of the given Etcd, which bounds the size of
+// the full snapshot restored by the compaction job. If no member has reported its database size yet, the etcd quota is
+// returned instead.
+func getDatabaseSize(etcd *druidv1alpha1.Etcd) int64 {
+	if dbSize := getLargestMemberDBSize(etcd); dbSize > 0 {
+		return dbSize
+	}
+	return getEtcdQuota(etcd)
+}
+
+// getLargestMemberDBSize returns the largest database size reported by the members of the given Etcd, or 0 if no member
+// has reported its database size yet.
+func getLargestMemberDBSize(etcd *druidv1alpha1.Etcd) int64 {
+	var dbSize int64
+	for _, member := range etcd.Status.Members {
+		if member.DBSize != nil {
+			dbSize = max(dbSize, member.DBSize.Value())
+		}
+	}
+	return dbSize
+}
+
+// getEtcdQuota returns the configured quota of the etcd database, or the default quota if none is configured.
+func getEtcdQuota(etcd *druidv1alpha1.Etcd) int64 {
+	if etcd.Spec.Etcd.Quota != nil {
+		return etcd.Spec.Etcd.Quota.Value()
+	}
+	return DefaultETCDQuota
+}
+
+// isPodOOMKilled checks if any container of the given pod has been terminated because it ran out of memory.
+func isPodOOMKilled(pod *v1.Pod) bool {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if terminated := containerStatus.State.Terminated; terminated != nil && terminated.Reason == reasonOOMKilled {
+			return true
+		}
+		if terminated := containerStatus.LastTerminationState.Terminated; terminated != nil && terminated.Reason == reasonOOMKilled {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compaction
+
+import (
+	"fmt"
+	"testing"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetCompactionJobResources(t *testing.T) {
+	adaptiveResources := &druidconfigv1alpha1.CompactionJobAdaptiveResourcesConfiguration{
+		DatabaseSizeMemoryPercent: 50,
+		MemoryPerDeltaEvent:       resource.MustParse("1Ki"),
+		MemoryLimitPercent:        150,
+		OOMRetryMemoryPercent:     200,
+	}
+	tests := []struct {
+		name                     string
+		adaptiveResources        *druidconfigv1alpha1.CompactionJobAdaptiveResourcesConfiguration
+		specResources            *corev1.ResourceRequirements
+		quota                    string
+		memberDBSizes            []string
+		accumulatedRevisions     int64
+		oomKilledMemoryRequests  *resource.Quantity
+		history                  []druidv1alpha1.CompactionJobRun
+		expectedCPURequests      string
+		expectedMemoryRequests   string
+		expectedMemoryLimits     string
+		expectMemoryLimitsNotSet bool
+		expectMemoryCappedEvent  bool
+	}{
+		{
+			name:                     "default resources without adaptive resources",
+			quota:                    "8Gi",
+			accumulatedRevisions:     1024 * 1024,
+			expectedCPURequests:      "600m",
+			expectedMemoryRequests:   "3Gi",
+			expectMemoryLimitsNotSet: true,
+		},
+		{
+			name: "resources from the etcd spec without adaptive resources",
+			specResources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("5Gi")},
+			},
+			quota:                    "8Gi",
+			expectedCPURequests:      "1",
+			expectedMemoryRequests:   "5Gi",
+			expectMemoryLimitsNotSet: true,
+		},
+		{
+			name:                   "memory is sized from the quota and delta events if no database size has been reported",
+			adaptiveResources:      adaptiveResources,
+			quota:                  "8Gi",
+			accumulatedRevisions:   1024 * 1024,
+			expectedCPURequests:    "600m",
+			expectedMemoryRequests: "5Gi",
+			expectedMemoryLimits:   "7680Mi",
+		},
+		{
+			name:                   "memory is sized from the largest reported database size and delta events",
+			adaptiveResources:      adaptiveResources,
+			quota:                  "8Gi",
+			memberDBSizes:          []string{"4Gi", "6Gi"},
+			accumulatedRevisions:   1024 * 1024,
+			expectedCPURequests:    "600m",
+			expectedMemoryRequests: "4Gi",
+			expectedMemoryLimits:   "6Gi",
+		},
+		{
+			name:                   "configured memory requests are used as lower bound",
+			adaptiveResources:      adaptiveResources,
+			quota:                  "2Gi",
+			accumulatedRevisions:   1024,
+			expectedCPURequests:    "600m",
+			expectedMemoryRequests: "3Gi",
+			expectedMemoryLimits:   "4608Mi",
+		},
+		{
+			name:                    "memory is increased after previous job was OOMKilled",
+			adaptiveResources:       adaptiveResources,
+			quota:                   "2Gi",
+			accumulatedRevisions:    1024,
+			oomKilledMemoryRequests: ptr.To(resource.MustParse("4Gi")),
+			expectedCPURequests:     "600m",
+			expectedMemoryRequests:  "8Gi",
+			expectedMemoryLimits:    "12Gi",
+		},
+		{
+			name:                 "memory is not increased if a job has succeeded since a job was OOMKilled",
+			adaptiveResources:    adaptiveResources,
+			quota:                "2Gi",
+			accumulatedRevisions: 1024,
+			history: []druidv1alpha1.CompactionJobRun{
+				{JobName: "test-job-2", Result: druidv1alpha1.CompactionJobResultSucceeded, MemoryRequests: ptr.To(resource.MustParse("8Gi"))},
+				{JobName: "test-job-1", Result: druidv1alpha1.CompactionJobResultFailed, OOMKilled: true, MemoryRequests: ptr.To(resource.MustParse("4Gi"))},
+			},
+			expectedCPURequests:    "600m",
+			expectedMemoryRequests: "3Gi",
+			expectedMemoryLimits:   "4608Mi",
+		},
+		{
+			name: "memory is capped by max memory",
+			adaptiveResources: &druidconfigv1alpha1.CompactionJobAdaptiveResourcesConfiguration{
+				DatabaseSizeMemoryPercent: 50,
+				MemoryPerDeltaEvent:       resource.MustParse("1Ki"),
+				MemoryLimitPercent:        100,
+				OOMRetryMemoryPercent:     200,
+				MaxMemory:                 ptr.To(resource.MustParse("4Gi")),
+			},
+			quota:                   "16Gi",
+			accumulatedRevisions:    1024 * 1024,
+			expectedCPURequests:     "600m",
+			expectedMemoryRequests:  "4Gi",
+			expectedMemoryLimits:    "4Gi",
+			expectMemoryCappedEvent: true,
+		},
+	}
+
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			etcd.Spec.Etcd.Quota = ptr.To(resource.MustParse(test.quota))
+			if test.specResources != nil {
+				etcd.Spec.Backup.SnapshotCompaction = &druidv1alpha1.SnapshotCompactionSpec{Resources: test.specResources}
+			}
+			for i, dbSize := range test.memberDBSizes {
+				etcd.Status.Members = append(etcd.Status.Members, druidv1alpha1.EtcdMemberStatus{
+					Name:   fmt.Sprintf("%s-%d", etcd.Name, i),
+					DBSize: ptr.To(resource.MustParse(dbSize)),
+				})
+			}
+			if test.oomKilledMemoryRequests != nil || test.history != nil {
+				etcd.Status.Compaction = &druidv1alpha1.CompactionStatus{History: test.history, OOMKilledMemoryRequests: test.oomKilledMemoryRequests}
+			}
+			recorder := record.NewFakeRecorder(1)
+			r := &Reconciler{
+				config:   druidconfigv1alpha1.CompactionControllerConfiguration{AdaptiveResources: test.adaptiveResources},
+				recorder: recorder,
+			}
+			resources := r.getCompactionJobResources(logr.Discard(), etcd, test.accumulatedRevisions)
+			g.Expect(resources.Requests.Cpu().Cmp(resource.MustParse(test.expectedCPURequests))).To(BeZero())
+			g.Expect(resources.Requests.Memory().Cmp(resource.MustParse(test.expectedMemoryRequests))).To(BeZero())
+			if test.expectMemoryLimitsNotSet {
+				g.Expect(resources.Limits).To(BeEmpty())
+			} else {
+				g.Expect(resources.Limits.Memory().Cmp(resource.MustParse(test.expectedMemoryLimits))).To(BeZero())
+			}
+			if test.expectMemoryCappedEvent {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring(druidv1alpha1.EventReasonCompactionJobMemoryCapped)))
+			} else {
+				g.Expect(recorder.Events).ToNot(Receive())
+			}
+		})
+	}
+}
+
+func TestIsPodOOMKilled(t *testing.T) {
+	tests := []struct {
+		name              string
+		containerStatuses []corev1.ContainerStatus
+		expected          bool
+	}{
+		{
+			name:     "no container statuses",
+			expected: false,
+		},
+		{
+			name: "container terminated with error",
+			containerStatuses: []corev1.ContainerStatus{
+				{State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error"}}},
+			},
+			expected: false,
+		},
+		{
+			name: "container terminated due to OOMKilled",
+			containerStatuses: []corev1.ContainerStatus{
+				{State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}}},
+			},
+			expected: true,
+		},
+		{
+			name: "container was previously terminated due to OOMKilled",
+			containerStatuses: []corev1.ContainerStatus{
+				{LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}}},
+			},
+			expected: true,
+		},
+	}
+
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			pod := &corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: test.containerStatuses}}
+			g.Expect(isPodOOMKilled(pod)).To(Equal(test.expected))
+		})
+	}
+}
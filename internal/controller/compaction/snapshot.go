@@ -13,7 +13,6 @@ import (
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
 	druidmetrics "github.com/gardener/etcd-druid/internal/metrics"
 
 	"github.com/go-logr/logr"
@@ -81,7 +80,7 @@ func newHTTPClient(ctx context.Context, cl client.Client, etcd *druidv1alpha1.Et
 	httpScheme := "http"
 	httpTransport := &http.Transport{}
 
-	if tlsConfig := etcd.Spec.Backup.TLS; tlsConfig != nil {
+	if tlsConfig := etcd.GetBackupTLS(); tlsConfig != nil {
 		httpScheme = "https"
 		etcdbrCASecret := &v1.Secret{}
 		dataKey := ptr.Deref(tlsConfig.TLSCASecretRef.DataKey, "bundle.crt")
@@ -121,7 +120,7 @@ func fullSnapshot(ctx context.Context, etcd *druidv1alpha1.Etcd, httpClient http
 		httpScheme,
 		druidv1alpha1.GetClientServiceName(etcd.ObjectMeta),
 		etcd.Namespace,
-		ptr.Deref(etcd.Spec.Backup.Port, common.DefaultPortEtcdBackupRestore),
+		ptr.Deref(etcd.Spec.Backup.Port, druidv1alpha1.DefaultPortEtcdBackupRestore),
 	)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullSnapshotURL, nil)
 	if err != nil {
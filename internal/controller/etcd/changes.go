@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"sync/atomic"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// changeDetectorKey is the context key under which a changeDetector is stored.
+type changeDetectorKey struct{}
+
+// changeDetector records whether any resource has been written with a context carrying it.
+type changeDetector struct {
+	changed atomic.Bool
+}
+
+// withChangeDetector returns a copy of the given context carrying a new changeDetector.
+func withChangeDetector(ctx context.Context) (context.Context, *changeDetector) {
+	detector := &changeDetector{}
+	return context.WithValue(ctx, changeDetectorKey{}, detector), detector
+}
+
+// markChanged marks the changeDetector of the given context, if any, as changed.
+func markChanged(ctx context.Context) {
+	if detector, ok := ctx.Value(changeDetectorKey{}).(*changeDetector); ok {
+		detector.changed.Store(true)
+	}
+}
+
+// changeDetectingClient is a client.Client which marks the changeDetector of the context of every successful
+// create, update, patch and delete request. It is used by the component operators so that the components which have
+// been changed in a reconciliation run can be determined.
+type changeDetectingClient struct {
+	client.Client
+}
+
+func newChangeDetectingClient(cl client.Client) client.Client {
+	return &changeDetectingClient{Client: cl}
+}
+
+func (c *changeDetectingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return detectChange(ctx, c.Client.Create(ctx, obj, opts...))
+}
+
+func (c *changeDetectingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return detectChange(ctx, c.Client.Update(ctx, obj, opts...))
+}
+
+func (c *changeDetectingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return detectChange(ctx, c.Client.Patch(ctx, obj, patch, opts...))
+}
+
+func (c *changeDetectingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return detectChange(ctx, c.Client.Delete(ctx, obj, opts...))
+}
+
+func detectChange(ctx context.Context, err error) error {
+	if err == nil {
+		markChanged(ctx)
+	}
+	return err
+}
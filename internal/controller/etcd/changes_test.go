@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestObserveComponentSyncRecordsChangedComponents(t *testing.T) {
+	existingConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "test-ns"}}
+	tests := []struct {
+		name                      string
+		syncFn                    func(cl client.Client) func(component.OperatorContext, *druidv1alpha1.Etcd) error
+		expectedChangedComponents []string
+	}{
+		{
+			name: "sync which only reads resources",
+			syncFn: func(cl client.Client) func(component.OperatorContext, *druidv1alpha1.Etcd) error {
+				return func(ctx component.OperatorContext, _ *druidv1alpha1.Etcd) error {
+					return cl.Get(ctx, client.ObjectKeyFromObject(existingConfigMap), &corev1.ConfigMap{})
+				}
+			},
+		},
+		{
+			name: "sync which creates a resource",
+			syncFn: func(cl client.Client) func(component.OperatorContext, *druidv1alpha1.Etcd) error {
+				return func(ctx component.OperatorContext, _ *druidv1alpha1.Etcd) error {
+					return cl.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "test-ns"}})
+				}
+			},
+			expectedChangedComponents: []string{string(component.ConfigMapKind)},
+		},
+		{
+			name: "sync which fails to create a resource",
+			syncFn: func(cl client.Client) func(component.OperatorContext, *druidv1alpha1.Etcd) error {
+				return func(ctx component.OperatorContext, _ *druidv1alpha1.Etcd) error {
+					_ = cl.Create(ctx, existingConfigMap.DeepCopy())
+					return nil
+				}
+			},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			cl := newChangeDetectingClient(testutils.NewTestClientBuilder().WithObjects(existingConfigMap.DeepCopy()).Build())
+			etcd := &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "test-etcd", Namespace: "test-ns"}}
+			ctx := component.NewOperatorContext(context.Background(), logr.Discard(), "test")
+
+			g.Expect(observeComponentSync(ctx, etcd, component.ConfigMapKind, component.OperationSync, tt.syncFn(cl))).To(Succeed())
+
+			g.Expect(ctx.GetChangedComponents()).To(Equal(tt.expectedChangedComponents))
+			deleteEtcdMetrics(etcd)
+		})
+	}
+}
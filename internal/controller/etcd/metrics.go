@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	druidmetrics "github.com/gardener/etcd-druid/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespaceEtcdDruid = "etcddruid"
+	subsystemBackup    = "backup"
+	subsystemTLS       = "tls"
+	subsystemEtcd      = "etcd"
+)
+
+const (
+	// backupRPOLevelHealthy is the value of metricBackupRPOLevel if the backups meet the recovery point objectives and are taken as per schedule.
+	backupRPOLevelHealthy float64 = iota
+	// backupRPOLevelDegraded is the value of metricBackupRPOLevel if the backups meet the recovery point objectives but are not taken as per schedule.
+	backupRPOLevelDegraded
+	// backupRPOLevelBreached is the value of metricBackupRPOLevel if the backups do not meet the recovery point objectives.
+	backupRPOLevelBreached
+)
+
+var (
+	// metricBackupRPOLevel is the metric used to expose the level of the recovery point objectives of the backups of an etcd cluster.
+	metricBackupRPOLevel = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemBackup,
+			Name:      "rpo_level",
+			Help:      "Level of the recovery point objectives of the backups of an etcd cluster: 0 for healthy, 1 for degraded and 2 for breached.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName},
+	)
+
+	// metricBackupRPOBreachesTotal is the metric used to count the number of times the recovery point objectives of the backups of an etcd cluster were breached.
+	metricBackupRPOBreachesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemBackup,
+			Name:      "rpo_breaches_total",
+			Help:      "Total number of times the recovery point objectives of the backups of an etcd cluster were breached.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName},
+	)
+
+	// metricTLSCertificateExpiryTimestamp is the metric used to expose the expiry time of the certificates in the TLS secrets referenced by an etcd cluster.
+	metricTLSCertificateExpiryTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemTLS,
+			Name:      "certificate_expiry_timestamp_seconds",
+			Help:      "Expiry time of a certificate in a TLS secret referenced by an etcd cluster, in seconds since the Unix epoch. For CA bundles, the CA which expires last is considered.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName, druidmetrics.LabelSecretName, druidmetrics.LabelCertificateUsage},
+	)
+
+	// metricBackupLastFullSnapshotTimestamp is the metric used to expose the time of the latest full snapshot of an etcd cluster.
+	metricBackupLastFullSnapshotTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemBackup,
+			Name:      "last_full_snapshot_timestamp_seconds",
+			Help:      "Time of the latest full snapshot of an etcd cluster as per its full snapshot lease, in seconds since the Unix epoch.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName},
+	)
+
+	// metricBackupLastDeltaSnapshotTimestamp is the metric used to expose the time of the latest delta snapshot of an etcd cluster.
+	metricBackupLastDeltaSnapshotTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemBackup,
+			Name:      "last_delta_snapshot_timestamp_seconds",
+			Help:      "Time of the latest delta snapshot of an etcd cluster as per its delta snapshot lease, in seconds since the Unix epoch.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName},
+	)
+
+	// metricEtcdCondition is the metric used to expose the status of the conditions of an etcd cluster.
+	metricEtcdCondition = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemEtcd,
+			Name:      "condition",
+			Help:      "Status of a condition of an etcd cluster: 1 for the current status of the condition, 0 for the other statuses.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName, druidmetrics.LabelConditionType, druidmetrics.LabelConditionStatus},
+	)
+
+	// metricEtcdMembers is the metric used to expose the desired number of members of an etcd cluster.
+	metricEtcdMembers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemEtcd,
+			Name:      "members",
+			Help:      "Desired number of members of an etcd cluster.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName},
+	)
+
+	// metricEtcdMembersReady is the metric used to expose the number of ready members of an etcd cluster.
+	metricEtcdMembersReady = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemEtcd,
+			Name:      "members_ready",
+			Help:      "Number of members of an etcd cluster with status Ready.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName},
+	)
+
+	// metricEtcdLeaderChangesTotal is the metric used to count the number of times the leader of an etcd cluster changed.
+	// The leader is only sampled once per status sync, so that several leader changes between two status syncs are
+	// counted once. The exact number of leader changes is exposed by etcd via `etcd_server_leader_changes_seen_total`.
+	metricEtcdLeaderChangesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemEtcd,
+			Name:      "leader_changes_total",
+			Help:      "Total number of leader changes of an etcd cluster observed by etcd-druid, sampled once per status sync.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName},
+	)
+
+	// metricComponentSyncDurationSeconds is the metric used to expose the time taken to sync a component of an etcd cluster.
+	// It is not labelled by etcd cluster to bound the number of series.
+	metricComponentSyncDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemEtcd,
+			Name:      "component_sync_duration_seconds",
+			Help:      "Time taken in seconds to sync a component of an etcd cluster.",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{druidmetrics.LabelComponentKind},
+	)
+
+	// metricComponentSyncErrorsTotal is the metric used to count the number of failed syncs of a component of an etcd cluster.
+	metricComponentSyncErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemEtcd,
+			Name:      "component_sync_errors_total",
+			Help:      "Total number of failed syncs of a component of an etcd cluster. Syncs which are retried later on purpose are not counted.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName, druidmetrics.LabelComponentKind},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(metricBackupRPOLevel)
+	metrics.Registry.MustRegister(metricBackupRPOBreachesTotal)
+	metrics.Registry.MustRegister(metricTLSCertificateExpiryTimestamp)
+	metrics.Registry.MustRegister(metricBackupLastFullSnapshotTimestamp)
+	metrics.Registry.MustRegister(metricBackupLastDeltaSnapshotTimestamp)
+	metrics.Registry.MustRegister(metricEtcdCondition)
+	metrics.Registry.MustRegister(metricEtcdMembers)
+	metrics.Registry.MustRegister(metricEtcdMembersReady)
+	metrics.Registry.MustRegister(metricEtcdLeaderChangesTotal)
+	metrics.Registry.MustRegister(metricComponentSyncDurationSeconds)
+	metrics.Registry.MustRegister(metricComponentSyncErrorsTotal)
+}
@@ -22,7 +22,7 @@ func (r *Reconciler) completeReconcile(ctx component.OperatorContext, etcd *drui
 	}
 
 	for _, fn := range reconcileCompletionStepFns {
-		if stepResult := fn(ctx, etcd); ctrlutils.ShortCircuitReconcileFlow(stepResult) {
+		if stepResult := traceStep(ctx, etcd, fn); ctrlutils.ShortCircuitReconcileFlow(stepResult) {
 			return r.recordIncompleteReconcileOperation(ctx, etcd, stepResult)
 		}
 	}
@@ -11,6 +11,7 @@ import (
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/component"
+	"github.com/gardener/etcd-druid/internal/controller/etcdopstask"
 	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
 	"github.com/gardener/etcd-druid/internal/utils"
 	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
@@ -28,7 +29,7 @@ func (r *Reconciler) triggerDeletionFlow(ctx component.OperatorContext, logger l
 		r.removeFinalizer,
 	}
 	for _, fn := range deleteStepFns {
-		if stepResult := fn(ctx, etcd); ctrlutils.ShortCircuitReconcileFlow(stepResult) {
+		if stepResult := traceStep(ctx, etcd, fn); ctrlutils.ShortCircuitReconcileFlow(stepResult) {
 			return r.recordIncompleteDeletionOperation(ctx, logger, etcd, stepResult)
 		}
 	}
@@ -76,6 +77,13 @@ func (r *Reconciler) removeFinalizer(ctx component.OperatorContext, etcd *druidv
 	if err := kubernetes.RemoveFinalizers(ctx, r.client, etcd, druidapicommon.EtcdFinalizerName); client.IgnoreNotFound(err) != nil {
 		return ctrlutils.ReconcileWithError(err)
 	}
+	deleteBackupRPOMetrics(etcd)
+	deleteCertificateExpiryMetrics(etcd)
+	deleteEtcdMetrics(etcd)
+	r.memberHealthChecker.DeleteWALFsyncSamples(etcd.ObjectMeta)
+	r.customCheckHTTPClients.Delete(etcd.ObjectMeta)
+	etcdopstask.DeleteEtcdMetrics(etcd.ObjectMeta)
+	r.reconcileStartedGenerations.Delete(client.ObjectKeyFromObject(etcd))
 	return ctrlutils.ContinueReconcile()
 }
 
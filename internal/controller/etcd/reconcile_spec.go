@@ -13,9 +13,12 @@ import (
 	"github.com/gardener/etcd-druid/internal/component"
 	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	druidmetrics "github.com/gardener/etcd-druid/internal/metrics"
+	"github.com/gardener/etcd-druid/internal/tracing"
 	"github.com/gardener/etcd-druid/internal/utils"
 	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
 
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +35,7 @@ func (r *Reconciler) reconcileSpec(ctx component.OperatorContext, etcd *druidv1a
 	reconcileStepFns := []reconcileFn{
 		r.recordReconcileStartOperation,
 		r.ensureFinalizer,
+		r.copyBackupsToRestoreFrom,
 		r.preSyncEtcdResources,
 		r.syncEtcdResources,
 		r.cleanupEtcdResources,
@@ -39,7 +43,7 @@ func (r *Reconciler) reconcileSpec(ctx component.OperatorContext, etcd *druidv1a
 	}
 
 	for _, fn := range reconcileStepFns {
-		if stepResult := fn(ctx, etcd); ctrlutils.ShortCircuitReconcileFlow(stepResult) {
+		if stepResult := traceStep(ctx, etcd, fn); ctrlutils.ShortCircuitReconcileFlow(stepResult) {
 			return r.recordIncompleteReconcileOperation(ctx, etcd, stepResult)
 		}
 	}
@@ -62,12 +66,13 @@ func (r *Reconciler) preSyncEtcdResources(ctx component.OperatorContext, etcd *d
 	resourceOperators := r.getOrderedOperatorsForPreSync()
 	for _, kind := range resourceOperators {
 		op := r.operatorRegistry.GetOperator(kind)
-		if err := op.PreSync(ctx, etcd); err != nil {
+		if err := observeComponentSync(ctx, etcd, kind, component.OperationPreSync, op.PreSync); err != nil {
 			if derr := druiderr.AsDruidError(err); derr != nil && derr.Code == druiderr.ErrRequeueAfter {
 				ctx.Logger.Info("retrying pre-sync of component", "kind", kind, "syncRetryInterval", syncRetryInterval.String(), "reason", derr.Message)
 				return ctrlutils.ReconcileAfter(syncRetryInterval, fmt.Sprintf("requeueing pre-sync of component %s to be retried after %s", kind, syncRetryInterval.String()))
 			}
 			ctx.Logger.Error(err, "failed to sync etcd resource", "kind", kind)
+			r.recordComponentSyncFailure(etcd, kind, err)
 			return ctrlutils.ReconcileWithError(err)
 		}
 	}
@@ -78,18 +83,49 @@ func (r *Reconciler) syncEtcdResources(ctx component.OperatorContext, etcd *drui
 	resourceOperators := r.getOrderedOperatorsForSync(etcd.ObjectMeta)
 	for _, kind := range resourceOperators {
 		op := r.operatorRegistry.GetOperator(kind)
-		if err := op.Sync(ctx, etcd); err != nil {
+		if err := observeComponentSync(ctx, etcd, kind, component.OperationSync, op.Sync); err != nil {
 			if derr := druiderr.AsDruidError(err); derr != nil && derr.Code == druiderr.ErrRequeueAfter {
 				ctx.Logger.Info("retrying sync of component", "kind", kind, "syncRetryInterval", syncRetryInterval.String(), "reason", derr.Message)
 				return ctrlutils.ReconcileAfter(syncRetryInterval, fmt.Sprintf("retrying sync of component %s after %s", kind, syncRetryInterval.String()))
 			}
 			ctx.Logger.Error(err, "failed to sync etcd resource", "kind", kind)
+			r.recordComponentSyncFailure(etcd, kind, err)
 			return ctrlutils.ReconcileWithError(err)
 		}
 	}
 	return ctrlutils.ContinueReconcile()
 }
 
+func (r *Reconciler) recordComponentSyncFailure(etcd *druidv1alpha1.Etcd, kind component.Kind, err error) {
+	r.recorder.Eventf(etcd, corev1.EventTypeWarning, druidv1alpha1.EventReasonComponentSyncFailed, "Failed to sync component %s: %v", kind, err)
+}
+
+// observeComponentSync runs the given (pre-)sync operation of a component within a span, and records its duration,
+// whether it has changed any resources of the component, and its failure unless it is retried later on purpose.
+func observeComponentSync(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, kind component.Kind, operation string, syncFn func(component.OperatorContext, *druidv1alpha1.Etcd) error) error {
+	spanCtx, span := tracing.StartSpan(ctx, fmt.Sprintf("%s.%s", kind, operation), tracing.AttributeComponentKind.String(string(kind)))
+	syncCtx := ctx
+	var detector *changeDetector
+	syncCtx.Context, detector = withChangeDetector(spanCtx)
+	start := time.Now()
+	err := syncFn(syncCtx, etcd)
+	if detector.changed.Load() {
+		ctx.RecordChangedComponent(kind)
+	}
+	metricComponentSyncDurationSeconds.With(prometheus.Labels{druidmetrics.LabelComponentKind: string(kind)}).Observe(time.Since(start).Seconds())
+	if derr := druiderr.AsDruidError(err); err != nil && (derr == nil || derr.Code != druiderr.ErrRequeueAfter) {
+		metricComponentSyncErrorsTotal.With(prometheus.Labels{
+			druidmetrics.LabelEtcdNamespace: etcd.Namespace,
+			druidmetrics.LabelEtcdName:      etcd.Name,
+			druidmetrics.LabelComponentKind: string(kind),
+		}).Inc()
+		tracing.EndSpan(span, err)
+		return err
+	}
+	tracing.EndSpan(span, nil)
+	return err
+}
+
 // cleanupEtcdResources cleans up the resources that are no longer required for the Etcd cluster.
 // This is required when runtime components are disabled for an Etcd cluster after it was created with runtime components enabled,
 // so druid needs to ensure that the previously created runtime components are now cleaned up to avoid leaked resources in the cluster.
@@ -118,6 +154,9 @@ func (r *Reconciler) recordReconcileStartOperation(ctx component.OperatorContext
 		ctx.Logger.Error(err, "failed to record etcd reconcile start operation")
 		return ctrlutils.ReconcileWithError(err)
 	}
+	if ctrlutils.IsNewReconcileRequest(etcd) && r.markReconcileStarted(etcd) {
+		r.recorder.Eventf(etcd, corev1.EventTypeNormal, druidv1alpha1.EventReasonReconcileStarted, "Reconciliation of generation %d started", etcd.Generation)
+	}
 	return ctrlutils.ContinueReconcile()
 }
 
@@ -126,10 +165,17 @@ func (r *Reconciler) recordReconcileSuccessOperation(ctx component.OperatorConte
 		ctx.Logger.Error(err, "failed to record etcd reconcile success operation")
 		return ctrlutils.ReconcileWithError(err)
 	}
+	if ctrlutils.IsNewReconcileRequest(etcd) {
+		r.reconcileStartedGenerations.Delete(client.ObjectKeyFromObject(etcd))
+		r.recorder.Eventf(etcd, corev1.EventTypeNormal, druidv1alpha1.EventReasonReconcileSucceeded, "Reconciliation of generation %d succeeded", etcd.Generation)
+	}
 	return ctrlutils.ContinueReconcile()
 }
 
 func (r *Reconciler) recordIncompleteReconcileOperation(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, exitReconcileStepResult ctrlutils.ReconcileStepResult) ctrlutils.ReconcileStepResult {
+	if exitReconcileStepResult.HasErrors() {
+		r.recorder.Eventf(etcd, corev1.EventTypeWarning, druidv1alpha1.EventReasonReconcileFailed, "Reconciliation of generation %d failed: %v", etcd.Generation, exitReconcileStepResult.GetCombinedError())
+	}
 	if err := r.lastOpErrRecorder.RecordErrors(ctx, etcd, druidv1alpha1.LastOperationTypeReconcile, exitReconcileStepResult); err != nil {
 		ctx.Logger.Error(err, "failed to record last operation and last errors for etcd reconciliation")
 		return ctrlutils.ReconcileWithError(err)
@@ -137,6 +183,13 @@ func (r *Reconciler) recordIncompleteReconcileOperation(ctx component.OperatorCo
 	return exitReconcileStepResult
 }
 
+// markReconcileStarted records that the reconciliation of the current generation of the given Etcd has started. It
+// returns false if it has already been recorded, i.e. if the reconciliation of this generation is requeued.
+func (r *Reconciler) markReconcileStarted(etcd *druidv1alpha1.Etcd) bool {
+	previousGeneration, loaded := r.reconcileStartedGenerations.Swap(client.ObjectKeyFromObject(etcd), etcd.Generation)
+	return !loaded || previousGeneration.(int64) != etcd.Generation
+}
+
 // shouldReconcileSpec assesses whether the Etcd spec should undergo reconciliation.
 //
 // Reconciliation decision follows these rules:
@@ -174,7 +227,7 @@ func (r *Reconciler) recordEtcdSpecReconcileSuspension(etcd *druidv1alpha1.Etcd,
 	r.recorder.Eventf(
 		etcd,
 		corev1.EventTypeWarning,
-		"SpecReconciliationSkipped",
+		druidv1alpha1.EventReasonSpecReconciliationSkipped,
 		"spec reconciliation of %s/%s is skipped by etcd-druid due to the presence of annotation %s on the etcd resource",
 		etcd.Namespace,
 		etcd.Name,
@@ -206,8 +259,10 @@ func (r *Reconciler) getOrderedOperatorsForSync(etcdObjMeta metav1.ObjectMeta) [
 
 	// add the rest of the operators that are always needed for the etcd cluster
 	operators = append(operators,
+		component.ManagedCertificatesKind,
 		component.ConfigMapKind,
 		component.StatefulSetKind,
+		component.EtcdAuthKind,
 	)
 
 	return operators
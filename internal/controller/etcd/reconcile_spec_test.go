@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	druidmetrics "github.com/gardener/etcd-druid/internal/metrics"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestObserveComponentSync(t *testing.T) {
+	tests := []struct {
+		name                string
+		etcdName            string
+		err                 error
+		expectedErrorsTotal float64
+	}{
+		{
+			name:     "successful sync",
+			etcdName: "etcd-sync-succeeded",
+		},
+		{
+			name:                "failed sync",
+			etcdName:            "etcd-sync-failed",
+			err:                 errors.New("test error"),
+			expectedErrorsTotal: 1,
+		},
+		{
+			name:     "sync requeued",
+			etcdName: "etcd-sync-requeued",
+			err:      druiderr.New(druiderr.ErrRequeueAfter, "Sync", "test requeue"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: tt.etcdName, Namespace: "test-ns"}}
+
+			err := observeComponentSync(component.NewOperatorContext(context.Background(), logr.Discard(), "test"), etcd, component.StatefulSetKind, component.OperationSync, func(component.OperatorContext, *druidv1alpha1.Etcd) error { return tt.err })
+
+			if tt.err == nil {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(Equal(tt.err))
+			}
+			labels := prometheus.Labels{
+				druidmetrics.LabelEtcdNamespace: etcd.Namespace,
+				druidmetrics.LabelEtcdName:      etcd.Name,
+				druidmetrics.LabelComponentKind: string(component.StatefulSetKind),
+			}
+			g.Expect(testutil.ToFloat64(metricComponentSyncErrorsTotal.With(labels))).To(Equal(tt.expectedErrorsTotal))
+			deleteEtcdMetrics(etcd)
+		})
+	}
+}
+
+func TestRecordReconcileOperationEvents(t *testing.T) {
+	g := NewWithT(t)
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{recorder: recorder, lastOpErrRecorder: noopLastOpErrRecorder{}}
+	ctx := component.NewOperatorContext(context.Background(), logr.Discard(), "test")
+	etcd := &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "etcd-events", Namespace: "test-ns", Generation: 2}}
+	etcd.Status.ObservedGeneration = ptr.To[int64](1)
+
+	// the reconciliation of generation 2 is requeued
+	g.Expect(r.recordReconcileStartOperation(ctx, etcd)).To(Equal(ctrlutils.ContinueReconcile()))
+	g.Expect(r.recordReconcileStartOperation(ctx, etcd)).To(Equal(ctrlutils.ContinueReconcile()))
+	// generation 3 is reconciled successfully
+	etcd.Generation = 3
+	g.Expect(r.recordReconcileStartOperation(ctx, etcd)).To(Equal(ctrlutils.ContinueReconcile()))
+	g.Expect(r.recordReconcileSuccessOperation(ctx, etcd)).To(Equal(ctrlutils.ContinueReconcile()))
+	// generation 3 is reconciled again on request
+	etcd.Status.ObservedGeneration = ptr.To[int64](3)
+	etcd.Annotations = map[string]string{druidv1alpha1.DruidOperationAnnotation: druidv1alpha1.DruidOperationReconcile}
+	g.Expect(r.recordReconcileStartOperation(ctx, etcd)).To(Equal(ctrlutils.ContinueReconcile()))
+
+	close(recorder.Events)
+	var events []string
+	for event := range recorder.Events {
+		events = append(events, event)
+	}
+	g.Expect(events).To(Equal([]string{
+		"Normal ReconcileStarted Reconciliation of generation 2 started",
+		"Normal ReconcileStarted Reconciliation of generation 3 started",
+		"Normal ReconcileSucceeded Reconciliation of generation 3 succeeded",
+		"Normal ReconcileStarted Reconciliation of generation 3 started",
+	}))
+}
+
+type noopLastOpErrRecorder struct{}
+
+func (noopLastOpErrRecorder) RecordStart(component.OperatorContext, *druidv1alpha1.Etcd, druidapicommon.LastOperationType) error {
+	return nil
+}
+
+func (noopLastOpErrRecorder) RecordSuccess(component.OperatorContext, *druidv1alpha1.Etcd, druidapicommon.LastOperationType) error {
+	return nil
+}
+
+func (noopLastOpErrRecorder) RecordErrors(component.OperatorContext, *druidv1alpha1.Etcd, druidapicommon.LastOperationType, ctrlutils.ReconcileStepResult) error {
+	return nil
+}
@@ -10,10 +10,17 @@ import (
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/component"
 	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/health/certificate"
+	"github.com/gardener/etcd-druid/internal/health/condition"
 	"github.com/gardener/etcd-druid/internal/health/status"
+	druidmetrics "github.com/gardener/etcd-druid/internal/metrics"
 	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
 
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,8 +37,12 @@ func (r *Reconciler) reconcileStatus(ctx component.OperatorContext, etcd *druidv
 	}
 	originalEtcd := etcd.DeepCopy()
 
+	// The certificates are inspected once for both the CertificatesValid condition and the certificate expiry metrics.
+	certificates := inspectCertificates(ctx, r.client, etcd)
 	var mutateETCDStatusStepFns = []mutateEtcdStatusFn{
-		r.mutateETCDStatusWithMemberStatusAndConditions,
+		func(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger) ctrlutils.ReconcileStepResult {
+			return r.mutateETCDStatusWithMemberStatusAndConditions(ctx, etcd, logger, certificates)
+		},
 		r.inspectStatefulSetAndMutateETCDStatus,
 		r.setSelector,
 		r.mutateBootstrapWithExistingClusterStatus,
@@ -46,11 +57,235 @@ func (r *Reconciler) reconcileStatus(ctx component.OperatorContext, etcd *druidv
 		sLog.Error(err, "failed to update etcd status")
 		return ctrlutils.ReconcileWithError(err)
 	}
+	r.recordBackupRPOStatus(originalEtcd, etcd)
+	r.recordEtcdStatusEvents(originalEtcd, etcd)
+	recordCertificateExpiryMetrics(etcd, certificates)
+	recordEtcdStatusMetrics(originalEtcd, etcd)
+	r.recordSnapshotMetrics(ctx, etcd)
 	return ctrlutils.ContinueReconcile()
 }
 
-func (r *Reconciler) mutateETCDStatusWithMemberStatusAndConditions(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger) ctrlutils.ReconcileStepResult {
-	statusCheck := status.NewChecker(r.client, r.config.EtcdMember.NotReadyThreshold.Duration, r.config.EtcdMember.UnknownThreshold.Duration)
+// recordEtcdStatusMetrics updates the condition and member metrics for the given Etcd, and counts a leader change if
+// the leader differs from the one in the original Etcd.
+func recordEtcdStatusMetrics(originalEtcd, etcd *druidv1alpha1.Etcd) {
+	labels := prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace, druidmetrics.LabelEtcdName: etcd.Name}
+	metricEtcdCondition.DeletePartialMatch(labels)
+	for _, cond := range etcd.Status.Conditions {
+		for _, status := range []druidv1alpha1.ConditionStatus{druidv1alpha1.ConditionTrue, druidv1alpha1.ConditionFalse, druidv1alpha1.ConditionUnknown} {
+			value := 0.0
+			if cond.Status == status {
+				value = 1
+			}
+			metricEtcdCondition.With(prometheus.Labels{
+				druidmetrics.LabelEtcdNamespace:   etcd.Namespace,
+				druidmetrics.LabelEtcdName:        etcd.Name,
+				druidmetrics.LabelConditionType:   string(cond.Type),
+				druidmetrics.LabelConditionStatus: string(status),
+			}).Set(value)
+		}
+	}
+
+	metricEtcdMembers.With(labels).Set(float64(etcd.Spec.Replicas))
+	readyMembers := 0
+	for _, member := range etcd.Status.Members {
+		if member.Status == druidv1alpha1.EtcdMemberStatusReady {
+			readyMembers++
+		}
+	}
+	metricEtcdMembersReady.With(labels).Set(float64(readyMembers))
+
+	// make sure that the counter is exported from the start, so that increases can be detected
+	leaderChanges := metricEtcdLeaderChangesTotal.With(labels)
+	if previousLeader, currentLeader := getLeaderName(originalEtcd), getLeaderName(etcd); previousLeader != "" && currentLeader != "" && previousLeader != currentLeader {
+		leaderChanges.Inc()
+	}
+}
+
+func getLeaderName(etcd *druidv1alpha1.Etcd) string {
+	for _, member := range etcd.Status.Members {
+		if member.Role != nil && *member.Role == druidv1alpha1.EtcdRoleLeader {
+			return member.Name
+		}
+	}
+	return ""
+}
+
+// recordEtcdStatusEvents emits events for the transitions of the member readiness, the leader, the snapshots and the
+// cluster ID mismatch between the original and the given Etcd.
+func (r *Reconciler) recordEtcdStatusEvents(originalEtcd, etcd *druidv1alpha1.Etcd) {
+	previousMembers := make(map[string]druidv1alpha1.EtcdMemberStatus, len(originalEtcd.Status.Members))
+	for _, member := range originalEtcd.Status.Members {
+		previousMembers[member.Name] = member
+	}
+	for _, member := range etcd.Status.Members {
+		previousMember, ok := previousMembers[member.Name]
+		if !ok || previousMember.Status == member.Status {
+			continue
+		}
+		if previousMember.Status == druidv1alpha1.EtcdMemberStatusReady {
+			r.recorder.Eventf(etcd, corev1.EventTypeWarning, druidv1alpha1.EventReasonMemberNotReady, "Member %s is %s: %s", member.Name, member.Status, member.Reason)
+		} else if member.Status == druidv1alpha1.EtcdMemberStatusReady {
+			r.recorder.Eventf(etcd, corev1.EventTypeNormal, druidv1alpha1.EventReasonMemberReady, "Member %s is ready", member.Name)
+		}
+	}
+
+	if previousLeader, currentLeader := getLeaderName(originalEtcd), getLeaderName(etcd); previousLeader != "" && currentLeader != "" && previousLeader != currentLeader {
+		r.recorder.Eventf(etcd, corev1.EventTypeNormal, druidv1alpha1.EventReasonLeaderChanged, "Leader changed from %s to %s", previousLeader, currentLeader)
+	}
+
+	if currentReason := getBackupReadyConditionReason(etcd); currentReason != getBackupReadyConditionReason(originalEtcd) && (currentReason == condition.BackupFailed || currentReason == condition.RPODegraded) {
+		r.recorder.Event(etcd, corev1.EventTypeWarning, druidv1alpha1.EventReasonSnapshotStale, getBackupReadyConditionMessage(etcd))
+	}
+
+	if clusterIDMismatch := getCondition(etcd, druidv1alpha1.ConditionTypeClusterIDMismatch); clusterIDMismatch != nil && clusterIDMismatch.Status == druidv1alpha1.ConditionTrue {
+		if previous := getCondition(originalEtcd, druidv1alpha1.ConditionTypeClusterIDMismatch); previous == nil || previous.Status != druidv1alpha1.ConditionTrue {
+			r.recorder.Event(etcd, corev1.EventTypeWarning, druidv1alpha1.EventReasonClusterIDMismatchDetected, clusterIDMismatch.Message)
+		}
+	}
+}
+
+// recordSnapshotMetrics updates the metrics for the latest full and delta snapshots of the given Etcd from its
+// snapshot leases.
+func (r *Reconciler) recordSnapshotMetrics(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) {
+	labels := prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace, druidmetrics.LabelEtcdName: etcd.Name}
+	if !etcd.IsBackupStoreEnabled() {
+		metricBackupLastFullSnapshotTimestamp.Delete(labels)
+		metricBackupLastDeltaSnapshotTimestamp.Delete(labels)
+		return
+	}
+	for leaseName, metric := range map[string]*prometheus.GaugeVec{
+		druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta):  metricBackupLastFullSnapshotTimestamp,
+		druidv1alpha1.GetDeltaSnapshotLeaseName(etcd.ObjectMeta): metricBackupLastDeltaSnapshotTimestamp,
+	} {
+		lease := &coordinationv1.Lease{}
+		if err := r.client.Get(ctx, client.ObjectKey{Name: leaseName, Namespace: etcd.Namespace}, lease); err != nil {
+			if !apierrors.IsNotFound(err) {
+				ctx.Logger.Error(err, "failed to get snapshot lease for metrics", "lease", leaseName)
+			}
+			metric.Delete(labels)
+			continue
+		}
+		if lease.Spec.RenewTime == nil {
+			metric.Delete(labels)
+			continue
+		}
+		metric.With(labels).Set(float64(lease.Spec.RenewTime.Unix()))
+	}
+}
+
+// deleteEtcdMetrics deletes the condition, member, leader, snapshot and component sync metrics for the given Etcd.
+func deleteEtcdMetrics(etcd *druidv1alpha1.Etcd) {
+	labels := prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace, druidmetrics.LabelEtcdName: etcd.Name}
+	metricEtcdCondition.DeletePartialMatch(labels)
+	metricEtcdMembers.Delete(labels)
+	metricEtcdMembersReady.Delete(labels)
+	metricEtcdLeaderChangesTotal.Delete(labels)
+	metricBackupLastFullSnapshotTimestamp.Delete(labels)
+	metricBackupLastDeltaSnapshotTimestamp.Delete(labels)
+	metricComponentSyncErrorsTotal.DeletePartialMatch(labels)
+}
+
+// recordBackupRPOStatus updates the recovery point objective metrics for the given Etcd, and emits an event whenever
+// the recovery point objectives are breached or met again.
+func (r *Reconciler) recordBackupRPOStatus(originalEtcd, etcd *druidv1alpha1.Etcd) {
+	labels := prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace, druidmetrics.LabelEtcdName: etcd.Name}
+	previousReason := getBackupReadyConditionReason(originalEtcd)
+	currentReason := getBackupReadyConditionReason(etcd)
+	switch currentReason {
+	case condition.RPOHealthy:
+		metricBackupRPOLevel.With(labels).Set(backupRPOLevelHealthy)
+	case condition.RPODegraded:
+		metricBackupRPOLevel.With(labels).Set(backupRPOLevelDegraded)
+	case condition.RPOBreached:
+		metricBackupRPOLevel.With(labels).Set(backupRPOLevelBreached)
+	default:
+		if etcd.Spec.Backup.RecoveryPointObjectives == nil {
+			metricBackupRPOLevel.Delete(labels)
+		}
+	}
+
+	if currentReason == previousReason {
+		return
+	}
+	if currentReason == condition.RPOBreached {
+		metricBackupRPOBreachesTotal.With(labels).Inc()
+		r.recorder.Event(etcd, corev1.EventTypeWarning, druidv1alpha1.EventReasonBackupRPOBreached, getBackupReadyConditionMessage(etcd))
+	} else if previousReason == condition.RPOBreached && (currentReason == condition.RPOHealthy || currentReason == condition.RPODegraded) {
+		r.recorder.Event(etcd, corev1.EventTypeNormal, druidv1alpha1.EventReasonBackupRPOMet, getBackupReadyConditionMessage(etcd))
+	}
+}
+
+// deleteBackupRPOMetrics deletes the recovery point objective metrics for the given Etcd.
+func deleteBackupRPOMetrics(etcd *druidv1alpha1.Etcd) {
+	labels := prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace, druidmetrics.LabelEtcdName: etcd.Name}
+	metricBackupRPOLevel.Delete(labels)
+	metricBackupRPOBreachesTotal.Delete(labels)
+}
+
+// inspectCertificates inspects the certificates referenced by the given Etcd. It returns nil if TLS is not configured.
+func inspectCertificates(ctx component.OperatorContext, cl client.Client, etcd *druidv1alpha1.Etcd) *certificate.Inspection {
+	if etcd.GetClientURLTLS() == nil && etcd.GetPeerURLTLS() == nil && etcd.GetBackupTLS() == nil {
+		return nil
+	}
+	infos, err := certificate.Inspect(ctx, cl, etcd)
+	if err != nil {
+		ctx.Logger.Error(err, "failed to inspect certificates")
+	}
+	return &certificate.Inspection{Infos: infos, Err: err}
+}
+
+// recordCertificateExpiryMetrics updates the certificate expiry metrics for the given Etcd from the given inspection of
+// its certificates. Certificates which cannot be inspected are left out, since the CertificatesValid condition already
+// reports them.
+func recordCertificateExpiryMetrics(etcd *druidv1alpha1.Etcd, certificates *certificate.Inspection) {
+	deleteCertificateExpiryMetrics(etcd)
+	if certificates == nil {
+		return
+	}
+	for _, info := range certificates.Infos {
+		metricTLSCertificateExpiryTimestamp.With(prometheus.Labels{
+			druidmetrics.LabelEtcdNamespace:    etcd.Namespace,
+			druidmetrics.LabelEtcdName:         etcd.Name,
+			druidmetrics.LabelSecretName:       info.SecretName,
+			druidmetrics.LabelCertificateUsage: string(info.Usage),
+		}).Set(float64(info.NotAfter.Unix()))
+	}
+}
+
+// deleteCertificateExpiryMetrics deletes the certificate expiry metrics for the given Etcd.
+func deleteCertificateExpiryMetrics(etcd *druidv1alpha1.Etcd) {
+	metricTLSCertificateExpiryTimestamp.DeletePartialMatch(prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace, druidmetrics.LabelEtcdName: etcd.Name})
+}
+
+func getBackupReadyConditionReason(etcd *druidv1alpha1.Etcd) string {
+	if backupReadyCondition := getBackupReadyCondition(etcd); backupReadyCondition != nil {
+		return backupReadyCondition.Reason
+	}
+	return ""
+}
+
+func getBackupReadyConditionMessage(etcd *druidv1alpha1.Etcd) string {
+	if backupReadyCondition := getBackupReadyCondition(etcd); backupReadyCondition != nil {
+		return backupReadyCondition.Message
+	}
+	return ""
+}
+
+func getBackupReadyCondition(etcd *druidv1alpha1.Etcd) *druidv1alpha1.Condition {
+	return getCondition(etcd, druidv1alpha1.ConditionTypeBackupReady)
+}
+
+func getCondition(etcd *druidv1alpha1.Etcd, conditionType druidv1alpha1.ConditionType) *druidv1alpha1.Condition {
+	for i := range etcd.Status.Conditions {
+		if etcd.Status.Conditions[i].Type == conditionType {
+			return &etcd.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) mutateETCDStatusWithMemberStatusAndConditions(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, logger logr.Logger, certificates *certificate.Inspection) ctrlutils.ReconcileStepResult {
+	statusCheck := status.NewChecker(r.client, r.config.EtcdMember.NotReadyThreshold.Duration, r.config.EtcdMember.UnknownThreshold.Duration, r.config.HealthChecks, r.memberHealthChecker, r.customCheckHTTPClients, certificates)
 	if err := statusCheck.Check(ctx, logger, etcd); err != nil {
 		logger.Error(err, "Error executing status checks to update member status and conditions")
 		return ctrlutils.ReconcileWithError(err)
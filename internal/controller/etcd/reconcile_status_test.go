@@ -5,14 +5,24 @@
 package etcd
 
 import (
+	"context"
+	"slices"
 	"testing"
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/component"
+	"github.com/gardener/etcd-druid/internal/health/condition"
+	druidmetrics "github.com/gardener/etcd-druid/internal/metrics"
+	testutils "github.com/gardener/etcd-druid/test/utils"
 
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	. "github.com/onsi/gomega"
 )
@@ -128,3 +138,283 @@ func TestMutateBootstrapWithExistingClusterStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordBackupRPOStatus(t *testing.T) {
+	backupReadyCondition := func(reason string) []druidv1alpha1.Condition {
+		return []druidv1alpha1.Condition{{Type: druidv1alpha1.ConditionTypeBackupReady, Reason: reason, Message: reason + " message"}}
+	}
+	tests := []struct {
+		name                   string
+		etcdName               string
+		previousReason         string
+		currentReason          string
+		expectedLevel          float64
+		expectedBreachesTotal  float64
+		expectedEventsPrefixes []string
+	}{
+		{
+			name:           "healthy backups without transition",
+			etcdName:       "etcd-healthy",
+			previousReason: condition.RPOHealthy,
+			currentReason:  condition.RPOHealthy,
+			expectedLevel:  backupRPOLevelHealthy,
+		},
+		{
+			name:           "transition from healthy to degraded",
+			etcdName:       "etcd-degraded",
+			previousReason: condition.RPOHealthy,
+			currentReason:  condition.RPODegraded,
+			expectedLevel:  backupRPOLevelDegraded,
+		},
+		{
+			name:                   "transition from degraded to breached",
+			etcdName:               "etcd-breached",
+			previousReason:         condition.RPODegraded,
+			currentReason:          condition.RPOBreached,
+			expectedLevel:          backupRPOLevelBreached,
+			expectedBreachesTotal:  1,
+			expectedEventsPrefixes: []string{"Warning BackupRPOBreached"},
+		},
+		{
+			name:                   "transition from breached to healthy",
+			etcdName:               "etcd-recovered",
+			previousReason:         condition.RPOBreached,
+			currentReason:          condition.RPOHealthy,
+			expectedLevel:          backupRPOLevelHealthy,
+			expectedEventsPrefixes: []string{"Normal BackupRPOMet"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{recorder: recorder}
+			originalEtcd := &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: tt.etcdName, Namespace: "test-ns"}}
+			originalEtcd.Spec.Backup.RecoveryPointObjectives = &druidv1alpha1.RecoveryPointObjectives{}
+			originalEtcd.Status.Conditions = backupReadyCondition(tt.previousReason)
+			etcd := originalEtcd.DeepCopy()
+			etcd.Status.Conditions = backupReadyCondition(tt.currentReason)
+
+			r.recordBackupRPOStatus(originalEtcd, etcd)
+
+			labels := prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace, druidmetrics.LabelEtcdName: etcd.Name}
+			g.Expect(testutil.ToFloat64(metricBackupRPOLevel.With(labels))).To(Equal(tt.expectedLevel))
+			g.Expect(testutil.ToFloat64(metricBackupRPOBreachesTotal.With(labels))).To(Equal(tt.expectedBreachesTotal))
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			g.Expect(events).To(HaveLen(len(tt.expectedEventsPrefixes)))
+			for i, prefix := range tt.expectedEventsPrefixes {
+				g.Expect(events[i]).To(HavePrefix(prefix))
+			}
+			deleteBackupRPOMetrics(etcd)
+		})
+	}
+}
+
+func TestRecordEtcdStatusEvents(t *testing.T) {
+	member := func(name string, status druidv1alpha1.EtcdMemberConditionStatus, role druidv1alpha1.EtcdRole) druidv1alpha1.EtcdMemberStatus {
+		return druidv1alpha1.EtcdMemberStatus{Name: name, Status: status, Role: ptr.To(role), Reason: "LeaseExpired"}
+	}
+	tests := []struct {
+		name                   string
+		previousStatus         druidv1alpha1.EtcdStatus
+		currentStatus          druidv1alpha1.EtcdStatus
+		expectedEventsPrefixes []string
+	}{
+		{
+			name: "no transitions",
+			previousStatus: druidv1alpha1.EtcdStatus{Members: []druidv1alpha1.EtcdMemberStatus{
+				member("etcd-0", druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdRoleLeader),
+			}},
+			currentStatus: druidv1alpha1.EtcdStatus{Members: []druidv1alpha1.EtcdMemberStatus{
+				member("etcd-0", druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdRoleLeader),
+			}},
+		},
+		{
+			name: "member became not ready and leader changed",
+			previousStatus: druidv1alpha1.EtcdStatus{Members: []druidv1alpha1.EtcdMemberStatus{
+				member("etcd-0", druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdRoleLeader),
+				member("etcd-1", druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdRoleMember),
+			}},
+			currentStatus: druidv1alpha1.EtcdStatus{Members: []druidv1alpha1.EtcdMemberStatus{
+				member("etcd-0", druidv1alpha1.EtcdMemberStatusNotReady, druidv1alpha1.EtcdRoleMember),
+				member("etcd-1", druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdRoleLeader),
+			}},
+			expectedEventsPrefixes: []string{"Warning MemberNotReady Member etcd-0 is NotReady", "Normal LeaderChanged Leader changed from etcd-0 to etcd-1"},
+		},
+		{
+			name: "member became ready",
+			previousStatus: druidv1alpha1.EtcdStatus{Members: []druidv1alpha1.EtcdMemberStatus{
+				member("etcd-0", druidv1alpha1.EtcdMemberStatusUnknown, druidv1alpha1.EtcdRoleMember),
+			}},
+			currentStatus: druidv1alpha1.EtcdStatus{Members: []druidv1alpha1.EtcdMemberStatus{
+				member("etcd-0", druidv1alpha1.EtcdMemberStatusReady, druidv1alpha1.EtcdRoleMember),
+			}},
+			expectedEventsPrefixes: []string{"Normal MemberReady Member etcd-0 is ready"},
+		},
+		{
+			name: "snapshots became stale",
+			previousStatus: druidv1alpha1.EtcdStatus{Conditions: []druidv1alpha1.Condition{
+				{Type: druidv1alpha1.ConditionTypeBackupReady, Reason: condition.RPOHealthy},
+			}},
+			currentStatus: druidv1alpha1.EtcdStatus{Conditions: []druidv1alpha1.Condition{
+				{Type: druidv1alpha1.ConditionTypeBackupReady, Reason: condition.RPODegraded, Message: "delta snapshot is overdue"},
+			}},
+			expectedEventsPrefixes: []string{"Warning SnapshotStale delta snapshot is overdue"},
+		},
+		{
+			name: "cluster ID mismatch detected",
+			previousStatus: druidv1alpha1.EtcdStatus{Conditions: []druidv1alpha1.Condition{
+				{Type: druidv1alpha1.ConditionTypeClusterIDMismatch, Status: druidv1alpha1.ConditionFalse},
+			}},
+			currentStatus: druidv1alpha1.EtcdStatus{Conditions: []druidv1alpha1.Condition{
+				{Type: druidv1alpha1.ConditionTypeClusterIDMismatch, Status: druidv1alpha1.ConditionTrue, Message: "cluster ID mismatch"},
+			}},
+			expectedEventsPrefixes: []string{"Warning ClusterIDMismatchDetected cluster ID mismatch"},
+		},
+		{
+			name: "cluster ID mismatch persists",
+			previousStatus: druidv1alpha1.EtcdStatus{Conditions: []druidv1alpha1.Condition{
+				{Type: druidv1alpha1.ConditionTypeClusterIDMismatch, Status: druidv1alpha1.ConditionTrue},
+			}},
+			currentStatus: druidv1alpha1.EtcdStatus{Conditions: []druidv1alpha1.Condition{
+				{Type: druidv1alpha1.ConditionTypeClusterIDMismatch, Status: druidv1alpha1.ConditionTrue},
+			}},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{recorder: recorder}
+			originalEtcd := &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "test-etcd", Namespace: "test-ns"}, Status: tt.previousStatus}
+			etcd := originalEtcd.DeepCopy()
+			etcd.Status = tt.currentStatus
+
+			r.recordEtcdStatusEvents(originalEtcd, etcd)
+
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			g.Expect(events).To(HaveLen(len(tt.expectedEventsPrefixes)))
+			for i, prefix := range tt.expectedEventsPrefixes {
+				g.Expect(events[i]).To(HavePrefix(prefix))
+			}
+		})
+	}
+}
+
+func TestRecordEtcdStatusMetrics(t *testing.T) {
+	members := func(leader string, ready ...string) []druidv1alpha1.EtcdMemberStatus {
+		var result []druidv1alpha1.EtcdMemberStatus
+		for _, name := range []string{"etcd-0", "etcd-1", "etcd-2"} {
+			member := druidv1alpha1.EtcdMemberStatus{Name: name, Status: druidv1alpha1.EtcdMemberStatusNotReady, Role: ptr.To(druidv1alpha1.EtcdRoleMember)}
+			if slices.Contains(ready, name) {
+				member.Status = druidv1alpha1.EtcdMemberStatusReady
+			}
+			if name == leader {
+				member.Role = ptr.To(druidv1alpha1.EtcdRoleLeader)
+			}
+			result = append(result, member)
+		}
+		return result
+	}
+	tests := []struct {
+		name                       string
+		etcdName                   string
+		previousMembers            []druidv1alpha1.EtcdMemberStatus
+		currentMembers             []druidv1alpha1.EtcdMemberStatus
+		expectedMembersReady       float64
+		expectedLeaderChangesTotal float64
+	}{
+		{
+			name:                 "all members ready without leader change",
+			etcdName:             "etcd-stable",
+			previousMembers:      members("etcd-0", "etcd-0", "etcd-1", "etcd-2"),
+			currentMembers:       members("etcd-0", "etcd-0", "etcd-1", "etcd-2"),
+			expectedMembersReady: 3,
+		},
+		{
+			name:                       "leader changed",
+			etcdName:                   "etcd-leader-changed",
+			previousMembers:            members("etcd-0", "etcd-0", "etcd-1", "etcd-2"),
+			currentMembers:             members("etcd-1", "etcd-1", "etcd-2"),
+			expectedMembersReady:       2,
+			expectedLeaderChangesTotal: 1,
+		},
+		{
+			name:                 "leader lost",
+			etcdName:             "etcd-leader-lost",
+			previousMembers:      members("etcd-0", "etcd-0", "etcd-1"),
+			currentMembers:       members("", "etcd-0"),
+			expectedMembersReady: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			originalEtcd := &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: tt.etcdName, Namespace: "test-ns"}}
+			originalEtcd.Spec.Replicas = 3
+			originalEtcd.Status.Members = tt.previousMembers
+			etcd := originalEtcd.DeepCopy()
+			etcd.Status.Members = tt.currentMembers
+			etcd.Status.Conditions = []druidv1alpha1.Condition{{Type: druidv1alpha1.ConditionTypeReady, Status: druidv1alpha1.ConditionFalse}}
+
+			recordEtcdStatusMetrics(originalEtcd, etcd)
+
+			labels := prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace, druidmetrics.LabelEtcdName: etcd.Name}
+			g.Expect(testutil.ToFloat64(metricEtcdMembers.With(labels))).To(Equal(float64(3)))
+			g.Expect(testutil.ToFloat64(metricEtcdMembersReady.With(labels))).To(Equal(tt.expectedMembersReady))
+			g.Expect(testutil.ToFloat64(metricEtcdLeaderChangesTotal.With(labels))).To(Equal(tt.expectedLeaderChangesTotal))
+			for status, expected := range map[druidv1alpha1.ConditionStatus]float64{
+				druidv1alpha1.ConditionTrue:    0,
+				druidv1alpha1.ConditionFalse:   1,
+				druidv1alpha1.ConditionUnknown: 0,
+			} {
+				g.Expect(testutil.ToFloat64(metricEtcdCondition.With(prometheus.Labels{
+					druidmetrics.LabelEtcdNamespace:   etcd.Namespace,
+					druidmetrics.LabelEtcdName:        etcd.Name,
+					druidmetrics.LabelConditionType:   string(druidv1alpha1.ConditionTypeReady),
+					druidmetrics.LabelConditionStatus: string(status),
+				}))).To(Equal(expected))
+			}
+
+			deleteEtcdMetrics(etcd)
+			g.Expect(metricEtcdCondition.DeletePartialMatch(labels)).To(BeZero())
+			g.Expect(metricEtcdMembersReady.Delete(labels)).To(BeFalse())
+		})
+	}
+}
+
+func TestRecordSnapshotMetrics(t *testing.T) {
+	g := NewWithT(t)
+	etcd := testutils.EtcdBuilderWithDefaults("etcd-snapshots", "test-ns").WithProviderLocal("etcd-snapshots").Build()
+	renewTime := metav1.NewMicroTime(time.Now().Truncate(time.Second))
+	fullSnapshotLease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: druidv1alpha1.GetFullSnapshotLeaseName(etcd.ObjectMeta), Namespace: etcd.Namespace},
+		Spec:       coordinationv1.LeaseSpec{RenewTime: &renewTime},
+	}
+	deltaSnapshotLease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: druidv1alpha1.GetDeltaSnapshotLeaseName(etcd.ObjectMeta), Namespace: etcd.Namespace},
+	}
+	cl := testutils.NewTestClientBuilder().WithObjects(fullSnapshotLease, deltaSnapshotLease).Build()
+	r := &Reconciler{client: cl}
+
+	r.recordSnapshotMetrics(component.NewOperatorContext(context.Background(), logr.Discard(), "test"), etcd)
+
+	labels := prometheus.Labels{druidmetrics.LabelEtcdNamespace: etcd.Namespace, druidmetrics.LabelEtcdName: etcd.Name}
+	g.Expect(testutil.ToFloat64(metricBackupLastFullSnapshotTimestamp.With(labels))).To(Equal(float64(renewTime.Unix())))
+	// the delta snapshot lease has not been renewed yet
+	g.Expect(metricBackupLastDeltaSnapshotTimestamp.Delete(labels)).To(BeFalse())
+	deleteEtcdMetrics(etcd)
+}
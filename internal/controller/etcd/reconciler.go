@@ -6,12 +6,15 @@ package etcd
 
 import (
 	"context"
+	"sync"
 
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/component"
 	"github.com/gardener/etcd-druid/internal/component/clientservice"
 	"github.com/gardener/etcd-druid/internal/component/configmap"
+	"github.com/gardener/etcd-druid/internal/component/etcdauth"
+	"github.com/gardener/etcd-druid/internal/component/managedcertificates"
 	"github.com/gardener/etcd-druid/internal/component/memberlease"
 	"github.com/gardener/etcd-druid/internal/component/peerservice"
 	"github.com/gardener/etcd-druid/internal/component/poddistruptionbudget"
@@ -21,11 +24,15 @@ import (
 	"github.com/gardener/etcd-druid/internal/component/snapshotlease"
 	"github.com/gardener/etcd-druid/internal/component/statefulset"
 	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/health/condition"
+	"github.com/gardener/etcd-druid/internal/health/etcdmember"
 	"github.com/gardener/etcd-druid/internal/images"
+	"github.com/gardener/etcd-druid/internal/tracing"
 	"github.com/gardener/etcd-druid/internal/utils/imagevector"
 
 	"github.com/go-logr/logr"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -42,22 +49,32 @@ type Reconciler struct {
 	operatorRegistry  component.Registry
 	lastOpErrRecorder ctrlutils.LastOperationAndLastErrorsRecorder
 	logger            logr.Logger
+	// copyBackupsTaskControllerEnabled is true if the EtcdCopyBackupsTask controller, which copies the backups to restore
+	// new etcd clusters from, is enabled.
+	copyBackupsTaskControllerEnabled bool
+	// memberHealthChecker checks the health of the etcd members, and keeps the state required for it between reconciliations.
+	memberHealthChecker *etcdmember.HealthChecker
+	// customCheckHTTPClients are the HTTP clients of the custom health checks, which are reused between reconciliations.
+	customCheckHTTPClients *condition.CustomCheckHTTPClients
+	// reconcileStartedGenerations holds the generation of each Etcd whose reconciliation has been started but has not
+	// yet succeeded, so that the start is only reported once per generation.
+	reconcileStartedGenerations sync.Map
 }
 
 // NewReconciler creates a new reconciler for Etcd.
-func NewReconciler(mgr manager.Manager, config druidconfigv1alpha1.EtcdControllerConfiguration) (*Reconciler, error) {
+func NewReconciler(mgr manager.Manager, config druidconfigv1alpha1.EtcdControllerConfiguration, copyBackupsTaskControllerEnabled bool) (*Reconciler, error) {
 	imageVector, err := images.CreateImageVector()
 	if err != nil {
 		return nil, err
 	}
-	return NewReconcilerWithImageVector(mgr, ControllerName, config, imageVector)
+	return NewReconcilerWithImageVector(mgr, ControllerName, config, copyBackupsTaskControllerEnabled, imageVector)
 }
 
 // NewReconcilerWithImageVector creates a new reconciler for Etcd with the given image vector.
-func NewReconcilerWithImageVector(mgr manager.Manager, controllerName string, config druidconfigv1alpha1.EtcdControllerConfiguration, iv imagevector.ImageVector) (*Reconciler, error) {
+func NewReconcilerWithImageVector(mgr manager.Manager, controllerName string, config druidconfigv1alpha1.EtcdControllerConfiguration, copyBackupsTaskControllerEnabled bool, iv imagevector.ImageVector) (*Reconciler, error) {
 	logger := log.Log.WithName(controllerName)
-	operatorReg := createAndInitializeOperatorRegistry(mgr.GetClient(), config, iv)
-	lastOpErrRecorder := ctrlutils.NewLastOperationAndLastErrorsRecorder(mgr.GetClient(), logger)
+	operatorReg := createAndInitializeOperatorRegistry(newChangeDetectingClient(mgr.GetClient()), config, iv)
+	lastOpErrRecorder := ctrlutils.NewLastOperationAndLastErrorsRecorder(mgr.GetClient(), logger, ptr.Deref(config.OperationHistoryLimit, 0))
 	return &Reconciler{
 		client:            mgr.GetClient(),
 		config:            config,
@@ -66,6 +83,10 @@ func NewReconcilerWithImageVector(mgr manager.Manager, controllerName string, co
 		logger:            logger,
 		operatorRegistry:  operatorReg,
 		lastOpErrRecorder: lastOpErrRecorder,
+
+		copyBackupsTaskControllerEnabled: copyBackupsTaskControllerEnabled,
+		memberHealthChecker:              etcdmember.NewHealthChecker(),
+		customCheckHTTPClients:           condition.NewCustomCheckHTTPClients(),
 	}, nil
 }
 
@@ -73,9 +94,11 @@ type reconcileFn func(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) c
 
 // +kubebuilder:rbac:groups=druid.gardener.cloud,resources=etcds,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=druid.gardener.cloud,resources=etcds/status,verbs=get;create;update;patch
+// +kubebuilder:rbac:groups=druid.gardener.cloud,resources=etcdcopybackupstasks,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=serviceaccounts;services;configmaps,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;create;update;patch;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;create;update;patch;delete
@@ -94,8 +117,14 @@ type reconcileFn func(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) c
 //     as well as status fields derived from spec reconciliation.
 //  4. Remove operation-reconcile annotation if it was set and if spec reconciliation had succeeded.
 //  5. Scheduled Requeue: Requeue the reconciliation request after a defined period (EtcdStatusSyncPeriod) to maintain sync.
-func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, err error) {
 	runID := string(controller.ReconcileIDFromContext(ctx))
+	ctx, span := tracing.StartSpan(ctx, "ReconcileEtcd",
+		tracing.AttributeEtcdNamespace.String(req.Namespace),
+		tracing.AttributeEtcdName.String(req.Name),
+		tracing.AttributeRunID.String(runID),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
 	operatorCtx := component.NewOperatorContext(ctx, r.logger, runID)
 
 	etcd := &druidv1alpha1.Etcd{}
@@ -103,7 +132,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return result.ReconcileResult()
 	}
 
-	if result := r.reconcileEtcdDeletion(operatorCtx, etcd); ctrlutils.ShortCircuitReconcileFlow(result) {
+	if result := traceStep(operatorCtx, etcd, r.reconcileEtcdDeletion); ctrlutils.ShortCircuitReconcileFlow(result) {
 		return result.ReconcileResult()
 	}
 
@@ -111,10 +140,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	var reconcileSpecResult ctrlutils.ReconcileStepResult
 	if shouldReconcileSpec {
-		reconcileSpecResult = r.reconcileSpec(operatorCtx, etcd)
+		reconcileSpecResult = traceStep(operatorCtx, etcd, r.reconcileSpec)
 	}
 
-	if result := r.reconcileStatus(operatorCtx, etcd); ctrlutils.ShortCircuitReconcileFlow(result) {
+	if result := traceStep(operatorCtx, etcd, r.reconcileStatus); ctrlutils.ShortCircuitReconcileFlow(result) {
 		r.logger.Error(result.GetCombinedError(), "Failed to reconcile status")
 		return result.ReconcileResult()
 	}
@@ -134,7 +163,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// if any failure is encountered during reconciliation, then reconciliation is re-attempted upon the next requeue.
 	// r.completeReconcile() is executed only if the spec was reconciled, as denoted by the `shouldReconcileSpec` flag.
 	if shouldReconcileSpec {
-		if result := r.completeReconcile(operatorCtx, etcd); ctrlutils.ShortCircuitReconcileFlow(result) {
+		if result := traceStep(operatorCtx, etcd, r.completeReconcile); ctrlutils.ShortCircuitReconcileFlow(result) {
 			return result.ReconcileResult()
 		}
 	}
@@ -157,8 +186,10 @@ func createAndInitializeOperatorRegistry(client client.Client, config druidconfi
 	reg.Register(component.PodDisruptionBudgetKind, poddistruptionbudget.New(client))
 	reg.Register(component.ClientServiceKind, clientservice.New(client))
 	reg.Register(component.PeerServiceKind, peerservice.New(client))
+	reg.Register(component.ManagedCertificatesKind, managedcertificates.New(client))
 	reg.Register(component.ConfigMapKind, configmap.New(client))
 	reg.Register(component.StatefulSetKind, statefulset.New(client, imageVector))
+	reg.Register(component.EtcdAuthKind, etcdauth.New(client))
 	return reg
 }
 
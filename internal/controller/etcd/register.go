@@ -5,14 +5,23 @@
 package etcd
 
 import (
+	"context"
+	"reflect"
+	"slices"
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -22,17 +31,60 @@ const ControllerName = "etcd-controller"
 
 // RegisterWithManager registers the Etcd Controller with the given controller manager.
 func (r *Reconciler) RegisterWithManager(mgr ctrl.Manager, controllerName string) error {
-	builder := ctrl.
+	controllerBuilder := ctrl.
 		NewControllerManagedBy(mgr).
 		Named(controllerName).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: *r.config.ConcurrentSyncs,
 			RateLimiter:             workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](10*time.Millisecond, r.config.EtcdStatusSyncPeriod.Duration),
 		}).
-		For(&druidv1alpha1.Etcd{}).
-		WithEventFilter(r.buildPredicate())
+		For(&druidv1alpha1.Etcd{}, builder.WithPredicates(r.buildPredicate())).
+		// Changes to the data of referenced secrets are rolled out to the etcd StatefulSet via the
+		// referenced secrets checksum, see the statefulset component.
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.mapSecretToEtcds),
+			builder.WithPredicates(secretDataChanged()),
+		)
+
+	return controllerBuilder.Complete(r)
+}
+
+// mapSecretToEtcds maps a Secret to all Etcd resources in the same namespace which reference it.
+func (r *Reconciler) mapSecretToEtcds(ctx context.Context, obj client.Object) []reconcile.Request {
+	etcdList := &druidv1alpha1.EtcdList{}
+	if err := r.client.List(ctx, etcdList, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.logger.Error(err, "failed to list Etcd resources referencing secret", "namespace", obj.GetNamespace(), "name", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, etcd := range etcdList.Items {
+		if slices.Contains(druidresources.GetReferencedSecretNames(&etcd), obj.GetName()) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: etcd.Namespace, Name: etcd.Name}})
+		}
+	}
+	return requests
+}
 
-	return builder.Complete(r)
+// secretDataChanged returns a predicate that only allows update events of secrets whose data has changed.
+// Creation and deletion of a referenced secret is already handled by the respective Etcd reconciliation.
+func secretDataChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			oldSecret, ok := updateEvent.ObjectOld.(*corev1.Secret)
+			if !ok {
+				return false
+			}
+			newSecret, ok := updateEvent.ObjectNew.(*corev1.Secret)
+			if !ok {
+				return false
+			}
+			return !reflect.DeepEqual(oldSecret.Data, newSecret.Data)
+		},
+		CreateFunc:  func(_ event.CreateEvent) bool { return false },
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
 }
 
 // buildPredicate returns a predicate that filters events that are relevant for the Etcd controller.
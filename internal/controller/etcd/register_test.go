@@ -5,17 +5,24 @@
 package etcd
 
 import (
+	"context"
 	"testing"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
 	mockmanager "github.com/gardener/etcd-druid/internal/mock/controller-runtime/manager"
 	testutils "github.com/gardener/etcd-druid/test/utils"
 
+	"github.com/go-logr/logr"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	. "github.com/onsi/gomega"
 )
@@ -423,7 +430,45 @@ func createReconciler(t *testing.T, enableEtcdSpecAutoReconcile bool) *Reconcile
 	etcdConfig := druidconfigv1alpha1.EtcdControllerConfiguration{
 		EnableEtcdSpecAutoReconcile: enableEtcdSpecAutoReconcile,
 	}
-	r, err := NewReconcilerWithImageVector(mgr, ControllerName, etcdConfig, nil)
+	r, err := NewReconcilerWithImageVector(mgr, ControllerName, etcdConfig, true, nil)
 	g.Expect(err).NotTo(HaveOccurred())
 	return r
 }
+
+func TestMapSecretToEtcds(t *testing.T) {
+	g := NewWithT(t)
+	etcdWithTLS := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithClientTLS().Build()
+	etcdWithoutTLS := testutils.EtcdBuilderWithDefaults("etcd-without-tls", testutils.TestNamespace).Build()
+	etcdInOtherNamespace := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, "other-namespace").WithClientTLS().Build()
+	cl := testutils.CreateTestFakeClientWithSchemeForObjects(kubernetes.Scheme, nil, nil, nil, nil, []client.Object{etcdWithTLS, etcdWithoutTLS, etcdInOtherNamespace})
+	r := &Reconciler{client: cl, logger: logr.Discard()}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: testutils.ClientTLSCASecretName, Namespace: testutils.TestNamespace}}
+	g.Expect(r.mapSecretToEtcds(context.Background(), secret)).To(ConsistOf(
+		reconcile.Request{NamespacedName: client.ObjectKeyFromObject(etcdWithTLS)},
+	))
+
+	unreferencedSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unreferenced", Namespace: testutils.TestNamespace}}
+	g.Expect(r.mapSecretToEtcds(context.Background(), unreferencedSecret)).To(BeEmpty())
+}
+
+func TestSecretDataChangedPredicate(t *testing.T) {
+	g := NewWithT(t)
+	p := secretDataChanged()
+	oldSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: testutils.ClientTLSCASecretName, Namespace: testutils.TestNamespace},
+		Data:       map[string][]byte{"ca.crt": []byte("old")},
+	}
+
+	labelsChanged := oldSecret.DeepCopy()
+	labelsChanged.Labels = map[string]string{"foo": "bar"}
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: oldSecret, ObjectNew: labelsChanged})).To(BeFalse())
+
+	dataChanged := oldSecret.DeepCopy()
+	dataChanged.Data["ca.crt"] = []byte("new")
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: oldSecret, ObjectNew: dataChanged})).To(BeTrue())
+
+	g.Expect(p.Create(event.CreateEvent{Object: oldSecret})).To(BeFalse())
+	g.Expect(p.Delete(event.DeleteEvent{Object: oldSecret})).To(BeFalse())
+	g.Expect(p.Generic(event.GenericEvent{Object: oldSecret})).To(BeFalse())
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"fmt"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	druiderr "github.com/gardener/etcd-druid/internal/errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ErrGetRestoreFromCopyBackupsTask indicates an error in getting the EtcdCopyBackupsTask which copies the backups to restore from.
+	ErrGetRestoreFromCopyBackupsTask druidapicommon.ErrorCode = "ERR_GET_RESTORE_FROM_COPY_BACKUPS_TASK"
+	// ErrCreateRestoreFromCopyBackupsTask indicates an error in creating the EtcdCopyBackupsTask which copies the backups to restore from.
+	ErrCreateRestoreFromCopyBackupsTask druidapicommon.ErrorCode = "ERR_CREATE_RESTORE_FROM_COPY_BACKUPS_TASK"
+	// ErrDeleteRestoreFromCopyBackupsTask indicates an error in deleting the EtcdCopyBackupsTask which copies the backups to restore from.
+	ErrDeleteRestoreFromCopyBackupsTask druidapicommon.ErrorCode = "ERR_DELETE_RESTORE_FROM_COPY_BACKUPS_TASK"
+	// ErrRestoreFromCopyBackupsTaskFailed indicates that the EtcdCopyBackupsTask which copies the backups to restore from has failed.
+	ErrRestoreFromCopyBackupsTaskFailed druidapicommon.ErrorCode = "ERR_RESTORE_FROM_COPY_BACKUPS_TASK_FAILED"
+	// ErrRestoreFromCopyBackupsTaskControllerDisabled indicates that the backups to restore from cannot be copied as the
+	// EtcdCopyBackupsTask controller is disabled.
+	ErrRestoreFromCopyBackupsTaskControllerDisabled druidapicommon.ErrorCode = "ERR_RESTORE_FROM_COPY_BACKUPS_TASK_CONTROLLER_DISABLED"
+)
+
+// copyBackupsToRestoreFrom copies the backups referenced by etcd.spec.backup.restoreFrom into the backup store of a
+// new Etcd before any of its members is started, so that backup-restore bootstraps the new etcd cluster from these backups.
+// The copy is performed by an EtcdCopyBackupsTask owned by the Etcd, which is recreated if it fails. Once the Etcd has
+// been reconciled successfully the task is deleted, as etcd.spec.backup.restoreFrom is only considered when the Etcd is created.
+func (r *Reconciler) copyBackupsToRestoreFrom(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd) ctrlutils.ReconcileStepResult {
+	if etcd.Spec.Backup.RestoreFrom == nil || !etcd.IsBackupStoreEnabled() {
+		return ctrlutils.ContinueReconcile()
+	}
+
+	task := &druidv1alpha1.EtcdCopyBackupsTask{}
+	taskKey := client.ObjectKey{Namespace: etcd.Namespace, Name: druidv1alpha1.GetRestoreFromCopyBackupsTaskName(etcd.ObjectMeta)}
+	if etcd.Status.ObservedGeneration != nil {
+		return r.deleteRestoreFromCopyBackupsTask(ctx, taskKey)
+	}
+	if !r.copyBackupsTaskControllerEnabled {
+		return ctrlutils.ReconcileWithError(druiderr.New(
+			ErrRestoreFromCopyBackupsTaskControllerDisabled,
+			"copyBackupsToRestoreFrom",
+			"etcd.spec.backup.restoreFrom requires the EtcdCopyBackupsTask controller to be enabled"))
+	}
+	if err := r.client.Get(ctx, taskKey, task); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrlutils.ReconcileWithError(druiderr.WrapError(err,
+				ErrGetRestoreFromCopyBackupsTask,
+				"copyBackupsToRestoreFrom",
+				fmt.Sprintf("failed to get EtcdCopyBackupsTask %s", taskKey.Name)))
+		}
+		task = newRestoreFromCopyBackupsTask(etcd, taskKey)
+		ctx.Logger.Info("Creating EtcdCopyBackupsTask to copy the backups to restore from", "task", taskKey)
+		if err = r.client.Create(ctx, task); err != nil {
+			return ctrlutils.ReconcileWithError(druiderr.WrapError(err,
+				ErrCreateRestoreFromCopyBackupsTask,
+				"copyBackupsToRestoreFrom",
+				fmt.Sprintf("failed to create EtcdCopyBackupsTask %s", taskKey.Name)))
+		}
+		return ctrlutils.ReconcileAfter(syncRetryInterval, fmt.Sprintf("waiting for EtcdCopyBackupsTask %s to copy the backups to restore from", taskKey.Name))
+	}
+
+	if condition := getCopyBackupsTaskCondition(task, druidv1alpha1.EtcdCopyBackupsTaskFailed); condition != nil && condition.Status == druidv1alpha1.ConditionTrue {
+		// The failed task is deleted, so that it is recreated by the next reconciliation to retry the copy.
+		ctx.Logger.Info("Deleting failed EtcdCopyBackupsTask to retry copying the backups to restore from", "task", taskKey, "message", condition.Message)
+		if err := r.client.Delete(ctx, task, client.Preconditions{UID: &task.UID}); client.IgnoreNotFound(err) != nil {
+			return ctrlutils.ReconcileWithError(druiderr.WrapError(err,
+				ErrDeleteRestoreFromCopyBackupsTask,
+				"copyBackupsToRestoreFrom",
+				fmt.Sprintf("failed to delete failed EtcdCopyBackupsTask %s", taskKey.Name)))
+		}
+		return ctrlutils.ReconcileWithError(druiderr.New(
+			ErrRestoreFromCopyBackupsTaskFailed,
+			"copyBackupsToRestoreFrom",
+			fmt.Sprintf("EtcdCopyBackupsTask %s failed to copy the backups to restore from and will be recreated: %s", taskKey.Name, condition.Message)))
+	}
+	if condition := getCopyBackupsTaskCondition(task, druidv1alpha1.EtcdCopyBackupsTaskSucceeded); condition == nil || condition.Status != druidv1alpha1.ConditionTrue {
+		ctx.Logger.Info("Waiting for EtcdCopyBackupsTask to copy the backups to restore from", "task", taskKey, "syncRetryInterval", syncRetryInterval.String())
+		return ctrlutils.ReconcileAfter(syncRetryInterval, fmt.Sprintf("waiting for EtcdCopyBackupsTask %s to copy the backups to restore from", taskKey.Name))
+	}
+	return ctrlutils.ContinueReconcile()
+}
+
+// deleteRestoreFromCopyBackupsTask deletes the EtcdCopyBackupsTask which has copied the backups to restore from, if it still exists.
+func (r *Reconciler) deleteRestoreFromCopyBackupsTask(ctx component.OperatorContext, taskKey client.ObjectKey) ctrlutils.ReconcileStepResult {
+	task := &druidv1alpha1.EtcdCopyBackupsTask{}
+	if err := r.client.Get(ctx, taskKey, task); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrlutils.ContinueReconcile()
+		}
+		return ctrlutils.ReconcileWithError(druiderr.WrapError(err,
+			ErrGetRestoreFromCopyBackupsTask,
+			"deleteRestoreFromCopyBackupsTask",
+			fmt.Sprintf("failed to get EtcdCopyBackupsTask %s", taskKey.Name)))
+	}
+	ctx.Logger.Info("Deleting EtcdCopyBackupsTask which has copied the backups to restore from", "task", taskKey)
+	if err := r.client.Delete(ctx, task); client.IgnoreNotFound(err) != nil {
+		return ctrlutils.ReconcileWithError(druiderr.WrapError(err,
+			ErrDeleteRestoreFromCopyBackupsTask,
+			"deleteRestoreFromCopyBackupsTask",
+			fmt.Sprintf("failed to delete EtcdCopyBackupsTask %s", taskKey.Name)))
+	}
+	return ctrlutils.ContinueReconcile()
+}
+
+// newRestoreFromCopyBackupsTask constructs the EtcdCopyBackupsTask which copies the backups to restore from into the backup store of the Etcd.
+// Only the backups up to the revision or timestamp to restore to are copied, so that the etcd cluster is restored to that point in time.
+func newRestoreFromCopyBackupsTask(etcd *druidv1alpha1.Etcd, taskKey client.ObjectKey) *druidv1alpha1.EtcdCopyBackupsTask {
+	return &druidv1alpha1.EtcdCopyBackupsTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            taskKey.Name,
+			Namespace:       taskKey.Namespace,
+			Labels:          druidv1alpha1.GetDefaultLabels(etcd.ObjectMeta),
+			OwnerReferences: []metav1.OwnerReference{druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)},
+		},
+		Spec: druidv1alpha1.EtcdCopyBackupsTaskSpec{
+			SourceStore:  *etcd.Spec.Backup.RestoreFrom.Store.DeepCopy(),
+			TargetStore:  *etcd.Spec.Backup.Store.DeepCopy(),
+			MaxRevision:  etcd.Spec.Backup.RestoreFrom.Revision,
+			MaxTimestamp: etcd.Spec.Backup.RestoreFrom.Timestamp,
+		},
+	}
+}
+
+// getCopyBackupsTaskCondition returns the condition of the given type from the status of the EtcdCopyBackupsTask, if present.
+func getCopyBackupsTaskCondition(task *druidv1alpha1.EtcdCopyBackupsTask, conditionType druidv1alpha1.ConditionType) *druidv1alpha1.Condition {
+	for i := range task.Status.Conditions {
+		if task.Status.Conditions[i].Type == conditionType {
+			return &task.Status.Conditions[i]
+		}
+	}
+	return nil
+}
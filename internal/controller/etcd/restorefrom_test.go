@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	"github.com/gardener/etcd-druid/internal/component"
+	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCopyBackupsToRestoreFrom(t *testing.T) {
+	restoreFrom := &druidv1alpha1.RestoreFromSpec{
+		Store: druidv1alpha1.StoreSpec{
+			Container: ptr.To("source-container"),
+			Prefix:    "source-etcd",
+			Provider:  ptr.To[druidv1alpha1.StorageProvider]("aws"),
+			SecretRef: &corev1.SecretReference{Name: "source-backup-secret"},
+		},
+	}
+	restoreFromRevision := restoreFrom.DeepCopy()
+	restoreFromRevision.Revision = ptr.To[int64](42)
+	restoreFromTimestamp := restoreFrom.DeepCopy()
+	restoreFromTimestamp.Timestamp = &metav1.Time{Time: time.Date(2026, 10, 1, 6, 30, 0, 0, time.UTC)}
+	newTask := func(etcd *druidv1alpha1.Etcd, conditions ...druidv1alpha1.Condition) *druidv1alpha1.EtcdCopyBackupsTask {
+		task := newRestoreFromCopyBackupsTask(etcd, client.ObjectKey{Namespace: etcd.Namespace, Name: druidv1alpha1.GetRestoreFromCopyBackupsTaskName(etcd.ObjectMeta)})
+		task.Status.Conditions = conditions
+		return task
+	}
+	tests := []struct {
+		name               string
+		restoreFrom        *druidv1alpha1.RestoreFromSpec
+		observedGeneration *int64
+		taskConditions     []druidv1alpha1.Condition
+		taskExists         bool
+		controllerDisabled bool
+		createErr          *apierrors.StatusError
+		deleteErr          *apierrors.StatusError
+		expectTaskCreated  bool
+		expectRequeue      bool
+		expectErr          bool
+	}{
+		{
+			name: "restoreFrom is not set",
+		},
+		{
+			name:               "etcd has already been reconciled",
+			restoreFrom:        restoreFrom,
+			observedGeneration: ptr.To[int64](1),
+		},
+		{
+			name:               "task is deleted once the etcd has been reconciled",
+			restoreFrom:        restoreFrom,
+			observedGeneration: ptr.To[int64](1),
+			taskExists:         true,
+			taskConditions:     []druidv1alpha1.Condition{{Type: druidv1alpha1.EtcdCopyBackupsTaskSucceeded, Status: druidv1alpha1.ConditionTrue}},
+		},
+		{
+			name:               "task deletion fails",
+			restoreFrom:        restoreFrom,
+			observedGeneration: ptr.To[int64](1),
+			taskExists:         true,
+			deleteErr:          testutils.TestAPIInternalErr,
+			expectTaskCreated:  true,
+			expectErr:          true,
+		},
+		{
+			name:               "copy backups task controller is disabled",
+			restoreFrom:        restoreFrom,
+			controllerDisabled: true,
+			expectErr:          true,
+		},
+		{
+			name:              "task is created for a new etcd",
+			restoreFrom:       restoreFrom,
+			expectTaskCreated: true,
+			expectRequeue:     true,
+		},
+		{
+			name:              "task is created for the revision to restore to",
+			restoreFrom:       restoreFromRevision,
+			expectTaskCreated: true,
+			expectRequeue:     true,
+		},
+		{
+			name:              "task is created for the timestamp to restore to",
+			restoreFrom:       restoreFromTimestamp,
+			expectTaskCreated: true,
+			expectRequeue:     true,
+		},
+		{
+			name:        "task creation fails",
+			restoreFrom: restoreFrom,
+			createErr:   testutils.TestAPIInternalErr,
+			expectErr:   true,
+		},
+		{
+			name:              "task has not yet completed",
+			restoreFrom:       restoreFrom,
+			taskExists:        true,
+			expectTaskCreated: true,
+			expectRequeue:     true,
+		},
+		{
+			name:              "task has succeeded",
+			restoreFrom:       restoreFrom,
+			taskExists:        true,
+			taskConditions:    []druidv1alpha1.Condition{{Type: druidv1alpha1.EtcdCopyBackupsTaskSucceeded, Status: druidv1alpha1.ConditionTrue}},
+			expectTaskCreated: true,
+		},
+		{
+			name:           "failed task is deleted to be recreated",
+			restoreFrom:    restoreFrom,
+			taskExists:     true,
+			taskConditions: []druidv1alpha1.Condition{{Type: druidv1alpha1.EtcdCopyBackupsTaskFailed, Status: druidv1alpha1.ConditionTrue, Message: "copy failed"}},
+			expectErr:      true,
+		},
+	}
+
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithDefaultBackup().Build()
+			etcd.Spec.Backup.RestoreFrom = test.restoreFrom
+			etcd.Status.ObservedGeneration = test.observedGeneration
+			var existingObjects []client.Object
+			if test.taskExists {
+				existingObjects = append(existingObjects, newTask(etcd, test.taskConditions...))
+			}
+			taskKey := client.ObjectKey{Namespace: etcd.Namespace, Name: druidv1alpha1.GetRestoreFromCopyBackupsTaskName(etcd.ObjectMeta)}
+			cl := testutils.CreateTestFakeClientWithSchemeForObjects(kubernetes.Scheme, nil, test.createErr, nil, test.deleteErr, existingObjects, taskKey)
+			r := &Reconciler{client: cl, logger: logr.Discard(), copyBackupsTaskControllerEnabled: !test.controllerDisabled}
+			opCtx := component.NewOperatorContext(context.Background(), logr.Discard(), uuid.NewString())
+
+			result := r.copyBackupsToRestoreFrom(opCtx, etcd)
+			g.Expect(result.HasErrors()).To(Equal(test.expectErr))
+			g.Expect(result.NeedsRequeue()).To(Equal(test.expectRequeue || test.expectErr))
+			g.Expect(ctrlutils.ShortCircuitReconcileFlow(result)).To(Equal(test.expectRequeue || test.expectErr))
+
+			task := &druidv1alpha1.EtcdCopyBackupsTask{}
+			err := cl.Get(context.Background(), taskKey, task)
+			if !test.expectTaskCreated {
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(task.Spec.SourceStore).To(Equal(test.restoreFrom.Store))
+			g.Expect(task.Spec.TargetStore).To(Equal(*etcd.Spec.Backup.Store))
+			g.Expect(task.Spec.MaxRevision).To(Equal(test.restoreFrom.Revision))
+			g.Expect(task.Spec.MaxTimestamp.Equal(test.restoreFrom.Timestamp)).To(BeTrue())
+			g.Expect(task.OwnerReferences).To(ConsistOf(druidv1alpha1.GetAsOwnerReference(etcd.ObjectMeta)))
+		})
+	}
+}
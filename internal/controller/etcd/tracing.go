@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/component"
+	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/tracing"
+)
+
+// traceStep runs the given reconcile step within a span, which is named after the step and is a child of the span of
+// the enclosing reconcile flow.
+func traceStep(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, fn reconcileFn) ctrlutils.ReconcileStepResult {
+	spanCtx, span := tracing.StartSpan(ctx, getStepName(fn))
+	ctx.Context = spanCtx
+	result := fn(ctx, etcd)
+	tracing.EndSpan(span, result.GetCombinedError())
+	return result
+}
+
+// getStepName returns the name of the method implementing the given reconcile step, e.g. `syncEtcdResources`.
+func getStepName(fn reconcileFn) string {
+	name := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+	name = strings.TrimSuffix(name, "-fm")
+	return name[strings.LastIndex(name, ".")+1:]
+}
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetStepName(t *testing.T) {
+	g := NewWithT(t)
+	r := &Reconciler{}
+	g.Expect(getStepName(r.syncEtcdResources)).To(Equal("syncEtcdResources"))
+	g.Expect(getStepName(r.reconcileStatus)).To(Equal("reconcileStatus"))
+}
@@ -9,11 +9,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/images"
 	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
@@ -285,13 +286,13 @@ func (r *Reconciler) createJobObject(ctx context.Context, task *druidv1alpha1.Et
 	}
 
 	targetStore := task.Spec.TargetStore
-	targetProvider, err := druidstore.StorageProviderFromInfraProvider(targetStore.Provider)
+	targetProvider, err := druidresources.StorageProviderFromInfraProvider(targetStore.Provider)
 	if err != nil {
 		return nil, err
 	}
 
 	sourceStore := task.Spec.SourceStore
-	sourceProvider, err := druidstore.StorageProviderFromInfraProvider(sourceStore.Provider)
+	sourceProvider, err := druidresources.StorageProviderFromInfraProvider(sourceStore.Provider)
 	if err != nil {
 		return nil, err
 	}
@@ -355,7 +356,7 @@ func (r *Reconciler) createJobObject(ctx context.Context, task *druidv1alpha1.Et
 		},
 	}
 
-	if targetProvider == druidstore.Local {
+	if targetProvider == druidresources.Local {
 		// init container to change file permissions of the folders used as store to 65532 (nonroot)
 		// used only with local provider
 		job.Spec.Template.Spec.InitContainers = []corev1.Container{
@@ -388,7 +389,7 @@ func (r *Reconciler) createJobObject(ctx context.Context, task *druidv1alpha1.Et
 
 func getCommonLabels(task *druidv1alpha1.EtcdCopyBackupsTask) map[string]string {
 	return map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameEtcdCopyBackupsJob,
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameEtcdCopyBackupsJob,
 		druidv1alpha1.LabelPartOfKey:    task.Name,
 		druidv1alpha1.LabelManagedByKey: druidv1alpha1.LabelManagedByValue,
 		druidv1alpha1.LabelAppNameKey:   task.GetJobName(),
@@ -417,6 +418,14 @@ func createJobArgs(task *druidv1alpha1.EtcdCopyBackupsTask, sourceObjStoreProvid
 		args = append(args, "--max-backups-to-copy="+strconv.Itoa(int(*task.Spec.MaxBackups)))
 	}
 
+	if task.Spec.MaxRevision != nil {
+		args = append(args, "--max-revision="+strconv.FormatInt(*task.Spec.MaxRevision, 10))
+	}
+
+	if task.Spec.MaxTimestamp != nil {
+		args = append(args, "--max-timestamp="+task.Spec.MaxTimestamp.UTC().Format(time.RFC3339))
+	}
+
 	if task.Spec.WaitForFinalSnapshot != nil {
 		args = append(args, "--wait-for-final-snapshot="+strconv.FormatBool(task.Spec.WaitForFinalSnapshot.Enabled))
 		if task.Spec.WaitForFinalSnapshot.Timeout != nil {
@@ -440,7 +449,7 @@ func getVolumeNamePrefix(prefix string) string {
 // This function creates the necessary Volume configurations for various storage providers.
 func (r *Reconciler) createVolumesFromStore(ctx context.Context, store *druidv1alpha1.StoreSpec, namespace, provider, prefix string) (volumes []corev1.Volume, err error) {
 	switch provider {
-	case druidstore.Local:
+	case druidresources.Local:
 		hostPathDirectory := corev1.HostPathDirectoryOrCreate
 		hostPathPrefix, err := druidstore.GetHostMountPathFromSecretRef(ctx, r.Client, r.logger, store, namespace)
 		if err != nil {
@@ -455,17 +464,17 @@ func (r *Reconciler) createVolumesFromStore(ctx context.Context, store *druidv1a
 				},
 			},
 		})
-	case druidstore.GCS, druidstore.S3, druidstore.ABS, druidstore.Swift, druidstore.OCS, druidstore.OSS:
+	case druidresources.GCS, druidresources.S3, druidresources.ABS, druidresources.Swift, druidresources.OCS, druidresources.OSS:
 		if store.SecretRef == nil {
 			err = fmt.Errorf("no secretRef is configured for backup %sstore", prefix)
 			return
 		}
 		volumes = append(volumes, corev1.Volume{
-			Name: getVolumeNamePrefix(prefix) + common.VolumeNameProviderBackupSecret,
+			Name: getVolumeNamePrefix(prefix) + druidresources.VolumeNameProviderBackupSecret,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  store.SecretRef.Name,
-					DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+					DefaultMode: ptr.To(druidresources.ModeOwnerReadWriteGroupRead),
 				},
 			},
 		})
@@ -479,19 +488,19 @@ func (r *Reconciler) createVolumesFromStore(ctx context.Context, store *druidv1a
 // This function creates the necessary Volume configurations for various storage providers and returns any errors encountered.
 func createVolumeMountsFromStore(store *druidv1alpha1.StoreSpec, provider, volumeMountPrefix string) (volumeMounts []corev1.VolumeMount) {
 	switch provider {
-	case druidstore.Local:
+	case druidresources.Local:
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      volumeMountPrefix + "host-storage",
 			MountPath: "/home/nonroot/" + *store.Container,
 		})
-	case druidstore.GCS:
+	case druidresources.GCS:
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
-			Name:      getVolumeNamePrefix(volumeMountPrefix) + common.VolumeNameProviderBackupSecret,
+			Name:      getVolumeNamePrefix(volumeMountPrefix) + druidresources.VolumeNameProviderBackupSecret,
 			MountPath: getGCSSecretVolumeMountPathWithPrefixAndSuffix(getVolumeNamePrefix(volumeMountPrefix), "/"),
 		})
-	case druidstore.S3, druidstore.ABS, druidstore.Swift, druidstore.OCS, druidstore.OSS:
+	case druidresources.S3, druidresources.ABS, druidresources.Swift, druidresources.OCS, druidresources.OSS:
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
-			Name:      getVolumeNamePrefix(volumeMountPrefix) + common.VolumeNameProviderBackupSecret,
+			Name:      getVolumeNamePrefix(volumeMountPrefix) + druidresources.VolumeNameProviderBackupSecret,
 			MountPath: getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumeMountPrefix, "/"),
 		})
 	}
@@ -500,13 +509,13 @@ func createVolumeMountsFromStore(store *druidv1alpha1.StoreSpec, provider, volum
 
 func getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, volumeSuffix string) string {
 	// "/var/<volumePrefix>etcd-backup<volumeSuffix>"
-	tokens := strings.Split(strings.Trim(common.VolumeMountPathNonGCSProviderBackupSecret, "/"), "/")
+	tokens := strings.Split(strings.Trim(druidresources.VolumeMountPathNonGCSProviderBackupSecret, "/"), "/")
 	return fmt.Sprintf("/%s/%s%s%s", tokens[0], volumePrefix, tokens[1], volumeSuffix)
 }
 
 func getGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, volumeSuffix string) string {
 	// "/var/.<volumePrefix>gcp<volumeSuffix>"
-	tokens := strings.Split(strings.TrimSuffix(common.VolumeMountPathGCSBackupSecret, "/"), ".")
+	tokens := strings.Split(strings.TrimSuffix(druidresources.VolumeMountPathGCSBackupSecret, "/"), ".")
 	return fmt.Sprintf("%s.%s%s%s", tokens[0], volumePrefix, tokens[1], volumeSuffix)
 }
 
@@ -515,20 +524,20 @@ func getGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, volumeSuffix s
 // This function creates the necessary environment variables for various storage providers and configurations. The generated
 // environment variables include storage container information and provider-specific credentials.
 func createEnvVarsFromStore(store *druidv1alpha1.StoreSpec, storeProvider, envKeyPrefix, volumePrefix string) (envVars []corev1.EnvVar) {
-	envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvStorageContainer, *store.Container))
+	envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+druidresources.EnvStorageContainer, *store.Container))
 	switch storeProvider {
-	case druidstore.S3:
-		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvAWSApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
-	case druidstore.ABS:
-		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvAzureApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
-	case druidstore.GCS:
-		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvGoogleApplicationCredentials, getGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "/serviceaccount.json")))
-	case druidstore.Swift:
-		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvOpenstackApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
-	case druidstore.OCS:
-		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvOpenshiftApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
-	case druidstore.OSS:
-		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+common.EnvAlicloudApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
+	case druidresources.S3:
+		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+druidresources.EnvAWSApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
+	case druidresources.ABS:
+		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+druidresources.EnvAzureApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
+	case druidresources.GCS:
+		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+druidresources.EnvGoogleApplicationCredentials, getGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "/serviceaccount.json")))
+	case druidresources.Swift:
+		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+druidresources.EnvOpenstackApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
+	case druidresources.OCS:
+		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+druidresources.EnvOpenshiftApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
+	case druidresources.OSS:
+		envVars = append(envVars, utils.GetEnvVarFromValue(envKeyPrefix+druidresources.EnvAlicloudApplicationCredentials, getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "")))
 	}
 	return envVars
 }
@@ -12,9 +12,9 @@ import (
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/client/kubernetes"
 	"github.com/gardener/etcd-druid/internal/common"
-	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils/imagevector"
 	k8sutils "github.com/gardener/etcd-druid/internal/utils/kubernetes"
 	testutils "github.com/gardener/etcd-druid/test/utils"
@@ -324,8 +324,8 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 
 	Describe("#createJobArguments", func() {
 		var (
-			providerLocal = druidv1alpha1.StorageProvider(druidstore.Local)
-			providerS3    = druidv1alpha1.StorageProvider(druidstore.S3)
+			providerLocal = druidv1alpha1.StorageProvider(druidresources.Local)
+			providerS3    = druidv1alpha1.StorageProvider(druidresources.S3)
 			task          *druidv1alpha1.EtcdCopyBackupsTask
 			expected      = []string{
 				"copy",
@@ -360,27 +360,39 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 		})
 
 		It("should create the correct arguments", func() {
-			arguments := createJobArgs(task, druidstore.Local, druidstore.S3)
+			arguments := createJobArgs(task, druidresources.Local, druidresources.S3)
 			Expect(arguments).To(Equal(expected))
 		})
 
 		It("should include the max backup age in the arguments", func() {
 			task.Spec.MaxBackupAge = ptr.To[uint32](10)
-			arguments := createJobArgs(task, druidstore.Local, druidstore.S3)
+			arguments := createJobArgs(task, druidresources.Local, druidresources.S3)
 			Expect(arguments).To(Equal(append(expected, "--max-backup-age=10")))
 		})
 
 		It("should include the max number of backups in the arguments", func() {
 			task.Spec.MaxBackups = ptr.To[uint32](5)
-			arguments := createJobArgs(task, druidstore.Local, druidstore.S3)
+			arguments := createJobArgs(task, druidresources.Local, druidresources.S3)
 			Expect(arguments).To(Equal(append(expected, "--max-backups-to-copy=5")))
 		})
 
+		It("should include the max revision in the arguments", func() {
+			task.Spec.MaxRevision = ptr.To[int64](42)
+			arguments := createJobArgs(task, druidresources.Local, druidresources.S3)
+			Expect(arguments).To(Equal(append(expected, "--max-revision=42")))
+		})
+
+		It("should include the max timestamp in the arguments", func() {
+			task.Spec.MaxTimestamp = &metav1.Time{Time: time.Date(2026, 10, 1, 8, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60))}
+			arguments := createJobArgs(task, druidresources.Local, druidresources.S3)
+			Expect(arguments).To(Equal(append(expected, "--max-timestamp=2026-10-01T06:30:00Z")))
+		})
+
 		It("should include the wait for final snapshot in the arguments", func() {
 			task.Spec.WaitForFinalSnapshot = &druidv1alpha1.WaitForFinalSnapshotSpec{
 				Enabled: true,
 			}
-			arguments := createJobArgs(task, druidstore.Local, druidstore.S3)
+			arguments := createJobArgs(task, druidresources.Local, druidresources.S3)
 			Expect(arguments).To(Equal(append(expected, "--wait-for-final-snapshot=true")))
 		})
 
@@ -389,7 +401,7 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 				Enabled: true,
 				Timeout: &metav1.Duration{Duration: time.Minute},
 			}
-			arguments := createJobArgs(task, druidstore.Local, druidstore.S3)
+			arguments := createJobArgs(task, druidresources.Local, druidresources.S3)
 			Expect(arguments).To(Equal(append(expected, "--wait-for-final-snapshot=true", "--wait-for-final-snapshot-timeout=1m0s")))
 		})
 	})
@@ -403,12 +415,12 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 		)
 		// Loop through different storage providers to test with
 		for _, p := range []string{
-			druidstore.ABS,
-			druidstore.GCS,
-			druidstore.S3,
-			druidstore.Swift,
-			druidstore.OSS,
-			druidstore.OCS,
+			druidresources.ABS,
+			druidresources.GCS,
+			druidresources.S3,
+			druidresources.Swift,
+			druidresources.OSS,
+			druidresources.OCS,
 		} {
 			Context(fmt.Sprintf("with provider #%s", p), func() {
 				provider := p
@@ -429,7 +441,7 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 		}
 		Context("with provider #Local", func() {
 			BeforeEach(func() {
-				storageProvider := druidv1alpha1.StorageProvider(druidstore.Local)
+				storageProvider := druidv1alpha1.StorageProvider(druidresources.Local)
 				storeSpec = &druidv1alpha1.StoreSpec{
 					Container: &container,
 					Provider:  &storageProvider,
@@ -437,8 +449,8 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 			})
 
 			It("should create the correct env vars", func() {
-				envVars := createEnvVarsFromStore(storeSpec, druidstore.Local, envKeyPrefix, volumePrefix)
-				checkEnvVars(envVars, druidstore.Local, container, envKeyPrefix, volumePrefix)
+				envVars := createEnvVarsFromStore(storeSpec, druidresources.Local, envKeyPrefix, volumePrefix)
+				checkEnvVars(envVars, druidresources.Local, container, envKeyPrefix, volumePrefix)
 
 			})
 		})
@@ -451,13 +463,13 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 		)
 		// Loop through different storage providers to test with
 		for _, p := range []string{
-			druidstore.Local,
-			druidstore.ABS,
-			druidstore.GCS,
-			druidstore.S3,
-			druidstore.Swift,
-			druidstore.OSS,
-			druidstore.OCS,
+			druidresources.Local,
+			druidresources.ABS,
+			druidresources.GCS,
+			druidresources.S3,
+			druidresources.Swift,
+			druidresources.OSS,
+			druidresources.OCS,
 		} {
 			Context(fmt.Sprintf("with provider #%s", p), func() {
 				provider := p
@@ -477,14 +489,14 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 					expectedMountName := ""
 
 					switch provider {
-					case druidstore.Local:
+					case druidresources.Local:
 						expectedMountName = volumeMountPrefix + "host-storage"
 						expectedMountPath = "/home/nonroot/" + *storeSpec.Container
-					case druidstore.GCS:
-						expectedMountName = volumeMountPrefix + common.VolumeNameProviderBackupSecret
+					case druidresources.GCS:
+						expectedMountName = volumeMountPrefix + druidresources.VolumeNameProviderBackupSecret
 						expectedMountPath = getGCSSecretVolumeMountPathWithPrefixAndSuffix(volumeMountPrefix, "/")
-					case druidstore.S3, druidstore.ABS, druidstore.Swift, druidstore.OCS, druidstore.OSS:
-						expectedMountName = volumeMountPrefix + common.VolumeNameProviderBackupSecret
+					case druidresources.S3, druidresources.ABS, druidresources.Swift, druidresources.OCS, druidresources.OSS:
+						expectedMountName = volumeMountPrefix + druidresources.VolumeNameProviderBackupSecret
 						expectedMountPath = getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumeMountPrefix, "/")
 					default:
 						Fail(fmt.Sprintf("Unknown provider: %s", provider))
@@ -532,7 +544,7 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 
 			It("should create the correct volumes when secret data hostPath is set", func() {
 				secret.Data = map[string][]byte{
-					druidstore.EtcdBackupSecretHostPath: []byte("/test/hostPath"),
+					druidresources.EtcdBackupSecretHostPath: []byte("/test/hostPath"),
 				}
 				Expect(fakeClient.Create(ctx, secret)).To(Succeed())
 
@@ -559,7 +571,7 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 
 				hostPathVolumeSource := volumes[0].HostPath
 				Expect(hostPathVolumeSource).NotTo(BeNil())
-				Expect(hostPathVolumeSource.Path).To(Equal(druidstore.LocalProviderDefaultMountPath + "/" + *store.Container))
+				Expect(hostPathVolumeSource.Path).To(Equal(druidresources.LocalProviderDefaultMountPath + "/" + *store.Container))
 				Expect(*hostPathVolumeSource.Type).To(Equal(corev1.HostPathDirectoryOrCreate))
 			})
 
@@ -574,7 +586,7 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 
 				hostPathVolumeSource := volumes[0].HostPath
 				Expect(hostPathVolumeSource).NotTo(BeNil())
-				Expect(hostPathVolumeSource.Path).To(Equal(druidstore.LocalProviderDefaultMountPath + "/" + *store.Container))
+				Expect(hostPathVolumeSource.Path).To(Equal(druidresources.LocalProviderDefaultMountPath + "/" + *store.Container))
 				Expect(*hostPathVolumeSource.Type).To(Equal(corev1.HostPathDirectoryOrCreate))
 			})
 		})
@@ -595,12 +607,12 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 
 			// Loop through different storage providers to test with
 			for _, p := range []string{
-				druidstore.ABS,
-				druidstore.GCS,
-				druidstore.S3,
-				druidstore.Swift,
-				druidstore.OSS,
-				druidstore.OCS,
+				druidresources.ABS,
+				druidresources.GCS,
+				druidresources.S3,
+				druidresources.Swift,
+				druidresources.OSS,
+				druidresources.OCS,
 			} {
 				Context(fmt.Sprintf("#%s", p), func() {
 					BeforeEach(func() {
@@ -639,7 +651,7 @@ var _ = Describe("EtcdCopyBackupsTaskController", func() {
 						Expect(volumeSource.Secret).NotTo(BeNil())
 						Expect(*volumeSource.Secret).To(Equal(corev1.SecretVolumeSource{
 							SecretName:  store.SecretRef.Name,
-							DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+							DefaultMode: ptr.To(druidresources.ModeOwnerReadWriteGroupRead),
 						}))
 					})
 
@@ -683,24 +695,24 @@ func ensureEtcdCopyBackupsTaskRemoval(ctx context.Context, name, namespace strin
 func checkEnvVars(envVars []corev1.EnvVar, storeProvider, container, envKeyPrefix, volumePrefix string) {
 	expected := []corev1.EnvVar{
 		{
-			Name:  envKeyPrefix + common.EnvStorageContainer,
+			Name:  envKeyPrefix + druidresources.EnvStorageContainer,
 			Value: container,
 		}}
 	mapToEnvVarKey := map[string]string{
-		druidstore.S3:    envKeyPrefix + common.EnvAWSApplicationCredentials,
-		druidstore.ABS:   envKeyPrefix + common.EnvAzureApplicationCredentials,
-		druidstore.GCS:   envKeyPrefix + common.EnvGoogleApplicationCredentials,
-		druidstore.Swift: envKeyPrefix + common.EnvOpenstackApplicationCredentials,
-		druidstore.OCS:   envKeyPrefix + common.EnvOpenshiftApplicationCredentials,
-		druidstore.OSS:   envKeyPrefix + common.EnvAlicloudApplicationCredentials,
+		druidresources.S3:    envKeyPrefix + druidresources.EnvAWSApplicationCredentials,
+		druidresources.ABS:   envKeyPrefix + druidresources.EnvAzureApplicationCredentials,
+		druidresources.GCS:   envKeyPrefix + druidresources.EnvGoogleApplicationCredentials,
+		druidresources.Swift: envKeyPrefix + druidresources.EnvOpenstackApplicationCredentials,
+		druidresources.OCS:   envKeyPrefix + druidresources.EnvOpenshiftApplicationCredentials,
+		druidresources.OSS:   envKeyPrefix + druidresources.EnvAlicloudApplicationCredentials,
 	}
 	switch storeProvider {
-	case druidstore.S3, druidstore.ABS, druidstore.Swift, druidstore.OCS, druidstore.OSS:
+	case druidresources.S3, druidresources.ABS, druidresources.Swift, druidresources.OCS, druidresources.OSS:
 		expected = append(expected, corev1.EnvVar{
 			Name:  mapToEnvVarKey[storeProvider],
 			Value: getNonGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, ""),
 		})
-	case druidstore.GCS:
+	case druidresources.GCS:
 		expected = append(expected, corev1.EnvVar{
 			Name:  mapToEnvVarKey[storeProvider],
 			Value: getGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "/serviceaccount.json"),
@@ -710,9 +722,9 @@ func checkEnvVars(envVars []corev1.EnvVar, storeProvider, container, envKeyPrefi
 }
 
 func matchJob(task *druidv1alpha1.EtcdCopyBackupsTask, imageVector imagevector.ImageVector) gomegatypes.GomegaMatcher {
-	sourceProvider, err := druidstore.StorageProviderFromInfraProvider(task.Spec.SourceStore.Provider)
+	sourceProvider, err := druidresources.StorageProviderFromInfraProvider(task.Spec.SourceStore.Provider)
 	Expect(err).NotTo(HaveOccurred())
-	targetProvider, err := druidstore.StorageProviderFromInfraProvider(task.Spec.TargetStore.Provider)
+	targetProvider, err := druidresources.StorageProviderFromInfraProvider(task.Spec.TargetStore.Provider)
 	Expect(err).NotTo(HaveOccurred())
 
 	images, err := imagevector.FindImages(imageVector, []string{common.ImageKeyEtcdBackupRestore})
@@ -724,7 +736,7 @@ func matchJob(task *druidv1alpha1.EtcdCopyBackupsTask, imageVector imagevector.I
 			"Name":      Equal(task.Name + "-worker"),
 			"Namespace": Equal(task.Namespace),
 			"Labels": MatchKeys(IgnoreExtras, Keys{
-				druidv1alpha1.LabelComponentKey: Equal(common.ComponentNameEtcdCopyBackupsJob),
+				druidv1alpha1.LabelComponentKey: Equal(druidv1alpha1.ComponentNameEtcdCopyBackupsJob),
 				druidv1alpha1.LabelPartOfKey:    Equal(task.Name),
 				druidv1alpha1.LabelManagedByKey: Equal(druidv1alpha1.LabelManagedByValue),
 				druidv1alpha1.LabelAppNameKey:   Equal(task.GetJobName()),
@@ -744,7 +756,7 @@ func matchJob(task *druidv1alpha1.EtcdCopyBackupsTask, imageVector imagevector.I
 			"Template": MatchFields(IgnoreExtras, Fields{
 				"ObjectMeta": MatchFields(IgnoreExtras, Fields{
 					"Labels": MatchKeys(IgnoreExtras, Keys{
-						druidv1alpha1.LabelComponentKey: Equal(common.ComponentNameEtcdCopyBackupsJob),
+						druidv1alpha1.LabelComponentKey: Equal(druidv1alpha1.ComponentNameEtcdCopyBackupsJob),
 						druidv1alpha1.LabelPartOfKey:    Equal(task.Name),
 						druidv1alpha1.LabelManagedByKey: Equal(druidv1alpha1.LabelManagedByValue),
 						druidv1alpha1.LabelAppNameKey:   Equal(task.GetJobName()),
@@ -798,6 +810,12 @@ func getArgElements(task *druidv1alpha1.EtcdCopyBackupsTask, sourceProvider, tar
 	if task.Spec.MaxBackups != nil && *task.Spec.MaxBackups != 0 {
 		addEqual(elements, fmt.Sprintf("%s=%d", "--max-backups-to-copy", *task.Spec.MaxBackups))
 	}
+	if task.Spec.MaxRevision != nil {
+		addEqual(elements, fmt.Sprintf("%s=%d", "--max-revision", *task.Spec.MaxRevision))
+	}
+	if task.Spec.MaxTimestamp != nil {
+		addEqual(elements, fmt.Sprintf("%s=%s", "--max-timestamp", task.Spec.MaxTimestamp.UTC().Format(time.RFC3339)))
+	}
 	if task.Spec.WaitForFinalSnapshot != nil && task.Spec.WaitForFinalSnapshot.Enabled {
 		addEqual(elements, fmt.Sprintf("%s=%t", "--wait-for-final-snapshot", task.Spec.WaitForFinalSnapshot.Enabled))
 		if task.Spec.WaitForFinalSnapshot.Timeout != nil && task.Spec.WaitForFinalSnapshot.Timeout.Duration != 0 {
@@ -810,14 +828,14 @@ func getArgElements(task *druidv1alpha1.EtcdCopyBackupsTask, sourceProvider, tar
 func getEnvElements(task *druidv1alpha1.EtcdCopyBackupsTask) Elements {
 	elements := Elements{}
 	if task.Spec.TargetStore.Container != nil && *task.Spec.TargetStore.Container != "" {
-		elements[common.EnvStorageContainer] = MatchFields(IgnoreExtras, Fields{
-			"Name":  Equal(common.EnvStorageContainer),
+		elements[druidresources.EnvStorageContainer] = MatchFields(IgnoreExtras, Fields{
+			"Name":  Equal(druidresources.EnvStorageContainer),
 			"Value": Equal(*task.Spec.TargetStore.Container),
 		})
 	}
 	if task.Spec.SourceStore.Container != nil && *task.Spec.SourceStore.Container != "" {
-		elements[common.EnvSourceStorageContainer] = MatchFields(IgnoreExtras, Fields{
-			"Name":  Equal(common.EnvSourceStorageContainer),
+		elements[druidresources.EnvSourceStorageContainer] = MatchFields(IgnoreExtras, Fields{
+			"Name":  Equal(druidresources.EnvSourceStorageContainer),
 			"Value": Equal(*task.Spec.SourceStore.Container),
 		})
 	}
@@ -864,7 +882,7 @@ func matchJobWithProviders(task *druidv1alpha1.EtcdCopyBackupsTask, sourceProvid
 		})
 		return And(matcher, volumeMatcher)
 	}
-	if targetProvider == druidstore.Local {
+	if targetProvider == druidresources.Local {
 		// With a Local target, druid adds a change-backup-bucket-permissions init container that
 		// chowns the source and target backup dirs. It must use the exec (argv) form, not sh -c,
 		// so that the container names cannot inject shell commands.
@@ -896,43 +914,43 @@ func getProviderEnvElements(storeProvider, prefix, volumePrefix string) Elements
 	switch storeProvider {
 	case "S3":
 		return Elements{
-			prefix + common.EnvAWSApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvAWSApplicationCredentials),
+			prefix + druidresources.EnvAWSApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvAWSApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
 	case "ABS":
 		return Elements{
-			prefix + common.EnvAzureApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvAzureApplicationCredentials),
+			prefix + druidresources.EnvAzureApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvAzureApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
 	case "GCS":
 		return Elements{
-			prefix + common.EnvGoogleApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvGoogleApplicationCredentials),
+			prefix + druidresources.EnvGoogleApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvGoogleApplicationCredentials),
 				"Value": Equal(getGCSSecretVolumeMountPathWithPrefixAndSuffix(volumePrefix, "/serviceaccount.json")),
 			}),
 		}
 	case "Swift":
 		return Elements{
-			prefix + common.EnvOpenstackApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvOpenstackApplicationCredentials),
+			prefix + druidresources.EnvOpenstackApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvOpenstackApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
 	case "OSS":
 		return Elements{
-			prefix + common.EnvAlicloudApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvAlicloudApplicationCredentials),
+			prefix + druidresources.EnvAlicloudApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvAlicloudApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
 	case "OCS":
 		return Elements{
-			prefix + common.EnvOpenshiftApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvOpenshiftApplicationCredentials),
+			prefix + druidresources.EnvOpenshiftApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvOpenshiftApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
@@ -945,15 +963,15 @@ func getVolumeMountsElements(storeProvider, volumePrefix string) Elements {
 	switch storeProvider {
 	case "GCS":
 		return Elements{
-			volumePrefix + common.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
-				"Name":      Equal(getVolumeNamePrefix(volumePrefix) + common.VolumeNameProviderBackupSecret),
+			volumePrefix + druidresources.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
+				"Name":      Equal(getVolumeNamePrefix(volumePrefix) + druidresources.VolumeNameProviderBackupSecret),
 				"MountPath": Equal(fmt.Sprintf("/var/.%sgcp/", volumePrefix)),
 			}),
 		}
 	default:
 		return Elements{
-			volumePrefix + common.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
-				"Name":      Equal(volumePrefix + common.VolumeNameProviderBackupSecret),
+			volumePrefix + druidresources.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
+				"Name":      Equal(volumePrefix + druidresources.VolumeNameProviderBackupSecret),
 				"MountPath": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
@@ -962,12 +980,12 @@ func getVolumeMountsElements(storeProvider, volumePrefix string) Elements {
 
 func getVolumesElements(volumePrefix string, store *druidv1alpha1.StoreSpec) Elements {
 	return Elements{
-		volumePrefix + common.VolumeNameProviderBackupSecret: MatchAllFields(Fields{
-			"Name": Equal(volumePrefix + common.VolumeNameProviderBackupSecret),
+		volumePrefix + druidresources.VolumeNameProviderBackupSecret: MatchAllFields(Fields{
+			"Name": Equal(volumePrefix + druidresources.VolumeNameProviderBackupSecret),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
 					"SecretName":  Equal(store.SecretRef.Name),
-					"DefaultMode": PointTo(Equal(common.ModeOwnerReadWriteGroupRead)),
+					"DefaultMode": PointTo(Equal(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		}),
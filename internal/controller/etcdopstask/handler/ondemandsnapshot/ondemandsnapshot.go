@@ -13,10 +13,10 @@ import (
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
 	taskhandler "github.com/gardener/etcd-druid/internal/controller/etcdopstask/handler"
 	utils "github.com/gardener/etcd-druid/internal/controller/etcdopstask/handler/utils"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	"github.com/gardener/etcd-druid/internal/tracing"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -100,21 +100,25 @@ func (h *handler) Execute(ctx context.Context) taskhandler.Result {
 	}
 	h.httpClient = httpClient
 
-	url := fmt.Sprintf("%s://%s.%s:%d/snapshot/%s", httpScheme, druidv1alpha1.GetClientServiceName(etcd.ObjectMeta), etcd.Namespace, ptr.Deref(etcd.Spec.Backup.Port, common.DefaultPortEtcdBackupRestore), h.config.Type)
+	url := fmt.Sprintf("%s://%s.%s:%d/snapshot/%s", httpScheme, druidv1alpha1.GetClientServiceName(etcd.ObjectMeta), etcd.Namespace, ptr.Deref(etcd.Spec.Backup.Port, druidv1alpha1.DefaultPortEtcdBackupRestore), h.config.Type)
 	if ptr.Deref(h.config.IsFinal, false) {
 		url += "?final=true"
 	}
 
+	ctx, span := tracing.StartSpan(ctx, fmt.Sprintf("POST /snapshot/%s", h.config.Type))
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
 	if err != nil {
+		tracing.EndSpan(span, err)
 		return taskhandler.Result{
 			Description: "Failed to create HTTP request",
 			Error:       druiderr.WrapError(err, ErrCreateHTTPRequest, string(druidv1alpha1.LastOperationTypeExecution), "failed to create HTTP request"),
 			Requeue:     true,
 		}
 	}
+	tracing.InjectHTTPHeaders(ctx, req)
 
 	resp, err := h.httpClient.Do(req)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return taskhandler.Result{
 			Description: "Failed to execute HTTP request",
@@ -13,7 +13,7 @@ import (
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component/statefulset"
 	taskhandler "github.com/gardener/etcd-druid/internal/controller/etcdopstask/handler"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
@@ -29,7 +29,7 @@ import (
 // ConfigureHTTPClientForEtcdBR configures the HTTP client with TLS if backup TLS is enabled.
 // It returns the configured HTTP client, the HTTP scheme to use, and any error result.
 func ConfigureHTTPClientForEtcdBR(ctx context.Context, k8sClient client.Client, etcd *druidv1alpha1.Etcd, defaultClient http.Client, phase druidapicommon.LastOperationType) (httpClient http.Client, httpScheme string, errResult *taskhandler.Result) {
-	tlsConfig := etcd.Spec.Backup.TLS
+	tlsConfig := etcd.GetBackupTLS()
 	if tlsConfig == nil {
 		return defaultClient, "http", nil
 	}
@@ -57,11 +57,11 @@ func ConfigureHTTPClientForEtcdBR(ctx context.Context, k8sClient client.Client,
 		return
 	}
 
-	etcdbrCASecretName, ok := kutil.GetSecretNameFromVolume(sts, common.VolumeNameBackupRestoreCA)
+	etcdbrCASecretName, ok := kutil.GetSecretNameFromVolume(sts, druidresources.VolumeNameBackupRestoreCA)
 	if !ok {
 		errResult = &taskhandler.Result{
-			Description: fmt.Sprintf("backup-restore CA volume %q not found on StatefulSet %s/%s", common.VolumeNameBackupRestoreCA, etcd.Namespace, etcd.Name),
-			Error:       druiderr.WrapError(fmt.Errorf("volume %q not found on StatefulSet %s/%s", common.VolumeNameBackupRestoreCA, etcd.Namespace, etcd.Name), taskhandler.ErrGetCASecret, string(phase), "resolve backup-restore CA secret from StatefulSet volumes"),
+			Description: fmt.Sprintf("backup-restore CA volume %q not found on StatefulSet %s/%s", druidresources.VolumeNameBackupRestoreCA, etcd.Namespace, etcd.Name),
+			Error:       druiderr.WrapError(fmt.Errorf("volume %q not found on StatefulSet %s/%s", druidresources.VolumeNameBackupRestoreCA, etcd.Namespace, etcd.Name), taskhandler.ErrGetCASecret, string(phase), "resolve backup-restore CA secret from StatefulSet volumes"),
 			Requeue:     false,
 		}
 		return
@@ -12,6 +12,7 @@ import (
 
 	"github.com/go-logr/logr/testr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -23,5 +24,7 @@ func newTestReconciler(t *testing.T, cl client.Client) *Reconciler {
 		config: &druidconfigv1alpha1.EtcdOpsTaskControllerConfiguration{
 			RequeueInterval: &metav1.Duration{Duration: 60 * time.Second},
 		},
+		// a FakeRecorder without an Events channel discards all recorded events.
+		recorder: &record.FakeRecorder{},
 	}
 }
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdopstask
+
+import (
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidmetrics "github.com/gardener/etcd-druid/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespaceEtcdDruid     = "etcddruid"
+	subsystemEtcdOpsTask   = "etcdopstask"
+	baseTaskDurationSecond = 10
+)
+
+var (
+	// metricTasksCompletedTotal is the metric used to count the number of completed EtcdOpsTasks by type and final state.
+	// Its series for an Etcd are deleted via DeleteEtcdMetrics once the Etcd has been deleted.
+	metricTasksCompletedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemEtcdOpsTask,
+			Name:      "completed_total",
+			Help:      "Total number of EtcdOpsTasks which completed with the state Succeeded, Failed or Rejected.",
+		},
+		[]string{druidmetrics.LabelEtcdNamespace, druidmetrics.LabelEtcdName, druidmetrics.LabelTaskType, druidmetrics.LabelTaskState},
+	)
+
+	// metricTaskDurationSeconds is the metric used to expose the time taken by an EtcdOpsTask from its start until its completion.
+	metricTaskDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespaceEtcdDruid,
+			Subsystem: subsystemEtcdOpsTask,
+			Name:      "duration_seconds",
+			Help:      "Time taken in seconds by an EtcdOpsTask from its start until its completion.",
+			Buckets:   prometheus.ExponentialBuckets(baseTaskDurationSecond, 2, 8),
+		},
+		[]string{druidmetrics.LabelTaskType, druidmetrics.LabelTaskState},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(metricTasksCompletedTotal)
+	metrics.Registry.MustRegister(metricTaskDurationSeconds)
+}
+
+// recordTaskCompletion updates the metrics for the given completed task.
+func recordTaskCompletion(task *druidv1alpha1.EtcdOpsTask) {
+	taskType := getTaskType(task)
+	state := string(ptr.Deref(task.Status.State, ""))
+	metricTasksCompletedTotal.With(prometheus.Labels{
+		druidmetrics.LabelEtcdNamespace: task.Namespace,
+		druidmetrics.LabelEtcdName:      ptr.Deref(task.Spec.EtcdName, ""),
+		druidmetrics.LabelTaskType:      taskType,
+		druidmetrics.LabelTaskState:     state,
+	}).Inc()
+	// rejected tasks have never been started
+	if task.Status.StartedAt != nil {
+		metricTaskDurationSeconds.With(prometheus.Labels{
+			druidmetrics.LabelTaskType:  taskType,
+			druidmetrics.LabelTaskState: state,
+		}).Observe(time.Since(task.Status.StartedAt.Time).Seconds())
+	}
+}
+
+// DeleteEtcdMetrics deletes the metrics recorded for the EtcdOpsTasks of the given Etcd. It must be called once the
+// Etcd has been deleted.
+func DeleteEtcdMetrics(etcdObjMeta metav1.ObjectMeta) {
+	metricTasksCompletedTotal.DeletePartialMatch(prometheus.Labels{
+		druidmetrics.LabelEtcdNamespace: etcdObjMeta.Namespace,
+		druidmetrics.LabelEtcdName:      etcdObjMeta.Name,
+	})
+}
@@ -13,9 +13,12 @@ import (
 	"github.com/gardener/etcd-druid/internal/controller/etcdopstask/handler"
 	"github.com/gardener/etcd-druid/internal/controller/etcdopstask/handler/ondemandsnapshot"
 	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
+	"github.com/gardener/etcd-druid/internal/tracing"
 
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -32,6 +35,7 @@ type Reconciler struct {
 	logger              logr.Logger
 	config              *druidconfigv1alpha1.EtcdOpsTaskControllerConfiguration
 	taskHandlerRegistry handler.TaskHandlerRegistry
+	recorder            record.EventRecorder
 }
 
 // NewReconciler returns a new Reconciler for EtcdOpsTask resources.
@@ -48,6 +52,7 @@ func NewReconcilerWithTaskHandlerRegistry(mgr manager.Manager, cfg *druidconfigv
 		logger:              logger,
 		config:              cfg,
 		taskHandlerRegistry: taskHandlerRegistry,
+		recorder:            mgr.GetEventRecorderFor(controllerName),
 	}
 }
 
@@ -55,9 +60,16 @@ func NewReconcilerWithTaskHandlerRegistry(mgr manager.Manager, cfg *druidconfigv
 // +kubebuilder:rbac:groups=druid.gardener.cloud,resources=etcdopstasks/status,verbs=get;create;update;patch
 
 // Reconcile is the main reconciliation loop for EtcdOpsTask resources.
-func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (_ reconcile.Result, err error) {
+	runID := string(controller.ReconcileIDFromContext(ctx))
+	ctx, span := tracing.StartSpan(ctx, "ReconcileEtcdOpsTask",
+		tracing.AttributeEtcdNamespace.String(req.Namespace),
+		tracing.AttributeEtcdOpsTaskName.String(req.Name),
+		tracing.AttributeRunID.String(runID),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
 	logger := r.logger.WithValues(
-		"runID", string(controller.ReconcileIDFromContext(ctx)),
+		"runID", runID,
 		"namespace", req.Namespace,
 		"name", req.Name,
 	)
@@ -71,6 +83,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		}
 		return reconcile.Result{}, err
 	}
+	span.SetAttributes(tracing.AttributeEtcdName.String(ptr.Deref(task.Spec.EtcdName, "")))
 
 	taskHandlerInstance, err := r.getTaskHandler(task)
 	if err != nil {
@@ -89,13 +102,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 // getTaskHandler instantiates the appropriate TaskHandler for the given task.
 func (r *Reconciler) getTaskHandler(task *druidv1alpha1.EtcdOpsTask) (handler.Handler, error) {
-	config := task.Spec.Config
+	taskType := getTaskType(task)
+	if taskType == "" {
+		return nil, fmt.Errorf("unsupported task configuration: no valid task type found")
+	}
+	return r.taskHandlerRegistry.GetHandler(taskType, r.client, task, nil)
+}
 
+// getTaskType returns the type of the given task, which is the name of the configured operation, or an empty string if
+// no known operation is configured.
+func getTaskType(task *druidv1alpha1.EtcdOpsTask) string {
 	switch {
-	case config.OnDemandSnapshot != nil:
-		return r.taskHandlerRegistry.GetHandler("OnDemandSnapshot", r.client, task, nil)
+	case task.Spec.Config.OnDemandSnapshot != nil:
+		return "OnDemandSnapshot"
 	default:
-		return nil, fmt.Errorf("unsupported task configuration: no valid task type found")
+		return ""
 	}
 }
 
@@ -18,6 +18,7 @@ import (
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -125,6 +126,7 @@ func setLastError(task *druidv1alpha1.EtcdOpsTask, err error) {
 func (r *Reconciler) updateTaskStatus(ctx context.Context, task *druidv1alpha1.EtcdOpsTask, update taskStatusUpdate) error {
 	runID := string(controller.ReconcileIDFromContext(ctx))
 	originalStatus := task.Status.DeepCopy()
+	wasCompleted := task.IsCompleted()
 
 	if update.Operation != nil {
 		setLastOperation(task, update.Operation.Type, update.Operation.State, update.Operation.Description, runID)
@@ -142,12 +144,45 @@ func (r *Reconciler) updateTaskStatus(ctx context.Context, task *druidv1alpha1.E
 		if err := r.client.Status().Update(ctx, task); err != nil {
 			return err
 		}
+		if !wasCompleted && task.IsCompleted() {
+			recordTaskCompletion(task)
+		}
+		if ptr.Deref(originalStatus.State, "") != ptr.Deref(task.Status.State, "") {
+			r.recordTaskStateTransition(task)
+		}
 	}
 
 	return nil
 
 }
 
+// taskStateEventReasons maps each task state to the reason of the event emitted when a task enters it.
+var taskStateEventReasons = map[druidv1alpha1.TaskState]string{
+	druidv1alpha1.TaskStatePending:    druidv1alpha1.EventReasonTaskPending,
+	druidv1alpha1.TaskStateInProgress: druidv1alpha1.EventReasonTaskInProgress,
+	druidv1alpha1.TaskStateSucceeded:  druidv1alpha1.EventReasonTaskSucceeded,
+	druidv1alpha1.TaskStateFailed:     druidv1alpha1.EventReasonTaskFailed,
+	druidv1alpha1.TaskStateRejected:   druidv1alpha1.EventReasonTaskRejected,
+}
+
+// recordTaskStateTransition emits an event for the current state of the given task.
+func (r *Reconciler) recordTaskStateTransition(task *druidv1alpha1.EtcdOpsTask) {
+	state := ptr.Deref(task.Status.State, "")
+	reason, ok := taskStateEventReasons[state]
+	if !ok {
+		return
+	}
+	eventType := corev1.EventTypeNormal
+	if state == druidv1alpha1.TaskStateFailed || state == druidv1alpha1.TaskStateRejected {
+		eventType = corev1.EventTypeWarning
+	}
+	message := fmt.Sprintf("Task is %s", state)
+	if task.Status.LastOperation != nil && task.Status.LastOperation.Description != "" {
+		message += ": " + task.Status.LastOperation.Description
+	}
+	r.recorder.Event(task, eventType, reason, message)
+}
+
 // handleTaskResult is a common helper to handle task handler results with status updates
 func (r *Reconciler) handleTaskResult(ctx context.Context, logger logr.Logger, task *druidv1alpha1.EtcdOpsTask, result handler.Result, phase druidapicommon.LastOperationType) ctrlutils.ReconcileStepResult {
 	if result.Requeue {
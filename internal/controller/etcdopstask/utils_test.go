@@ -17,9 +17,13 @@ import (
 	"github.com/gardener/etcd-druid/internal/controller/etcdopstask/handler"
 	ctrlutils "github.com/gardener/etcd-druid/internal/controller/utils"
 	druiderr "github.com/gardener/etcd-druid/internal/errors"
+	druidmetrics "github.com/gardener/etcd-druid/internal/metrics"
 	"github.com/gardener/etcd-druid/test/utils"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -476,6 +480,119 @@ func TestUpdateTaskStatus(t *testing.T) {
 	}
 }
 
+// TestUpdateTaskStatusRecordsCompletion tests that updateTaskStatus records the metrics of a task once it completes.
+func TestUpdateTaskStatusRecordsCompletion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	task := utils.EtcdOpsTaskBuilderWithDefaults("test-task", "test-ns-metrics").
+		WithEtcdName("test-etcd").
+		WithOnDemandSnapshotConfig(&druidv1alpha1.OnDemandSnapshotConfig{}).
+		WithState(druidv1alpha1.TaskStateInProgress).
+		WithStartedAt(metav1.NewTime(time.Now().Add(-time.Minute))).
+		Build()
+	cl := utils.NewTestClientBuilder().
+		WithScheme(kubernetes.Scheme).
+		WithStatusSubresource(task).
+		Build()
+	g.Expect(cl.Create(ctx, task)).To(Succeed())
+	r := newTestReconciler(t, cl)
+	labels := prometheus.Labels{
+		druidmetrics.LabelEtcdNamespace: task.Namespace,
+		druidmetrics.LabelEtcdName:      *task.Spec.EtcdName,
+		druidmetrics.LabelTaskType:      "OnDemandSnapshot",
+		druidmetrics.LabelTaskState:     string(druidv1alpha1.TaskStateSucceeded),
+	}
+	defer metricTasksCompletedTotal.Delete(labels)
+
+	for range 2 {
+		g.Expect(r.updateTaskStatus(ctx, task, taskStatusUpdate{State: ptr.To(druidv1alpha1.TaskStateSucceeded)})).To(Succeed())
+		g.Expect(testutil.ToFloat64(metricTasksCompletedTotal.With(labels))).To(Equal(float64(1)))
+	}
+}
+
+// TestDeleteEtcdMetrics tests that DeleteEtcdMetrics deletes the metrics of the EtcdOpsTasks of the given Etcd only.
+func TestDeleteEtcdMetrics(t *testing.T) {
+	g := NewWithT(t)
+	labels := func(etcdName string) prometheus.Labels {
+		return prometheus.Labels{
+			druidmetrics.LabelEtcdNamespace: "test-ns-metrics",
+			druidmetrics.LabelEtcdName:      etcdName,
+			druidmetrics.LabelTaskType:      "OnDemandSnapshot",
+			druidmetrics.LabelTaskState:     string(druidv1alpha1.TaskStateSucceeded),
+		}
+	}
+	metricTasksCompletedTotal.With(labels("deleted-etcd")).Inc()
+	metricTasksCompletedTotal.With(labels("other-etcd")).Inc()
+	defer metricTasksCompletedTotal.Delete(labels("other-etcd"))
+
+	DeleteEtcdMetrics(metav1.ObjectMeta{Name: "deleted-etcd", Namespace: "test-ns-metrics"})
+
+	g.Expect(metricTasksCompletedTotal.Delete(labels("deleted-etcd"))).To(BeFalse())
+	g.Expect(testutil.ToFloat64(metricTasksCompletedTotal.With(labels("other-etcd")))).To(Equal(float64(1)))
+}
+
+// TestUpdateTaskStatusRecordsStateTransitionEvents tests that updateTaskStatus emits an event only when the task state changes.
+func TestUpdateTaskStatusRecordsStateTransitionEvents(t *testing.T) {
+	tests := []struct {
+		name          string
+		initialState  druidv1alpha1.TaskState
+		newState      druidv1alpha1.TaskState
+		expectedEvent string
+	}{
+		{
+			name:          "should emit a Normal event when the task moves to InProgress",
+			initialState:  druidv1alpha1.TaskStatePending,
+			newState:      druidv1alpha1.TaskStateInProgress,
+			expectedEvent: "Normal TaskInProgress Task is InProgress",
+		},
+		{
+			name:          "should emit a Warning event when the task fails",
+			initialState:  druidv1alpha1.TaskStateInProgress,
+			newState:      druidv1alpha1.TaskStateFailed,
+			expectedEvent: "Warning TaskFailed Task is Failed",
+		},
+		{
+			name:         "should not emit an event when the task state does not change",
+			initialState: druidv1alpha1.TaskStateInProgress,
+			newState:     druidv1alpha1.TaskStateInProgress,
+		},
+	}
+
+	t.Parallel()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			ctx := context.Background()
+			task := utils.EtcdOpsTaskBuilderWithDefaults("test-task", "test-ns").
+				WithEtcdName("test-etcd").
+				WithOnDemandSnapshotConfig(&druidv1alpha1.OnDemandSnapshotConfig{}).
+				WithState(tc.initialState).
+				Build()
+			cl := utils.NewTestClientBuilder().
+				WithScheme(kubernetes.Scheme).
+				WithStatusSubresource(task).
+				Build()
+			g.Expect(cl.Create(ctx, task)).To(Succeed())
+			recorder := record.NewFakeRecorder(10)
+			r := newTestReconciler(t, cl)
+			r.recorder = recorder
+
+			g.Expect(r.updateTaskStatus(ctx, task, taskStatusUpdate{State: ptr.To(tc.newState)})).To(Succeed())
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			if tc.expectedEvent == "" {
+				g.Expect(events).To(BeEmpty())
+			} else {
+				g.Expect(events).To(ConsistOf(HavePrefix(tc.expectedEvent)))
+			}
+		})
+	}
+}
+
 // TestHandleTaskResult tests the handleTaskResult method
 func TestHandleTaskResult(t *testing.T) {
 	tests := []struct {
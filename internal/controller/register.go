@@ -27,7 +27,7 @@ func Register(mgr ctrl.Manager, controllerConfig druidconfigv1alpha1.ControllerC
 	var err error
 
 	// Add etcd reconciler to the manager
-	etcdReconciler, err := etcd.NewReconciler(mgr, controllerConfig.Etcd)
+	etcdReconciler, err := etcd.NewReconciler(mgr, controllerConfig.Etcd, controllerConfig.EtcdCopyBackupsTask.Enabled)
 	if err != nil {
 		return err
 	}
@@ -6,10 +6,12 @@ package secret
 
 import (
 	"context"
+	"slices"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
 
 	"github.com/go-logr/logr"
@@ -70,29 +72,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 func isFinalizerNeeded(secretName string, etcdList *druidv1alpha1.EtcdList) (bool, *druidv1alpha1.Etcd) {
 	for _, etcd := range etcdList.Items {
-		if etcd.Spec.Etcd.ClientUrlTLS != nil &&
-			(etcd.Spec.Etcd.ClientUrlTLS.TLSCASecretRef.Name == secretName ||
-				etcd.Spec.Etcd.ClientUrlTLS.ServerTLSSecretRef.Name == secretName ||
-				etcd.Spec.Etcd.ClientUrlTLS.ClientTLSSecretRef.Name == secretName) {
-			return true, &etcd
-		}
-
-		if etcd.Spec.Etcd.PeerUrlTLS != nil &&
-			(etcd.Spec.Etcd.PeerUrlTLS.TLSCASecretRef.Name == secretName ||
-				etcd.Spec.Etcd.PeerUrlTLS.ServerTLSSecretRef.Name == secretName) { // Currently, no client certificate for peer url is used in ETCD cluster
-			return true, &etcd
-		}
-
-		if etcd.Spec.Backup.TLS != nil &&
-			(etcd.Spec.Backup.TLS.TLSCASecretRef.Name == secretName ||
-				etcd.Spec.Backup.TLS.ServerTLSSecretRef.Name == secretName ||
-				etcd.Spec.Backup.TLS.ClientTLSSecretRef.Name == secretName) {
-			return true, &etcd
-		}
-
-		if etcd.Spec.Backup.Store != nil &&
-			etcd.Spec.Backup.Store.SecretRef != nil &&
-			etcd.Spec.Backup.Store.SecretRef.Name == secretName {
+		if slices.Contains(druidresources.GetReferencedSecretNames(&etcd), secretName) {
 			return true, &etcd
 		}
 	}
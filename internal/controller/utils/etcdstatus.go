@@ -6,6 +6,7 @@ package utils
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
@@ -29,17 +30,21 @@ type LastOperationAndLastErrorsRecorder interface {
 	RecordErrors(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, operationType druidapicommon.LastOperationType, operationResult ReconcileStepResult) error
 }
 
-// NewLastOperationAndLastErrorsRecorder returns a new LastOperationAndLastErrorsRecorder.
-func NewLastOperationAndLastErrorsRecorder(client client.Client, logger logr.Logger) LastOperationAndLastErrorsRecorder {
+// NewLastOperationAndLastErrorsRecorder returns a new LastOperationAndLastErrorsRecorder. If operationHistoryLimit is
+// greater than zero, then completed operations are additionally recorded in etcd.Status.OperationHistory, which is
+// bounded to operationHistoryLimit entries.
+func NewLastOperationAndLastErrorsRecorder(client client.Client, logger logr.Logger, operationHistoryLimit int) LastOperationAndLastErrorsRecorder {
 	return &lastOpErrRecorder{
-		client: client,
-		logger: logger,
+		client:                client,
+		logger:                logger,
+		operationHistoryLimit: operationHistoryLimit,
 	}
 }
 
 type lastOpErrRecorder struct {
-	client client.Client
-	logger logr.Logger
+	client                client.Client
+	logger                logr.Logger
+	operationHistoryLimit int
 }
 
 func (l *lastOpErrRecorder) RecordStart(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, operationType druidapicommon.LastOperationType) error {
@@ -82,13 +87,74 @@ func (l *lastOpErrRecorder) RecordErrors(ctx component.OperatorContext, etcd *dr
 
 func (l *lastOpErrRecorder) recordLastOperationAndErrors(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, operationType druidapicommon.LastOperationType, operationState druidapicommon.LastOperationState, description string, lastErrors ...druidapicommon.LastError) error {
 	originalEtcd := etcd.DeepCopy()
+	now := metav1.NewTime(time.Now().UTC())
+	if l.operationHistoryLimit > 0 && operationState != druidv1alpha1.LastOperationStateProcessing {
+		l.recordOperationInHistory(ctx, etcd, operationType, operationState, description, now)
+	}
 	etcd.Status.LastOperation = &druidapicommon.LastOperation{
 		RunID:          ctx.RunID,
 		Type:           operationType,
 		State:          operationState,
-		LastUpdateTime: metav1.NewTime(time.Now().UTC()),
+		LastUpdateTime: now,
 		Description:    description,
 	}
 	etcd.Status.LastErrors = lastErrors
 	return l.client.Status().Patch(ctx, etcd, client.MergeFrom(originalEtcd))
 }
+
+// recordOperationInHistory adds the completed operation of the current run to etcd.Status.OperationHistory, unless it
+// is a periodic reconciliation of an unchanged spec which succeeded without changing any component. Consecutive
+// requeued or failed runs of the same operation and generation are coalesced into a single record, so that retries do
+// not displace the other records.
+func (l *lastOpErrRecorder) recordOperationInHistory(ctx component.OperatorContext, etcd *druidv1alpha1.Etcd, operationType druidapicommon.LastOperationType, operationState druidapicommon.LastOperationState, description string, completionTime metav1.Time) {
+	changedComponents := ctx.GetChangedComponents()
+	if operationType == druidv1alpha1.LastOperationTypeReconcile && operationState == druidv1alpha1.LastOperationStateSucceeded && len(changedComponents) == 0 && !IsNewReconcileRequest(etcd) {
+		return
+	}
+	// The last operation still holds the start of the current run, which has been recorded by RecordStart.
+	startTime := completionTime
+	if lastOp := etcd.Status.LastOperation; lastOp != nil && lastOp.RunID == ctx.RunID && lastOp.State == druidv1alpha1.LastOperationStateProcessing {
+		startTime = lastOp.LastUpdateTime
+	}
+	record := druidv1alpha1.EtcdOperationRecord{
+		RunID:             ctx.RunID,
+		Type:              operationType,
+		State:             operationState,
+		StartTime:         startTime,
+		CompletionTime:    completionTime,
+		Generation:        etcd.Generation,
+		ChangedComponents: changedComponents,
+		Description:       description,
+	}
+	existingHistory := etcd.Status.OperationHistory
+	if len(existingHistory) > 0 && isRetryOf(record, existingHistory[0]) {
+		record.StartTime = existingHistory[0].StartTime
+		for _, kind := range existingHistory[0].ChangedComponents {
+			if !slices.Contains(record.ChangedComponents, kind) {
+				record.ChangedComponents = append(record.ChangedComponents, kind)
+			}
+		}
+		existingHistory = existingHistory[1:]
+	}
+	history := make([]druidv1alpha1.EtcdOperationRecord, 0, len(existingHistory)+1)
+	history = append(history, record)
+	for _, existingRecord := range existingHistory {
+		if existingRecord.RunID != record.RunID {
+			history = append(history, existingRecord)
+		}
+	}
+	if len(history) > l.operationHistoryLimit {
+		history = history[:l.operationHistoryLimit]
+	}
+	etcd.Status.OperationHistory = history
+}
+
+// isRetryOf checks if the given record and the previous record are both requeued or failed runs of the same operation
+// for the same generation.
+func isRetryOf(record, previousRecord druidv1alpha1.EtcdOperationRecord) bool {
+	isIncomplete := func(state druidapicommon.LastOperationState) bool {
+		return state == druidv1alpha1.LastOperationStateRequeue || state == druidv1alpha1.LastOperationStateError
+	}
+	return isIncomplete(record.State) && isIncomplete(previousRecord.State) &&
+		record.Type == previousRecord.Type && record.Generation == previousRecord.Generation
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/client/kubernetes"
+	"github.com/gardener/etcd-druid/internal/component"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LastOperationAndLastErrorsRecorder Tests", func() {
+	var (
+		etcd *druidv1alpha1.Etcd
+		cl   client.Client
+	)
+
+	BeforeEach(func() {
+		etcd = testutils.EtcdBuilderWithDefaults("test-etcd", "test-ns").Build()
+		etcd.Generation = 2
+		etcd.Status.ObservedGeneration = ptr.To[int64](2)
+		cl = testutils.NewTestClientBuilder().WithScheme(kubernetes.Scheme).WithObjects(etcd).WithStatusSubresource(etcd).Build()
+	})
+
+	reconcile := func(recorder LastOperationAndLastErrorsRecorder, runID string, changedComponents ...component.Kind) {
+		ctx := component.NewOperatorContext(context.Background(), logr.Discard(), runID)
+		Expect(recorder.RecordStart(ctx, etcd, druidv1alpha1.LastOperationTypeReconcile)).To(Succeed())
+		for _, kind := range changedComponents {
+			ctx.RecordChangedComponent(kind)
+		}
+		Expect(recorder.RecordSuccess(ctx, etcd, druidv1alpha1.LastOperationTypeReconcile)).To(Succeed())
+	}
+
+	It("should not record an operation history if it is disabled", func() {
+		recorder := NewLastOperationAndLastErrorsRecorder(cl, logr.Discard(), 0)
+		reconcile(recorder, "run-1", component.StatefulSetKind)
+		Expect(etcd.Status.LastOperation.State).To(Equal(druidv1alpha1.LastOperationStateSucceeded))
+		Expect(etcd.Status.OperationHistory).To(BeEmpty())
+	})
+
+	It("should record completed operations which changed components", func() {
+		recorder := NewLastOperationAndLastErrorsRecorder(cl, logr.Discard(), 5)
+		reconcile(recorder, "run-1", component.ConfigMapKind, component.StatefulSetKind)
+
+		Expect(etcd.Status.OperationHistory).To(HaveLen(1))
+		record := etcd.Status.OperationHistory[0]
+		Expect(record.RunID).To(Equal("run-1"))
+		Expect(record.Type).To(Equal(druidv1alpha1.LastOperationTypeReconcile))
+		Expect(record.State).To(Equal(druidv1alpha1.LastOperationStateSucceeded))
+		Expect(record.Generation).To(Equal(int64(2)))
+		Expect(record.ChangedComponents).To(Equal([]string{string(component.ConfigMapKind), string(component.StatefulSetKind)}))
+		Expect(record.StartTime.Time).To(BeTemporally("<=", record.CompletionTime.Time))
+
+		persistedEtcd := &druidv1alpha1.Etcd{}
+		Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(etcd), persistedEtcd)).To(Succeed())
+		Expect(persistedEtcd.Status.OperationHistory).To(Equal(etcd.Status.OperationHistory))
+	})
+
+	It("should not record periodic reconciliations of an unchanged spec without changes", func() {
+		recorder := NewLastOperationAndLastErrorsRecorder(cl, logr.Discard(), 5)
+		reconcile(recorder, "run-1")
+		Expect(etcd.Status.OperationHistory).To(BeEmpty())
+	})
+
+	It("should record reconciliations of a new generation without changes", func() {
+		etcd.Status.ObservedGeneration = ptr.To[int64](1)
+		cl = testutils.NewTestClientBuilder().WithScheme(kubernetes.Scheme).WithObjects(etcd).WithStatusSubresource(etcd).Build()
+		recorder := NewLastOperationAndLastErrorsRecorder(cl, logr.Discard(), 5)
+		reconcile(recorder, "run-1")
+		Expect(etcd.Status.OperationHistory).To(HaveLen(1))
+		Expect(etcd.Status.OperationHistory[0].Generation).To(Equal(int64(2)))
+		Expect(etcd.Status.OperationHistory[0].ChangedComponents).To(BeEmpty())
+	})
+
+	It("should record failed operations", func() {
+		recorder := NewLastOperationAndLastErrorsRecorder(cl, logr.Discard(), 5)
+		ctx := component.NewOperatorContext(context.Background(), logr.Discard(), "run-1")
+		Expect(recorder.RecordStart(ctx, etcd, druidv1alpha1.LastOperationTypeReconcile)).To(Succeed())
+		Expect(recorder.RecordErrors(ctx, etcd, druidv1alpha1.LastOperationTypeReconcile, ReconcileWithError(fmt.Errorf("test error")))).To(Succeed())
+		Expect(etcd.Status.OperationHistory).To(HaveLen(1))
+		Expect(etcd.Status.OperationHistory[0].State).To(Equal(druidv1alpha1.LastOperationStateError))
+	})
+
+	It("should coalesce consecutive failed and requeued runs of the same generation", func() {
+		recorder := NewLastOperationAndLastErrorsRecorder(cl, logr.Discard(), 2)
+		reconcile(recorder, "run-1", component.ConfigMapKind)
+		var startTime time.Time
+		for i, result := range []ReconcileStepResult{ReconcileWithError(fmt.Errorf("test error")), ReconcileAfter(10*time.Second, "test requeue"), ReconcileWithError(fmt.Errorf("test error"))} {
+			ctx := component.NewOperatorContext(context.Background(), logr.Discard(), fmt.Sprintf("run-%d", i+2))
+			Expect(recorder.RecordStart(ctx, etcd, druidv1alpha1.LastOperationTypeReconcile)).To(Succeed())
+			if i == 0 {
+				startTime = etcd.Status.LastOperation.LastUpdateTime.Time
+				ctx.RecordChangedComponent(component.StatefulSetKind)
+			}
+			Expect(recorder.RecordErrors(ctx, etcd, druidv1alpha1.LastOperationTypeReconcile, result)).To(Succeed())
+		}
+
+		Expect(etcd.Status.OperationHistory).To(HaveLen(2))
+		record := etcd.Status.OperationHistory[0]
+		Expect(record.RunID).To(Equal("run-4"))
+		Expect(record.State).To(Equal(druidv1alpha1.LastOperationStateError))
+		Expect(record.Generation).To(Equal(int64(2)))
+		Expect(record.StartTime.Time).To(Equal(startTime))
+		Expect(record.ChangedComponents).To(Equal([]string{string(component.StatefulSetKind)}))
+		Expect(etcd.Status.OperationHistory[1].RunID).To(Equal("run-1"))
+	})
+
+	It("should keep only the most recent operations up to the limit", func() {
+		recorder := NewLastOperationAndLastErrorsRecorder(cl, logr.Discard(), 2)
+		for _, runID := range []string{"run-1", "run-2", "run-3"} {
+			reconcile(recorder, runID, component.StatefulSetKind)
+		}
+		Expect(etcd.Status.OperationHistory).To(HaveLen(2))
+		Expect(etcd.Status.OperationHistory[0].RunID).To(Equal("run-3"))
+		Expect(etcd.Status.OperationHistory[1].RunID).To(Equal("run-2"))
+	})
+})
@@ -28,6 +28,12 @@ func GetLatestEtcd(ctx context.Context, client client.Client, objectKey client.O
 	return ContinueReconcile()
 }
 
+// IsNewReconcileRequest checks if the spec of the given Etcd is reconciled because it has changed, or because the
+// reconciliation has been requested via annotation, as opposed to a periodic reconciliation of an unchanged spec.
+func IsNewReconcileRequest(etcd *druidv1alpha1.Etcd) bool {
+	return etcd.Status.ObservedGeneration == nil || *etcd.Status.ObservedGeneration != etcd.Generation || druidv1alpha1.HasReconcileOperationAnnotation(etcd.ObjectMeta)
+}
+
 // ReconcileStepResult holds the result of a reconcile step.
 type ReconcileStepResult struct {
 	result            ctrl.Result
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificate
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// WarningThreshold is the remaining validity of a certificate below which its expiry is considered a warning.
+	WarningThreshold = 30 * 24 * time.Hour
+	// CriticalThreshold is the remaining validity of a certificate below which its expiry is considered critical.
+	CriticalThreshold = 7 * 24 * time.Hour
+
+	defaultCADataKey = "ca.crt"
+)
+
+// Level is the level of the expiry of a certificate.
+type Level int
+
+const (
+	// LevelValid indicates that the certificate is valid for longer than the WarningThreshold.
+	LevelValid Level = iota
+	// LevelWarning indicates that the certificate expires within the WarningThreshold.
+	LevelWarning
+	// LevelCritical indicates that the certificate expires within the CriticalThreshold or has already expired.
+	LevelCritical
+)
+
+// Usage describes for which communication and in which role a certificate is used by an etcd cluster.
+type Usage string
+
+const (
+	// UsageClientCA is the CA used for the client communication with etcd.
+	UsageClientCA Usage = "client-ca"
+	// UsageClientServer is the server certificate used for the client communication with etcd.
+	UsageClientServer Usage = "client-server"
+	// UsageClientClient is the client certificate used for the client communication with etcd.
+	UsageClientClient Usage = "client-client"
+	// UsagePeerCA is the CA used for the peer communication within the etcd cluster.
+	UsagePeerCA Usage = "peer-ca"
+	// UsagePeerServer is the server certificate used for the peer communication within the etcd cluster.
+	UsagePeerServer Usage = "peer-server"
+	// UsageBackupCA is the CA used for the communication with etcd-backup-restore.
+	UsageBackupCA Usage = "backup-ca"
+	// UsageBackupServer is the server certificate used by etcd-backup-restore.
+	UsageBackupServer Usage = "backup-server"
+	// UsageBackupClient is the client certificate used for the communication with etcd-backup-restore.
+	UsageBackupClient Usage = "backup-client"
+)
+
+// Info describes a certificate contained in a TLS secret referenced by an Etcd.
+type Info struct {
+	// SecretName is the name of the secret containing the certificate.
+	SecretName string
+	// Usage describes how the certificate is used by the etcd cluster.
+	Usage Usage
+	// CommonName is the common name of the subject of the certificate.
+	CommonName string
+	// NotAfter is the time at which the certificate expires.
+	NotAfter time.Time
+	// SANMismatch describes the host names the certificate is used for but which are not contained in its subject
+	// alternative names. It is empty if all host names are contained, or if the certificate is not used for a host name.
+	SANMismatch string
+}
+
+// Inspection is the result of inspecting the certificates referenced by an Etcd.
+type Inspection struct {
+	// Infos describe the certificates of all secrets which could be inspected.
+	Infos []Info
+	// Err joins the errors of all secrets which could not be read or parsed.
+	Err error
+}
+
+// Level returns the level of the expiry of the certificate at the given time.
+func (i Info) Level(now time.Time) Level {
+	remaining := i.NotAfter.Sub(now)
+	switch {
+	case remaining < CriticalThreshold:
+		return LevelCritical
+	case remaining < WarningThreshold:
+		return LevelWarning
+	default:
+		return LevelValid
+	}
+}
+
+// Describe returns a human-readable description of the certificate and its expiry at the given time.
+func (i Info) Describe(now time.Time) string {
+	if !now.Before(i.NotAfter) {
+		return fmt.Sprintf("%s certificate %q in secret %s expired at %s", i.Usage, i.CommonName, i.SecretName, i.NotAfter.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("%s certificate %q in secret %s expires at %s", i.Usage, i.CommonName, i.SecretName, i.NotAfter.UTC().Format(time.RFC3339))
+}
+
+// secretKey identifies the data of a secret containing a certificate, along with its usage.
+type secretKey struct {
+	name    string
+	dataKey string
+	usage   Usage
+	isCA    bool
+}
+
+// Inspect parses the certificates contained in all TLS secrets referenced by the given Etcd, including the
+// certificates managed by etcd-druid. For CA bundles, the CA which expires last is returned. Server certificates are
+// additionally checked against the names of the client and peer services. An error is returned for every secret which
+// cannot be read or parsed, along with the certificates of all other secrets.
+func Inspect(ctx context.Context, cl client.Client, etcd *druidv1alpha1.Etcd) ([]Info, error) {
+	var keys []secretKey
+	if tlsConfig := etcd.GetClientURLTLS(); tlsConfig != nil {
+		keys = append(keys, getSecretKeys(tlsConfig, UsageClientCA, UsageClientServer, UsageClientClient)...)
+	}
+	if peerTLSConfig := etcd.GetPeerURLTLS(); peerTLSConfig != nil {
+		keys = append(keys, getSecretKeys(&peerTLSConfig.TLSConfig, UsagePeerCA, UsagePeerServer, "")...)
+	}
+	if tlsConfig := etcd.GetBackupTLS(); tlsConfig != nil {
+		keys = append(keys, getSecretKeys(tlsConfig, UsageBackupCA, UsageBackupServer, UsageBackupClient)...)
+	}
+
+	var (
+		infos []Info
+		errs  []error
+	)
+	for _, key := range keys {
+		secret := &corev1.Secret{}
+		if err := cl.Get(ctx, client.ObjectKey{Name: key.name, Namespace: etcd.Namespace}, secret); err != nil {
+			errs = append(errs, fmt.Errorf("failed to get secret %s: %w", key.name, err))
+			continue
+		}
+		certs, err := parseCertificates(secret.Data[key.dataKey])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse certificate from key %s of secret %s: %w", key.dataKey, key.name, err))
+			continue
+		}
+		if len(certs) == 0 {
+			errs = append(errs, fmt.Errorf("no certificate found in key %s of secret %s", key.dataKey, key.name))
+			continue
+		}
+		// The leaf certificate is the first one, any further certificates are intermediates. A CA bundle is valid as
+		// long as any of its CAs is valid, e.g. during a rotation of the CA.
+		cert := certs[0]
+		if key.isCA {
+			cert = slices.MaxFunc(certs, func(a, b *x509.Certificate) int { return a.NotAfter.Compare(b.NotAfter) })
+		}
+		infos = append(infos, Info{
+			SecretName:  key.name,
+			Usage:       key.usage,
+			CommonName:  cert.Subject.CommonName,
+			NotAfter:    cert.NotAfter,
+			SANMismatch: checkSANs(etcd, key.usage, cert),
+		})
+	}
+	return infos, errors.Join(errs...)
+}
+
+func getSecretKeys(tlsConfig *druidv1alpha1.TLSConfig, caUsage, serverUsage, clientUsage Usage) []secretKey {
+	keys := []secretKey{
+		{name: tlsConfig.TLSCASecretRef.Name, dataKey: ptr.Deref(tlsConfig.TLSCASecretRef.DataKey, defaultCADataKey), usage: caUsage, isCA: true},
+		{name: tlsConfig.ServerTLSSecretRef.Name, dataKey: corev1.TLSCertKey, usage: serverUsage},
+	}
+	if clientUsage != "" && tlsConfig.ClientTLSSecretRef.Name != "" {
+		keys = append(keys, secretKey{name: tlsConfig.ClientTLSSecretRef.Name, dataKey: corev1.TLSCertKey, usage: clientUsage})
+	}
+	return keys
+}
+
+// checkSANs checks the subject alternative names of server certificates against the host names under which the
+// respective server is reached by the clients and peers of the etcd cluster.
+func checkSANs(etcd *druidv1alpha1.Etcd, usage Usage, cert *x509.Certificate) string {
+	switch usage {
+	case UsageClientServer:
+		clientServiceName := druidv1alpha1.GetClientServiceName(etcd.ObjectMeta)
+		hostNames := []string{
+			clientServiceName,
+			fmt.Sprintf("%s.%s", clientServiceName, etcd.Namespace),
+			fmt.Sprintf("%s.%s.svc", clientServiceName, etcd.Namespace),
+			fmt.Sprintf("%s.%s.svc.cluster.local", clientServiceName, etcd.Namespace),
+		}
+		for _, hostName := range hostNames {
+			if cert.VerifyHostname(hostName) == nil {
+				return ""
+			}
+		}
+		return fmt.Sprintf("none of the host names of client service %s", clientServiceName)
+	case UsagePeerServer:
+		var missing []string
+		for i := range max(int(etcd.Spec.Replicas), 1) {
+			hostName := fmt.Sprintf("%s.%s.%s.svc", druidv1alpha1.GetOrdinalPodName(etcd.ObjectMeta, i), druidv1alpha1.GetPeerServiceName(etcd.ObjectMeta), etcd.Namespace)
+			if cert.VerifyHostname(hostName) != nil {
+				missing = append(missing, hostName)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Sprintf("peer host names %s", strings.Join(missing, ", "))
+		}
+	}
+	return ""
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
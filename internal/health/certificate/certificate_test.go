@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestInfoLevel(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name          string
+		notAfter      time.Time
+		expectedLevel Level
+	}{
+		{name: "valid for longer than the warning threshold", notAfter: now.Add(60 * 24 * time.Hour), expectedLevel: LevelValid},
+		{name: "expires within the warning threshold", notAfter: now.Add(20 * 24 * time.Hour), expectedLevel: LevelWarning},
+		{name: "expires within the critical threshold", notAfter: now.Add(24 * time.Hour), expectedLevel: LevelCritical},
+		{name: "already expired", notAfter: now.Add(-time.Hour), expectedLevel: LevelCritical},
+	}
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(Info{NotAfter: test.notAfter}.Level(now)).To(Equal(test.expectedLevel))
+		})
+	}
+}
+
+func TestInspect(t *testing.T) {
+	now := time.Now()
+	clientHostNames := []string{"etcd-test-client", "etcd-test-client.test-ns.svc"}
+	peerHostNames := []string{"*.etcd-test-peer.test-ns.svc"}
+	tests := []struct {
+		name                string
+		secrets             []client.Object
+		expectedInfos       []Info
+		expectErr           bool
+		expectSANMismatches map[Usage]bool
+	}{
+		{
+			name: "all certificates valid and matching",
+			secrets: []client.Object{
+				newSecret(t, testutils.ClientTLSCASecretName, "ca.crt", newCertificatePEM(t, "ca-old", nil, now.Add(-time.Hour), true), newCertificatePEM(t, "ca-new", nil, now.Add(365*24*time.Hour), true)),
+				newSecret(t, testutils.ClientTLSServerCertSecretName, corev1.TLSCertKey, newCertificatePEM(t, "etcd-server", clientHostNames, now.Add(90*24*time.Hour), false)),
+				newSecret(t, testutils.ClientTLSClientCertSecretName, corev1.TLSCertKey, newCertificatePEM(t, "etcd-client", nil, now.Add(10*24*time.Hour), false)),
+				newSecret(t, testutils.PeerTLSCASecretName, "ca.crt", newCertificatePEM(t, "ca-peer", nil, now.Add(365*24*time.Hour), true)),
+				newSecret(t, testutils.PeerTLSServerCertSecretName, corev1.TLSCertKey, newCertificatePEM(t, "etcd-peer", peerHostNames, now.Add(90*24*time.Hour), false)),
+			},
+			expectedInfos: []Info{
+				{SecretName: testutils.ClientTLSCASecretName, Usage: UsageClientCA, CommonName: "ca-new"},
+				{SecretName: testutils.ClientTLSServerCertSecretName, Usage: UsageClientServer, CommonName: "etcd-server"},
+				{SecretName: testutils.ClientTLSClientCertSecretName, Usage: UsageClientClient, CommonName: "etcd-client"},
+				{SecretName: testutils.PeerTLSCASecretName, Usage: UsagePeerCA, CommonName: "ca-peer"},
+				{SecretName: testutils.PeerTLSServerCertSecretName, Usage: UsagePeerServer, CommonName: "etcd-peer"},
+			},
+		},
+		{
+			name: "server certificates not matching the host names",
+			secrets: []client.Object{
+				newSecret(t, testutils.ClientTLSCASecretName, "ca.crt", newCertificatePEM(t, "ca", nil, now.Add(365*24*time.Hour), true)),
+				newSecret(t, testutils.ClientTLSServerCertSecretName, corev1.TLSCertKey, newCertificatePEM(t, "etcd-server", []string{"etcd-other-client"}, now.Add(90*24*time.Hour), false)),
+				newSecret(t, testutils.ClientTLSClientCertSecretName, corev1.TLSCertKey, newCertificatePEM(t, "etcd-client", nil, now.Add(90*24*time.Hour), false)),
+				newSecret(t, testutils.PeerTLSCASecretName, "ca.crt", newCertificatePEM(t, "ca-peer", nil, now.Add(365*24*time.Hour), true)),
+				newSecret(t, testutils.PeerTLSServerCertSecretName, corev1.TLSCertKey, newCertificatePEM(t, "etcd-peer", []string{"etcd-test-0.etcd-test-peer.test-ns.svc"}, now.Add(90*24*time.Hour), false)),
+			},
+			expectedInfos: []Info{
+				{SecretName: testutils.ClientTLSCASecretName, Usage: UsageClientCA, CommonName: "ca"},
+				{SecretName: testutils.ClientTLSServerCertSecretName, Usage: UsageClientServer, CommonName: "etcd-server"},
+				{SecretName: testutils.ClientTLSClientCertSecretName, Usage: UsageClientClient, CommonName: "etcd-client"},
+				{SecretName: testutils.PeerTLSCASecretName, Usage: UsagePeerCA, CommonName: "ca-peer"},
+				{SecretName: testutils.PeerTLSServerCertSecretName, Usage: UsagePeerServer, CommonName: "etcd-peer"},
+			},
+			expectSANMismatches: map[Usage]bool{UsageClientServer: true, UsagePeerServer: true},
+		},
+		{
+			name: "missing and invalid secrets",
+			secrets: []client.Object{
+				newSecret(t, testutils.ClientTLSCASecretName, "ca.crt", newCertificatePEM(t, "ca", nil, now.Add(365*24*time.Hour), true)),
+				newSecret(t, testutils.ClientTLSServerCertSecretName, corev1.TLSCertKey, []byte("invalid")),
+				newSecret(t, testutils.PeerTLSCASecretName, "ca.crt", newCertificatePEM(t, "ca-peer", nil, now.Add(365*24*time.Hour), true)),
+				newSecret(t, testutils.PeerTLSServerCertSecretName, corev1.TLSCertKey, newCertificatePEM(t, "etcd-peer", peerHostNames, now.Add(90*24*time.Hour), false)),
+			},
+			expectedInfos: []Info{
+				{SecretName: testutils.ClientTLSCASecretName, Usage: UsageClientCA, CommonName: "ca"},
+				{SecretName: testutils.PeerTLSCASecretName, Usage: UsagePeerCA, CommonName: "ca-peer"},
+				{SecretName: testutils.PeerTLSServerCertSecretName, Usage: UsagePeerServer, CommonName: "etcd-peer"},
+			},
+			expectErr: true,
+		},
+	}
+
+	t.Parallel()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			etcd := testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithReplicas(3).WithClientTLS().WithPeerTLS().Build()
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, test.secrets)
+
+			infos, err := Inspect(context.Background(), cl, etcd)
+			if test.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(infos).To(HaveLen(len(test.expectedInfos)))
+			for i, expected := range test.expectedInfos {
+				g.Expect(infos[i].SecretName).To(Equal(expected.SecretName))
+				g.Expect(infos[i].Usage).To(Equal(expected.Usage))
+				g.Expect(infos[i].CommonName).To(Equal(expected.CommonName))
+				g.Expect(infos[i].NotAfter).ToNot(BeZero())
+				if test.expectSANMismatches[expected.Usage] {
+					g.Expect(infos[i].SANMismatch).ToNot(BeEmpty())
+				} else {
+					g.Expect(infos[i].SANMismatch).To(BeEmpty())
+				}
+			}
+		})
+	}
+}
+
+func newSecret(t *testing.T, name, dataKey string, data ...[]byte) *corev1.Secret {
+	t.Helper()
+	var content []byte
+	for _, d := range data {
+		content = append(content, d...)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testutils.TestNamespace},
+		Data:       map[string][]byte{dataKey: content},
+	}
+}
+
+func newCertificatePEM(t *testing.T, commonName string, dnsNames []string, notAfter time.Time, isCA bool) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              dnsNames,
+		NotBefore:             notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -22,6 +22,7 @@ var skipMergeConditions = map[druidv1alpha1.ConditionType]struct{}{
 	druidv1alpha1.ConditionTypeDataVolumesReady:                {},
 	druidv1alpha1.ConditionTypeClusterIDMismatch:               {},
 	druidv1alpha1.ConditionTypeBootstrappedWithExistingCluster: {},
+	druidv1alpha1.ConditionTypeCertificatesValid:               {},
 }
 
 // Builder is an interface for building conditions.
@@ -6,10 +6,11 @@ package condition
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/utils"
 
 	coordinationv1 "k8s.io/api/coordination/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -29,6 +30,12 @@ const (
 	Unknown string = "Unknown"
 	// NotChecked is a constant that means that the etcd backup status has not been updated or rechecked
 	NotChecked string = "NotChecked"
+	// RPOHealthy is a constant that means that the etcd backups meet the configured recovery point objectives and are taken as per schedule
+	RPOHealthy string = "RPOHealthy"
+	// RPODegraded is a constant that means that the etcd backups still meet the configured recovery point objectives but are not taken as per schedule
+	RPODegraded string = "RPODegraded"
+	// RPOBreached is a constant that means that the etcd backups do not meet the configured recovery point objectives
+	RPOBreached string = "RPOBreached"
 )
 
 func (a *backupReadyCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) Result {
@@ -58,7 +65,7 @@ func (a *backupReadyCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) R
 
 	// Compute the full snapshot interval if full snapshot schedule is set
 	if etcd.Spec.Backup.FullSnapshotSchedule != nil {
-		if fullSnapshotInterval, err = utils.ComputeScheduleInterval(*etcd.Spec.Backup.FullSnapshotSchedule); err != nil {
+		if fullSnapshotInterval, err = druidv1alpha1.ComputeScheduleInterval(*etcd.Spec.Backup.FullSnapshotSchedule); err != nil {
 			return result
 		}
 	}
@@ -72,6 +79,18 @@ func (a *backupReadyCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) R
 	fullLeaseRenewTime := fullSnapLease.Spec.RenewTime
 	fullLeaseCreateTime := &fullSnapLease.CreationTimestamp
 
+	if etcd.Spec.Backup.RecoveryPointObjectives != nil {
+		fullSnapshotTime := fullLeaseCreateTime.Time
+		if fullLeaseRenewTime != nil {
+			fullSnapshotTime = fullLeaseRenewTime.Time
+		}
+		var deltaSnapshotTime *time.Time
+		if deltaLeaseRenewTime != nil {
+			deltaSnapshotTime = &deltaLeaseRenewTime.Time
+		}
+		return checkRecoveryPointObjectives(result, etcd.Spec.Backup, fullSnapshotTime, deltaSnapshotTime, fullSnapshotInterval, time.Now())
+	}
+
 	if fullLeaseRenewTime == nil && deltaLeaseRenewTime != nil {
 		// Most probable during reconcile of existing clusters if fresh leases are created
 		// Treat backup as succeeded if delta snap lease renewal happens in the required time window and full snap lease is not older than fullSnapshotInterval
@@ -124,6 +143,47 @@ func (a *backupReadyCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) R
 	return result
 }
 
+// checkRecoveryPointObjectives computes the BackupReady condition result from the configured recovery point objectives.
+// Backups are considered breached if the latest snapshots are older than any of the objectives, degraded if they still meet
+// the objectives but are older than expected as per their schedule, and healthy otherwise.
+func checkRecoveryPointObjectives(result *result, backupSpec druidv1alpha1.BackupSpec, fullSnapshotTime time.Time, deltaSnapshotTime *time.Time, fullSnapshotInterval time.Duration, now time.Time) Result {
+	rpo := backupSpec.RecoveryPointObjectives
+	fullSnapshotAge := now.Sub(fullSnapshotTime)
+	// A full snapshot is a recovery point as well, hence the latest snapshot is the more recent one of both.
+	latestSnapshotAge := fullSnapshotAge
+	if deltaSnapshotTime != nil {
+		latestSnapshotAge = min(latestSnapshotAge, now.Sub(*deltaSnapshotTime))
+	}
+
+	var breaches, delays []string
+	if rpo.MaxFullSnapshotAge != nil && fullSnapshotAge > rpo.MaxFullSnapshotAge.Duration {
+		breaches = append(breaches, fmt.Sprintf("latest full snapshot is %s old, exceeding the objective of %s", fullSnapshotAge.Round(time.Second), rpo.MaxFullSnapshotAge.Duration))
+	} else if fullSnapshotAge >= fullSnapshotInterval {
+		delays = append(delays, fmt.Sprintf("latest full snapshot is %s old, exceeding the schedule interval of %s", fullSnapshotAge.Round(time.Second), fullSnapshotInterval))
+	}
+	if rpo.MaxDeltaSnapshotAge != nil && latestSnapshotAge > rpo.MaxDeltaSnapshotAge.Duration {
+		breaches = append(breaches, fmt.Sprintf("latest snapshot is %s old, exceeding the objective of %s", latestSnapshotAge.Round(time.Second), rpo.MaxDeltaSnapshotAge.Duration))
+	} else if backupSpec.DeltaSnapshotPeriod != nil && latestSnapshotAge >= 2*backupSpec.DeltaSnapshotPeriod.Duration {
+		delays = append(delays, fmt.Sprintf("latest snapshot is %s old, exceeding twice the delta snapshot period of %s", latestSnapshotAge.Round(time.Second), backupSpec.DeltaSnapshotPeriod.Duration))
+	}
+
+	switch {
+	case len(breaches) > 0:
+		result.status = druidv1alpha1.ConditionFalse
+		result.reason = RPOBreached
+		result.message = "Recovery point objectives breached: " + strings.Join(breaches, ", ")
+	case len(delays) > 0:
+		result.status = druidv1alpha1.ConditionTrue
+		result.reason = RPODegraded
+		result.message = "Recovery point objectives met, but snapshots are delayed: " + strings.Join(delays, ", ")
+	default:
+		result.status = druidv1alpha1.ConditionTrue
+		result.reason = RPOHealthy
+		result.message = "Recovery point objectives met"
+	}
+	return result
+}
+
 // BackupReadyCheck returns a check for the "BackupReady" condition.
 func BackupReadyCheck(cl client.Client) Checker {
 	return &backupReadyCheck{
@@ -224,6 +224,78 @@ var _ = Describe("BackupReadyCheck", func() {
 			})
 		})
 
+		Context("With recovery point objectives configured", func() {
+			var rpoEtcd *druidv1alpha1.Etcd
+
+			BeforeEach(func() {
+				rpoEtcd = etcd.DeepCopy()
+				rpoEtcd.Spec.Backup.RecoveryPointObjectives = &druidv1alpha1.RecoveryPointObjectives{
+					MaxFullSnapshotAge:  &v1.Duration{Duration: 25 * time.Hour},
+					MaxDeltaSnapshotAge: &v1.Duration{Duration: 10 * time.Minute},
+				}
+			})
+
+			expectLeasesRenewedAt := func(renewTime time.Time) {
+				cl.EXPECT().Get(context.TODO(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, le *coordinationv1.Lease, _ ...client.GetOption) error {
+						*le = lease
+						le.Spec.RenewTime = &v1.MicroTime{Time: renewTime}
+						return nil
+					},
+				).AnyTimes()
+			}
+
+			It("Should set status to RPOHealthy if snapshots are taken as per schedule", func() {
+				expectLeasesRenewedAt(time.Now())
+
+				check := BackupReadyCheck(cl)
+				result := check.Check(context.TODO(), *rpoEtcd)
+
+				Expect(result).ToNot(BeNil())
+				Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionTypeBackupReady))
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+				Expect(result.Reason()).To(Equal(RPOHealthy))
+			})
+
+			It("Should set status to RPODegraded if snapshots are delayed but objectives are met", func() {
+				expectLeasesRenewedAt(time.Now().Add(-3 * deltaSnapshotDuration))
+
+				check := BackupReadyCheck(cl)
+				result := check.Check(context.TODO(), *rpoEtcd)
+
+				Expect(result).ToNot(BeNil())
+				Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionTypeBackupReady))
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+				Expect(result.Reason()).To(Equal(RPODegraded))
+			})
+
+			It("Should set status to RPOBreached if the latest snapshot is older than the objective", func() {
+				expectLeasesRenewedAt(time.Now().Add(-30 * time.Minute))
+
+				check := BackupReadyCheck(cl)
+				result := check.Check(context.TODO(), *rpoEtcd)
+
+				Expect(result).ToNot(BeNil())
+				Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionTypeBackupReady))
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+				Expect(result.Reason()).To(Equal(RPOBreached))
+				Expect(result.Message()).To(ContainSubstring("latest snapshot is 30m0s old"))
+			})
+
+			It("Should set status to RPOBreached if the full snapshot is older than the objective", func() {
+				rpoEtcd.Spec.Backup.RecoveryPointObjectives.MaxFullSnapshotAge = &v1.Duration{Duration: time.Minute}
+				expectLeasesRenewedAt(time.Now().Add(-2 * time.Minute))
+
+				check := BackupReadyCheck(cl)
+				result := check.Check(context.TODO(), *rpoEtcd)
+
+				Expect(result).ToNot(BeNil())
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+				Expect(result.Reason()).To(Equal(RPOBreached))
+				Expect(result.Message()).To(ContainSubstring("latest full snapshot"))
+			})
+		})
+
 		Context("With no backup store configured", func() {
 			It("Should return nil condition", func() {
 				cl.EXPECT().Get(context.TODO(), gomock.Any(), gomock.Any()).DoAndReturn(
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/health/certificate"
+)
+
+const (
+	// CertificatesValid is a constant that means that all certificates are valid for longer than the warning threshold.
+	CertificatesValid string = "CertificatesValid"
+	// CertificatesExpiryWarning is a constant that means that at least one certificate expires within the warning threshold.
+	CertificatesExpiryWarning string = "CertificatesExpiryWarning"
+	// CertificatesExpiryCritical is a constant that means that at least one certificate expires within the critical threshold or has expired.
+	CertificatesExpiryCritical string = "CertificatesExpiryCritical"
+	// CertificatesSANMismatch is a constant that means that at least one server certificate does not match the host names of the etcd cluster.
+	CertificatesSANMismatch string = "CertificatesSANMismatch"
+)
+
+type certificatesValidCheck struct {
+	certificates certificate.Inspection
+}
+
+func (c *certificatesValidCheck) Check(_ context.Context, etcd druidv1alpha1.Etcd) Result {
+	// Do not add the CertificatesValid condition if TLS is not configured
+	if etcd.GetClientURLTLS() == nil && etcd.GetPeerURLTLS() == nil && etcd.GetBackupTLS() == nil {
+		return nil
+	}
+	result := &result{
+		conType: druidv1alpha1.ConditionTypeCertificatesValid,
+		status:  druidv1alpha1.ConditionUnknown,
+		reason:  Unknown,
+	}
+	evaluateCertificates(result, c.certificates.Infos, time.Now())
+	if err := c.certificates.Err; err != nil {
+		// Certificates which could be inspected still determine the status if they are not valid, as the certificates
+		// which could not be inspected cannot make the status any better.
+		if result.status == druidv1alpha1.ConditionTrue {
+			result.status = druidv1alpha1.ConditionUnknown
+			result.reason = Unknown
+			result.message = fmt.Sprintf("Cannot inspect certificates: %v", err)
+		} else {
+			result.message += fmt.Sprintf(". Cannot inspect certificates: %v", err)
+		}
+	}
+	return result
+}
+
+// evaluateCertificates computes the CertificatesValid condition result from the given certificates. Certificates
+// expiring within the critical threshold and server certificates not matching the host names of the etcd cluster
+// result in a status of False, while certificates expiring within the warning threshold only change the reason.
+func evaluateCertificates(result *result, infos []certificate.Info, now time.Time) {
+	var critical, warnings, mismatches []string
+	for _, info := range infos {
+		switch info.Level(now) {
+		case certificate.LevelCritical:
+			critical = append(critical, info.Describe(now))
+		case certificate.LevelWarning:
+			warnings = append(warnings, info.Describe(now))
+		}
+		if info.SANMismatch != "" {
+			mismatches = append(mismatches, fmt.Sprintf("%s certificate %q in secret %s does not contain %s", info.Usage, info.CommonName, info.SecretName, info.SANMismatch))
+		}
+	}
+
+	switch {
+	case len(critical) > 0:
+		result.status = druidv1alpha1.ConditionFalse
+		result.reason = CertificatesExpiryCritical
+		result.message = fmt.Sprintf("Certificates expire within %s: %s", certificate.CriticalThreshold, strings.Join(append(critical, mismatches...), "; "))
+	case len(mismatches) > 0:
+		result.status = druidv1alpha1.ConditionFalse
+		result.reason = CertificatesSANMismatch
+		result.message = "Certificates do not match the host names of the etcd cluster: " + strings.Join(mismatches, "; ")
+	case len(warnings) > 0:
+		result.status = druidv1alpha1.ConditionTrue
+		result.reason = CertificatesExpiryWarning
+		result.message = fmt.Sprintf("Certificates expire within %s: %s", certificate.WarningThreshold, strings.Join(warnings, "; "))
+	default:
+		result.status = druidv1alpha1.ConditionTrue
+		result.reason = CertificatesValid
+		result.message = "All certificates are valid"
+	}
+}
+
+// CertificatesValidCheck returns a check for the "CertificatesValid" condition, which evaluates the given inspection of
+// the certificates referenced by the Etcd.
+func CertificatesValidCheck(certificates certificate.Inspection) Checker {
+	return &certificatesValidCheck{
+		certificates: certificates,
+	}
+}
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/health/certificate"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/gardener/etcd-druid/internal/health/condition"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CertificatesValidCheck", func() {
+	Describe("#Check", func() {
+		var (
+			etcd            *druidv1alpha1.Etcd
+			clientHostNames = []string{"etcd-test-client.test-ns.svc"}
+		)
+
+		newCertificateSecret := func(name, dataKey string, dnsNames []string, validity time.Duration, isCA bool) *corev1.Secret {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			now := time.Now()
+			template := &x509.Certificate{
+				SerialNumber:          big.NewInt(1),
+				Subject:               pkix.Name{CommonName: name},
+				DNSNames:              dnsNames,
+				NotBefore:             now.Add(-time.Hour),
+				NotAfter:              now.Add(validity),
+				IsCA:                  isCA,
+				BasicConstraintsValid: true,
+			}
+			der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+			Expect(err).ToNot(HaveOccurred())
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testutils.TestNamespace},
+				Data:       map[string][]byte{dataKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})},
+			}
+		}
+
+		clientTLSSecrets := func(serverDNSNames []string, serverValidity time.Duration) []client.Object {
+			return []client.Object{
+				newCertificateSecret(testutils.ClientTLSCASecretName, "ca.crt", nil, 365*24*time.Hour, true),
+				newCertificateSecret(testutils.ClientTLSServerCertSecretName, corev1.TLSCertKey, serverDNSNames, serverValidity, false),
+				newCertificateSecret(testutils.ClientTLSClientCertSecretName, corev1.TLSCertKey, nil, 365*24*time.Hour, false),
+			}
+		}
+
+		inspect := func(objects ...client.Object) certificate.Inspection {
+			infos, err := certificate.Inspect(context.TODO(), testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, objects), etcd)
+			return certificate.Inspection{Infos: infos, Err: err}
+		}
+
+		BeforeEach(func() {
+			etcd = testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithClientTLS().Build()
+		})
+
+		It("should return nil if TLS is not configured", func() {
+			etcd.Spec.Etcd.ClientUrlTLS = nil
+			check := CertificatesValidCheck(certificate.Inspection{})
+
+			Expect(check.Check(context.TODO(), *etcd)).To(BeNil())
+		})
+
+		It("should return status Unknown if a secret cannot be read", func() {
+			check := CertificatesValidCheck(inspect())
+
+			result := check.Check(context.TODO(), *etcd)
+
+			Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionTypeCertificatesValid))
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+			Expect(result.Reason()).To(Equal(Unknown))
+			Expect(result.Message()).To(ContainSubstring(testutils.ClientTLSCASecretName))
+		})
+
+		It("should return status False if a certificate is about to expire even though another secret cannot be read", func() {
+			check := CertificatesValidCheck(inspect(clientTLSSecrets(clientHostNames, 24*time.Hour)[1:]...))
+
+			result := check.Check(context.TODO(), *etcd)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Reason()).To(Equal(CertificatesExpiryCritical))
+			Expect(result.Message()).To(ContainSubstring(testutils.ClientTLSServerCertSecretName))
+			Expect(result.Message()).To(ContainSubstring("Cannot inspect certificates"))
+			Expect(result.Message()).To(ContainSubstring(testutils.ClientTLSCASecretName))
+		})
+
+		It("should return status True if all certificates are valid", func() {
+			check := CertificatesValidCheck(inspect(clientTLSSecrets(clientHostNames, 365*24*time.Hour)...))
+
+			result := check.Check(context.TODO(), *etcd)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+			Expect(result.Reason()).To(Equal(CertificatesValid))
+		})
+
+		It("should return status True with a warning if a certificate expires soon", func() {
+			check := CertificatesValidCheck(inspect(clientTLSSecrets(clientHostNames, 20*24*time.Hour)...))
+
+			result := check.Check(context.TODO(), *etcd)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+			Expect(result.Reason()).To(Equal(CertificatesExpiryWarning))
+			Expect(result.Message()).To(ContainSubstring(testutils.ClientTLSServerCertSecretName))
+		})
+
+		It("should return status False if a certificate is about to expire", func() {
+			check := CertificatesValidCheck(inspect(clientTLSSecrets(clientHostNames, 24*time.Hour)...))
+
+			result := check.Check(context.TODO(), *etcd)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Reason()).To(Equal(CertificatesExpiryCritical))
+		})
+
+		It("should return status False if the server certificate does not match the client service", func() {
+			check := CertificatesValidCheck(inspect(clientTLSSecrets([]string{"etcd-other-client"}, 365*24*time.Hour)...))
+
+			result := check.Check(context.TODO(), *etcd)
+
+			Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+			Expect(result.Reason()).To(Equal(CertificatesSANMismatch))
+			Expect(result.Message()).To(ContainSubstring("etcd-test-client"))
+		})
+	})
+})
@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CustomCheckSucceeded is a constant that means that a custom health check succeeded.
+	CustomCheckSucceeded string = "CheckSucceeded"
+	// CustomCheckFailed is a constant that means that a custom health check failed.
+	CustomCheckFailed string = "CheckFailed"
+	// CustomCheckError is a constant that means that a custom health check could not be performed.
+	CustomCheckError string = "CheckError"
+
+	defaultCustomCheckTimeout  = 5 * time.Second
+	maxCustomCheckResponseSize = 16 << 20
+)
+
+// CustomCheckHTTPClients provides the HTTP clients used by custom health checks. A client is kept per etcd cluster and
+// per webhook, and is reused as long as its TLS configuration does not change, so that connections are reused between
+// checks. It must therefore be shared by the checks of all reconciliations.
+type CustomCheckHTTPClients struct {
+	newTransport func(tlsConfig *tls.Config) http.RoundTripper
+
+	mu      sync.Mutex
+	clients map[string]*customCheckHTTPClient
+}
+
+type customCheckHTTPClient struct {
+	tlsConfig *tls.Config
+	client    *http.Client
+}
+
+// NewCustomCheckHTTPClients returns CustomCheckHTTPClients which send the requests of the custom health checks.
+func NewCustomCheckHTTPClients() *CustomCheckHTTPClients {
+	return NewCustomCheckHTTPClientsWithTransport(func(tlsConfig *tls.Config) http.RoundTripper {
+		return &http.Transport{TLSClientConfig: tlsConfig}
+	})
+}
+
+// NewCustomCheckHTTPClientsWithTransport returns CustomCheckHTTPClients whose clients send the requests of the custom
+// health checks with the transports created by the given function.
+func NewCustomCheckHTTPClientsWithTransport(newTransport func(tlsConfig *tls.Config) http.RoundTripper) *CustomCheckHTTPClients {
+	return &CustomCheckHTTPClients{
+		newTransport: newTransport,
+		clients:      map[string]*customCheckHTTPClient{},
+	}
+}
+
+// Delete closes and drops the HTTP client of the given Etcd. It must be called once the Etcd has been deleted.
+func (c *CustomCheckHTTPClients) Delete(etcdObjMeta metav1.ObjectMeta) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := getEtcdHTTPClientKey(etcdObjMeta)
+	if cached, ok := c.clients[key]; ok {
+		cached.client.CloseIdleConnections()
+		delete(c.clients, key)
+	}
+}
+
+// get returns the HTTP client for the given key. A cached client is replaced if its TLS configuration differs from the
+// given one.
+func (c *CustomCheckHTTPClients) get(key string, tlsConfig *tls.Config) *http.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.clients[key]; ok {
+		if isTLSConfigEqual(cached.tlsConfig, tlsConfig) {
+			return cached.client
+		}
+		cached.client.CloseIdleConnections()
+	}
+	httpClient := &http.Client{Transport: c.newTransport(tlsConfig)}
+	c.clients[key] = &customCheckHTTPClient{tlsConfig: tlsConfig, client: httpClient}
+	return httpClient
+}
+
+// isTLSConfigEqual checks if the given TLS configurations trust the same CAs and present the same client certificates.
+func isTLSConfigEqual(a, b *tls.Config) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.ServerName != b.ServerName || a.MinVersion != b.MinVersion || !a.RootCAs.Equal(b.RootCAs) || len(a.Certificates) != len(b.Certificates) {
+		return false
+	}
+	for i := range a.Certificates {
+		if !slices.EqualFunc(a.Certificates[i].Certificate, b.Certificates[i].Certificate, bytes.Equal) {
+			return false
+		}
+	}
+	return true
+}
+
+func getEtcdHTTPClientKey(etcdObjMeta metav1.ObjectMeta) string {
+	return "etcd/" + etcdObjMeta.Namespace + "/" + etcdObjMeta.Name
+}
+
+type customCheck struct {
+	cl          client.Client
+	healthCheck druidapicommon.HealthCheck
+	httpClients *CustomCheckHTTPClients
+}
+
+// webhookResponse is the response expected from the endpoint of a webhook health check.
+type webhookResponse struct {
+	Status  druidv1alpha1.ConditionStatus `json:"status"`
+	Reason  string                        `json:"reason,omitempty"`
+	Message string                        `json:"message,omitempty"`
+}
+
+func (c *customCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) Result {
+	res := &result{
+		conType: druidv1alpha1.ConditionType(c.healthCheck.ConditionType),
+		status:  druidv1alpha1.ConditionUnknown,
+		reason:  CustomCheckError,
+	}
+	var err error
+	switch {
+	case c.healthCheck.HTTP != nil:
+		err = c.checkHTTP(ctx, &etcd, res)
+	case c.healthCheck.Metric != nil:
+		err = c.checkMetric(ctx, &etcd, res)
+	case c.healthCheck.Webhook != nil:
+		err = c.checkWebhook(ctx, &etcd, res)
+	default:
+		err = fmt.Errorf("no check configured")
+	}
+	if err != nil {
+		res.status = druidv1alpha1.ConditionUnknown
+		res.reason = CustomCheckError
+		res.message = fmt.Sprintf("Cannot perform health check: %v", err)
+	}
+	return res
+}
+
+func (c *customCheck) checkHTTP(ctx context.Context, etcd *druidv1alpha1.Etcd, res *result) error {
+	statusCode, _, err := c.getFromClientService(ctx, etcd, c.healthCheck.HTTP.Path)
+	if err != nil {
+		return err
+	}
+	expectedStatusCodes := c.healthCheck.HTTP.ExpectedStatusCodes
+	if len(expectedStatusCodes) == 0 {
+		expectedStatusCodes = []int32{http.StatusOK}
+	}
+	if !slices.Contains(expectedStatusCodes, int32(statusCode)) {
+		res.status, res.reason = druidv1alpha1.ConditionFalse, CustomCheckFailed
+		res.message = fmt.Sprintf("GET %s returned status code %d", c.healthCheck.HTTP.Path, statusCode)
+		return nil
+	}
+	res.status, res.reason = druidv1alpha1.ConditionTrue, CustomCheckSucceeded
+	res.message = fmt.Sprintf("GET %s returned status code %d", c.healthCheck.HTTP.Path, statusCode)
+	return nil
+}
+
+func (c *customCheck) checkMetric(ctx context.Context, etcd *druidv1alpha1.Etcd, res *result) error {
+	metricCheck := c.healthCheck.Metric
+	statusCode, data, err := c.getFromClientService(ctx, etcd, "/metrics")
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("GET /metrics returned status code %d", statusCode)
+	}
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to parse metrics: %w", err)
+	}
+	values := getMetricValues(families[metricCheck.Name], metricCheck.Labels)
+	if len(values) == 0 {
+		return fmt.Errorf("no samples found for metric %s", metricCheck.Name)
+	}
+	var violations []string
+	for _, value := range values {
+		if metricCheck.Min != nil && value < metricCheck.Min.AsApproximateFloat64() {
+			violations = append(violations, fmt.Sprintf("%g is below the minimum %s", value, metricCheck.Min.String()))
+		}
+		if metricCheck.Max != nil && value > metricCheck.Max.AsApproximateFloat64() {
+			violations = append(violations, fmt.Sprintf("%g is above the maximum %s", value, metricCheck.Max.String()))
+		}
+	}
+	if len(violations) > 0 {
+		res.status, res.reason = druidv1alpha1.ConditionFalse, CustomCheckFailed
+		res.message = fmt.Sprintf("Metric %s: %s", metricCheck.Name, strings.Join(violations, "; "))
+		return nil
+	}
+	res.status, res.reason = druidv1alpha1.ConditionTrue, CustomCheckSucceeded
+	res.message = fmt.Sprintf("Metric %s is within the thresholds", metricCheck.Name)
+	return nil
+}
+
+func (c *customCheck) checkWebhook(ctx context.Context, etcd *druidv1alpha1.Etcd, res *result) error {
+	webhook := c.healthCheck.Webhook
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(webhook.CABundle) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(webhook.CABundle) {
+			return fmt.Errorf("no CA certificate found in caBundle")
+		}
+	}
+	body, err := json.Marshal(etcd)
+	if err != nil {
+		return err
+	}
+	timeout := defaultCustomCheckTimeout
+	if webhook.Timeout != nil {
+		timeout = min(webhook.Timeout.Duration, druidapicommon.MaxWebhookHealthCheckTimeout)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	statusCode, data, err := doCustomCheckRequest(c.httpClients.get("webhook/"+c.healthCheck.ConditionType, tlsConfig), req)
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("webhook returned status code %d", statusCode)
+	}
+	response := webhookResponse{}
+	if err = json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("failed to decode webhook response: %w", err)
+	}
+	switch response.Status {
+	case druidv1alpha1.ConditionTrue:
+		res.reason = CustomCheckSucceeded
+	case druidv1alpha1.ConditionFalse:
+		res.reason = CustomCheckFailed
+	case druidv1alpha1.ConditionUnknown:
+		res.reason = CustomCheckError
+	default:
+		return fmt.Errorf("webhook returned invalid status %q", response.Status)
+	}
+	res.status = response.Status
+	if response.Reason != "" {
+		res.reason = response.Reason
+	}
+	res.message = response.Message
+	return nil
+}
+
+// getFromClientService sends a GET request for the given path to the client service of the etcd cluster.
+func (c *customCheck) getFromClientService(ctx context.Context, etcd *druidv1alpha1.Etcd, path string) (int, []byte, error) {
+	tlsConfig, err := kubernetes.GetEtcdClientTLSConfig(ctx, c.cl, etcd)
+	if err != nil {
+		return 0, nil, err
+	}
+	scheme := "http"
+	if tlsConfig != nil {
+		scheme = "https"
+	}
+	url := fmt.Sprintf("%s://%s.%s.svc:%d%s", scheme, druidv1alpha1.GetClientServiceName(etcd.ObjectMeta), etcd.Namespace, ptr.Deref(etcd.Spec.Etcd.ClientPort, druidv1alpha1.DefaultPortEtcdClient), path)
+	ctx, cancel := context.WithTimeout(ctx, defaultCustomCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	return doCustomCheckRequest(c.httpClients.get(getEtcdHTTPClientKey(etcd.ObjectMeta), tlsConfig), req)
+}
+
+func doCustomCheckRequest(httpClient *http.Client, req *http.Request) (int, []byte, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCustomCheckResponseSize))
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, data, nil
+}
+
+// getMetricValues returns the values of the samples of the given metric family which carry all the given labels.
+func getMetricValues(family *dto.MetricFamily, labels map[string]string) []float64 {
+	if family == nil {
+		return nil
+	}
+	var values []float64
+	for _, metric := range family.GetMetric() {
+		if !hasLabels(metric, labels) {
+			continue
+		}
+		switch {
+		case metric.Gauge != nil:
+			values = append(values, metric.GetGauge().GetValue())
+		case metric.Counter != nil:
+			values = append(values, metric.GetCounter().GetValue())
+		case metric.Untyped != nil:
+			values = append(values, metric.GetUntyped().GetValue())
+		}
+	}
+	return values
+}
+
+func hasLabels(metric *dto.Metric, labels map[string]string) bool {
+	for name, value := range labels {
+		if !slices.ContainsFunc(metric.GetLabel(), func(label *dto.LabelPair) bool {
+			return label.GetName() == name && label.GetValue() == value
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// CustomCheck returns a check for the given custom health check, whose result is published as a condition of the
+// type configured in the health check. The requests of the check are sent with the given HTTP clients.
+func CustomCheck(healthCheck druidapicommon.HealthCheck, httpClients *CustomCheckHTTPClients) func(client.Client) Checker {
+	return func(cl client.Client) Checker {
+		return &customCheck{
+			cl:          cl,
+			healthCheck: healthCheck,
+			httpClients: httpClients,
+		}
+	}
+}
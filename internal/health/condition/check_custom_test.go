@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package condition_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/etcd-druid/internal/health/condition"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CustomCheck", func() {
+	Describe("#Check", func() {
+		const metrics = `# TYPE etcd_mvcc_db_total_size_in_bytes gauge
+etcd_mvcc_db_total_size_in_bytes 2.147483648e+09
+# TYPE etcd_server_proposals_failed_total counter
+etcd_server_proposals_failed_total 3
+# TYPE etcd_network_peer_round_trip_time_seconds_sum untyped
+etcd_network_peer_round_trip_time_seconds_sum{To="a"} 0.5
+etcd_network_peer_round_trip_time_seconds_sum{To="b"} 5
+`
+		var (
+			etcd          *druidv1alpha1.Etcd
+			requestURLs   []string
+			statusCode    int
+			httpClients   *CustomCheckHTTPClients
+			tlsConfigs    []*tls.Config
+			fakeTransport http.RoundTripper
+		)
+
+		BeforeEach(func() {
+			etcd = testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).Build()
+			etcd.Spec.Etcd.ClientUrlTLS = nil
+			requestURLs = nil
+			statusCode = http.StatusOK
+			tlsConfigs = nil
+			fakeTransport = testutils.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				requestURLs = append(requestURLs, req.URL.String())
+				recorder := httptest.NewRecorder()
+				recorder.WriteHeader(statusCode)
+				_, _ = recorder.WriteString(metrics)
+				return recorder.Result(), nil
+			})
+			httpClients = NewCustomCheckHTTPClientsWithTransport(func(tlsConfig *tls.Config) http.RoundTripper {
+				tlsConfigs = append(tlsConfigs, tlsConfig)
+				return fakeTransport
+			})
+		})
+
+		check := func(healthCheck druidapicommon.HealthCheck) Result {
+			return CustomCheck(healthCheck, httpClients)(testutils.CreateDefaultFakeClient()).Check(context.TODO(), *etcd)
+		}
+
+		Context("http", func() {
+			It("should request the path on the client service and succeed for an expected status code", func() {
+				result := check(druidapicommon.HealthCheck{ConditionType: "Healthy", HTTP: &druidapicommon.HTTPHealthCheck{Path: "/health"}})
+
+				Expect(requestURLs).To(ConsistOf(fmt.Sprintf("http://%s.%s.svc:2379/health", druidv1alpha1.GetClientServiceName(etcd.ObjectMeta), etcd.Namespace)))
+				Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionType("Healthy")))
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+				Expect(result.Reason()).To(Equal(CustomCheckSucceeded))
+			})
+
+			It("should reuse the HTTP client of the etcd cluster until it is deleted", func() {
+				healthCheck := druidapicommon.HealthCheck{ConditionType: "Healthy", HTTP: &druidapicommon.HTTPHealthCheck{Path: "/health"}}
+				check(healthCheck)
+				check(druidapicommon.HealthCheck{ConditionType: "Alive", HTTP: &druidapicommon.HTTPHealthCheck{Path: "/livez"}})
+				Expect(requestURLs).To(HaveLen(2))
+				Expect(tlsConfigs).To(HaveLen(1))
+
+				httpClients.Delete(etcd.ObjectMeta)
+				check(healthCheck)
+				Expect(tlsConfigs).To(HaveLen(2))
+			})
+
+			It("should fail for an unexpected status code", func() {
+				statusCode = http.StatusServiceUnavailable
+				result := check(druidapicommon.HealthCheck{ConditionType: "Healthy", HTTP: &druidapicommon.HTTPHealthCheck{Path: "/health", ExpectedStatusCodes: []int32{http.StatusOK, http.StatusNoContent}}})
+
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+				Expect(result.Reason()).To(Equal(CustomCheckFailed))
+				Expect(result.Message()).To(ContainSubstring("503"))
+			})
+
+			It("should return status Unknown if the client TLS secrets cannot be read", func() {
+				etcd = testutils.EtcdBuilderWithDefaults(testutils.TestEtcdName, testutils.TestNamespace).WithClientTLS().Build()
+				result := check(druidapicommon.HealthCheck{ConditionType: "Healthy", HTTP: &druidapicommon.HTTPHealthCheck{Path: "/health"}})
+
+				Expect(requestURLs).To(BeEmpty())
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+				Expect(result.Reason()).To(Equal(CustomCheckError))
+			})
+		})
+
+		Context("metric", func() {
+			DescribeTable("should compare the samples of the metric with the thresholds",
+				func(metricCheck druidapicommon.MetricHealthCheck, expectedStatus druidv1alpha1.ConditionStatus) {
+					result := check(druidapicommon.HealthCheck{ConditionType: "MetricOK", Metric: &metricCheck})
+
+					Expect(requestURLs).To(ConsistOf(HaveSuffix(":2379/metrics")))
+					Expect(result.Status()).To(Equal(expectedStatus))
+				},
+				Entry("gauge below maximum", druidapicommon.MetricHealthCheck{Name: "etcd_mvcc_db_total_size_in_bytes", Max: ptr.To(resource.MustParse("6Gi"))}, druidv1alpha1.ConditionTrue),
+				Entry("gauge above maximum", druidapicommon.MetricHealthCheck{Name: "etcd_mvcc_db_total_size_in_bytes", Max: ptr.To(resource.MustParse("1Gi"))}, druidv1alpha1.ConditionFalse),
+				Entry("counter below minimum", druidapicommon.MetricHealthCheck{Name: "etcd_server_proposals_failed_total", Min: ptr.To(resource.MustParse("5"))}, druidv1alpha1.ConditionFalse),
+				Entry("one of the samples above maximum", druidapicommon.MetricHealthCheck{Name: "etcd_network_peer_round_trip_time_seconds_sum", Max: ptr.To(resource.MustParse("1"))}, druidv1alpha1.ConditionFalse),
+				Entry("selected sample below maximum", druidapicommon.MetricHealthCheck{Name: "etcd_network_peer_round_trip_time_seconds_sum", Labels: map[string]string{"To": "a"}, Max: ptr.To(resource.MustParse("1"))}, druidv1alpha1.ConditionTrue),
+				Entry("missing metric", druidapicommon.MetricHealthCheck{Name: "etcd_unknown", Max: ptr.To(resource.MustParse("1"))}, druidv1alpha1.ConditionUnknown),
+			)
+		})
+
+		Context("webhook", func() {
+			var (
+				server   *httptest.Server
+				response any
+			)
+
+			BeforeEach(func() {
+				// the webhook is tested against a real server
+				httpClients = NewCustomCheckHTTPClients()
+				server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					received := druidv1alpha1.Etcd{}
+					if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&received) != nil || received.Name != etcd.Name {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					_ = json.NewEncoder(w).Encode(response)
+				}))
+				DeferCleanup(server.Close)
+			})
+
+			webhookCheck := func() druidapicommon.HealthCheck {
+				return druidapicommon.HealthCheck{ConditionType: "ExternallyHealthy", Webhook: &druidapicommon.WebhookHealthCheck{
+					URL:      server.URL,
+					CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+				}}
+			}
+
+			It("should publish the result returned by the webhook", func() {
+				response = map[string]string{"status": "False", "reason": "TooManyKeys", "message": "too many keys"}
+				result := check(webhookCheck())
+
+				Expect(result.ConditionType()).To(Equal(druidv1alpha1.ConditionType("ExternallyHealthy")))
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionFalse))
+				Expect(result.Reason()).To(Equal("TooManyKeys"))
+				Expect(result.Message()).To(Equal("too many keys"))
+			})
+
+			It("should default the reason", func() {
+				response = map[string]string{"status": "True"}
+				result := check(webhookCheck())
+
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionTrue))
+				Expect(result.Reason()).To(Equal(CustomCheckSucceeded))
+			})
+
+			It("should return status Unknown for an invalid response", func() {
+				response = map[string]string{"status": "Maybe"}
+				result := check(webhookCheck())
+
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+				Expect(result.Reason()).To(Equal(CustomCheckError))
+			})
+
+			It("should reuse the HTTP client of the webhook as long as the CA bundle does not change", func() {
+				httpClients = NewCustomCheckHTTPClientsWithTransport(func(tlsConfig *tls.Config) http.RoundTripper {
+					tlsConfigs = append(tlsConfigs, tlsConfig)
+					return &http.Transport{TLSClientConfig: tlsConfig}
+				})
+				response = map[string]string{"status": "True"}
+				Expect(check(webhookCheck()).Status()).To(Equal(druidv1alpha1.ConditionTrue))
+				Expect(check(webhookCheck()).Status()).To(Equal(druidv1alpha1.ConditionTrue))
+				Expect(tlsConfigs).To(HaveLen(1))
+
+				healthCheck := webhookCheck()
+				healthCheck.Webhook.CABundle = nil
+				Expect(check(healthCheck).Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+				Expect(tlsConfigs).To(HaveLen(2))
+			})
+
+			It("should return status Unknown if the server certificate is not trusted", func() {
+				response = map[string]string{"status": "True"}
+				healthCheck := webhookCheck()
+				healthCheck.Webhook.CABundle = nil
+				result := check(healthCheck)
+
+				Expect(result.Status()).To(Equal(druidv1alpha1.ConditionUnknown))
+				Expect(result.Message()).To(ContainSubstring("certificate"))
+			})
+		})
+	})
+})
@@ -85,13 +85,18 @@ func (b *defaultBuilder) Build() []druidv1alpha1.EtcdMemberStatus {
 			Status:             res.Status(),
 			Reason:             res.Reason(),
 			LastTransitionTime: now,
+			DBSize:             res.DBSize(),
 		}
 
-		// Don't reset LastTransitionTime if status didn't change
 		if oldMemberStatus, ok := b.old[name]; ok {
+			// Don't reset LastTransitionTime if status didn't change
 			if oldMemberStatus.Status == res.Status() {
 				memberStatus.LastTransitionTime = oldMemberStatus.LastTransitionTime
 			}
+			// Keep the last reported database size if the member has not reported it in this check
+			if memberStatus.DBSize == nil {
+				memberStatus.DBSize = oldMemberStatus.DBSize
+			}
 		}
 
 		members = append(members, memberStatus)
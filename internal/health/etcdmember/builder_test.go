@@ -9,6 +9,7 @@ import (
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -55,6 +56,7 @@ var _ = Describe("Builder", func() {
 						Status:             druidv1alpha1.EtcdMemberStatusReady,
 						Reason:             "bar reason",
 						LastTransitionTime: metav1.NewTime(now.Add(-6 * time.Hour)),
+						DBSize:             ptr.To(resource.MustParse("1Gi")),
 					},
 					"3": {
 						Name:               "member3",
@@ -94,6 +96,37 @@ var _ = Describe("Builder", func() {
 					}),
 				))
 			})
+
+			It("should keep the database size if it has not been reported", func() {
+				builder.WithResults([]Result{
+					&result{
+						MemberID:     ptr.To("1"),
+						MemberName:   "member1",
+						MemberStatus: druidv1alpha1.EtcdMemberStatusReady,
+						MemberReason: "foo reason",
+						MemberDBSize: ptr.To(resource.MustParse("2Gi")),
+					},
+					&result{
+						MemberID:     ptr.To("2"),
+						MemberName:   "member2",
+						MemberStatus: druidv1alpha1.EtcdMemberStatusReady,
+						MemberReason: "bar reason",
+					},
+				})
+
+				conditions := builder.Build()
+
+				Expect(conditions).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"Name":   Equal("member1"),
+						"DBSize": PointTo(Equal(resource.MustParse("2Gi"))),
+					}),
+					MatchFields(IgnoreExtras, Fields{
+						"Name":   Equal("member2"),
+						"DBSize": PointTo(Equal(resource.MustParse("1Gi"))),
+					}),
+				))
+			})
 		})
 
 		Context("when Builder has no old members", func() {
@@ -154,6 +187,7 @@ type result struct {
 	MemberRole   *druidv1alpha1.EtcdRole
 	MemberStatus druidv1alpha1.EtcdMemberConditionStatus
 	MemberReason string
+	MemberDBSize *resource.Quantity
 }
 
 func (r *result) ID() *string {
@@ -175,3 +209,7 @@ func (r *result) Reason() string {
 func (r *result) Status() druidv1alpha1.EtcdMemberConditionStatus {
 	return r.MemberStatus
 }
+
+func (r *result) DBSize() *resource.Quantity {
+	return r.MemberDBSize
+}
@@ -0,0 +1,386 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdmember
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
+
+	"github.com/go-logr/logr"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AppliedIndexLagThreshold is the number of raft entries by which the applied index of a member may lag behind the
+	// applied index of the leader, before the member is considered not ready. It matches the gap between the committed
+	// and the applied index at which etcd starts to reject requests.
+	AppliedIndexLagThreshold = 5000
+	// SlowWALFsyncThreshold is the average latency of the WAL fsyncs of a member since the previous check, above which
+	// the member is considered not ready. etcd itself warns about fsyncs taking longer than a second.
+	SlowWALFsyncThreshold = time.Second
+
+	// metricsRequestTimeout is the time within which the metrics of all members have to be fetched, and the timeout of
+	// any other request to etcd.
+	metricsRequestTimeout = 5 * time.Second
+
+	metricIsLeader             = "etcd_server_is_leader"
+	metricIsLearner            = "etcd_server_is_learner"
+	metricHasLeader            = "etcd_server_has_leader"
+	metricProposalsApplied     = "etcd_server_proposals_applied_total"
+	metricWALFsyncDurationSecs = "etcd_disk_wal_fsync_duration_seconds"
+	metricDBTotalSizeInBytes   = "etcd_mvcc_db_total_size_in_bytes"
+)
+
+// MemberMetricsFetchFn fetches the metrics of an etcd member in the Prometheus text format from the given URL.
+type MemberMetricsFetchFn func(ctx context.Context, httpClient *http.Client, url string) ([]byte, error)
+
+// AlarmsFetchFn fetches the active alarms of an etcd cluster from the maintenance API served at the given URL.
+type AlarmsFetchFn func(ctx context.Context, httpClient *http.Client, url string) ([]Alarm, error)
+
+// memberMetrics holds the metrics of an etcd member which are relevant for its health.
+type memberMetrics struct {
+	isLeader      bool
+	isLearner     bool
+	hasLeader     bool
+	appliedIndex  float64
+	walFsyncSum   float64
+	walFsyncCount float64
+	dbSize        *float64
+}
+
+// Alarm is an active alarm of an etcd cluster, as returned by the maintenance API of etcd.
+type Alarm struct {
+	// MemberID is the decimal ID of the member which raised the alarm.
+	MemberID string `json:"memberID"`
+	// Alarm is the type of the alarm.
+	Alarm string `json:"alarm"`
+}
+
+// alarmNoSpace is the alarm raised by etcd when a member has exhausted the backend quota.
+const alarmNoSpace = "NOSPACE"
+
+// HealthChecker checks the health of etcd members as reported by etcd. It keeps the WAL fsync latencies of the members
+// observed by the previous check, and must therefore be shared by the checks of all reconciliations.
+type HealthChecker struct {
+	fetchMemberMetrics MemberMetricsFetchFn
+	fetchAlarms        AlarmsFetchFn
+
+	walFsyncSamplesMu sync.Mutex
+	// walFsyncSamples holds the cumulative WAL fsync latencies of the etcd members observed by the previous check, keyed
+	// by the Etcd and the member name, so that the latency can be computed for the interval between two checks.
+	walFsyncSamples map[types.NamespacedName]map[string]walFsyncSample
+}
+
+type walFsyncSample struct {
+	sum   float64
+	count float64
+}
+
+// NewHealthChecker returns a HealthChecker which fetches the metrics and alarms from etcd.
+func NewHealthChecker() *HealthChecker {
+	return NewHealthCheckerWithFetchers(fetchMemberMetrics, fetchAlarms)
+}
+
+// NewHealthCheckerWithFetchers returns a HealthChecker which fetches the metrics and alarms with the given functions.
+func NewHealthCheckerWithFetchers(fetchMemberMetrics MemberMetricsFetchFn, fetchAlarms AlarmsFetchFn) *HealthChecker {
+	return &HealthChecker{
+		fetchMemberMetrics: fetchMemberMetrics,
+		fetchAlarms:        fetchAlarms,
+		walFsyncSamples:    map[types.NamespacedName]map[string]walFsyncSample{},
+	}
+}
+
+// DeleteWALFsyncSamples deletes the WAL fsync latencies recorded for the members of the given Etcd. It must be called
+// once the Etcd has been deleted.
+func (c *HealthChecker) DeleteWALFsyncSamples(etcdObjMeta metav1.ObjectMeta) {
+	c.walFsyncSamplesMu.Lock()
+	defer c.walFsyncSamplesMu.Unlock()
+	delete(c.walFsyncSamples, types.NamespacedName{Namespace: etcdObjMeta.Namespace, Name: etcdObjMeta.Name})
+}
+
+type healthCheck struct {
+	logger  logr.Logger
+	cl      client.Client
+	checker *HealthChecker
+}
+
+// check complements the results of the ReadyCheck with the health of the etcd members as reported by etcd. Members
+// which are ready according to their lease are considered not ready if they are learners, do not know a leader, lag
+// behind the leader in applying raft entries, have raised the NOSPACE alarm, or their WAL fsyncs are slow. Members
+// whose metrics cannot be fetched keep their status. The size of the database reported by each member is recorded in
+// its result. The metrics of all members are fetched concurrently.
+func (h *healthCheck) check(ctx context.Context, etcd *druidv1alpha1.Etcd, results []*result) {
+	var readyResults []*result
+	for _, res := range results {
+		if res.status == druidv1alpha1.EtcdMemberStatusReady {
+			readyResults = append(readyResults, res)
+		}
+	}
+	if len(readyResults) == 0 {
+		h.pruneWALFsyncSamples(etcd, results)
+		return
+	}
+
+	httpClient, scheme, err := h.newHTTPClient(ctx, etcd)
+	if err != nil {
+		h.logger.Error(err, "failed to create client for etcd metrics, skipping member health check")
+		h.pruneWALFsyncSamples(etcd, results)
+		return
+	}
+	defer httpClient.CloseIdleConnections()
+
+	metrics := h.getMetricsOfMembers(ctx, httpClient, etcd, scheme, readyResults)
+	var leader *memberMetrics
+	var alarmURL string
+	for _, res := range readyResults {
+		m, ok := metrics[res.name]
+		if !ok {
+			continue
+		}
+		if m.isLeader || alarmURL == "" {
+			alarmURL = getMemberURL(etcd, scheme, res.name) + "/v3/maintenance/alarm"
+		}
+		if m.isLeader {
+			leader = m
+		}
+	}
+
+	var noSpaceMemberIDs []uint64
+	if alarmURL != "" {
+		alarms, err := h.checker.fetchAlarms(ctx, httpClient, alarmURL)
+		if err != nil {
+			h.logger.V(4).Info("Failed to fetch alarms of etcd cluster", "error", err.Error())
+		}
+		for _, alarm := range alarms {
+			if alarm.Alarm != alarmNoSpace {
+				continue
+			}
+			if id, err := strconv.ParseUint(alarm.MemberID, 10, 64); err == nil {
+				noSpaceMemberIDs = append(noSpaceMemberIDs, id)
+			}
+		}
+	}
+
+	walFsyncLatencies := h.recordWALFsyncSamples(etcd, results, metrics)
+	for _, res := range readyResults {
+		m, ok := metrics[res.name]
+		if !ok {
+			continue
+		}
+		if m.dbSize != nil {
+			res.dbSize = resource.NewQuantity(int64(*m.dbSize), resource.BinarySI)
+		}
+		noSpace := res.id != nil && slices.ContainsFunc(noSpaceMemberIDs, func(id uint64) bool {
+			memberID, err := strconv.ParseUint(*res.id, 16, 64)
+			return err == nil && memberID == id
+		})
+		if reason := evaluateMemberHealth(m, leader, noSpace, walFsyncLatencies[res.name]); reason != "" {
+			res.status = druidv1alpha1.EtcdMemberStatusNotReady
+			res.reason = reason
+		}
+	}
+}
+
+// getMetricsOfMembers fetches the metrics of the given members concurrently, within a deadline shared by all of them.
+// Members whose metrics cannot be fetched are missing in the returned map.
+func (h *healthCheck) getMetricsOfMembers(ctx context.Context, httpClient *http.Client, etcd *druidv1alpha1.Etcd, scheme string, results []*result) map[string]*memberMetrics {
+	ctx, cancel := context.WithTimeout(ctx, metricsRequestTimeout)
+	defer cancel()
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		metrics = make(map[string]*memberMetrics, len(results))
+	)
+	for _, res := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m, err := h.getMemberMetrics(ctx, httpClient, getMemberURL(etcd, scheme, res.name)+"/metrics")
+			if err != nil {
+				h.logger.V(4).Info("Failed to fetch metrics of etcd member, keeping its status", "name", res.name, "error", err.Error())
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			metrics[res.name] = m
+		}()
+	}
+	wg.Wait()
+	return metrics
+}
+
+// evaluateMemberHealth returns the reason why a member is not ready according to its metrics and alarms, or an empty
+// string if they do not indicate any problem.
+func evaluateMemberHealth(m, leader *memberMetrics, noSpace bool, walFsyncLatency *time.Duration) string {
+	switch {
+	case m.isLearner:
+		return druidv1alpha1.EtcdMemberReasonLearner
+	case !m.hasLeader:
+		return druidv1alpha1.EtcdMemberReasonNoLeader
+	case noSpace:
+		return druidv1alpha1.EtcdMemberReasonBackendQuotaExhausted
+	case leader != nil && leader.appliedIndex-m.appliedIndex > AppliedIndexLagThreshold:
+		return druidv1alpha1.EtcdMemberReasonAppliedIndexLagging
+	case walFsyncLatency != nil && *walFsyncLatency > SlowWALFsyncThreshold:
+		return druidv1alpha1.EtcdMemberReasonSlowDisk
+	}
+	return ""
+}
+
+// recordWALFsyncSamples records the current WAL fsync samples of the members of the given Etcd, and returns the average
+// latency of the WAL fsyncs of each member since the previous check. There is no latency for a member if there is no
+// previous sample, if there were no fsyncs since, or if the member has been restarted in the meantime. Samples of
+// members whose metrics could not be fetched are kept, samples of members which no longer exist are dropped.
+func (h *healthCheck) recordWALFsyncSamples(etcd *druidv1alpha1.Etcd, results []*result, metrics map[string]*memberMetrics) map[string]*time.Duration {
+	key := types.NamespacedName{Namespace: etcd.Namespace, Name: etcd.Name}
+	h.checker.walFsyncSamplesMu.Lock()
+	defer h.checker.walFsyncSamplesMu.Unlock()
+	previous := h.checker.walFsyncSamples[key]
+	current := make(map[string]walFsyncSample, len(results))
+	latencies := make(map[string]*time.Duration, len(metrics))
+	for _, res := range results {
+		prev, hasPrev := previous[res.name]
+		m, ok := metrics[res.name]
+		if !ok {
+			if hasPrev {
+				current[res.name] = prev
+			}
+			continue
+		}
+		current[res.name] = walFsyncSample{sum: m.walFsyncSum, count: m.walFsyncCount}
+		if count := m.walFsyncCount - prev.count; hasPrev && count > 0 {
+			latency := time.Duration((m.walFsyncSum - prev.sum) / count * float64(time.Second))
+			latencies[res.name] = &latency
+		}
+	}
+	h.checker.walFsyncSamples[key] = current
+	return latencies
+}
+
+// pruneWALFsyncSamples drops the WAL fsync samples of members of the given Etcd which no longer exist.
+func (h *healthCheck) pruneWALFsyncSamples(etcd *druidv1alpha1.Etcd, results []*result) {
+	h.recordWALFsyncSamples(etcd, results, nil)
+}
+
+func (h *healthCheck) newHTTPClient(ctx context.Context, etcd *druidv1alpha1.Etcd) (*http.Client, string, error) {
+	tlsConfig, err := kubernetes.GetEtcdClientTLSConfig(ctx, h.cl, etcd)
+	if err != nil {
+		return nil, "", err
+	}
+	if tlsConfig == nil {
+		return &http.Client{Timeout: metricsRequestTimeout}, "http", nil
+	}
+	// the members are reached via the peer service, but the server certificate is only required to be valid for the
+	// client service
+	tlsConfig.ServerName = fmt.Sprintf("%s.%s.svc", druidv1alpha1.GetClientServiceName(etcd.ObjectMeta), etcd.Namespace)
+	return &http.Client{
+		Timeout:   metricsRequestTimeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}, "https", nil
+}
+
+func (h *healthCheck) getMemberMetrics(ctx context.Context, httpClient *http.Client, url string) (*memberMetrics, error) {
+	data, err := h.checker.fetchMemberMetrics(ctx, httpClient, url)
+	if err != nil {
+		return nil, err
+	}
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+	if _, ok := families[metricHasLeader]; !ok {
+		return nil, fmt.Errorf("metric %s not found", metricHasLeader)
+	}
+	m := &memberMetrics{
+		isLeader:     getGaugeValue(families, metricIsLeader) == 1,
+		isLearner:    getGaugeValue(families, metricIsLearner) == 1,
+		hasLeader:    getGaugeValue(families, metricHasLeader) == 1,
+		appliedIndex: getGaugeValue(families, metricProposalsApplied),
+	}
+	if family, ok := families[metricDBTotalSizeInBytes]; ok && len(family.GetMetric()) > 0 {
+		m.dbSize = ptr.To(family.GetMetric()[0].GetGauge().GetValue())
+	}
+	if family, ok := families[metricWALFsyncDurationSecs]; ok && len(family.GetMetric()) > 0 {
+		histogram := family.GetMetric()[0].GetHistogram()
+		m.walFsyncSum = histogram.GetSampleSum()
+		m.walFsyncCount = float64(histogram.GetSampleCount())
+	}
+	return m, nil
+}
+
+func getGaugeValue(families map[string]*dto.MetricFamily, name string) float64 {
+	family, ok := families[name]
+	if !ok || len(family.GetMetric()) == 0 {
+		return 0
+	}
+	return family.GetMetric()[0].GetGauge().GetValue()
+}
+
+// getMemberURL returns the URL of the client port of the given member, which serves the metrics and the gRPC gateway of
+// etcd, and is reached via the peer service.
+func getMemberURL(etcd *druidv1alpha1.Etcd, scheme, memberName string) string {
+	podName := podNameFromLeaseName(memberName, etcd.Spec.MemberNamePrefix)
+	clientPort := ptr.Deref(etcd.Spec.Etcd.ClientPort, druidv1alpha1.DefaultPortEtcdClient)
+	return fmt.Sprintf("%s://%s.%s.%s.svc:%d", scheme, podName, druidv1alpha1.GetPeerServiceName(etcd.ObjectMeta), etcd.Namespace, clientPort)
+}
+
+func fetchMemberMetrics(ctx context.Context, httpClient *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 16<<20))
+}
+
+func fetchAlarms(ctx context.Context, httpClient *http.Client, url string) ([]Alarm, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(`{"action":"GET"}`))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	var alarmResp struct {
+		Alarms []Alarm `json:"alarms"`
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&alarmResp); err != nil {
+		return nil, err
+	}
+	return alarmResp.Alarms, nil
+}
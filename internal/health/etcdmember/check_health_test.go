@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdmember_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	testutils "github.com/gardener/etcd-druid/test/utils"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/etcd-druid/internal/health/etcdmember"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadyCheck with member health", func() {
+	Describe("#Check", func() {
+		const (
+			etcdName      = "etcd"
+			etcdNamespace = "etcd-test"
+		)
+		var (
+			ctx              context.Context
+			etcd             *druidv1alpha1.Etcd
+			leases           []*coordinationv1.Lease
+			metrics          map[string]string
+			alarms           []Alarm
+			fetchedURLsMu    sync.Mutex
+			fetchedURLs      []string
+			fetchedAlarmURLs []string
+			healthChecker    *HealthChecker
+		)
+
+		memberMetrics := func(isLeader, isLearner, hasLeader int, appliedIndex int64, walFsyncSum float64, walFsyncCount int) string {
+			return fmt.Sprintf(`# TYPE etcd_server_is_leader gauge
+etcd_server_is_leader %d
+# TYPE etcd_server_is_learner gauge
+etcd_server_is_learner %d
+# TYPE etcd_server_has_leader gauge
+etcd_server_has_leader %d
+# TYPE etcd_server_proposals_applied_total gauge
+etcd_server_proposals_applied_total %d
+# TYPE etcd_disk_wal_fsync_duration_seconds histogram
+etcd_disk_wal_fsync_duration_seconds_bucket{le="+Inf"} %d
+etcd_disk_wal_fsync_duration_seconds_sum %f
+etcd_disk_wal_fsync_duration_seconds_count %d
+`, isLeader, isLearner, hasLeader, appliedIndex, walFsyncCount, walFsyncSum, walFsyncCount)
+		}
+
+		memberURL := func(memberName string) string {
+			return fmt.Sprintf("http://%s.%s-peer.%s.svc:2379", memberName, etcdName, etcd.Namespace)
+		}
+
+		// memberLease returns a lease of a member with the hex ID i+10, which has been renewed recently or has expired.
+		memberLease := func(i int, expired bool) *coordinationv1.Lease {
+			renewTime := time.Now()
+			if expired {
+				renewTime = renewTime.Add(-unknownThreshold - time.Second)
+			}
+			return createMemberLease(fmt.Sprintf("%s-%d", etcdName, i), etcd.Namespace, ptr.To(fmt.Sprintf("%x:0:%s", i+10, druidv1alpha1.EtcdRoleMember)), &renewTime)
+		}
+
+		check := func() []Result {
+			pods := make([]*corev1.Pod, 0, len(leases))
+			for _, lease := range leases {
+				pods = append(pods, createMemberPod(lease.Name, lease.Namespace, true))
+			}
+			cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, mapToClientObjects(leases, pods))
+			return ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold, healthChecker).Check(ctx, *etcd)
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			etcd = testutils.EtcdBuilderWithDefaults(etcdName, etcdNamespace).WithReplicas(3).Build()
+			etcd.Spec.Etcd.ClientUrlTLS = nil
+			leases = []*coordinationv1.Lease{memberLease(0, false), memberLease(1, false), memberLease(2, false)}
+			metrics = map[string]string{}
+			alarms = nil
+			fetchedURLs = nil
+			fetchedAlarmURLs = nil
+			fetchMemberMetrics := func(_ context.Context, _ *http.Client, url string) ([]byte, error) {
+				// the metrics of the members are fetched concurrently
+				fetchedURLsMu.Lock()
+				defer fetchedURLsMu.Unlock()
+				fetchedURLs = append(fetchedURLs, url)
+				for name, m := range metrics {
+					if url == memberURL(name)+"/metrics" {
+						return []byte(m), nil
+					}
+				}
+				return nil, fmt.Errorf("connection refused")
+			}
+			fetchAlarms := func(_ context.Context, _ *http.Client, url string) ([]Alarm, error) {
+				fetchedAlarmURLs = append(fetchedAlarmURLs, url)
+				return alarms, nil
+			}
+			healthChecker = NewHealthCheckerWithFetchers(fetchMemberMetrics, fetchAlarms)
+		})
+
+		It("should keep the status of healthy members", func() {
+			metrics["etcd-0"] = memberMetrics(1, 0, 1, 10000, 0.1, 10)
+			metrics["etcd-1"] = memberMetrics(0, 0, 1, 9990, 0.1, 10)
+			metrics["etcd-2"] = memberMetrics(0, 0, 1, 9000, 0.1, 10)
+
+			results := check()
+
+			Expect(results).To(HaveLen(3))
+			for _, res := range results {
+				Expect(res.Status()).To(Equal(druidv1alpha1.EtcdMemberStatusReady))
+				Expect(res.Reason()).To(Equal(druidv1alpha1.EtcdMemberReasonLeaseSucceeded))
+			}
+			Expect(fetchedAlarmURLs).To(ConsistOf(memberURL("etcd-0") + "/v3/maintenance/alarm"))
+		})
+
+		It("should record the database size reported by the members", func() {
+			metrics["etcd-0"] = memberMetrics(1, 0, 1, 10000, 0.1, 10) + "# TYPE etcd_mvcc_db_total_size_in_bytes gauge\netcd_mvcc_db_total_size_in_bytes 1.073741824e+09\n"
+			metrics["etcd-1"] = memberMetrics(0, 0, 1, 10000, 0.1, 10)
+
+			results := check()
+
+			Expect(results).To(HaveLen(3))
+			Expect(results[0].DBSize()).ToNot(BeNil())
+			Expect(results[0].DBSize().Cmp(resource.MustParse("1Gi"))).To(BeZero())
+			Expect(results[1].DBSize()).To(BeNil())
+			Expect(results[2].DBSize()).To(BeNil())
+		})
+
+		It("should only probe ready members and keep the status of the others", func() {
+			leases[2] = memberLease(2, true)
+			metrics["etcd-0"] = memberMetrics(1, 0, 1, 10000, 0.1, 10)
+			metrics["etcd-1"] = memberMetrics(0, 0, 1, 10000, 0.1, 10)
+
+			results := check()
+
+			Expect(results).To(HaveLen(3))
+			Expect(results[2].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusUnknown))
+			Expect(results[2].Reason()).To(Equal(druidv1alpha1.EtcdMemberReasonLeaseExpired))
+			Expect(fetchedURLs).To(HaveLen(2))
+		})
+
+		It("should keep the status of members whose metrics cannot be fetched", func() {
+			metrics["etcd-0"] = memberMetrics(1, 0, 1, 10000, 0.1, 10)
+
+			results := check()
+
+			Expect(results).To(HaveLen(3))
+			for _, res := range results {
+				Expect(res.Status()).To(Equal(druidv1alpha1.EtcdMemberStatusReady))
+			}
+		})
+
+		It("should not fetch alarms if the metrics of no member can be fetched", func() {
+			results := check()
+
+			Expect(results).To(HaveLen(3))
+			Expect(fetchedAlarmURLs).To(BeEmpty())
+		})
+
+		It("should set members to NotReady based on their metrics and alarms", func() {
+			metrics["etcd-0"] = memberMetrics(1, 0, 1, 10000, 0.1, 10)
+			metrics["etcd-1"] = memberMetrics(0, 1, 1, 10000, 0.1, 10)
+			metrics["etcd-2"] = memberMetrics(0, 0, 1, 10000-AppliedIndexLagThreshold-1, 0.1, 10)
+			// the member with the hex ID a raised the NOSPACE alarm
+			alarms = []Alarm{{MemberID: "10", Alarm: "NOSPACE"}, {MemberID: "11", Alarm: "CORRUPT"}}
+
+			results := check()
+
+			Expect(results).To(HaveLen(3))
+			Expect(results[0].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusNotReady))
+			Expect(results[0].Reason()).To(Equal(druidv1alpha1.EtcdMemberReasonBackendQuotaExhausted))
+			Expect(results[1].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusNotReady))
+			Expect(results[1].Reason()).To(Equal(druidv1alpha1.EtcdMemberReasonLearner))
+			Expect(results[2].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusNotReady))
+			Expect(results[2].Reason()).To(Equal(druidv1alpha1.EtcdMemberReasonAppliedIndexLagging))
+		})
+
+		It("should set members without leader to NotReady", func() {
+			metrics["etcd-0"] = memberMetrics(0, 0, 0, 10000, 0.1, 10)
+
+			results := check()
+
+			Expect(results[0].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusNotReady))
+			Expect(results[0].Reason()).To(Equal(druidv1alpha1.EtcdMemberReasonNoLeader))
+		})
+
+		It("should keep the transition time of members which remain NotReady", func() {
+			metrics["etcd-0"] = memberMetrics(0, 0, 0, 10000, 0.1, 10)
+			firstCheckTime := metav1.NewTime(time.Now().Add(-time.Minute))
+			members := NewBuilder().
+				WithNowFunc(func() metav1.Time { return firstCheckTime }).
+				WithResults(check()).
+				Build()
+			Expect(members[0].Status).To(Equal(druidv1alpha1.EtcdMemberStatusNotReady))
+
+			members = NewBuilder().
+				WithNowFunc(func() metav1.Time { return metav1.Now() }).
+				WithOldMembers(members).
+				WithResults(check()).
+				Build()
+			Expect(members[0].Status).To(Equal(druidv1alpha1.EtcdMemberStatusNotReady))
+			Expect(members[0].LastTransitionTime).To(Equal(firstCheckTime))
+		})
+
+		It("should set members with slow WAL fsyncs since the previous check to NotReady", func() {
+			metrics["etcd-0"] = memberMetrics(1, 0, 1, 10000, 0.1, 10)
+			metrics["etcd-1"] = memberMetrics(0, 0, 1, 10000, 0.1, 10)
+
+			results := check()
+			Expect(results[0].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusReady))
+			Expect(results[1].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusReady))
+
+			metrics["etcd-0"] = memberMetrics(1, 0, 1, 10000, 0.2, 20)
+			metrics["etcd-1"] = memberMetrics(0, 0, 1, 10000, 20.1, 20)
+
+			results = check()
+			Expect(results[0].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusReady))
+			Expect(results[1].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusNotReady))
+			Expect(results[1].Reason()).To(Equal(druidv1alpha1.EtcdMemberReasonSlowDisk))
+		})
+
+		It("should drop the WAL fsync samples of removed members", func() {
+			metrics["etcd-0"] = memberMetrics(1, 0, 1, 10000, 0.1, 10)
+			metrics["etcd-1"] = memberMetrics(0, 0, 1, 10000, 0.1, 10)
+			check()
+
+			// etcd-1 is removed and re-added with a fresh WAL, so there is no previous sample to compare with
+			leases = leases[:1]
+			check()
+			leases = append(leases, memberLease(1, false))
+			metrics["etcd-1"] = memberMetrics(0, 0, 1, 10000, 20.1, 20)
+
+			results := check()
+			Expect(results[1].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusReady))
+		})
+
+		It("should drop the WAL fsync samples of deleted Etcds", func() {
+			metrics["etcd-0"] = memberMetrics(1, 0, 1, 10000, 0.1, 10)
+			check()
+
+			// the Etcd is deleted and recreated with a fresh WAL, so there is no previous sample to compare with
+			healthChecker.DeleteWALFsyncSamples(etcd.ObjectMeta)
+			metrics["etcd-0"] = memberMetrics(1, 0, 1, 10000, 20.1, 20)
+
+			results := check()
+			Expect(results[0].Status()).To(Equal(druidv1alpha1.EtcdMemberStatusReady))
+		})
+	})
+})
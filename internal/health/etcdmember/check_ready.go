@@ -25,6 +25,7 @@ type readyCheck struct {
 	cl                          client.Client
 	etcdMemberNotReadyThreshold time.Duration
 	etcdMemberUnknownThreshold  time.Duration
+	health                      *healthCheck
 }
 
 // TimeNow is the function used by this check to get the current time.
@@ -32,7 +33,7 @@ var TimeNow = time.Now
 
 func (r *readyCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) []Result {
 	var (
-		results   []Result
+		results   []*result
 		checkTime = TimeNow().UTC()
 	)
 
@@ -80,7 +81,7 @@ func (r *readyCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) []Resul
 		// Check if member state must be considered as not ready
 		if renew.Add(r.etcdMemberUnknownThreshold).Add(r.etcdMemberNotReadyThreshold).Before(checkTime) {
 			res.status = druidv1alpha1.EtcdMemberStatusNotReady
-			res.reason = "UnknownGracePeriodExceeded"
+			res.reason = druidv1alpha1.EtcdMemberReasonUnknownGracePeriodExceeded
 			results = append(results, res)
 			continue
 		}
@@ -92,23 +93,31 @@ func (r *readyCheck) Check(ctx context.Context, etcd druidv1alpha1.Etcd) []Resul
 			ready, err := r.checkContainersAreReady(ctx, lease.Namespace, podName)
 			if (err == nil && !ready) || apierrors.IsNotFound(err) {
 				res.status = druidv1alpha1.EtcdMemberStatusNotReady
-				res.reason = "ContainersNotReady"
+				res.reason = druidv1alpha1.EtcdMemberReasonContainersNotReady
 				results = append(results, res)
 				continue
 			}
 
 			res.status = druidv1alpha1.EtcdMemberStatusUnknown
-			res.reason = "LeaseExpired"
+			res.reason = druidv1alpha1.EtcdMemberReasonLeaseExpired
 			results = append(results, res)
 			continue
 		}
 
 		res.status = druidv1alpha1.EtcdMemberStatusReady
-		res.reason = "LeaseSucceeded"
+		res.reason = druidv1alpha1.EtcdMemberReasonLeaseSucceeded
 		results = append(results, res)
 	}
 
-	return results
+	// Members which are ready according to their lease are checked further in the same check, so that the transition
+	// time of a member which is not ready as per its health is not reset on every check.
+	r.health.check(ctx, &etcd, results)
+
+	checkResults := make([]Result, 0, len(results))
+	for _, res := range results {
+		checkResults = append(checkResults, res)
+	}
+	return checkResults
 }
 
 const memberLeaseHolderIdentitySeparator = ":"
@@ -120,13 +129,19 @@ func podNameFromLeaseName(leaseName string, memberNamePrefix *string) string {
 	return leaseName
 }
 
-// ReadyCheck returns a check for the "Ready" condition.
-func ReadyCheck(cl client.Client, logger logr.Logger, etcdMemberNotReadyThreshold, etcdMemberUnknownThreshold time.Duration) Checker {
+// ReadyCheck returns a check for the "Ready" condition, which is derived from the member leases and the health of the
+// members as reported by etcd, which is checked with the given HealthChecker.
+func ReadyCheck(cl client.Client, logger logr.Logger, etcdMemberNotReadyThreshold, etcdMemberUnknownThreshold time.Duration, healthChecker *HealthChecker) Checker {
 	return &readyCheck{
 		logger:                      logger,
 		cl:                          cl,
 		etcdMemberNotReadyThreshold: etcdMemberNotReadyThreshold,
 		etcdMemberUnknownThreshold:  etcdMemberUnknownThreshold,
+		health: &healthCheck{
+			logger:  logger,
+			cl:      cl,
+			checker: healthChecker,
+		},
 	}
 }
 
@@ -60,7 +60,7 @@ var _ = Describe("ReadyCheck", func() {
 				pod := createMemberPod(member1Name, etcdNamespace, true)
 				existingObjects := mapToClientObjects([]*coordinationv1.Lease{lease}, []*corev1.Pod{pod})
 				cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects)
-				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold)
+				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold, NewHealthChecker())
 				etcd := testutils.EtcdBuilderWithDefaults(etcdName, etcdNamespace).WithReplicas(1).Build()
 				results := check.Check(ctx, *etcd)
 
@@ -76,7 +76,7 @@ var _ = Describe("ReadyCheck", func() {
 				lease := createMemberLease(member1Name, etcdNamespace, ptr.To(fmt.Sprintf("%s:%s:%s", member1ID, clusterID, druidv1alpha1.EtcdRoleLeader)), ptr.To(now.Add(-1*unknownThreshold).Add(-1*time.Second)))
 				existingObjects := mapToClientObjects([]*coordinationv1.Lease{lease}, []*corev1.Pod{pod})
 				cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects)
-				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold)
+				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold, NewHealthChecker())
 				etcd := testutils.EtcdBuilderWithDefaults(etcdName, etcdNamespace).WithReplicas(1).Build()
 				results := check.Check(ctx, *etcd)
 
@@ -91,7 +91,7 @@ var _ = Describe("ReadyCheck", func() {
 				lease := createMemberLease(member1Name, etcdNamespace, ptr.To(fmt.Sprintf("%s:%s:%s", member1ID, clusterID, druidv1alpha1.EtcdRoleLeader)), ptr.To(now.Add(-1*unknownThreshold).Add(-1*time.Second)))
 				existingObjects := mapToClientObjects([]*coordinationv1.Lease{lease}, nil)
 				cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects)
-				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold)
+				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold, NewHealthChecker())
 				etcd := testutils.EtcdBuilderWithDefaults(etcdName, etcdNamespace).WithReplicas(1).Build()
 				results := check.Check(ctx, *etcd)
 
@@ -106,7 +106,7 @@ var _ = Describe("ReadyCheck", func() {
 				lease := createMemberLease(member1Name, etcdNamespace, ptr.To(fmt.Sprintf("%s:%s:%s", member1ID, clusterID, druidv1alpha1.EtcdRoleLeader)), ptr.To(now.Add(-1*unknownThreshold).Add(-1*time.Second)))
 				existingObjects := mapToClientObjects([]*coordinationv1.Lease{lease}, nil)
 				cl := testutils.CreateTestFakeClientForObjects(testutils.TestAPIInternalErr, nil, nil, nil, existingObjects)
-				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold)
+				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold, NewHealthChecker())
 				etcd := testutils.EtcdBuilderWithDefaults(etcdName, etcdNamespace).WithReplicas(1).Build()
 				results := check.Check(ctx, *etcd)
 
@@ -130,7 +130,7 @@ var _ = Describe("ReadyCheck", func() {
 				member2Pod := createMemberPod(member2Name, etcdNamespace, false)
 				existingObjects := mapToClientObjects([]*coordinationv1.Lease{member1Lease, member2Lease, member3Lease}, []*corev1.Pod{member1Pod, member2Pod})
 				cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects)
-				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold)
+				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold, NewHealthChecker())
 				etcd := testutils.EtcdBuilderWithDefaults(etcdName, etcdNamespace).WithReplicas(3).Build()
 				results := check.Check(ctx, *etcd)
 
@@ -165,7 +165,7 @@ var _ = Describe("ReadyCheck", func() {
 
 				existingObjects := mapToClientObjects([]*coordinationv1.Lease{member1Lease, member2Lease, member3Lease}, nil)
 				cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects)
-				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold)
+				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold, NewHealthChecker())
 				etcd := testutils.EtcdBuilderWithDefaults(etcdName, etcdNamespace).WithReplicas(3).Build()
 				results := check.Check(ctx, *etcd)
 
@@ -200,7 +200,7 @@ var _ = Describe("ReadyCheck", func() {
 				member3Lease := createMemberLease(member3Name, etcdNamespace, ptr.To(fmt.Sprintf("%s:%s:%s", member3ID, clusterID, druidv1alpha1.EtcdRoleMember)), nil)
 				existingObjects := mapToClientObjects([]*coordinationv1.Lease{member1Lease, member2Lease, member3Lease}, nil)
 				cl := testutils.CreateTestFakeClientForObjects(nil, nil, nil, nil, existingObjects)
-				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold)
+				check = ReadyCheck(cl, logr.Discard(), notReadyThreshold, unknownThreshold, NewHealthChecker())
 				etcd := testutils.EtcdBuilderWithDefaults(etcdName, etcdNamespace).WithReplicas(3).Build()
 				results := check.Check(ctx, *etcd)
 
@@ -8,6 +8,8 @@ import (
 	"context"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Checker is an interface to check the members of an etcd cluster.
@@ -22,6 +24,7 @@ type Result interface {
 	Role() *druidv1alpha1.EtcdRole
 	Status() druidv1alpha1.EtcdMemberConditionStatus
 	Reason() string
+	DBSize() *resource.Quantity
 }
 
 type result struct {
@@ -30,6 +33,7 @@ type result struct {
 	role   *druidv1alpha1.EtcdRole
 	status druidv1alpha1.EtcdMemberConditionStatus
 	reason string
+	dbSize *resource.Quantity
 }
 
 func (r *result) ID() *string {
@@ -51,3 +55,7 @@ func (r *result) Status() druidv1alpha1.EtcdMemberConditionStatus {
 func (r *result) Reason() string {
 	return r.reason
 }
+
+func (r *result) DBSize() *resource.Quantity {
+	return r.dbSize
+}
@@ -6,10 +6,13 @@ package status
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/internal/health/certificate"
 	"github.com/gardener/etcd-druid/internal/health/condition"
 	"github.com/gardener/etcd-druid/internal/health/etcdmember"
 
@@ -22,7 +25,7 @@ import (
 type ConditionCheckFn func(client.Client) condition.Checker
 
 // EtcdMemberCheckFn is a type alias for a function which returns an implementation of `Check`.
-type EtcdMemberCheckFn func(client.Client, logr.Logger, time.Duration, time.Duration) etcdmember.Checker
+type EtcdMemberCheckFn func(client.Client, logr.Logger, time.Duration, time.Duration, *etcdmember.HealthChecker) etcdmember.Checker
 
 // TimeNow is the function used to get the current time.
 var TimeNow = time.Now
@@ -53,6 +56,10 @@ type Checker struct {
 	cl                          client.Client
 	etcdMemberNotReadyThreshold time.Duration
 	etcdMemberUnknownThreshold  time.Duration
+	healthChecks                []druidapicommon.HealthCheck
+	memberHealthChecker         *etcdmember.HealthChecker
+	customCheckHTTPClients      *condition.CustomCheckHTTPClients
+	certificates                *certificate.Inspection
 	conditionCheckFns           []ConditionCheckFn
 	conditionBuilderFn          func() condition.Builder
 	etcdMemberCheckFns          []EtcdMemberCheckFn
@@ -78,8 +85,13 @@ func (c *Checker) executeConditionChecks(ctx context.Context, etcd *druidv1alpha
 		wg sync.WaitGroup
 	)
 
+	// Custom health checks may send requests to endpoints outside the etcd cluster. Their total duration is capped so
+	// that they cannot block the status reconciliation.
+	customCtx, cancel := context.WithTimeout(ctx, druidapicommon.MaxWebhookHealthCheckTimeout)
+	defer cancel()
+
 	// Run condition checks in parallel since each check work independently of each other.
-	for _, newCheck := range c.conditionCheckFns {
+	runCheck := func(ctx context.Context, newCheck ConditionCheckFn) {
 		c := newCheck(c.cl)
 		wg.Add(1)
 		go (func() {
@@ -87,6 +99,15 @@ func (c *Checker) executeConditionChecks(ctx context.Context, etcd *druidv1alpha
 			resultCh <- c.Check(ctx, *etcd)
 		})()
 	}
+	for _, newCheck := range c.conditionCheckFns {
+		runCheck(ctx, newCheck)
+	}
+	if c.certificates != nil {
+		runCheck(ctx, func(client.Client) condition.Checker { return condition.CertificatesValidCheck(*c.certificates) })
+	}
+	for _, newCheck := range c.customConditionCheckFns(etcd) {
+		runCheck(customCtx, newCheck)
+	}
 
 	go (func() {
 		defer close(resultCh)
@@ -100,7 +121,7 @@ func (c *Checker) executeConditionChecks(ctx context.Context, etcd *druidv1alpha
 
 	conditions := c.conditionBuilderFn().
 		WithNowFunc(func() metav1.Time { return metav1.NewTime(TimeNow()) }).
-		WithOldConditions(etcd.Status.Conditions).
+		WithOldConditions(pruneCustomConditions(etcd.Status.Conditions, results)).
 		WithResults(results).
 		Build(etcd.Spec.Replicas)
 
@@ -108,12 +129,44 @@ func (c *Checker) executeConditionChecks(ctx context.Context, etcd *druidv1alpha
 	return nil
 }
 
+// pruneCustomConditions removes the conditions of custom health checks which are no longer configured, i.e. the conditions
+// which are neither maintained by etcd-druid itself nor the result of one of the executed checks.
+func pruneCustomConditions(conditions []druidv1alpha1.Condition, results []condition.Result) []druidv1alpha1.Condition {
+	return slices.DeleteFunc(slices.Clone(conditions), func(cond druidv1alpha1.Condition) bool {
+		return !druidv1alpha1.IsDruidManagedConditionType(cond.Type) && !slices.ContainsFunc(results, func(r condition.Result) bool {
+			return r != nil && r.ConditionType() == cond.Type
+		})
+	})
+}
+
+// customConditionCheckFns returns the condition checks for the custom health checks configured for etcd-druid and for
+// the given Etcd. Health checks of the Etcd replace the ones configured for etcd-druid with the same condition type.
+func (c *Checker) customConditionCheckFns(etcd *druidv1alpha1.Etcd) []ConditionCheckFn {
+	var checkFns []ConditionCheckFn
+	for _, healthCheck := range c.healthChecks {
+		if slices.ContainsFunc(etcd.Spec.HealthChecks, func(hc druidv1alpha1.EtcdHealthCheck) bool {
+			return hc.ConditionType == healthCheck.ConditionType
+		}) {
+			continue
+		}
+		checkFns = append(checkFns, condition.CustomCheck(healthCheck, c.customCheckHTTPClients))
+	}
+	for _, healthCheck := range etcd.Spec.HealthChecks {
+		checkFns = append(checkFns, condition.CustomCheck(druidapicommon.HealthCheck{
+			ConditionType: healthCheck.ConditionType,
+			HTTP:          healthCheck.HTTP,
+			Metric:        healthCheck.Metric,
+		}, c.customCheckHTTPClients))
+	}
+	return checkFns
+}
+
 // executeEtcdMemberChecks runs all registered etcd member checks **sequentially**.
 // The result of a check is passed via the `status` sub-resources to the next check.
 func (c *Checker) executeEtcdMemberChecks(ctx context.Context, logger logr.Logger, etcd *druidv1alpha1.Etcd) error {
 	// Run etcd member checks sequentially as most of them act on multiple elements.
 	for _, newCheck := range c.etcdMemberCheckFns {
-		results := newCheck(c.cl, logger, c.etcdMemberNotReadyThreshold, c.etcdMemberUnknownThreshold).Check(ctx, *etcd)
+		results := newCheck(c.cl, logger, c.etcdMemberNotReadyThreshold, c.etcdMemberUnknownThreshold, c.memberHealthChecker).Check(ctx, *etcd)
 
 		// Build and assign the results after each check, so that the next check
 		// can act on the latest results.
@@ -128,12 +181,20 @@ func (c *Checker) executeEtcdMemberChecks(ctx context.Context, logger logr.Logge
 	return nil
 }
 
-// NewChecker creates a new instance for checking the etcd status.
-func NewChecker(cl client.Client, etcdMemberNotReadyThreshold, etcdMemberUnknownThreshold time.Duration) *Checker {
+// NewChecker creates a new instance for checking the etcd status. The given custom health checks are evaluated for
+// every Etcd in addition to the registered condition checks and the health checks configured in the Etcd. The health of
+// the etcd members is checked with the given HealthChecker, the requests of the custom health checks are sent with the
+// given HTTP clients. The CertificatesValid condition is derived from the given inspection of the certificates, and is
+// not checked if it is nil.
+func NewChecker(cl client.Client, etcdMemberNotReadyThreshold, etcdMemberUnknownThreshold time.Duration, healthChecks []druidapicommon.HealthCheck, memberHealthChecker *etcdmember.HealthChecker, customCheckHTTPClients *condition.CustomCheckHTTPClients, certificates *certificate.Inspection) *Checker {
 	return &Checker{
 		cl:                          cl,
 		etcdMemberNotReadyThreshold: etcdMemberNotReadyThreshold,
 		etcdMemberUnknownThreshold:  etcdMemberUnknownThreshold,
+		healthChecks:                healthChecks,
+		memberHealthChecker:         memberHealthChecker,
+		customCheckHTTPClients:      customCheckHTTPClients,
+		certificates:                certificates,
 		conditionCheckFns:           ConditionChecks,
 		conditionBuilderFn:          NewDefaultConditionBuilder,
 		etcdMemberCheckFns:          EtcdMemberChecks,
@@ -6,15 +6,22 @@ package status_test
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"sync"
 	"time"
 
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/health/condition"
 	"github.com/gardener/etcd-druid/internal/health/etcdmember"
+	testutils "github.com/gardener/etcd-druid/test/utils"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -126,7 +133,7 @@ var _ = Describe("Check", func() {
 			})()
 
 			defer withVar(&EtcdMemberChecks, []EtcdMemberCheckFn{
-				func(_ client.Client, _ logr.Logger, _, _ time.Duration) etcdmember.Checker {
+				func(_ client.Client, _ logr.Logger, _, _ time.Duration, _ *etcdmember.HealthChecker) etcdmember.Checker {
 					return createEtcdMemberCheck(
 						etcdMemberResult{ptr.To("1"), "member1", ptr.To[druidv1alpha1.EtcdRole](druidv1alpha1.EtcdRoleLeader), druidv1alpha1.EtcdMemberStatusUnknown, "Unknown"},
 						etcdMemberResult{ptr.To("2"), "member2", ptr.To[druidv1alpha1.EtcdRole](druidv1alpha1.EtcdRoleMember), druidv1alpha1.EtcdMemberStatusNotReady, "bar reason"},
@@ -137,7 +144,7 @@ var _ = Describe("Check", func() {
 
 			defer withVar(&TimeNow, func() time.Time { return timeNow })()
 
-			checker := NewChecker(nil, 5*time.Minute, time.Minute)
+			checker := NewChecker(nil, 5*time.Minute, time.Minute, nil, nil, nil, nil)
 			logger := log.Log.WithName("Test")
 
 			Expect(checker.Check(context.Background(), logger, etcd)).To(Succeed())
@@ -213,6 +220,115 @@ var _ = Describe("Check", func() {
 			))
 
 		})
+
+		It("should execute the custom health checks of etcd-druid and the Etcd", func() {
+			etcd := &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-test", Namespace: "test-ns"},
+				Spec: druidv1alpha1.EtcdSpec{
+					Replicas:     1,
+					HealthChecks: []druidv1alpha1.EtcdHealthCheck{{ConditionType: "Healthy", HTTP: &druidapicommon.HTTPHealthCheck{Path: "/readyz"}}},
+				},
+			}
+			healthChecks := []druidapicommon.HealthCheck{
+				{ConditionType: "Healthy", HTTP: &druidapicommon.HTTPHealthCheck{Path: "/health"}},
+				{ConditionType: "Alive", HTTP: &druidapicommon.HTTPHealthCheck{Path: "/livez"}},
+			}
+
+			var (
+				mu    sync.Mutex
+				paths []string
+			)
+			httpClients := condition.NewCustomCheckHTTPClientsWithTransport(func(_ *tls.Config) http.RoundTripper {
+				return testutils.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					paths = append(paths, req.URL.Path)
+					return httptest.NewRecorder().Result(), nil
+				})
+			})
+			defer withVar(&ConditionChecks, []ConditionCheckFn{})()
+			defer withVar(&EtcdMemberChecks, []EtcdMemberCheckFn{})()
+
+			checker := NewChecker(nil, 5*time.Minute, time.Minute, healthChecks, nil, httpClients, nil)
+			Expect(checker.Check(context.Background(), log.Log.WithName("Test"), etcd)).To(Succeed())
+
+			Expect(paths).To(ConsistOf("/readyz", "/livez"))
+			Expect(etcd.Status.Conditions).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(druidv1alpha1.ConditionType("Healthy")),
+					"Status": Equal(druidv1alpha1.ConditionTrue),
+					"Reason": Equal(condition.CustomCheckSucceeded),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(druidv1alpha1.ConditionType("Alive")),
+					"Status": Equal(druidv1alpha1.ConditionTrue),
+					"Reason": Equal(condition.CustomCheckSucceeded),
+				}),
+			))
+		})
+
+		It("should remove the conditions of custom health checks which are no longer configured", func() {
+			etcd := &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-test", Namespace: "test-ns"},
+				Spec:       druidv1alpha1.EtcdSpec{Replicas: 1},
+				Status: druidv1alpha1.EtcdStatus{
+					Conditions: []druidv1alpha1.Condition{
+						{Type: "Healthy", Status: druidv1alpha1.ConditionTrue, Reason: condition.CustomCheckSucceeded},
+						{Type: "Removed", Status: druidv1alpha1.ConditionFalse, Reason: condition.CustomCheckFailed},
+						{Type: druidv1alpha1.ConditionTypeLastSnapshotCompactionSucceeded, Status: druidv1alpha1.ConditionTrue},
+					},
+				},
+			}
+			healthChecks := []druidapicommon.HealthCheck{{ConditionType: "Healthy", HTTP: &druidapicommon.HTTPHealthCheck{Path: "/health"}}}
+
+			httpClients := condition.NewCustomCheckHTTPClientsWithTransport(func(_ *tls.Config) http.RoundTripper {
+				return testutils.RoundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+					return httptest.NewRecorder().Result(), nil
+				})
+			})
+			defer withVar(&ConditionChecks, []ConditionCheckFn{})()
+			defer withVar(&EtcdMemberChecks, []EtcdMemberCheckFn{})()
+
+			checker := NewChecker(nil, 5*time.Minute, time.Minute, healthChecks, nil, httpClients, nil)
+			Expect(checker.Check(context.Background(), log.Log.WithName("Test"), etcd)).To(Succeed())
+
+			Expect(etcd.Status.Conditions).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(druidv1alpha1.ConditionType("Healthy")),
+					"Status": Equal(druidv1alpha1.ConditionTrue),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(druidv1alpha1.ConditionTypeLastSnapshotCompactionSucceeded),
+					"Status": Equal(druidv1alpha1.ConditionTrue),
+				}),
+			))
+		})
+
+		It("should cap the total duration of the custom health checks", func() {
+			etcd := &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-test", Namespace: "test-ns"},
+				Spec:       druidv1alpha1.EtcdSpec{Replicas: 1},
+			}
+			healthChecks := []druidapicommon.HealthCheck{{ConditionType: "Healthy", Webhook: &druidapicommon.WebhookHealthCheck{
+				URL:     "https://health.example.com",
+				Timeout: &metav1.Duration{Duration: druidapicommon.MaxWebhookHealthCheckTimeout},
+			}}}
+
+			var deadline time.Time
+			httpClients := condition.NewCustomCheckHTTPClientsWithTransport(func(_ *tls.Config) http.RoundTripper {
+				return testutils.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					deadline, _ = req.Context().Deadline()
+					return httptest.NewRecorder().Result(), nil
+				})
+			})
+			defer withVar(&ConditionChecks, []ConditionCheckFn{})()
+			defer withVar(&EtcdMemberChecks, []EtcdMemberCheckFn{})()
+
+			checker := NewChecker(nil, 5*time.Minute, time.Minute, healthChecks, nil, httpClients, nil)
+			Expect(checker.Check(context.Background(), log.Log.WithName("Test"), etcd)).To(Succeed())
+
+			Expect(deadline).To(BeTemporally("~", time.Now().Add(druidapicommon.MaxWebhookHealthCheckTimeout), time.Second))
+		})
 	})
 })
 
@@ -286,6 +402,10 @@ func (r *etcdMemberResult) Reason() string {
 	return r.reason
 }
 
+func (r *etcdMemberResult) DBSize() *resource.Quantity {
+	return nil
+}
+
 type etcdMemberTestChecker struct {
 	results []etcdMemberResult
 }
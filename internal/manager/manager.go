@@ -16,6 +16,7 @@ import (
 	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
 	"github.com/gardener/etcd-druid/internal/client/kubernetes"
 	druidcontroller "github.com/gardener/etcd-druid/internal/controller"
+	"github.com/gardener/etcd-druid/internal/tracing"
 	druidwebhook "github.com/gardener/etcd-druid/internal/webhook"
 	druidwebhookutils "github.com/gardener/etcd-druid/internal/webhook/utils"
 
@@ -42,6 +43,9 @@ func InitializeManager(config *druidconfigv1alpha1.OperatorConfiguration) (ctrl.
 	if mgr, err = createManager(config); err != nil {
 		return nil, err
 	}
+	if err = setupTracing(mgr, config.Tracing); err != nil {
+		return nil, err
+	}
 	slog.Info("registering controllers and webhooks with manager")
 	time.Sleep(10 * time.Second)
 	if err = druidcontroller.Register(mgr, config.Controllers); err != nil {
@@ -109,6 +113,31 @@ func createManager(operatorConfig *druidconfigv1alpha1.OperatorConfiguration) (c
 	})
 }
 
+// setupTracing sets up OpenTelemetry tracing as per the given configuration, and flushes the pending spans when the
+// manager is stopped.
+func setupTracing(mgr ctrl.Manager, tracingConfig *druidconfigv1alpha1.TracingConfiguration) error {
+	shutdown, err := tracing.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		return err
+	}
+	return mgr.Add(tracingShutdownRunnable(shutdown))
+}
+
+// tracingShutdownRunnable is a manager.Runnable which shuts down the tracer provider when the manager is stopped,
+// irrespective of whether this instance is the leader.
+type tracingShutdownRunnable tracing.ShutdownFunc
+
+func (t tracingShutdownRunnable) Start(ctx context.Context) error {
+	<-ctx.Done()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return t(shutdownCtx)
+}
+
+func (t tracingShutdownRunnable) NeedLeaderElection() bool {
+	return false
+}
+
 func registerHealthAndReadyEndpoints(mgr ctrl.Manager, config *druidconfigv1alpha1.OperatorConfiguration) error {
 	slog.Info("Registering ping health check endpoint")
 	// Add a health check which always returns true when it is checked
@@ -32,6 +32,22 @@ const (
 
 	// LabelEtcdNamespace is the label for prometheus metrics to indicate etcd namespace
 	LabelEtcdNamespace = "etcd_namespace"
+	// LabelEtcdName is the label for prometheus metrics to indicate etcd name
+	LabelEtcdName = "etcd_name"
+	// LabelSecretName is the label for prometheus metrics to indicate the name of a secret
+	LabelSecretName = "secret_name"
+	// LabelCertificateUsage is the label for prometheus metrics to indicate how a certificate is used by an etcd cluster
+	LabelCertificateUsage = "usage"
+	// LabelConditionType is the label for prometheus metrics to indicate the type of a condition
+	LabelConditionType = "condition"
+	// LabelConditionStatus is the label for prometheus metrics to indicate the status of a condition
+	LabelConditionStatus = "status"
+	// LabelComponentKind is the label for prometheus metrics to indicate the kind of a component of an etcd cluster
+	LabelComponentKind = "kind"
+	// LabelTaskType is the label for prometheus metrics to indicate the type of an EtcdOpsTask
+	LabelTaskType = "task_type"
+	// LabelTaskState is the label for prometheus metrics to indicate the state of an EtcdOpsTask
+	LabelTaskState = "state"
 )
 
 var (
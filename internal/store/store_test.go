@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils"
 	testutils "github.com/gardener/etcd-druid/test/utils"
@@ -47,7 +48,7 @@ func TestGetHostMountPathFromSecretRef(t *testing.T) {
 		{
 			name:                  "no secret ref configured, should return default mount path",
 			secretRefDefined:      false,
-			expectedHostMountPath: store.LocalProviderDefaultMountPath,
+			expectedHostMountPath: druidresources.LocalProviderDefaultMountPath,
 		},
 		{
 			name:             "secret ref points to an unknown secret, should return an error",
@@ -60,7 +61,7 @@ func TestGetHostMountPathFromSecretRef(t *testing.T) {
 			secretRefDefined:      true,
 			secretExists:          true,
 			hostPathSetInSecret:   false,
-			expectedHostMountPath: store.LocalProviderDefaultMountPath,
+			expectedHostMountPath: druidresources.LocalProviderDefaultMountPath,
 		},
 		{
 			name:                  "secret ref points to a secret whose data has a path, should return the path defined in secret.Data",
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+	druidversion "github.com/gardener/etcd-druid/internal/version"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// tracerName is the name of the tracer used for all spans created by etcd-druid.
+	tracerName = "github.com/gardener/etcd-druid"
+	// serviceName is the name of the service as which etcd-druid is identified in the traces.
+	serviceName = "etcd-druid"
+)
+
+// Attribute keys used for the spans created by etcd-druid.
+const (
+	// AttributeEtcdNamespace is the namespace of the Etcd resource.
+	AttributeEtcdNamespace = attribute.Key("etcd.namespace")
+	// AttributeEtcdName is the name of the Etcd resource.
+	AttributeEtcdName = attribute.Key("etcd.name")
+	// AttributeEtcdOpsTaskName is the name of the EtcdOpsTask resource.
+	AttributeEtcdOpsTaskName = attribute.Key("etcdopstask.name")
+	// AttributeRunID is the ID of the reconciliation run.
+	AttributeRunID = attribute.Key("druid.run_id")
+	// AttributeComponentKind is the kind of the component on which an operation is performed.
+	AttributeComponentKind = attribute.Key("druid.component.kind")
+)
+
+// ShutdownFunc flushes the pending spans and releases the resources of the tracer provider.
+type ShutdownFunc func(ctx context.Context) error
+
+// Setup configures the global OpenTelemetry tracer provider and propagator as per the given configuration. If tracing
+// is not enabled, then the global no-op tracer provider is left in place, so that creating spans has no effect.
+func Setup(ctx context.Context, config *druidconfigv1alpha1.TracingConfiguration) (ShutdownFunc, error) {
+	if config == nil || !config.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, closeFn, err := newExporter(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", druidversion.Get().GitVersion),
+	))
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to create tracing resource: %w", err), closeFn())
+	}
+	samplingRatio := float64(ptr.Deref(config.SamplingPercentage, 100)) / 100
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRatio))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return func(ctx context.Context) error {
+		return errors.Join(tracerProvider.Shutdown(ctx), closeFn())
+	}, nil
+}
+
+func newExporter(ctx context.Context, config *druidconfigv1alpha1.TracingConfiguration) (sdktrace.SpanExporter, func() error, error) {
+	switch {
+	case config.OTLP != nil:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.OTLP.Endpoint)}
+		if config.OTLP.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+		}
+		return exporter, func() error { return nil }, nil
+	case config.File != nil:
+		file, err := os.OpenFile(config.File.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			return nil, nil, errors.Join(fmt.Errorf("failed to create file trace exporter: %w", err), file.Close())
+		}
+		return exporter, file.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("no trace exporter configured")
+	}
+}
+
+// StartSpan starts a span with the given name and attributes as child of the span in the given context, if any.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the given error, if any, on the span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InjectHTTPHeaders propagates the span in the given context to the receiver of the given request via its headers.
+func InjectHTTPHeaders(ctx context.Context, req *http.Request) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+}
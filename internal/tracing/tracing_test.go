@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	druidconfigv1alpha1 "github.com/gardener/etcd-druid/api/config/v1alpha1"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSetupWithTracingDisabled(t *testing.T) {
+	g := NewWithT(t)
+	for _, config := range []*druidconfigv1alpha1.TracingConfiguration{nil, {Enabled: false}} {
+		shutdown, err := Setup(context.Background(), config)
+		g.Expect(err).ToNot(HaveOccurred())
+		_, span := StartSpan(context.Background(), "test")
+		g.Expect(span.SpanContext().IsValid()).To(BeFalse())
+		EndSpan(span, nil)
+		g.Expect(shutdown(context.Background())).To(Succeed())
+	}
+}
+
+func TestSetupWithFileExporter(t *testing.T) {
+	g := NewWithT(t)
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+	defer otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	path := filepath.Join(t.TempDir(), "traces.json")
+
+	shutdown, err := Setup(context.Background(), &druidconfigv1alpha1.TracingConfiguration{
+		Enabled: true,
+		File:    &druidconfigv1alpha1.FileTraceExporterConfiguration{Path: path},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx, parent := StartSpan(context.Background(), "parent", AttributeEtcdName.String("test-etcd"))
+	childCtx, child := StartSpan(ctx, "child")
+	req, err := http.NewRequestWithContext(childCtx, http.MethodPost, "https://etcd-test-client.test-ns:8080/snapshot/full", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	InjectHTTPHeaders(childCtx, req)
+	g.Expect(req.Header.Get("traceparent")).To(ContainSubstring(child.SpanContext().TraceID().String()))
+	EndSpan(child, errors.New("test error"))
+	EndSpan(parent, nil)
+	g.Expect(shutdown(context.Background())).To(Succeed())
+
+	data, err := os.ReadFile(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	traces := string(data)
+	g.Expect(traces).To(ContainSubstring(`"Name":"parent"`))
+	g.Expect(traces).To(ContainSubstring(`"Name":"child"`))
+	g.Expect(traces).To(ContainSubstring("test-etcd"))
+	g.Expect(traces).To(ContainSubstring("test error"))
+}
+
+func TestSetupWithoutExporter(t *testing.T) {
+	g := NewWithT(t)
+	_, err := Setup(context.Background(), &druidconfigv1alpha1.TracingConfiguration{Enabled: true})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -20,7 +20,7 @@ import (
 
 // IsPeerURLInSyncForAllMembers checks if the peer URL is in sync for all existing members of an etcd cluster identified by etcdName and in the provided namespace.
 func IsPeerURLInSyncForAllMembers(ctx context.Context, cl client.Client, logger logr.Logger, etcd *druidv1alpha1.Etcd, replicas int32) (bool, error) {
-	peerURLTLSEnabled := etcd.Spec.Etcd.PeerUrlTLS != nil
+	peerURLTLSEnabled := etcd.GetPeerURLTLS() != nil
 	if peerURLTLSEnabled {
 		return isPeerURLTLSEnabledForMembers(ctx, cl, logger, etcd, replicas)
 	}
@@ -149,7 +149,7 @@ func createLeases(etcd *druidv1alpha1.Etcd, withTLSEnabled int) []*coordinationv
 	numLeases := int(etcd.Spec.Replicas)
 	leases := make([]*coordinationv1.Lease, 0, numLeases)
 	labels := map[string]string{
-		druidv1alpha1.LabelComponentKey: common.ComponentNameMemberLease,
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameMemberLease,
 		druidv1alpha1.LabelPartOfKey:    etcd.Name,
 		druidv1alpha1.LabelManagedByKey: druidv1alpha1.LabelManagedByValue,
 	}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetEtcdClientTLSConfig returns the TLS configuration for clients of the given Etcd, which trusts the CA of the client
+// communication and presents the client certificate, if one is configured. It returns nil if TLS is not enabled for the
+// client communication.
+func GetEtcdClientTLSConfig(ctx context.Context, cl client.Client, etcd *druidv1alpha1.Etcd) (*tls.Config, error) {
+	tlsConfig := etcd.GetClientURLTLS()
+	if tlsConfig == nil {
+		return nil, nil
+	}
+	caSecret := &corev1.Secret{}
+	if err := cl.Get(ctx, client.ObjectKey{Name: tlsConfig.TLSCASecretRef.Name, Namespace: etcd.Namespace}, caSecret); err != nil {
+		return nil, fmt.Errorf("failed to get CA secret %s: %w", tlsConfig.TLSCASecretRef.Name, err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caSecret.Data[ptr.Deref(tlsConfig.TLSCASecretRef.DataKey, "ca.crt")]) {
+		return nil, fmt.Errorf("no CA certificate found in secret %s", tlsConfig.TLSCASecretRef.Name)
+	}
+	clientTLSConfig := &tls.Config{
+		RootCAs:    caPool,
+		MinVersion: tls.VersionTLS12,
+	}
+	if tlsConfig.ClientTLSSecretRef.Name == "" {
+		return clientTLSConfig, nil
+	}
+	clientSecret := &corev1.Secret{}
+	if err := cl.Get(ctx, client.ObjectKey{Name: tlsConfig.ClientTLSSecretRef.Name, Namespace: etcd.Namespace}, clientSecret); err != nil {
+		return nil, fmt.Errorf("failed to get client TLS secret %s: %w", tlsConfig.ClientTLSSecretRef.Name, err)
+	}
+	clientCert, err := tls.X509KeyPair(clientSecret.Data[corev1.TLSCertKey], clientSecret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse client certificate from secret %s: %w", tlsConfig.ClientTLSSecretRef.Name, err)
+	}
+	clientTLSConfig.Certificates = []tls.Certificate{clientCert}
+	return clientTLSConfig, nil
+}
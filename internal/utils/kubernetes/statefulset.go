@@ -11,7 +11,7 @@ import (
 	"strings"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/utils"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -93,19 +93,19 @@ func FetchPVCWarningMessagesForStatefulSet(ctx context.Context, cl client.Client
 }
 
 var (
-	etcdTLSVolumeMountNames = sets.New(common.VolumeNameEtcdCA,
-		common.VolumeNameEtcdServerTLS,
-		common.VolumeNameEtcdClientTLS,
-		common.VolumeNameEtcdPeerCA,
-		common.VolumeNameEtcdPeerServerTLS,
-		common.VolumeNameBackupRestoreCA)
-	possiblePeerTLSVolumeMountNames = sets.New(common.VolumeNameEtcdPeerCA,
-		common.OldVolumeNameEtcdPeerCA,
-		common.VolumeNameEtcdPeerServerTLS,
-		common.OldVolumeNameEtcdPeerServerTLS)
-	etcdbrTLSVolumeMountNames = sets.New(common.VolumeNameBackupRestoreServerTLS,
-		common.VolumeNameEtcdCA,
-		common.VolumeNameEtcdClientTLS)
+	etcdTLSVolumeMountNames = sets.New(druidresources.VolumeNameEtcdCA,
+		druidresources.VolumeNameEtcdServerTLS,
+		druidresources.VolumeNameEtcdClientTLS,
+		druidresources.VolumeNameEtcdPeerCA,
+		druidresources.VolumeNameEtcdPeerServerTLS,
+		druidresources.VolumeNameBackupRestoreCA)
+	possiblePeerTLSVolumeMountNames = sets.New(druidresources.VolumeNameEtcdPeerCA,
+		druidresources.OldVolumeNameEtcdPeerCA,
+		druidresources.VolumeNameEtcdPeerServerTLS,
+		druidresources.OldVolumeNameEtcdPeerServerTLS)
+	etcdbrTLSVolumeMountNames = sets.New(druidresources.VolumeNameBackupRestoreServerTLS,
+		druidresources.VolumeNameEtcdCA,
+		druidresources.VolumeNameEtcdClientTLS)
 )
 
 // GetEtcdContainerPeerTLSVolumeMounts returns the volume mounts for the etcd container that are related to peer TLS.
@@ -116,7 +116,7 @@ func GetEtcdContainerPeerTLSVolumeMounts(sts *appsv1.StatefulSet) []corev1.Volum
 		return volumeMounts
 	}
 	for _, container := range sts.Spec.Template.Spec.Containers {
-		if container.Name == common.ContainerNameEtcd {
+		if container.Name == druidresources.ContainerNameEtcd {
 			for _, volMount := range container.VolumeMounts {
 				if possiblePeerTLSVolumeMountNames.Has(volMount.Name) {
 					volumeMounts = append(volumeMounts, volMount)
@@ -147,7 +147,7 @@ func GetStatefulSetContainerTLSVolumeMounts(sts *appsv1.StatefulSet) map[string]
 
 func filterTLSVolumeMounts(containerName string, allVolumeMounts []corev1.VolumeMount) []corev1.VolumeMount {
 	filteredVolMounts := make([]corev1.VolumeMount, 0, len(allVolumeMounts))
-	knownTLSVolMountNames := utils.IfConditionOr(containerName == common.ContainerNameEtcd, etcdTLSVolumeMountNames, etcdbrTLSVolumeMountNames)
+	knownTLSVolMountNames := utils.IfConditionOr(containerName == druidresources.ContainerNameEtcd, etcdTLSVolumeMountNames, etcdbrTLSVolumeMountNames)
 	for _, volMount := range allVolumeMounts {
 		if knownTLSVolMountNames.Has(volMount.Name) {
 			filteredVolMounts = append(filteredVolMounts, volMount)
@@ -11,8 +11,8 @@ import (
 	"strings"
 	"testing"
 
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/client/kubernetes"
-	"github.com/gardener/etcd-druid/internal/common"
 	"github.com/gardener/etcd-druid/internal/utils"
 	testutils "github.com/gardener/etcd-druid/test/utils"
 
@@ -289,15 +289,15 @@ func TestGetEtcdContainerPeerTLSVolumeMounts(t *testing.T) {
 			name:             "sts with old volume mount names",
 			oldVolMountNames: true,
 			expectedVolumeMounts: []corev1.VolumeMount{
-				{Name: common.OldVolumeNameEtcdPeerCA, MountPath: common.VolumeMountPathEtcdPeerCA},
-				{Name: common.OldVolumeNameEtcdPeerServerTLS, MountPath: common.VolumeMountPathEtcdPeerServerTLS},
+				{Name: druidresources.OldVolumeNameEtcdPeerCA, MountPath: druidresources.VolumeMountPathEtcdPeerCA},
+				{Name: druidresources.OldVolumeNameEtcdPeerServerTLS, MountPath: druidresources.VolumeMountPathEtcdPeerServerTLS},
 			},
 		},
 		{
 			name: "sts with new volume mount names",
 			expectedVolumeMounts: []corev1.VolumeMount{
-				{Name: common.VolumeNameEtcdPeerCA, MountPath: common.VolumeMountPathEtcdPeerCA},
-				{Name: common.VolumeNameEtcdPeerServerTLS, MountPath: common.VolumeMountPathEtcdPeerServerTLS},
+				{Name: druidresources.VolumeNameEtcdPeerCA, MountPath: druidresources.VolumeMountPathEtcdPeerCA},
+				{Name: druidresources.VolumeNameEtcdPeerServerTLS, MountPath: druidresources.VolumeMountPathEtcdPeerServerTLS},
 			},
 		},
 	}
@@ -311,16 +311,16 @@ func TestGetEtcdContainerPeerTLSVolumeMounts(t *testing.T) {
 				g.Expect(GetEtcdContainerPeerTLSVolumeMounts(sts)).To(HaveLen(0))
 			} else {
 				sts = testutils.CreateStatefulSet("test-sts", "test-ns", uuid.NewUUID(), 3)
-				sts.Spec.Template.Spec.Containers = append(sts.Spec.Template.Spec.Containers, corev1.Container{Name: common.ContainerNameEtcd})
+				sts.Spec.Template.Spec.Containers = append(sts.Spec.Template.Spec.Containers, corev1.Container{Name: druidresources.ContainerNameEtcd})
 				if tc.oldVolMountNames {
 					sts.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
-						{Name: common.OldVolumeNameEtcdPeerCA, MountPath: common.VolumeMountPathEtcdPeerCA},
-						{Name: common.OldVolumeNameEtcdPeerServerTLS, MountPath: common.VolumeMountPathEtcdPeerServerTLS},
+						{Name: druidresources.OldVolumeNameEtcdPeerCA, MountPath: druidresources.VolumeMountPathEtcdPeerCA},
+						{Name: druidresources.OldVolumeNameEtcdPeerServerTLS, MountPath: druidresources.VolumeMountPathEtcdPeerServerTLS},
 					}
 				} else {
 					sts.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
-						{Name: common.VolumeNameEtcdPeerCA, MountPath: common.VolumeMountPathEtcdPeerCA},
-						{Name: common.VolumeNameEtcdPeerServerTLS, MountPath: common.VolumeMountPathEtcdPeerServerTLS},
+						{Name: druidresources.VolumeNameEtcdPeerCA, MountPath: druidresources.VolumeMountPathEtcdPeerCA},
+						{Name: druidresources.VolumeNameEtcdPeerServerTLS, MountPath: druidresources.VolumeMountPathEtcdPeerServerTLS},
 					}
 				}
 				g.Expect(GetEtcdContainerPeerTLSVolumeMounts(sts)).To(Equal(tc.expectedVolumeMounts))
@@ -345,14 +345,14 @@ func TestGetStatefulSetContainerTLSVolumeMounts(t *testing.T) {
 			name:           "sts with peer TLS enabled",
 			peerTLSEnabled: true,
 			expectedVolumeMounts: map[string][]corev1.VolumeMount{
-				common.ContainerNameEtcd: {
-					{Name: common.VolumeNameEtcdPeerCA, MountPath: common.VolumeMountPathEtcdPeerCA},
-					{Name: common.VolumeNameEtcdPeerServerTLS, MountPath: common.VolumeMountPathEtcdPeerServerTLS},
+				druidresources.ContainerNameEtcd: {
+					{Name: druidresources.VolumeNameEtcdPeerCA, MountPath: druidresources.VolumeMountPathEtcdPeerCA},
+					{Name: druidresources.VolumeNameEtcdPeerServerTLS, MountPath: druidresources.VolumeMountPathEtcdPeerServerTLS},
 				},
-				common.ContainerNameEtcdBackupRestore: {
-					{Name: common.VolumeNameBackupRestoreServerTLS, MountPath: common.VolumeMountPathBackupRestoreServerTLS},
-					{Name: common.VolumeNameEtcdCA, MountPath: common.VolumeMountPathEtcdCA},
-					{Name: common.VolumeNameEtcdClientTLS, MountPath: common.VolumeMountPathEtcdClientTLS},
+				druidresources.ContainerNameEtcdBackupRestore: {
+					{Name: druidresources.VolumeNameBackupRestoreServerTLS, MountPath: druidresources.VolumeMountPathBackupRestoreServerTLS},
+					{Name: druidresources.VolumeNameEtcdCA, MountPath: druidresources.VolumeMountPathEtcdCA},
+					{Name: druidresources.VolumeNameEtcdClientTLS, MountPath: druidresources.VolumeMountPathEtcdClientTLS},
 				},
 			},
 		},
@@ -360,11 +360,11 @@ func TestGetStatefulSetContainerTLSVolumeMounts(t *testing.T) {
 			name:           "sts with peer TLS disabled",
 			peerTLSEnabled: false,
 			expectedVolumeMounts: map[string][]corev1.VolumeMount{
-				common.ContainerNameEtcd: {},
-				common.ContainerNameEtcdBackupRestore: {
-					{Name: common.VolumeNameBackupRestoreServerTLS, MountPath: common.VolumeMountPathBackupRestoreServerTLS},
-					{Name: common.VolumeNameEtcdCA, MountPath: common.VolumeMountPathEtcdCA},
-					{Name: common.VolumeNameEtcdClientTLS, MountPath: common.VolumeMountPathEtcdClientTLS},
+				druidresources.ContainerNameEtcd: {},
+				druidresources.ContainerNameEtcdBackupRestore: {
+					{Name: druidresources.VolumeNameBackupRestoreServerTLS, MountPath: druidresources.VolumeMountPathBackupRestoreServerTLS},
+					{Name: druidresources.VolumeNameEtcdCA, MountPath: druidresources.VolumeMountPathEtcdCA},
+					{Name: druidresources.VolumeNameEtcdClientTLS, MountPath: druidresources.VolumeMountPathEtcdClientTLS},
 				},
 			},
 		},
@@ -380,19 +380,19 @@ func TestGetStatefulSetContainerTLSVolumeMounts(t *testing.T) {
 			} else {
 				sts = testutils.CreateStatefulSet("test-sts", "test-ns", uuid.NewUUID(), 3)
 				sts.Spec.Template.Spec.Containers = []corev1.Container{
-					{Name: common.ContainerNameEtcd},
-					{Name: common.ContainerNameEtcdBackupRestore},
+					{Name: druidresources.ContainerNameEtcd},
+					{Name: druidresources.ContainerNameEtcdBackupRestore},
 				}
 				if tc.peerTLSEnabled {
 					sts.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
-						{Name: common.VolumeNameEtcdPeerCA, MountPath: common.VolumeMountPathEtcdPeerCA},
-						{Name: common.VolumeNameEtcdPeerServerTLS, MountPath: common.VolumeMountPathEtcdPeerServerTLS},
+						{Name: druidresources.VolumeNameEtcdPeerCA, MountPath: druidresources.VolumeMountPathEtcdPeerCA},
+						{Name: druidresources.VolumeNameEtcdPeerServerTLS, MountPath: druidresources.VolumeMountPathEtcdPeerServerTLS},
 					}
 				}
 				sts.Spec.Template.Spec.Containers[1].VolumeMounts = []corev1.VolumeMount{
-					{Name: common.VolumeNameBackupRestoreServerTLS, MountPath: common.VolumeMountPathBackupRestoreServerTLS},
-					{Name: common.VolumeNameEtcdCA, MountPath: common.VolumeMountPathEtcdCA},
-					{Name: common.VolumeNameEtcdClientTLS, MountPath: common.VolumeMountPathEtcdClientTLS},
+					{Name: druidresources.VolumeNameBackupRestoreServerTLS, MountPath: druidresources.VolumeMountPathBackupRestoreServerTLS},
+					{Name: druidresources.VolumeNameEtcdCA, MountPath: druidresources.VolumeMountPathEtcdCA},
+					{Name: druidresources.VolumeNameEtcdClientTLS, MountPath: druidresources.VolumeMountPathEtcdClientTLS},
 				}
 				g.Expect(GetStatefulSetContainerTLSVolumeMounts(sts)).To(Equal(tc.expectedVolumeMounts))
 			}
@@ -409,7 +409,7 @@ func TestGetSecretNameFromVolume(t *testing.T) {
 	stsWithoutCAVolume := testutils.CreateStatefulSet("test-sts", "test-ns", uuid.NewUUID(), 1)
 	stsWithEmptyDirNamedAsCAVolume := testutils.AddEmptyDirVolume(
 		testutils.CreateStatefulSet("test-sts", "test-ns", uuid.NewUUID(), 1),
-		common.VolumeNameBackupRestoreCA,
+		druidresources.VolumeNameBackupRestoreCA,
 	)
 
 	testCases := []struct {
@@ -422,28 +422,28 @@ func TestGetSecretNameFromVolume(t *testing.T) {
 		{
 			name:          "sts is nil",
 			sts:           nil,
-			volumeName:    common.VolumeNameBackupRestoreCA,
+			volumeName:    druidresources.VolumeNameBackupRestoreCA,
 			expectedName:  "",
 			expectedFound: false,
 		},
 		{
 			name:          "volume not present on sts",
 			sts:           stsWithoutCAVolume,
-			volumeName:    common.VolumeNameBackupRestoreCA,
+			volumeName:    druidresources.VolumeNameBackupRestoreCA,
 			expectedName:  "",
 			expectedFound: false,
 		},
 		{
 			name:          "secret-typed volume found, returns secret name",
 			sts:           stsWithCAVolume,
-			volumeName:    common.VolumeNameBackupRestoreCA,
+			volumeName:    druidresources.VolumeNameBackupRestoreCA,
 			expectedName:  etcdbrCASecretName,
 			expectedFound: true,
 		},
 		{
 			name:          "volume name matches but volume source is not a secret",
 			sts:           stsWithEmptyDirNamedAsCAVolume,
-			volumeName:    common.VolumeNameBackupRestoreCA,
+			volumeName:    druidresources.VolumeNameBackupRestoreCA,
 			expectedName:  "",
 			expectedFound: false,
 		},
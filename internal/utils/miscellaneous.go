@@ -10,9 +10,7 @@ import (
 	"fmt"
 	"maps"
 	"strings"
-	"time"
 
-	"github.com/robfig/cron/v3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -68,22 +66,6 @@ func IfConditionOr[T any](condition bool, trueVal, falseVal T) T {
 	return falseVal
 }
 
-// ComputeScheduleInterval computes the interval between two activations for the given cron schedule.
-// Assumes that every cron activation is at equal intervals apart, based on cron schedules such as
-// "once every X hours", "once every Y days", "at 1:00pm on every Tuesday", etc.
-// TODO: write a new function to accurately compute the previous activation time from the cron schedule
-// in order to compute when the previous activation of the cron schedule was supposed to have occurred,
-// instead of relying on the assumption that all the cron activations are evenly spaced.
-func ComputeScheduleInterval(cronSchedule string) (time.Duration, error) {
-	schedule, err := cron.ParseStandard(cronSchedule)
-	if err != nil {
-		return 0, err
-	}
-	nextScheduledTime := schedule.Next(time.Now())
-	nextNextScheduledTime := schedule.Next(nextScheduledTime)
-	return nextNextScheduledTime.Sub(nextScheduledTime), nil
-}
-
 // ComputeSHA256Hex computes the hexadecimal representation of the SHA256 hash of the given input byte
 // slice <in>, converts it to a string and returns it.
 func ComputeSHA256Hex(in []byte) string {
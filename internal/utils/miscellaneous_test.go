@@ -6,7 +6,6 @@ package utils
 
 import (
 	"testing"
-	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -162,59 +161,3 @@ func TestIfConditionOr(t *testing.T) {
 		})
 	}
 }
-
-func TestComputeScheduleInterval(t *testing.T) {
-	testCases := []struct {
-		name         string
-		cronSchedule string
-		expected     time.Duration
-		expectError  bool
-	}{
-		{
-			name:         "Valid cron schedule",
-			cronSchedule: "0 0 * * *",
-			expected:     24 * time.Hour,
-			expectError:  false,
-		},
-		{
-			name:         "Valid cron schedule",
-			cronSchedule: "0 0 * * 1",
-			expected:     24 * time.Hour * 7,
-			expectError:  false,
-		},
-		{
-			name:         "Valid cron schedule",
-			cronSchedule: "*/1 * * * *",
-			expected:     1 * time.Minute,
-			expectError:  false,
-		},
-		{
-			name:         "Valid cron schedule",
-			cronSchedule: "0 */1 * * *",
-			expected:     1 * time.Hour,
-			expectError:  false,
-		},
-		{
-			name:         "Invalid cron schedule",
-			cronSchedule: "invalid-cron",
-			expected:     0,
-			expectError:  true,
-		},
-	}
-
-	g := NewWithT(t)
-	t.Parallel()
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
-			duration, err := ComputeScheduleInterval(tc.cronSchedule)
-			if tc.expectError {
-				g.Expect(err).ToNot(BeNil())
-			} else {
-				g.Expect(err).To(BeNil())
-				g.Expect(duration).To(Equal(tc.expected))
-			}
-		})
-	}
-}
@@ -12,7 +12,7 @@ import (
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/store"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/test/e2e/testenv"
 	e2etestutils "github.com/gardener/etcd-druid/test/e2e/utils"
 	testutils "github.com/gardener/etcd-druid/test/utils"
@@ -75,7 +75,7 @@ func getProviderSuffix(provider druidv1alpha1.StorageProvider) string {
 	if provider == "none" {
 		return "none"
 	}
-	p, err := store.StorageProviderFromInfraProvider(ptr.To(provider))
+	p, err := druidresources.StorageProviderFromInfraProvider(ptr.To(provider))
 	if err != nil {
 		return ""
 	}
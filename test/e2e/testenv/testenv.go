@@ -12,7 +12,7 @@ import (
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/component"
 	"github.com/gardener/etcd-druid/internal/component/clientservice"
 	"github.com/gardener/etcd-druid/internal/component/configmap"
@@ -405,9 +405,9 @@ func getZeroDowntimeValidatorJob(namespace, etcdClientServiceName string, etcdCl
 							Command:         []string{"/bin/sh", "-c"},
 							Args:            []string{getHealthCheckScript(etcdClientServiceName, etcdClientServicePort)},
 							VolumeMounts: []corev1.VolumeMount{
-								{MountPath: common.VolumeMountPathEtcdCA, Name: testutils.ClientTLSCASecretName},
-								{MountPath: common.VolumeMountPathEtcdServerTLS, Name: testutils.ClientTLSServerCertSecretName},
-								{MountPath: common.VolumeMountPathEtcdClientTLS, Name: testutils.ClientTLSClientCertSecretName, ReadOnly: true},
+								{MountPath: druidresources.VolumeMountPathEtcdCA, Name: testutils.ClientTLSCASecretName},
+								{MountPath: druidresources.VolumeMountPathEtcdServerTLS, Name: testutils.ClientTLSServerCertSecretName},
+								{MountPath: druidresources.VolumeMountPathEtcdClientTLS, Name: testutils.ClientTLSClientCertSecretName, ReadOnly: true},
 							},
 							ReadinessProbe: getProbe("/tmp/healthy"),
 							LivenessProbe:  getProbe("/tmp/healthy"),
@@ -445,7 +445,7 @@ func getHealthCheckScript(etcdClientServiceName string, etcdClientServicePort in
             failed=0
             sleep 2
         fi
-    done`, common.VolumeMountPathEtcdCA, common.VolumeMountPathEtcdClientTLS, common.VolumeMountPathEtcdClientTLS, etcdClientServiceName, etcdClientServicePort)
+    done`, druidresources.VolumeMountPathEtcdCA, druidresources.VolumeMountPathEtcdClientTLS, druidresources.VolumeMountPathEtcdClientTLS, etcdClientServiceName, etcdClientServicePort)
 }
 
 // getProbe creates a probe with specified file path for readiness and liveness checks.
@@ -510,8 +510,8 @@ func getEtcdLoaderJob(g *WithT, namespace, etcdClientServiceName string, etcdCli
 							Command:         []string{"/bin/sh", "-c"},
 							Args:            []string{getEtcdLoaderScript(numKeys, etcdClientServiceName, etcdClientServicePort)},
 							VolumeMounts: []corev1.VolumeMount{
-								{MountPath: common.VolumeMountPathEtcdCA, Name: testutils.ClientTLSCASecretName},
-								{MountPath: common.VolumeMountPathEtcdClientTLS, Name: testutils.ClientTLSClientCertSecretName},
+								{MountPath: druidresources.VolumeMountPathEtcdCA, Name: testutils.ClientTLSCASecretName},
+								{MountPath: druidresources.VolumeMountPathEtcdClientTLS, Name: testutils.ClientTLSClientCertSecretName},
 							},
 						},
 					},
@@ -541,7 +541,7 @@ func getEtcdLoaderScript(numKeys int64, etcdClientServiceName string, etcdClient
 		-d "{\"key\": \"$key\", \"value\": \"$value\"}" \
 		-L https://%s:%d/v3/kv/put;
 	done
-	`, numKeys, common.VolumeMountPathEtcdCA, common.VolumeMountPathEtcdClientTLS, common.VolumeMountPathEtcdClientTLS, etcdClientServiceName, etcdClientServicePort)
+	`, numKeys, druidresources.VolumeMountPathEtcdCA, druidresources.VolumeMountPathEtcdClientTLS, druidresources.VolumeMountPathEtcdClientTLS, etcdClientServiceName, etcdClientServicePort)
 }
 
 // getTLSVolume creates a volume for the TLS secret.
@@ -551,7 +551,7 @@ func getTLSVolume(name, secretName string) corev1.Volume {
 		VolumeSource: corev1.VolumeSource{
 			Secret: &corev1.SecretVolumeSource{
 				SecretName:  secretName,
-				DefaultMode: ptr.To(common.ModeOwnerReadWriteGroupRead),
+				DefaultMode: ptr.To(druidresources.ModeOwnerReadWriteGroupRead),
 			},
 		},
 	}
@@ -609,16 +609,16 @@ func getSnapshotterJob(g *WithT, etcd *druidv1alpha1.Etcd, snapshotType string)
 							Command:         []string{"/bin/sh", "-c"},
 							Args: []string{
 								fmt.Sprintf(`curl --cacert %s/ca.crt --cert %s/tls.crt --key %s/tls.key https://%s:%d/snapshot/%s`,
-									common.VolumeMountPathBackupRestoreCA,
-									common.VolumeMountPathBackupRestoreClientTLS,
-									common.VolumeMountPathBackupRestoreClientTLS,
+									druidresources.VolumeMountPathBackupRestoreCA,
+									druidresources.VolumeMountPathBackupRestoreClientTLS,
+									druidresources.VolumeMountPathBackupRestoreClientTLS,
 									druidv1alpha1.GetClientServiceName(etcd.ObjectMeta),
 									*etcd.Spec.Backup.Port,
 									snapshotType),
 							},
 							VolumeMounts: []corev1.VolumeMount{
-								{MountPath: common.VolumeMountPathBackupRestoreCA, Name: testutils.BackupRestoreTLSCASecretName},
-								{MountPath: common.VolumeMountPathBackupRestoreClientTLS, Name: testutils.BackupRestoreTLSClientCertSecretName},
+								{MountPath: druidresources.VolumeMountPathBackupRestoreCA, Name: testutils.BackupRestoreTLSCASecretName},
+								{MountPath: druidresources.VolumeMountPathBackupRestoreClientTLS, Name: testutils.BackupRestoreTLSClientCertSecretName},
 							},
 						},
 					},
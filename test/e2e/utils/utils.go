@@ -11,7 +11,7 @@ import (
 	"strings"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/store"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -21,10 +21,10 @@ import (
 
 var knownBackupProviders = []druidv1alpha1.StorageProvider{
 	"none",
-	store.Local, druidv1alpha1.StorageProvider(strings.ToLower(store.Local)),
-	store.S3, druidv1alpha1.StorageProvider(strings.ToLower(store.S3)),
-	store.ABS, druidv1alpha1.StorageProvider(strings.ToLower(store.ABS)),
-	store.GCS, druidv1alpha1.StorageProvider(strings.ToLower(store.GCS)),
+	druidresources.Local, druidv1alpha1.StorageProvider(strings.ToLower(druidresources.Local)),
+	druidresources.S3, druidv1alpha1.StorageProvider(strings.ToLower(druidresources.S3)),
+	druidresources.ABS, druidv1alpha1.StorageProvider(strings.ToLower(druidresources.ABS)),
+	druidresources.GCS, druidv1alpha1.StorageProvider(strings.ToLower(druidresources.GCS)),
 }
 
 // getKubeconfig returns a Kubeconfig built from the config file in the specified path.
@@ -53,7 +53,7 @@ func ParseBackupProviders(providers string) ([]druidv1alpha1.StorageProvider, er
 		for p := range strings.SplitSeq(providers, ",") {
 			provider := druidv1alpha1.StorageProvider("none")
 			if p != "none" {
-				prov, err := store.StorageProviderFromInfraProvider(ptr.To(druidv1alpha1.StorageProvider(strings.TrimSpace(p))))
+				prov, err := druidresources.StorageProviderFromInfraProvider(ptr.To(druidv1alpha1.StorageProvider(strings.TrimSpace(p))))
 				if err != nil {
 					errs = errors.Join(errs, fmt.Errorf("failed to parse provider %s: %w", p, err))
 				}
@@ -10,8 +10,7 @@ import (
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
-	druidstore "github.com/gardener/etcd-druid/internal/store"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
 	testutils "github.com/gardener/etcd-druid/test/utils"
 
@@ -397,7 +396,7 @@ var _ = Describe("Compaction Controller", func() {
 })
 
 func validateEtcdForCompactionJob(instance *druidv1alpha1.Etcd, j *batchv1.Job) {
-	store, err := druidstore.StorageProviderFromInfraProvider(instance.Spec.Backup.Store.Provider)
+	store, err := druidresources.StorageProviderFromInfraProvider(instance.Spec.Backup.Store.Provider)
 	Expect(err).NotTo(HaveOccurred())
 
 	Expect(*j).To(MatchFields(IgnoreExtras, Fields{
@@ -446,20 +445,20 @@ func validateEtcdForCompactionJob(instance *druidv1alpha1.Etcd, j *batchv1.Job)
 								}),
 							}),
 							"Env": MatchElements(testutils.EnvIterator, IgnoreExtras, Elements{
-								common.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvStorageContainer),
+								druidresources.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvStorageContainer),
 									"Value": Equal(*instance.Spec.Backup.Store.Container),
 								}),
-								common.EnvPodName: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodName),
+								druidresources.EnvPodName: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodName),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.name"),
 										})),
 									})),
 								}),
-								common.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodNamespace),
+								druidresources.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodNamespace),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.namespace"),
@@ -507,42 +506,42 @@ func validateStoreGCPForCompactionJob(instance *druidv1alpha1.Etcd, j *batchv1.J
 								fmt.Sprintf("%s=%s", "--store-container", *instance.Spec.Backup.Store.Container): Equal(fmt.Sprintf("%s=%s", "--store-container", *instance.Spec.Backup.Store.Container)),
 							}),
 							"VolumeMounts": MatchElements(testutils.VolumeMountIterator, IgnoreExtras, Elements{
-								common.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
-									"Name":      Equal(common.VolumeNameProviderBackupSecret),
-									"MountPath": Equal(common.VolumeMountPathGCSBackupSecret),
+								druidresources.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
+									"Name":      Equal(druidresources.VolumeNameProviderBackupSecret),
+									"MountPath": Equal(druidresources.VolumeMountPathGCSBackupSecret),
 								}),
 							}),
 							"Env": MatchAllElements(testutils.EnvIterator, Elements{
-								common.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvStorageContainer),
+								druidresources.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvStorageContainer),
 									"Value": Equal(*instance.Spec.Backup.Store.Container),
 								}),
-								common.EnvPodName: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodName),
+								druidresources.EnvPodName: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodName),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.name"),
 										})),
 									})),
 								}),
-								common.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodNamespace),
+								druidresources.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodNamespace),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.namespace"),
 										})),
 									})),
 								}),
-								common.EnvGoogleApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvGoogleApplicationCredentials),
+								druidresources.EnvGoogleApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvGoogleApplicationCredentials),
 									"Value": Equal("/var/.gcp/serviceaccount.json"),
 								}),
 							}),
 						}),
 					}),
 					"Volumes": MatchElements(testutils.VolumeIterator, IgnoreExtras, Elements{
-						common.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
-							"Name": Equal(common.VolumeNameProviderBackupSecret),
+						druidresources.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
+							"Name": Equal(druidresources.VolumeNameProviderBackupSecret),
 							"VolumeSource": MatchFields(IgnoreExtras, Fields{
 								"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
 									"SecretName": Equal(instance.Spec.Backup.Store.SecretRef.Name),
@@ -569,36 +568,36 @@ func validateStoreAWSForCompactionJob(instance *druidv1alpha1.Etcd, j *batchv1.J
 								fmt.Sprintf("%s=%s", "--store-container", *instance.Spec.Backup.Store.Container): Equal(fmt.Sprintf("%s=%s", "--store-container", *instance.Spec.Backup.Store.Container)),
 							}),
 							"Env": MatchAllElements(testutils.EnvIterator, Elements{
-								common.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvStorageContainer),
+								druidresources.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvStorageContainer),
 									"Value": Equal(*instance.Spec.Backup.Store.Container),
 								}),
-								common.EnvPodName: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodName),
+								druidresources.EnvPodName: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodName),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.name"),
 										})),
 									})),
 								}),
-								common.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodNamespace),
+								druidresources.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodNamespace),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.namespace"),
 										})),
 									})),
 								}),
-								common.EnvAWSApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvAWSApplicationCredentials),
-									"Value": Equal(common.VolumeMountPathNonGCSProviderBackupSecret),
+								druidresources.EnvAWSApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvAWSApplicationCredentials),
+									"Value": Equal(druidresources.VolumeMountPathNonGCSProviderBackupSecret),
 								}),
 							}),
 						}),
 					}),
 					"Volumes": MatchElements(testutils.VolumeIterator, IgnoreExtras, Elements{
-						common.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
-							"Name": Equal(common.VolumeNameProviderBackupSecret),
+						druidresources.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
+							"Name": Equal(druidresources.VolumeNameProviderBackupSecret),
 							"VolumeSource": MatchFields(IgnoreExtras, Fields{
 								"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
 									"SecretName": Equal(instance.Spec.Backup.Store.SecretRef.Name),
@@ -625,36 +624,36 @@ func validateStoreAzureForCompactionJob(instance *druidv1alpha1.Etcd, j *batchv1
 								fmt.Sprintf("%s=%s", "--store-container", *instance.Spec.Backup.Store.Container): Equal(fmt.Sprintf("%s=%s", "--store-container", *instance.Spec.Backup.Store.Container)),
 							}),
 							"Env": MatchAllElements(testutils.EnvIterator, Elements{
-								common.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvStorageContainer),
+								druidresources.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvStorageContainer),
 									"Value": Equal(*instance.Spec.Backup.Store.Container),
 								}),
-								common.EnvPodName: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodName),
+								druidresources.EnvPodName: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodName),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.name"),
 										})),
 									})),
 								}),
-								common.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodNamespace),
+								druidresources.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodNamespace),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.namespace"),
 										})),
 									})),
 								}),
-								common.EnvAzureApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvAzureApplicationCredentials),
-									"Value": Equal(common.VolumeMountPathNonGCSProviderBackupSecret),
+								druidresources.EnvAzureApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvAzureApplicationCredentials),
+									"Value": Equal(druidresources.VolumeMountPathNonGCSProviderBackupSecret),
 								}),
 							}),
 						}),
 					}),
 					"Volumes": MatchElements(testutils.VolumeIterator, IgnoreExtras, Elements{
-						common.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
-							"Name": Equal(common.VolumeNameProviderBackupSecret),
+						druidresources.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
+							"Name": Equal(druidresources.VolumeNameProviderBackupSecret),
 							"VolumeSource": MatchFields(IgnoreExtras, Fields{
 								"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
 									"SecretName": Equal(instance.Spec.Backup.Store.SecretRef.Name),
@@ -681,36 +680,36 @@ func validateStoreOpenstackForCompactionJob(instance *druidv1alpha1.Etcd, j *bat
 								fmt.Sprintf("%s=%s", "--store-container", *instance.Spec.Backup.Store.Container): Equal(fmt.Sprintf("%s=%s", "--store-container", *instance.Spec.Backup.Store.Container)),
 							}),
 							"Env": MatchAllElements(testutils.EnvIterator, Elements{
-								common.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvStorageContainer),
+								druidresources.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvStorageContainer),
 									"Value": Equal(*instance.Spec.Backup.Store.Container),
 								}),
-								common.EnvPodName: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodName),
+								druidresources.EnvPodName: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodName),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.name"),
 										})),
 									})),
 								}),
-								common.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodNamespace),
+								druidresources.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodNamespace),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.namespace"),
 										})),
 									})),
 								}),
-								common.EnvOpenstackApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvOpenstackApplicationCredentials),
-									"Value": Equal(common.VolumeMountPathNonGCSProviderBackupSecret),
+								druidresources.EnvOpenstackApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvOpenstackApplicationCredentials),
+									"Value": Equal(druidresources.VolumeMountPathNonGCSProviderBackupSecret),
 								}),
 							}),
 						}),
 					}),
 					"Volumes": MatchElements(testutils.VolumeIterator, IgnoreExtras, Elements{
-						common.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
-							"Name": Equal(common.VolumeNameProviderBackupSecret),
+						druidresources.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
+							"Name": Equal(druidresources.VolumeNameProviderBackupSecret),
 							"VolumeSource": MatchFields(IgnoreExtras, Fields{
 								"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
 									"SecretName": Equal(instance.Spec.Backup.Store.SecretRef.Name),
@@ -738,36 +737,36 @@ func validateStoreAlicloudForCompactionJob(instance *druidv1alpha1.Etcd, j *batc
 							}),
 							"ImagePullPolicy": Equal(corev1.PullIfNotPresent),
 							"Env": MatchAllElements(testutils.EnvIterator, Elements{
-								common.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvStorageContainer),
+								druidresources.EnvStorageContainer: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvStorageContainer),
 									"Value": Equal(*instance.Spec.Backup.Store.Container),
 								}),
-								common.EnvPodName: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodName),
+								druidresources.EnvPodName: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodName),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.name"),
 										})),
 									})),
 								}),
-								common.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
-									"Name": Equal(common.EnvPodNamespace),
+								druidresources.EnvPodNamespace: MatchFields(IgnoreExtras, Fields{
+									"Name": Equal(druidresources.EnvPodNamespace),
 									"ValueFrom": PointTo(MatchFields(IgnoreExtras, Fields{
 										"FieldRef": PointTo(MatchFields(IgnoreExtras, Fields{
 											"FieldPath": Equal("metadata.namespace"),
 										})),
 									})),
 								}),
-								common.EnvAlicloudApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-									"Name":  Equal(common.EnvAlicloudApplicationCredentials),
-									"Value": Equal(common.VolumeMountPathNonGCSProviderBackupSecret),
+								druidresources.EnvAlicloudApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+									"Name":  Equal(druidresources.EnvAlicloudApplicationCredentials),
+									"Value": Equal(druidresources.VolumeMountPathNonGCSProviderBackupSecret),
 								}),
 							}),
 						}),
 					}),
 					"Volumes": MatchElements(testutils.VolumeIterator, IgnoreExtras, Elements{
-						common.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
-							"Name": Equal(common.VolumeNameProviderBackupSecret),
+						druidresources.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
+							"Name": Equal(druidresources.VolumeNameProviderBackupSecret),
 							"VolumeSource": MatchFields(IgnoreExtras, Fields{
 								"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
 									"SecretName": Equal(instance.Spec.Backup.Store.SecretRef.Name),
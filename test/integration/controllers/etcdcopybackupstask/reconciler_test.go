@@ -10,8 +10,8 @@ import (
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 	"github.com/gardener/etcd-druid/internal/common"
-	druidstore "github.com/gardener/etcd-druid/internal/store"
 	"github.com/gardener/etcd-druid/internal/utils/imagevector"
 	"github.com/gardener/etcd-druid/internal/utils/kubernetes"
 	testutils "github.com/gardener/etcd-druid/test/utils"
@@ -122,9 +122,9 @@ var _ = Describe("EtcdCopyBackupsTask Controller", func() {
 })
 
 func matchJob(task *druidv1alpha1.EtcdCopyBackupsTask, imageVector imagevector.ImageVector) gomegatypes.GomegaMatcher {
-	sourceProvider, err := druidstore.StorageProviderFromInfraProvider(task.Spec.SourceStore.Provider)
+	sourceProvider, err := druidresources.StorageProviderFromInfraProvider(task.Spec.SourceStore.Provider)
 	Expect(err).NotTo(HaveOccurred())
-	targetProvider, err := druidstore.StorageProviderFromInfraProvider(task.Spec.TargetStore.Provider)
+	targetProvider, err := druidresources.StorageProviderFromInfraProvider(task.Spec.TargetStore.Provider)
 	Expect(err).NotTo(HaveOccurred())
 
 	images, err := imagevector.FindImages(imageVector, []string{common.ImageKeyEtcdBackupRestore})
@@ -136,7 +136,7 @@ func matchJob(task *druidv1alpha1.EtcdCopyBackupsTask, imageVector imagevector.I
 			"Name":      Equal(task.Name + "-worker"),
 			"Namespace": Equal(task.Namespace),
 			"Labels": MatchKeys(IgnoreExtras, Keys{
-				druidv1alpha1.LabelComponentKey: Equal(common.ComponentNameEtcdCopyBackupsJob),
+				druidv1alpha1.LabelComponentKey: Equal(druidv1alpha1.ComponentNameEtcdCopyBackupsJob),
 				druidv1alpha1.LabelPartOfKey:    Equal(task.Name),
 				druidv1alpha1.LabelManagedByKey: Equal(druidv1alpha1.LabelManagedByValue),
 				druidv1alpha1.LabelAppNameKey:   Equal(task.GetJobName()),
@@ -156,7 +156,7 @@ func matchJob(task *druidv1alpha1.EtcdCopyBackupsTask, imageVector imagevector.I
 			"Template": MatchFields(IgnoreExtras, Fields{
 				"ObjectMeta": MatchFields(IgnoreExtras, Fields{
 					"Labels": MatchKeys(IgnoreExtras, Keys{
-						druidv1alpha1.LabelComponentKey: Equal(common.ComponentNameEtcdCopyBackupsJob),
+						druidv1alpha1.LabelComponentKey: Equal(druidv1alpha1.ComponentNameEtcdCopyBackupsJob),
 						druidv1alpha1.LabelPartOfKey:    Equal(task.Name),
 						druidv1alpha1.LabelManagedByKey: Equal(druidv1alpha1.LabelManagedByValue),
 						druidv1alpha1.LabelAppNameKey:   Equal(task.GetJobName()),
@@ -222,14 +222,14 @@ func getArgElements(task *druidv1alpha1.EtcdCopyBackupsTask, sourceProvider, tar
 func getEnvElements(task *druidv1alpha1.EtcdCopyBackupsTask) Elements {
 	elements := Elements{}
 	if task.Spec.TargetStore.Container != nil && *task.Spec.TargetStore.Container != "" {
-		elements[common.EnvStorageContainer] = MatchFields(IgnoreExtras, Fields{
-			"Name":  Equal(common.EnvStorageContainer),
+		elements[druidresources.EnvStorageContainer] = MatchFields(IgnoreExtras, Fields{
+			"Name":  Equal(druidresources.EnvStorageContainer),
 			"Value": Equal(*task.Spec.TargetStore.Container),
 		})
 	}
 	if task.Spec.SourceStore.Container != nil && *task.Spec.SourceStore.Container != "" {
-		elements[common.EnvSourceStorageContainer] = MatchFields(IgnoreExtras, Fields{
-			"Name":  Equal(common.EnvSourceStorageContainer),
+		elements[druidresources.EnvSourceStorageContainer] = MatchFields(IgnoreExtras, Fields{
+			"Name":  Equal(druidresources.EnvSourceStorageContainer),
 			"Value": Equal(*task.Spec.SourceStore.Container),
 		})
 	}
@@ -283,43 +283,43 @@ func getProviderEnvElements(storeProvider, prefix, volumePrefix string) Elements
 	switch storeProvider {
 	case "S3":
 		return Elements{
-			prefix + common.EnvAWSApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvAWSApplicationCredentials),
+			prefix + druidresources.EnvAWSApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvAWSApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
 	case "ABS":
 		return Elements{
-			prefix + common.EnvAzureApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvAzureApplicationCredentials),
+			prefix + druidresources.EnvAzureApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvAzureApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
 	case "GCS":
 		return Elements{
-			prefix + common.EnvGoogleApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvGoogleApplicationCredentials),
+			prefix + druidresources.EnvGoogleApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvGoogleApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/.%sgcp/serviceaccount.json", volumePrefix)),
 			}),
 		}
 	case "Swift":
 		return Elements{
-			prefix + common.EnvOpenstackApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvOpenstackApplicationCredentials),
+			prefix + druidresources.EnvOpenstackApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvOpenstackApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
 	case "OSS":
 		return Elements{
-			prefix + common.EnvAlicloudApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvAlicloudApplicationCredentials),
+			prefix + druidresources.EnvAlicloudApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvAlicloudApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
 	case "OCS":
 		return Elements{
-			prefix + common.EnvOpenshiftApplicationCredentials: MatchFields(IgnoreExtras, Fields{
-				"Name":  Equal(prefix + common.EnvOpenshiftApplicationCredentials),
+			prefix + druidresources.EnvOpenshiftApplicationCredentials: MatchFields(IgnoreExtras, Fields{
+				"Name":  Equal(prefix + druidresources.EnvOpenshiftApplicationCredentials),
 				"Value": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
@@ -332,15 +332,15 @@ func getVolumeMountsElements(storeProvider, volumePrefix string) Elements {
 	switch storeProvider {
 	case "GCS":
 		return Elements{
-			volumePrefix + common.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
-				"Name":      Equal(volumePrefix + common.VolumeNameProviderBackupSecret),
+			volumePrefix + druidresources.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
+				"Name":      Equal(volumePrefix + druidresources.VolumeNameProviderBackupSecret),
 				"MountPath": Equal(fmt.Sprintf("/var/.%sgcp/", volumePrefix)),
 			}),
 		}
 	default:
 		return Elements{
-			volumePrefix + common.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
-				"Name":      Equal(volumePrefix + common.VolumeNameProviderBackupSecret),
+			volumePrefix + druidresources.VolumeNameProviderBackupSecret: MatchFields(IgnoreExtras, Fields{
+				"Name":      Equal(volumePrefix + druidresources.VolumeNameProviderBackupSecret),
 				"MountPath": Equal(fmt.Sprintf("/var/%setcd-backup", volumePrefix)),
 			}),
 		}
@@ -350,12 +350,12 @@ func getVolumeMountsElements(storeProvider, volumePrefix string) Elements {
 func getVolumesElements(volumePrefix string, store *druidv1alpha1.StoreSpec) Elements {
 
 	return Elements{
-		volumePrefix + common.VolumeNameProviderBackupSecret: MatchAllFields(Fields{
-			"Name": Equal(volumePrefix + common.VolumeNameProviderBackupSecret),
+		volumePrefix + druidresources.VolumeNameProviderBackupSecret: MatchAllFields(Fields{
+			"Name": Equal(volumePrefix + druidresources.VolumeNameProviderBackupSecret),
 			"VolumeSource": MatchFields(IgnoreExtras, Fields{
 				"Secret": PointTo(MatchFields(IgnoreExtras, Fields{
 					"SecretName":  Equal(store.SecretRef.Name),
-					"DefaultMode": Equal(ptr.To(common.ModeOwnerReadWriteGroupRead)),
+					"DefaultMode": Equal(ptr.To(druidresources.ModeOwnerReadWriteGroupRead)),
 				})),
 			}),
 		}),
@@ -50,7 +50,7 @@ func initializeEtcdReconcilerTestEnv(t *testing.T, controllerName string, itTest
 					NotReadyThreshold: metav1.Duration{Duration: 5 * time.Minute},
 					UnknownThreshold:  metav1.Duration{Duration: 1 * time.Minute},
 				},
-			}, assets.CreateImageVector(g))
+			}, true, assets.CreateImageVector(g))
 		g.Expect(err).ToNot(HaveOccurred())
 		g.Expect(reconciler.RegisterWithManager(mgr, controllerName)).To(Succeed())
 	})
@@ -487,7 +487,7 @@ func testPartialDeletionFailureOfEtcdResourcesWhenEtcdMarkedForDeletion(t *testi
 	testClientBuilder := testutils.NewTestClientBuilder().
 		RecordErrorForObjects(testutils.ClientMethodDelete, testutils.TestAPIInternalErr, client.ObjectKey{Name: druidv1alpha1.GetClientServiceName(etcdInstance.ObjectMeta), Namespace: etcdInstance.Namespace}).
 		RecordErrorForObjectsMatchingLabels(testutils.ClientMethodDeleteAll, etcdInstance.Namespace, map[string]string{
-			druidv1alpha1.LabelComponentKey: common.ComponentNameSnapshotLease,
+			druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameSnapshotLease,
 			druidv1alpha1.LabelManagedByKey: druidv1alpha1.LabelManagedByValue,
 			druidv1alpha1.LabelPartOfKey:    etcdInstance.Name,
 		}, testutils.TestAPIInternalErr)
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Testing validations of etcd.spec.etcd.auth fields.
+
+package etcd
+
+import (
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/test/utils"
+
+	"k8s.io/utils/ptr"
+)
+
+// validates the users and roles of etcd.spec.etcd.auth and their combination with the TLS configuration.
+func TestValidateSpecEtcdAuth(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	roles := []druidv1alpha1.EtcdAuthRole{{
+		Name:        "app-a",
+		Permissions: []druidv1alpha1.EtcdAuthPermission{{KeyPrefix: "/app-a/", Type: druidv1alpha1.EtcdAuthPermissionTypeReadWrite}},
+	}}
+	tests := []struct {
+		name                string
+		etcdName            string
+		auth                *druidv1alpha1.EtcdAuth
+		managedCertificates bool
+		expectErr           bool
+	}{
+		{
+			name:                "users with defined roles and managedCertificates; valid",
+			etcdName:            "etcd-auth-1",
+			auth:                &druidv1alpha1.EtcdAuth{Roles: roles, Users: []druidv1alpha1.EtcdAuthUser{{Name: "app-a", Roles: []string{"app-a"}, IssueClientCertificate: ptr.To(true)}}},
+			managedCertificates: true,
+		},
+		{
+			name:      "auth without TLS for client communication; invalid",
+			etcdName:  "etcd-auth-2",
+			auth:      &druidv1alpha1.EtcdAuth{Roles: roles},
+			expectErr: true,
+		},
+		{
+			name:                "user with undefined role; invalid",
+			etcdName:            "etcd-auth-3",
+			auth:                &druidv1alpha1.EtcdAuth{Roles: roles, Users: []druidv1alpha1.EtcdAuthUser{{Name: "app-b", Roles: []string{"app-b"}}}},
+			managedCertificates: true,
+			expectErr:           true,
+		},
+		{
+			name:                "user named root; invalid",
+			etcdName:            "etcd-auth-4",
+			auth:                &druidv1alpha1.EtcdAuth{Users: []druidv1alpha1.EtcdAuthUser{{Name: "root"}}},
+			managedCertificates: true,
+			expectErr:           true,
+		},
+		{
+			name:                "role named root; invalid",
+			etcdName:            "etcd-auth-5",
+			auth:                &druidv1alpha1.EtcdAuth{Roles: []druidv1alpha1.EtcdAuthRole{{Name: "root"}}},
+			managedCertificates: true,
+			expectErr:           true,
+		},
+		{
+			name:      "client certificate for user without managedCertificates; invalid",
+			etcdName:  "etcd-auth-6",
+			auth:      &druidv1alpha1.EtcdAuth{Users: []druidv1alpha1.EtcdAuthUser{{Name: "app-a", IssueClientCertificate: ptr.To(true)}}},
+			expectErr: true,
+		},
+		{
+			name:                "user named after the client certificate of etcd-backup-restore; invalid",
+			etcdName:            "etcd-auth-7",
+			auth:                &druidv1alpha1.EtcdAuth{Users: []druidv1alpha1.EtcdAuthUser{{Name: "etcd-auth-7-client"}}},
+			managedCertificates: true,
+			expectErr:           true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.Etcd.Auth = test.auth
+			if test.managedCertificates {
+				etcd.Spec.ManagedCertificates = &druidv1alpha1.ManagedCertificatesSpec{}
+			}
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
@@ -8,9 +8,13 @@ package etcd
 
 import (
 	"testing"
+	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
 	"github.com/gardener/etcd-druid/test/utils"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 // TestValidateGarbageCollectionPolicy tests the validation of `Spec.Backup.GarbageCollectionPolicy` field in the Etcd resource.
@@ -307,3 +311,86 @@ func TestValidateSpecBackupFullSnapshotSchedule(t *testing.T) {
 		})
 	}
 }
+
+// validates that etcd.spec.backup.restoreFrom can only be set together with etcd.spec.backup.store.
+func TestValidateSpecBackupRestoreFromRequiresStore(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	tests := []struct {
+		name      string
+		etcdName  string
+		store     *druidv1alpha1.StoreSpec
+		expectErr bool
+	}{
+		{
+			name:      "restoreFrom with store; valid",
+			etcdName:  "etcd-restore-from-1",
+			store:     &druidv1alpha1.StoreSpec{Prefix: "etcd-restore-from-1"},
+			expectErr: false,
+		},
+		{
+			name:      "restoreFrom without store; invalid",
+			etcdName:  "etcd-restore-from-2",
+			expectErr: true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.Backup.Store = test.store
+			etcd.Spec.Backup.RestoreFrom = &druidv1alpha1.RestoreFromSpec{
+				Store: druidv1alpha1.StoreSpec{Prefix: "etcd-source"},
+			}
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
+
+// validates that etcd.spec.backup.restoreFrom.revision and etcd.spec.backup.restoreFrom.timestamp are mutually exclusive.
+func TestValidateSpecBackupRestoreFromPointInTime(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	tests := []struct {
+		name      string
+		etcdName  string
+		revision  *int64
+		timestamp *metav1.Time
+		expectErr bool
+	}{
+		{
+			name:      "restoreFrom with revision; valid",
+			etcdName:  "etcd-restore-from-pit-1",
+			revision:  ptr.To[int64](42),
+			expectErr: false,
+		},
+		{
+			name:      "restoreFrom with timestamp; valid",
+			etcdName:  "etcd-restore-from-pit-2",
+			timestamp: &metav1.Time{Time: time.Date(2026, 10, 1, 6, 30, 0, 0, time.UTC)},
+			expectErr: false,
+		},
+		{
+			name:      "restoreFrom with revision and timestamp; invalid",
+			etcdName:  "etcd-restore-from-pit-3",
+			revision:  ptr.To[int64](42),
+			timestamp: &metav1.Time{Time: time.Date(2026, 10, 1, 6, 30, 0, 0, time.UTC)},
+			expectErr: true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.Backup.Store = &druidv1alpha1.StoreSpec{Prefix: test.etcdName}
+			etcd.Spec.Backup.RestoreFrom = &druidv1alpha1.RestoreFromSpec{
+				Store:     druidv1alpha1.StoreSpec{Prefix: "etcd-source"},
+				Revision:  test.revision,
+				Timestamp: test.timestamp,
+			}
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Testing validations of etcd.spec.healthChecks fields.
+
+package etcd
+
+import (
+	"testing"
+
+	druidapicommon "github.com/gardener/etcd-druid/api/common"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/test/utils"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+// validates the condition types and the kinds of the custom health checks in etcd.spec.healthChecks.
+func TestValidateSpecHealthChecks(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	httpCheck := &druidapicommon.HTTPHealthCheck{Path: "/health"}
+	tests := []struct {
+		name         string
+		etcdName     string
+		healthChecks []druidv1alpha1.EtcdHealthCheck
+		expectErr    bool
+	}{
+		{
+			name:     "http and metric checks; valid",
+			etcdName: "etcd-healthchecks-1",
+			healthChecks: []druidv1alpha1.EtcdHealthCheck{
+				{ConditionType: "Healthy", HTTP: httpCheck},
+				{ConditionType: "DBSizeBelowLimit", Metric: &druidapicommon.MetricHealthCheck{Name: "etcd_mvcc_db_total_size_in_bytes", Max: ptr.To(resource.MustParse("6Gi"))}},
+			},
+		},
+		{
+			name:         "condition type maintained by etcd-druid; invalid",
+			etcdName:     "etcd-healthchecks-2",
+			healthChecks: []druidv1alpha1.EtcdHealthCheck{{ConditionType: "BackupReady", HTTP: httpCheck}},
+			expectErr:    true,
+		},
+		{
+			name:         "condition type not starting with an upper case letter; invalid",
+			etcdName:     "etcd-healthchecks-3",
+			healthChecks: []druidv1alpha1.EtcdHealthCheck{{ConditionType: "healthy", HTTP: httpCheck}},
+			expectErr:    true,
+		},
+		{
+			name:         "duplicate condition types; invalid",
+			etcdName:     "etcd-healthchecks-4",
+			healthChecks: []druidv1alpha1.EtcdHealthCheck{{ConditionType: "Healthy", HTTP: httpCheck}, {ConditionType: "Healthy", HTTP: httpCheck}},
+			expectErr:    true,
+		},
+		{
+			name:         "check without kind; invalid",
+			etcdName:     "etcd-healthchecks-5",
+			healthChecks: []druidv1alpha1.EtcdHealthCheck{{ConditionType: "Healthy"}},
+			expectErr:    true,
+		},
+		{
+			name:         "metric check without thresholds; invalid",
+			etcdName:     "etcd-healthchecks-6",
+			healthChecks: []druidv1alpha1.EtcdHealthCheck{{ConditionType: "DBSizeBelowLimit", Metric: &druidapicommon.MetricHealthCheck{Name: "etcd_mvcc_db_total_size_in_bytes"}}},
+			expectErr:    true,
+		},
+		{
+			name:         "check with http and metric; invalid",
+			etcdName:     "etcd-healthchecks-7",
+			healthChecks: []druidv1alpha1.EtcdHealthCheck{{ConditionType: "Healthy", HTTP: httpCheck, Metric: &druidapicommon.MetricHealthCheck{Name: "etcd_mvcc_db_total_size_in_bytes", Max: ptr.To(resource.MustParse("6Gi"))}}},
+			expectErr:    true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.HealthChecks = test.healthChecks
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
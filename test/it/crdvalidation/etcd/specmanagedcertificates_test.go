@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Testing validations of etcd.spec.managedCertificates fields.
+
+package etcd
+
+import (
+	"testing"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	"github.com/gardener/etcd-druid/test/utils"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// validates that etcd.spec.managedCertificates cannot be combined with explicitly configured TLS secrets.
+func TestValidateSpecManagedCertificatesExclusiveWithTLS(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	tlsConfig := druidv1alpha1.TLSConfig{
+		TLSCASecretRef:     druidv1alpha1.SecretReference{SecretReference: corev1.SecretReference{Name: "ca-etcd"}},
+		ServerTLSSecretRef: corev1.SecretReference{Name: "etcd-server-tls"},
+	}
+	tests := []struct {
+		name         string
+		etcdName     string
+		clientURLTLS *druidv1alpha1.TLSConfig
+		peerURLTLS   *druidv1alpha1.PeerTLSConfig
+		backupTLS    *druidv1alpha1.TLSConfig
+		expectErr    bool
+	}{
+		{
+			name:     "managedCertificates without TLS secrets; valid",
+			etcdName: "etcd-managed-certs-1",
+		},
+		{
+			name:         "managedCertificates with clientUrlTls; invalid",
+			etcdName:     "etcd-managed-certs-2",
+			clientURLTLS: &tlsConfig,
+			expectErr:    true,
+		},
+		{
+			name:       "managedCertificates with peerUrlTls; invalid",
+			etcdName:   "etcd-managed-certs-3",
+			peerURLTLS: &druidv1alpha1.PeerTLSConfig{TLSConfig: tlsConfig},
+			expectErr:  true,
+		},
+		{
+			name:      "managedCertificates with backup tls; invalid",
+			etcdName:  "etcd-managed-certs-4",
+			backupTLS: &tlsConfig,
+			expectErr: true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.ManagedCertificates = &druidv1alpha1.ManagedCertificatesSpec{}
+			etcd.Spec.Etcd.ClientUrlTLS = test.clientURLTLS
+			etcd.Spec.Etcd.PeerUrlTLS = test.peerURLTLS
+			etcd.Spec.Backup.TLS = test.backupTLS
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
+
+// validates that etcd.spec.managedCertificates cannot be combined with peers which are not managed by etcd-druid.
+func TestValidateSpecManagedCertificatesExclusiveWithExternalPeers(t *testing.T) {
+	skipCELTestsForOlderK8sVersions(t)
+	tests := []struct {
+		name                         string
+		etcdName                     string
+		additionalAdvertisePeerURLs  []druidv1alpha1.MemberPeerURLs
+		bootstrapWithExistingCluster *druidv1alpha1.BootstrapWithExistingCluster
+		expectErr                    bool
+	}{
+		{
+			name:                        "managedCertificates with additionalAdvertisePeerURLs; invalid",
+			etcdName:                    "etcd-managed-certs-5",
+			additionalAdvertisePeerURLs: []druidv1alpha1.MemberPeerURLs{{MemberName: "etcd-managed-certs-5-0", URLs: []string{"http://10.0.0.1:2380"}}},
+			expectErr:                   true,
+		},
+		{
+			name:     "managedCertificates with bootstrapWithExistingCluster; invalid",
+			etcdName: "etcd-managed-certs-6",
+			bootstrapWithExistingCluster: &druidv1alpha1.BootstrapWithExistingCluster{
+				Members:         []druidv1alpha1.BootstrapExistingMember{{Name: "source-0", PeerURLs: []string{"http://10.0.0.1:2380"}}},
+				ClientEndpoints: []string{"http://10.0.0.1:2379"},
+			},
+			expectErr: true,
+		},
+	}
+
+	testNs, g := setupTestEnvironment(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etcd := utils.EtcdBuilderWithoutDefaults(test.etcdName, testNs).WithReplicas(3).Build()
+			etcd.Spec.ManagedCertificates = &druidv1alpha1.ManagedCertificatesSpec{}
+			etcd.Spec.Etcd.AdditionalAdvertisePeerURLs = test.additionalAdvertisePeerURLs
+			etcd.Spec.Etcd.BootstrapWithExistingCluster = test.bootstrapWithExistingCluster
+			validateEtcdCreation(g, etcd, test.expectErr)
+		})
+	}
+}
@@ -9,7 +9,7 @@ import (
 	"os"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/store"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 
 	"k8s.io/utils/ptr"
 )
@@ -35,15 +35,15 @@ func GetEnvOrDefault(key, defaultValue string) string {
 // Returns nil if no emulator endpoint is configured for the given provider.
 func GetEmulatorEndpoint(provider druidv1alpha1.StorageProvider) *string {
 	switch provider {
-	case store.S3, "aws":
+	case druidresources.S3, "aws":
 		if host := GetEnvOrDefault("LOCALSTACK_HOST", ""); host != "" {
 			return ptr.To("http://" + host)
 		}
-	case store.ABS, "azure":
+	case druidresources.ABS, "azure":
 		if host := GetEnvOrDefault("AZURITE_DOMAIN", ""); host != "" {
 			return ptr.To("http://" + host + "/devstoreaccount1/")
 		}
-	case store.GCS, "gcp":
+	case druidresources.GCS, "gcp":
 		if host := GetEnvOrDefault("FAKEGCS_HOST", ""); host != "" {
 			return ptr.To("http://" + host + "/storage/v1/b")
 		}
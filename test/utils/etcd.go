@@ -11,8 +11,7 @@ import (
 
 	druidapicommon "github.com/gardener/etcd-druid/api/common"
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
-	"github.com/gardener/etcd-druid/internal/store"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 
 	"github.com/google/uuid"
 	corev1 "k8s.io/api/core/v1"
@@ -42,7 +41,7 @@ var (
 	autoCompactionRetention = "2m"
 	snapshotCount           = int64(10000)
 	quota                   = resource.MustParse("8Gi")
-	localProvider           = druidv1alpha1.StorageProvider(store.Local)
+	localProvider           = druidv1alpha1.StorageProvider(druidresources.Local)
 	prefix                  = "/tmp"
 	volumeClaimTemplateName = "etcd-main"
 	garbageCollectionPolicy = druidv1alpha1.GarbageCollectionPolicy(druidv1alpha1.GarbageCollectionPolicyExponential)
@@ -304,17 +303,17 @@ func (eb *EtcdBuilder) WithStorageProvider(provider druidv1alpha1.StorageProvide
 	// TODO: there is no default case right now which is not very right, returning an error in a default case makes it difficult to chain
 	// This should be improved later
 	switch provider {
-	case store.S3, "aws":
+	case druidresources.S3, "aws":
 		return eb.WithProviderS3(prefix)
-	case store.ABS, "azure":
+	case druidresources.ABS, "azure":
 		return eb.WithProviderABS(prefix)
-	case store.GCS, "gcp":
+	case druidresources.GCS, "gcp":
 		return eb.WithProviderGCS(prefix)
-	case store.Swift, "openstack":
+	case druidresources.Swift, "openstack":
 		return eb.WithProviderSwift(prefix)
-	case store.Local, "local":
+	case druidresources.Local, "local":
 		return eb.WithProviderLocal(prefix)
-	case store.OSS, "alicloud":
+	case druidresources.OSS, "alicloud":
 		return eb.WithProviderOSS(prefix)
 	case "none":
 		return eb.WithoutProvider()
@@ -632,9 +631,9 @@ func getDefaultEtcd(name, namespace string) *druidv1alpha1.Etcd {
 						"memory": ParseQuantity("1000Mi"),
 					},
 				},
-				ClientPort:  ptr.To(common.DefaultPortEtcdClient),
-				ServerPort:  ptr.To(common.DefaultPortEtcdPeer),
-				WrapperPort: ptr.To(common.DefaultPortEtcdWrapper),
+				ClientPort:  ptr.To(druidv1alpha1.DefaultPortEtcdClient),
+				ServerPort:  ptr.To(druidv1alpha1.DefaultPortEtcdPeer),
+				WrapperPort: ptr.To(druidv1alpha1.DefaultPortEtcdWrapper),
 			},
 			Common: druidv1alpha1.SharedConfig{
 				AutoCompactionMode:      &autoCompactionMode,
@@ -647,7 +646,7 @@ func getDefaultEtcd(name, namespace string) *druidv1alpha1.Etcd {
 // getBackupSpec returns a BackupSpec with default values set.
 func getBackupSpec() druidv1alpha1.BackupSpec {
 	return druidv1alpha1.BackupSpec{
-		Port:                     ptr.To(common.DefaultPortEtcdBackupRestore),
+		Port:                     ptr.To(druidv1alpha1.DefaultPortEtcdBackupRestore),
 		FullSnapshotSchedule:     &snapshotSchedule,
 		GarbageCollectionPolicy:  &garbageCollectionPolicy,
 		GarbageCollectionPeriod:  &garbageCollectionPeriod,
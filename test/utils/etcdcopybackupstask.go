@@ -8,7 +8,6 @@ import (
 	"time"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -110,7 +109,7 @@ func getLabels(taskName, jobName string) map[string]string {
 	return map[string]string{
 		druidv1alpha1.LabelPartOfKey:    taskName,
 		druidv1alpha1.LabelManagedByKey: druidv1alpha1.LabelManagedByValue,
-		druidv1alpha1.LabelComponentKey: common.ComponentNameEtcdCopyBackupsJob,
+		druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameEtcdCopyBackupsJob,
 		druidv1alpha1.LabelAppNameKey:   jobName,
 	}
 }
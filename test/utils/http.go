@@ -22,3 +22,11 @@ type MockRoundTripper struct {
 func (m *MockRoundTripper) RoundTrip(_ *http.Request) (*http.Response, error) {
 	return m.Response, m.Err
 }
+
+// RoundTripperFunc is an implementation of http.RoundTripper which handles all requests with the function.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip handles the request with the RoundTripperFunc.
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -11,7 +11,7 @@ import (
 	"os"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/store"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -45,12 +45,12 @@ func CreateSecrets(ctx context.Context, c client.Client, namespace string, secre
 // GenerateBackupSecretData generates backup secret data based on the specified provider.
 func GenerateBackupSecretData(provider druidv1alpha1.StorageProvider) (map[string][]byte, error) {
 	switch provider {
-	case store.Local:
+	case druidresources.Local:
 		return map[string][]byte{
-			"hostPath": []byte(store.LocalProviderDefaultMountPath),
+			"hostPath": []byte(druidresources.LocalProviderDefaultMountPath),
 		}, nil
 
-	case store.S3:
+	case druidresources.S3:
 		awsAccessKeyID, err := GetEnvOrError("AWS_ACCESS_KEY_ID")
 		if err != nil {
 			return nil, err
@@ -75,7 +75,7 @@ func GenerateBackupSecretData(provider druidv1alpha1.StorageProvider) (map[strin
 		}
 		return data, nil
 
-	case store.ABS:
+	case druidresources.ABS:
 		azureStorageAccountName, err := GetEnvOrError("AZ_STORAGE_ACCOUNT_NAME")
 		if err != nil {
 			return nil, err
@@ -91,7 +91,7 @@ func GenerateBackupSecretData(provider druidv1alpha1.StorageProvider) (map[strin
 		}
 		return data, nil
 
-	case store.GCS:
+	case druidresources.GCS:
 		gcpServiceAccountJSONPath, err := GetEnvOrError("GCP_SERVICEACCOUNT_JSON_PATH")
 		if err != nil {
 			return nil, err
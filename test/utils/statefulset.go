@@ -8,7 +8,7 @@ import (
 	"fmt"
 
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
-	"github.com/gardener/etcd-druid/internal/common"
+	druidresources "github.com/gardener/etcd-druid/api/resources"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -27,7 +27,7 @@ func CreateStatefulSet(name, namespace string, etcdUID types.UID, replicas int32
 			Labels: map[string]string{
 				druidv1alpha1.LabelManagedByKey: druidv1alpha1.LabelManagedByValue,
 				druidv1alpha1.LabelPartOfKey:    name,
-				druidv1alpha1.LabelComponentKey: common.ComponentNameStatefulSet,
+				druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameStatefulSet,
 				druidv1alpha1.LabelAppNameKey:   name,
 			},
 			Annotations: nil,
@@ -55,7 +55,7 @@ func CreateStatefulSet(name, namespace string, etcdUID types.UID, replicas int32
 					Labels: map[string]string{
 						druidv1alpha1.LabelManagedByKey: druidv1alpha1.LabelManagedByValue,
 						druidv1alpha1.LabelPartOfKey:    name,
-						druidv1alpha1.LabelComponentKey: common.ComponentNameStatefulSet,
+						druidv1alpha1.LabelComponentKey: druidv1alpha1.ComponentNameStatefulSet,
 						druidv1alpha1.LabelAppNameKey:   name,
 					},
 				},
@@ -88,7 +88,7 @@ func CreateStatefulSet(name, namespace string, etcdUID types.UID, replicas int32
 // template, pointing at the named secret. Returns the StatefulSet for chaining.
 func AddBackupRestoreCAVolume(sts *appsv1.StatefulSet, etcdbrCASecretName string) *appsv1.StatefulSet {
 	sts.Spec.Template.Spec.Volumes = append(sts.Spec.Template.Spec.Volumes, corev1.Volume{
-		Name: common.VolumeNameBackupRestoreCA,
+		Name: druidresources.VolumeNameBackupRestoreCA,
 		VolumeSource: corev1.VolumeSource{
 			Secret: &corev1.SecretVolumeSource{SecretName: etcdbrCASecretName},
 		},